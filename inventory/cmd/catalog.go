@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/catalog"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	inventoryService "github.com/baryshnikkov/rocket-factory/inventory/internal/service/part"
+	sharedDatabase "github.com/baryshnikkov/rocket-factory/shared/pkg/database"
+)
+
+// runCatalogCommand выполняет подкоманды import и export над хранилищем из INVENTORY_STORAGE.
+func runCatalogCommand(ctx context.Context, command string, args []string) (err error) {
+	flags := flag.NewFlagSet(command, flag.ContinueOnError)
+	format := flags.String("format", string(model.CatalogFormatJSONL), "формат файла: jsonl или csv")
+	file := flags.String("file", "", "путь к файлу (по умолчанию stdin/stdout)")
+	dryRun := flags.Bool("dry-run", false, "import: только показать изменения")
+	prune := flags.Bool("prune", false, "import: удалить детали, которых нет в файле")
+
+	if command != "import" && command != "export" {
+		return fmt.Errorf("unknown command %q, expected import or export", command)
+	}
+	if err = flags.Parse(args); err != nil {
+		return err
+	}
+
+	repository, closeRepository, err := newRepository(ctx)
+	if err != nil {
+		return fmt.Errorf("init inventory repository: %w", err)
+	}
+	defer func() {
+		if closeErr := closeRepository(); closeErr != nil {
+			log.Printf("failed to close inventory repository: %v\n", closeErr)
+		}
+	}()
+	if storage := os.Getenv(storageEnv); storage == "" || storage == sharedDatabase.StorageMemory {
+		log.Printf("⚠️ Хранилище %s не сохраняет данные между запусками\n", sharedDatabase.StorageMemory)
+	}
+
+	service := inventoryService.NewService(repository)
+	catalogFormat := model.CatalogFormat(strings.ToLower(*format))
+
+	if command == "export" {
+		parts, err := service.ExportParts(ctx)
+		if err != nil {
+			return err
+		}
+		return writeCatalog(*file, func(w io.Writer) error {
+			return catalog.Encode(catalogFormat, w, parts)
+		})
+	}
+
+	var rows []model.ImportRow
+	err = readCatalog(*file, func(r io.Reader) error {
+		rows, err = catalog.Decode(catalogFormat, r)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	report, err := service.ImportParts(ctx, rows, model.ImportOptions{DryRun: *dryRun, Prune: *prune})
+	if err != nil {
+		return err
+	}
+	printImportReport(os.Stdout, report, *dryRun)
+
+	if len(report.Errors) > 0 {
+		return fmt.Errorf("%d rows failed", len(report.Errors))
+	}
+	return nil
+}
+
+func readCatalog(path string, read func(r io.Reader) error) error {
+	if path == "" {
+		return read(os.Stdin)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	return read(f)
+}
+
+func writeCatalog(path string, write func(w io.Writer) error) (err error) {
+	if path == "" {
+		return write(os.Stdout)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	return write(f)
+}
+
+func printImportReport(w io.Writer, report model.ImportReport, dryRun bool) {
+	if dryRun {
+		_, _ = fmt.Fprintln(w, "dry run: изменения не сохранены")
+	}
+	_, _ = fmt.Fprintf(w, "added: %d, changed: %d, removed: %d, archived: %d, unchanged: %d, errors: %d\n",
+		len(report.Added), len(report.Changed), len(report.Removed), len(report.Archived), report.Unchanged, len(report.Errors))
+
+	for _, c := range report.Added {
+		_, _ = fmt.Fprintf(w, "+ line %d %s\n", c.Line, c.UUID)
+	}
+	for _, c := range report.Changed {
+		_, _ = fmt.Fprintf(w, "~ line %d %s: %s\n", c.Line, c.UUID, strings.Join(c.Fields, ", "))
+	}
+	for _, c := range report.Removed {
+		_, _ = fmt.Fprintf(w, "- %s\n", c.UUID)
+	}
+	for _, c := range report.Archived {
+		_, _ = fmt.Fprintf(w, "# %s: reserved, archived\n", c.UUID)
+	}
+	for _, e := range report.Errors {
+		_, _ = fmt.Fprintf(w, "! line %d %s: %s\n", e.Line, e.UUID, e.Message)
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
@@ -13,14 +14,57 @@ import (
 
 	inventoryV1API "github.com/baryshnikkov/rocket-factory/inventory/internal/api/inventory/v1"
 	"github.com/baryshnikkov/rocket-factory/inventory/internal/interceptor"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/repository"
+	inventoryDatabaseRepository "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/database"
 	inventoryRepository "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/part"
 	inventoryService "github.com/baryshnikkov/rocket-factory/inventory/internal/service/part"
+	"github.com/baryshnikkov/rocket-factory/inventory/migrations"
+	sharedDatabase "github.com/baryshnikkov/rocket-factory/shared/pkg/database"
 	inventoryV1Proto "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
 )
 
-const grpcPort = 50051
+const (
+	grpcPort = 50051
+
+	// Хранилище деталей: memory (по умолчанию), postgres или sqlite
+	storageEnv = "INVENTORY_STORAGE"
+	// Строка подключения к базе для postgres/sqlite
+	databaseDSNEnv = "INVENTORY_DATABASE_DSN"
+	// Режим разработки: при значении true каталог заполняется случайными деталями
+	seedPartsEnv = "INVENTORY_SEED_PARTS"
+)
 
 func main() {
+	ctx := context.Background()
+
+	// inventory import|export - работа с файлом каталога вместо запуска сервера
+	if len(os.Args) > 1 {
+		if err := runCatalogCommand(ctx, os.Args[1], os.Args[2:]); err != nil {
+			log.Printf("%s: %v\n", os.Args[1], err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	repository, closeRepository, err := newRepository(ctx)
+	if err != nil {
+		log.Printf("failed to init inventory repository: %v\n", err)
+		return
+	}
+	defer func() {
+		if err := closeRepository(); err != nil {
+			log.Printf("failed to close inventory repository: %v\n", err)
+		}
+	}()
+
+	if os.Getenv(seedPartsEnv) == "true" {
+		if err = repository.InitParts(ctx); err != nil {
+			log.Printf("failed to seed parts: %v\n", err)
+			return
+		}
+		log.Println("🌱 Каталог заполнен случайными деталями")
+	}
+
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", grpcPort))
 	if err != nil {
 		log.Printf("failed to listen: %v\n", err)
@@ -38,10 +82,11 @@ func main() {
 			interceptor.LoggerInterceptor(),
 			interceptor.Validate(),
 		),
+		grpc.ChainStreamInterceptor(
+			interceptor.ValidateStream(),
+		),
 	)
 
-	repository := inventoryRepository.NewRepository()
-	repository.InitParts()
 	service := inventoryService.NewService(repository)
 	api := inventoryV1API.NewAPI(service)
 
@@ -64,3 +109,18 @@ func main() {
 	grpcServer.GracefulStop()
 	log.Println("✅ Server stopped")
 }
+
+// newRepository выбирает реализацию InventoryRepository по переменной окружения INVENTORY_STORAGE.
+func newRepository(ctx context.Context) (repository.InventoryRepository, func() error, error) {
+	db, err := sharedDatabase.OpenFromEnv(ctx, storageEnv, databaseDSNEnv, migrations.FS)
+	if err != nil {
+		return nil, nil, err
+	}
+	if db == nil {
+		return inventoryRepository.NewRepository(), func() error { return nil }, nil
+	}
+
+	log.Printf("📦 Хранилище деталей: %s\n", os.Getenv(storageEnv))
+
+	return inventoryDatabaseRepository.NewRepository(db), db.Close, nil
+}
@@ -0,0 +1,27 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/converter"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) ArchivePart(ctx context.Context, req *inventoryV1.ArchivePartRequest) (*inventoryV1.ArchivePartResponse, error) {
+	part, err := a.inventoryService.ArchivePart(ctx, req.GetUuid())
+	if err != nil {
+		if errors.Is(err, model.ErrPartNotFound) {
+			return nil, status.Errorf(codes.NotFound, "part with UUID %s not found", req.GetUuid())
+		}
+		return nil, err
+	}
+
+	return &inventoryV1.ArchivePartResponse{
+		Part: converter.PartToProto(part),
+	}, nil
+}
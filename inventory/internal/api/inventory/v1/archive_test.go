@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (s *APISuite) TestArchivePartSuccess() {
+	part := testutils.CreatePart()
+	part.Archived = true
+
+	s.inventoryService.On("ArchivePart", s.ctx, part.UUID).Return(part, nil)
+
+	res, err := s.api.ArchivePart(s.ctx, &inventoryV1.ArchivePartRequest{Uuid: part.UUID})
+
+	s.Require().NoError(err)
+	s.Require().True(res.GetPart().GetArchived())
+}
+
+func (s *APISuite) TestArchivePartNotFound() {
+	uuid := gofakeit.UUID()
+
+	s.inventoryService.On("ArchivePart", s.ctx, uuid).Return(model.Part{}, model.ErrPartNotFound)
+
+	res, err := s.api.ArchivePart(s.ctx, &inventoryV1.ArchivePartRequest{Uuid: uuid})
+
+	s.Require().Error(err)
+	s.Require().Equal(codes.NotFound, status.Code(err))
+	s.Require().Nil(res)
+}
@@ -0,0 +1,17 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/converter"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) BatchGetParts(ctx context.Context, req *inventoryV1.BatchGetPartsRequest) (*inventoryV1.BatchGetPartsResponse, error) {
+	batch, err := a.inventoryService.BatchGetParts(ctx, req.GetUuids())
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.PartsBatchToProto(batch), nil
+}
@@ -0,0 +1,45 @@
+package v1
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/converter"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (s *APISuite) TestBatchGetPartsSuccess() {
+	var (
+		part    = testutils.CreatePart()
+		missing = gofakeit.UUID()
+		uuids   = []string{part.UUID, missing, part.UUID}
+
+		batch = model.PartsBatch{
+			Parts:        map[string]model.Part{part.UUID: part},
+			MissingUUIDs: []string{missing},
+		}
+	)
+
+	s.inventoryService.On("BatchGetParts", s.ctx, uuids).Return(batch, nil)
+
+	res, err := s.api.BatchGetParts(s.ctx, &inventoryV1.BatchGetPartsRequest{Uuids: uuids})
+
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]*inventoryV1.Part{part.UUID: converter.PartToProto(part)}, res.GetParts())
+	s.Require().Equal([]string{missing}, res.GetMissingUuids())
+}
+
+func (s *APISuite) TestBatchGetPartsFail() {
+	var (
+		serviceErr = gofakeit.Error()
+		uuids      = []string{gofakeit.UUID()}
+	)
+
+	s.inventoryService.On("BatchGetParts", s.ctx, uuids).Return(model.PartsBatch{}, serviceErr)
+
+	res, err := s.api.BatchGetParts(s.ctx, &inventoryV1.BatchGetPartsRequest{Uuids: uuids})
+
+	s.Require().ErrorIs(err, serviceErr)
+	s.Require().Nil(res)
+}
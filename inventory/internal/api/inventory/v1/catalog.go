@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"bufio"
+	"errors"
+	"io"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/catalog"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/converter"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+// catalogChunkSize - размер части файла в одном сообщении потока, с запасом до лимита gRPC в 4 МБ
+const catalogChunkSize = 64 << 10
+
+func (a *api) ExportParts(req *inventoryV1.ExportPartsRequest, stream grpc.ServerStreamingServer[inventoryV1.ExportPartsResponse]) error {
+	parts, err := a.inventoryService.ExportParts(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	w := &chunkWriter{stream: stream}
+	buf := bufio.NewWriterSize(w, catalogChunkSize)
+	if err = catalog.Encode(converter.CatalogFormatToModel(req.GetFormat()), buf, parts); err != nil {
+		if w.err != nil {
+			return w.err
+		}
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	return buf.Flush()
+}
+
+func (a *api) ImportParts(stream grpc.ClientStreamingServer[inventoryV1.ImportPartsRequest, inventoryV1.ImportPartsResponse]) error {
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return status.Error(codes.InvalidArgument, "import options are required")
+	}
+	if err != nil {
+		return err
+	}
+	opts := first.GetOptions()
+	if opts == nil {
+		return status.Error(codes.InvalidArgument, "first message must carry import options")
+	}
+
+	r := &chunkReader{stream: stream}
+	rows, err := catalog.Decode(converter.CatalogFormatToModel(opts.GetFormat()), r)
+	if r.err != nil {
+		return r.err
+	}
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	report, err := a.inventoryService.ImportParts(stream.Context(), rows, converter.ImportOptionsToModel(opts))
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(converter.ImportReportToProto(report))
+}
+
+// chunkWriter отправляет каждую запись отдельным сообщением потока
+type chunkWriter struct {
+	stream grpc.ServerStreamingServer[inventoryV1.ExportPartsResponse]
+	err    error
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	// Сообщение сериализуется при отправке, поэтому буфер bufio можно переиспользовать
+	if w.err = w.stream.Send(&inventoryV1.ExportPartsResponse{Chunk: p}); w.err != nil {
+		return 0, w.err
+	}
+	return len(p), nil
+}
+
+// chunkReader склеивает части файла из потока импорта. Ошибка потока сохраняется отдельно,
+// чтобы не выдать обрыв соединения за ошибку в файле.
+type chunkReader struct {
+	stream grpc.ClientStreamingServer[inventoryV1.ImportPartsRequest, inventoryV1.ImportPartsResponse]
+	chunk  []byte
+	err    error
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		req, err := r.stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return 0, io.EOF
+		}
+		if err != nil {
+			r.err = err
+			return 0, err
+		}
+		if req.GetOptions() != nil {
+			r.err = status.Error(codes.InvalidArgument, "import options must be sent only in the first message")
+			return 0, r.err
+		}
+		r.chunk = req.GetChunk()
+	}
+
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
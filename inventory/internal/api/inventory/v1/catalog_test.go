@@ -0,0 +1,203 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/catalog"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+// exportStream - серверный поток выгрузки, склеивающий отправленные части файла
+type exportStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	data   bytes.Buffer
+	chunks int
+}
+
+func (e *exportStream) Context() context.Context {
+	return e.ctx
+}
+
+func (e *exportStream) Send(resp *inventoryV1.ExportPartsResponse) error {
+	e.data.Write(resp.GetChunk())
+	e.chunks++
+	return nil
+}
+
+// importStream - клиентский поток загрузки с заранее заданными сообщениями
+type importStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*inventoryV1.ImportPartsRequest
+	recvErr  error
+	response *inventoryV1.ImportPartsResponse
+}
+
+func (i *importStream) Context() context.Context {
+	return i.ctx
+}
+
+func (i *importStream) Recv() (*inventoryV1.ImportPartsRequest, error) {
+	if len(i.requests) == 0 {
+		if i.recvErr != nil {
+			return nil, i.recvErr
+		}
+		return nil, io.EOF
+	}
+	req := i.requests[0]
+	i.requests = i.requests[1:]
+	return req, nil
+}
+
+func (i *importStream) SendAndClose(resp *inventoryV1.ImportPartsResponse) error {
+	i.response = resp
+	return nil
+}
+
+// importRequests разбивает файл на части по size байт после сообщения с параметрами
+func importRequests(opts *inventoryV1.ImportPartsOptions, data []byte, size int) []*inventoryV1.ImportPartsRequest {
+	requests := []*inventoryV1.ImportPartsRequest{{
+		Payload: &inventoryV1.ImportPartsRequest_Options{Options: opts},
+	}}
+	for len(data) > 0 {
+		n := min(size, len(data))
+		requests = append(requests, &inventoryV1.ImportPartsRequest{
+			Payload: &inventoryV1.ImportPartsRequest_Chunk{Chunk: data[:n]},
+		})
+		data = data[n:]
+	}
+	return requests
+}
+
+func (s *APISuite) TestExportPartsCSV() {
+	part := testutils.CreatePart()
+	stream := &exportStream{ctx: s.ctx}
+
+	s.inventoryService.On("ExportParts", s.ctx).Return([]model.Part{part}, nil)
+
+	err := s.api.ExportParts(&inventoryV1.ExportPartsRequest{Format: inventoryV1.CatalogFormat_CATALOG_FORMAT_CSV}, stream)
+
+	s.Require().NoError(err)
+	rows, err := catalog.Decode(model.CatalogFormatCSV, &stream.data)
+	s.Require().NoError(err)
+	s.Require().Len(rows, 1)
+	s.Require().Equal(part.UUID, rows[0].Part.UUID)
+}
+
+func (s *APISuite) TestExportPartsSplitsIntoChunks() {
+	parts := make([]model.Part, 0, 500)
+	for range 500 {
+		part := testutils.CreatePart()
+		part.Description = strings.Repeat("d", 512)
+		parts = append(parts, part)
+	}
+	stream := &exportStream{ctx: s.ctx}
+
+	s.inventoryService.On("ExportParts", s.ctx).Return(parts, nil)
+
+	err := s.api.ExportParts(&inventoryV1.ExportPartsRequest{Format: inventoryV1.CatalogFormat_CATALOG_FORMAT_JSONL}, stream)
+
+	s.Require().NoError(err)
+	s.Require().Greater(stream.chunks, 1)
+	rows, err := catalog.Decode(model.CatalogFormatJSONL, &stream.data)
+	s.Require().NoError(err)
+	s.Require().Len(rows, len(parts))
+	s.Require().Equal(parts[len(parts)-1].UUID, rows[len(rows)-1].Part.UUID)
+}
+
+func (s *APISuite) TestExportPartsServiceError() {
+	serviceErr := errors.New("db is down")
+	stream := &exportStream{ctx: s.ctx}
+
+	s.inventoryService.On("ExportParts", s.ctx).Return(nil, serviceErr)
+
+	err := s.api.ExportParts(&inventoryV1.ExportPartsRequest{Format: inventoryV1.CatalogFormat_CATALOG_FORMAT_JSONL}, stream)
+
+	s.Require().ErrorIs(err, serviceErr)
+	s.Require().Zero(stream.chunks)
+}
+
+func (s *APISuite) TestImportPartsSuccess() {
+	var (
+		part = testutils.CreatePart()
+		buf  bytes.Buffer
+	)
+	s.Require().NoError(catalog.Encode(model.CatalogFormatJSONL, &buf, []model.Part{part}))
+
+	report := model.ImportReport{
+		Changed:   []model.ImportChange{{Line: 1, UUID: part.UUID, Fields: []string{"price"}}},
+		Archived:  []model.ImportChange{{UUID: "reserved-part"}},
+		Unchanged: 3,
+		Errors:    []model.ImportError{{Line: 2, Message: "invalid part: name must not be empty"}},
+	}
+	stream := &importStream{ctx: s.ctx, requests: importRequests(&inventoryV1.ImportPartsOptions{
+		Format: inventoryV1.CatalogFormat_CATALOG_FORMAT_JSONL,
+		DryRun: true,
+		Prune:  true,
+	}, buf.Bytes(), 7)}
+
+	s.inventoryService.On("ImportParts", s.ctx, mock.MatchedBy(func(rows []model.ImportRow) bool {
+		return len(rows) == 1 && rows[0].Part.UUID == part.UUID
+	}), model.ImportOptions{DryRun: true, Prune: true}).Return(report, nil)
+
+	err := s.api.ImportParts(stream)
+
+	s.Require().NoError(err)
+	res := stream.response
+	s.Require().Empty(res.GetAdded())
+	s.Require().Len(res.GetChanged(), 1)
+	s.Require().Equal([]string{"price"}, res.GetChanged()[0].GetFields())
+	s.Require().Equal("reserved-part", res.GetArchived()[0].GetUuid())
+	s.Require().Equal(int32(3), res.GetUnchanged())
+	s.Require().Equal(int32(2), res.GetErrors()[0].GetLine())
+}
+
+func (s *APISuite) TestImportPartsInvalidFile() {
+	stream := &importStream{ctx: s.ctx, requests: importRequests(&inventoryV1.ImportPartsOptions{
+		Format: inventoryV1.CatalogFormat_CATALOG_FORMAT_CSV,
+	}, []byte("uuid,colour\n"), 1024)}
+
+	err := s.api.ImportParts(stream)
+
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	s.Require().Nil(stream.response)
+}
+
+func (s *APISuite) TestImportPartsRequiresOptionsFirst() {
+	stream := &importStream{ctx: s.ctx, requests: []*inventoryV1.ImportPartsRequest{{
+		Payload: &inventoryV1.ImportPartsRequest_Chunk{Chunk: []byte("{}\n")},
+	}}}
+
+	err := s.api.ImportParts(stream)
+
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	s.Require().Nil(stream.response)
+}
+
+func (s *APISuite) TestImportPartsStreamError() {
+	streamErr := status.Error(codes.Canceled, "client went away")
+	stream := &importStream{
+		ctx: s.ctx,
+		requests: importRequests(&inventoryV1.ImportPartsOptions{
+			Format: inventoryV1.CatalogFormat_CATALOG_FORMAT_JSONL,
+		}, []byte(`{"uuid":`), 1024),
+		recvErr: streamErr,
+	}
+
+	err := s.api.ImportParts(stream)
+
+	s.Require().ErrorIs(err, streamErr)
+	s.Require().Nil(stream.response)
+}
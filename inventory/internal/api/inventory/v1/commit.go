@@ -0,0 +1,28 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) CommitReservation(ctx context.Context, req *inventoryV1.CommitReservationRequest) (*inventoryV1.CommitReservationResponse, error) {
+	err := a.inventoryService.CommitReservation(ctx, req.GetReservationUuid())
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrReservationNotFound):
+			return nil, status.Errorf(codes.NotFound, "reservation with UUID %s not found", req.GetReservationUuid())
+		case errors.Is(err, model.ErrReservationExpired), errors.Is(err, model.ErrReservationReleased):
+			return nil, status.Errorf(codes.FailedPrecondition, "reservation %s: %v", req.GetReservationUuid(), err)
+		default:
+			return nil, err
+		}
+	}
+
+	return &inventoryV1.CommitReservationResponse{}, nil
+}
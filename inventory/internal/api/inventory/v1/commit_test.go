@@ -0,0 +1,51 @@
+package v1
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (s *APISuite) TestCommitReservationSuccess() {
+	reservationUUID := gofakeit.UUID()
+
+	s.inventoryService.On("CommitReservation", s.ctx, reservationUUID).Return(nil)
+
+	res, err := s.api.CommitReservation(s.ctx, &inventoryV1.CommitReservationRequest{
+		ReservationUuid: reservationUUID,
+	})
+
+	s.Require().NoError(err)
+	s.Require().NotNil(res)
+}
+
+func (s *APISuite) TestCommitReservationExpired() {
+	reservationUUID := gofakeit.UUID()
+
+	s.inventoryService.On("CommitReservation", s.ctx, reservationUUID).Return(model.ErrReservationExpired)
+
+	res, err := s.api.CommitReservation(s.ctx, &inventoryV1.CommitReservationRequest{
+		ReservationUuid: reservationUUID,
+	})
+
+	s.Require().Error(err)
+	s.Require().Equal(codes.FailedPrecondition, status.Code(err))
+	s.Require().Nil(res)
+}
+
+func (s *APISuite) TestCommitReservationNotFound() {
+	reservationUUID := gofakeit.UUID()
+
+	s.inventoryService.On("CommitReservation", s.ctx, reservationUUID).Return(model.ErrReservationNotFound)
+
+	res, err := s.api.CommitReservation(s.ctx, &inventoryV1.CommitReservationRequest{
+		ReservationUuid: reservationUUID,
+	})
+
+	s.Require().Error(err)
+	s.Require().Equal(codes.NotFound, status.Code(err))
+	s.Require().Nil(res)
+}
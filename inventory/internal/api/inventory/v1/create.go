@@ -0,0 +1,27 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/converter"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) CreatePart(ctx context.Context, req *inventoryV1.CreatePartRequest) (*inventoryV1.CreatePartResponse, error) {
+	part, err := a.inventoryService.CreatePart(ctx, converter.PartInputToModel(req.GetPart()))
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidPart) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return nil, err
+	}
+
+	return &inventoryV1.CreatePartResponse{
+		Part: converter.PartToProto(part),
+	}, nil
+}
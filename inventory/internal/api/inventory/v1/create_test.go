@@ -0,0 +1,99 @@
+package v1
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/converter"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (s *APISuite) TestCreatePartSuccess() {
+	var (
+		req = &inventoryV1.CreatePartRequest{
+			Part: &inventoryV1.PartInput{
+				Name:          "Main Engine",
+				Price:         100,
+				StockQuantity: 3,
+				Category:      inventoryV1.Category_CATEGORY_ENGINE,
+				Tags:          []string{"engine"},
+			},
+		}
+		created = testutils.CreatePart()
+	)
+
+	s.inventoryService.On("CreatePart", s.ctx, converter.PartInputToModel(req.GetPart())).Return(created, nil)
+
+	res, err := s.api.CreatePart(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().Equal(converter.PartToProto(created), res.GetPart())
+}
+
+func (s *APISuite) TestCreatePartInvalid() {
+	req := &inventoryV1.CreatePartRequest{
+		Part: &inventoryV1.PartInput{Price: 100},
+	}
+
+	s.inventoryService.On("CreatePart", s.ctx, converter.PartInputToModel(req.GetPart())).
+		Return(model.Part{}, fmt.Errorf("%w: name must not be empty", model.ErrInvalidPart))
+
+	res, err := s.api.CreatePart(s.ctx, req)
+
+	s.Require().Error(err)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	s.Require().Nil(res)
+}
+
+func (s *APISuite) TestCreatePartRequestValidation() {
+	req := &inventoryV1.CreatePartRequest{
+		Part: &inventoryV1.PartInput{
+			Name:          "Main Engine",
+			Price:         -1,
+			StockQuantity: -1,
+		},
+	}
+
+	s.Require().Error(req.ValidateAll())
+	s.Require().Error((&inventoryV1.CreatePartRequest{}).Validate())
+}
+
+func (s *APISuite) TestCreatePartKeepsMetadataKeys() {
+	var (
+		req = &inventoryV1.CreatePartRequest{
+			Part: &inventoryV1.PartInput{
+				Name:     "Main Engine",
+				Category: inventoryV1.Category_CATEGORY_ENGINE,
+				Metadata: map[string]*inventoryV1.Value{
+					"material":  {Kind: &inventoryV1.Value_StringValue{StringValue: "titanium"}},
+					"coating":   {Kind: &inventoryV1.Value_StringValue{StringValue: "ceramic"}},
+					"thrust_kn": {Kind: &inventoryV1.Value_DoubleValue{DoubleValue: 845}},
+				},
+			},
+		}
+		created = testutils.CreatePart()
+	)
+	created.Metadata = model.Metadata{
+		"material":  {StringValue: lo.ToPtr("titanium")},
+		"coating":   {StringValue: lo.ToPtr("ceramic")},
+		"thrust_kn": {DoubleValue: lo.ToPtr(845.0)},
+	}
+
+	s.inventoryService.On("CreatePart", s.ctx, mock.MatchedBy(func(part model.Part) bool {
+		return reflect.DeepEqual(created.Metadata, part.Metadata)
+	})).Return(created, nil)
+
+	res, err := s.api.CreatePart(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().Len(res.GetPart().GetMetadata(), 3)
+	s.Require().Equal("ceramic", res.GetPart().GetMetadata()["coating"].GetStringValue())
+	s.Require().Equal(845.0, res.GetPart().GetMetadata()["thrust_kn"].GetDoubleValue())
+}
@@ -0,0 +1,28 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) DeletePart(ctx context.Context, req *inventoryV1.DeletePartRequest) (*inventoryV1.DeletePartResponse, error) {
+	err := a.inventoryService.DeletePart(ctx, req.GetUuid())
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrPartNotFound):
+			return nil, status.Errorf(codes.NotFound, "part with UUID %s not found", req.GetUuid())
+		case errors.Is(err, model.ErrPartReserved):
+			return nil, status.Errorf(codes.FailedPrecondition, "part %s: %v", req.GetUuid(), err)
+		default:
+			return nil, err
+		}
+	}
+
+	return &inventoryV1.DeletePartResponse{}, nil
+}
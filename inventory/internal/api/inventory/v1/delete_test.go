@@ -0,0 +1,33 @@
+package v1
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (s *APISuite) TestDeletePartSuccess() {
+	uuid := gofakeit.UUID()
+
+	s.inventoryService.On("DeletePart", s.ctx, uuid).Return(nil)
+
+	res, err := s.api.DeletePart(s.ctx, &inventoryV1.DeletePartRequest{Uuid: uuid})
+
+	s.Require().NoError(err)
+	s.Require().NotNil(res)
+}
+
+func (s *APISuite) TestDeletePartReserved() {
+	uuid := gofakeit.UUID()
+
+	s.inventoryService.On("DeletePart", s.ctx, uuid).Return(model.ErrPartReserved)
+
+	res, err := s.api.DeletePart(s.ctx, &inventoryV1.DeletePartRequest{Uuid: uuid})
+
+	s.Require().Error(err)
+	s.Require().Equal(codes.FailedPrecondition, status.Code(err))
+	s.Require().Nil(res)
+}
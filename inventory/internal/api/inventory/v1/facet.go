@@ -0,0 +1,25 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/converter"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) GetPartFacets(ctx context.Context, req *inventoryV1.GetPartFacetsRequest) (*inventoryV1.GetPartFacetsResponse, error) {
+	facets, err := a.inventoryService.GetPartFacets(ctx, converter.PartsFilterToModel(req.GetFilter()))
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidPartsFilter) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return nil, err
+	}
+
+	return converter.PartFacetsToProto(facets), nil
+}
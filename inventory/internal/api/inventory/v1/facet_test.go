@@ -0,0 +1,47 @@
+package v1
+
+import (
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (s *APISuite) TestGetPartFacetsSuccess() {
+	var (
+		req = &inventoryV1.GetPartFacetsRequest{
+			Filter: &inventoryV1.PartsFilter{
+				Categories: []inventoryV1.Category{inventoryV1.Category_CATEGORY_ENGINE},
+			},
+		}
+		facets = model.PartFacets{
+			Categories:            []model.FacetCount{{Value: "ENGINE", Count: 2}, {Value: "WING", Count: 1}},
+			ManufacturerCountries: []model.FacetCount{{Value: "USA", Count: 2}},
+			Tags:                  []model.FacetCount{{Value: "power", Count: 1}},
+		}
+	)
+
+	s.inventoryService.On("GetPartFacets", s.ctx, mock.AnythingOfType("model.PartsFilter")).Return(facets, nil)
+
+	res, err := s.api.GetPartFacets(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().Len(res.GetCategories(), 2)
+	s.Require().Equal(inventoryV1.Category_CATEGORY_ENGINE, res.GetCategories()[0].GetCategory())
+	s.Require().Equal(int64(2), res.GetCategories()[0].GetCount())
+	s.Require().Equal(inventoryV1.Category_CATEGORY_WING, res.GetCategories()[1].GetCategory())
+	s.Require().Equal("USA", res.GetManufacturerCountries()[0].GetValue())
+	s.Require().Equal("power", res.GetTags()[0].GetValue())
+}
+
+func (s *APISuite) TestGetPartFacetsInvalidFilter() {
+	s.inventoryService.On("GetPartFacets", s.ctx, mock.AnythingOfType("model.PartsFilter")).
+		Return(model.PartFacets{}, model.ErrInvalidPartsFilter)
+
+	res, err := s.api.GetPartFacets(s.ctx, &inventoryV1.GetPartFacetsRequest{})
+
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	s.Require().Nil(res)
+}
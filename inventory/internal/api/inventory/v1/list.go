@@ -15,11 +15,19 @@ import (
 func (a *api) ListParts(ctx context.Context, req *inventoryV1.ListPartsRequest) (*inventoryV1.ListPartsResponse, error) {
 	filters := converter.PartsFilterToModel(req.GetFilter())
 
-	parts, err := a.inventoryService.ListParts(ctx, filters)
+	params, err := converter.ListPartsParamsToModel(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	list, err := a.inventoryService.ListParts(ctx, filters, params)
 	if err != nil {
 		if errors.Is(err, model.ErrPartsNotFound) {
 			return nil, status.Errorf(codes.NotFound, "parts not found: %v", err)
 		}
+		if errors.Is(err, model.ErrInvalidPageToken) || errors.Is(err, model.ErrInvalidPartsFilter) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
 		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
 			return nil, status.Errorf(codes.Unavailable, "inventory service error: %v", err)
 		}
@@ -27,6 +35,8 @@ func (a *api) ListParts(ctx context.Context, req *inventoryV1.ListPartsRequest)
 	}
 
 	return &inventoryV1.ListPartsResponse{
-		Parts: converter.PartsToProto(parts),
+		Parts:         converter.PartsToProto(list.Parts),
+		NextPageToken: list.NextPageToken,
+		TotalSize:     list.TotalSize,
 	}, nil
 }
@@ -1,10 +1,15 @@
 package v1
 
 import (
+	"reflect"
+	"time"
+
 	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/baryshnikkov/rocket-factory/inventory/internal/converter"
 	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
@@ -33,8 +38,8 @@ func (s *APISuite) TestListPartsSuccess() {
 		}
 	)
 
-	s.inventoryService.On("ListParts", s.ctx, mock.AnythingOfType("model.PartsFilter")).
-		Return(modelParts, nil)
+	s.inventoryService.On("ListParts", s.ctx, mock.AnythingOfType("model.PartsFilter"), mock.AnythingOfType("model.ListPartsParams")).
+		Return(model.PartsList{Parts: modelParts, TotalSize: 2}, nil)
 
 	res, err := s.api.ListParts(s.ctx, req)
 
@@ -42,6 +47,7 @@ func (s *APISuite) TestListPartsSuccess() {
 	s.Require().NotNil(res)
 	s.Require().Len(res.Parts, 2)
 	s.Require().Equal(expectedResponse.Parts, res.Parts)
+	s.Require().Equal(int32(2), res.TotalSize)
 }
 
 func (s *APISuite) TestListPartsEmptyFilter() {
@@ -55,8 +61,10 @@ func (s *APISuite) TestListPartsEmptyFilter() {
 		}
 	)
 
-	s.inventoryService.On("ListParts", s.ctx, model.PartsFilter{}).
-		Return(modelParts, nil)
+	s.inventoryService.On("ListParts", s.ctx, model.PartsFilter{}, model.ListPartsParams{
+		OrderBy: model.PartsOrder{Field: model.PartsOrderByCreatedAt},
+	}).
+		Return(model.PartsList{Parts: modelParts, TotalSize: 1}, nil)
 
 	res, err := s.api.ListParts(s.ctx, req)
 
@@ -72,8 +80,8 @@ func (s *APISuite) TestListPartsNotFound() {
 		},
 	}
 
-	s.inventoryService.On("ListParts", s.ctx, mock.AnythingOfType("model.PartsFilter")).
-		Return([]model.Part{}, model.ErrPartsNotFound)
+	s.inventoryService.On("ListParts", s.ctx, mock.AnythingOfType("model.PartsFilter"), mock.AnythingOfType("model.ListPartsParams")).
+		Return(model.PartsList{}, model.ErrPartsNotFound)
 
 	res, err := s.api.ListParts(s.ctx, req)
 
@@ -82,3 +90,139 @@ func (s *APISuite) TestListPartsNotFound() {
 	s.Require().Contains(err.Error(), "parts not found")
 	s.Require().Nil(res)
 }
+
+func (s *APISuite) TestListPartsPage() {
+	var (
+		modelParts = []model.Part{
+			testutils.CreatePart(),
+		}
+
+		req = &inventoryV1.ListPartsRequest{
+			PageSize:  1,
+			PageToken: "page-token",
+			OrderBy:   "price desc",
+		}
+
+		expectedParams = model.ListPartsParams{
+			PageSize:  1,
+			PageToken: "page-token",
+			OrderBy:   model.PartsOrder{Field: model.PartsOrderByPrice, Desc: true},
+		}
+	)
+
+	s.inventoryService.On("ListParts", s.ctx, model.PartsFilter{}, expectedParams).
+		Return(model.PartsList{Parts: modelParts, NextPageToken: "next-token", TotalSize: 5}, nil)
+
+	res, err := s.api.ListParts(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().Len(res.Parts, 1)
+	s.Require().Equal("next-token", res.NextPageToken)
+	s.Require().Equal(int32(5), res.TotalSize)
+}
+
+func (s *APISuite) TestListPartsInvalidOrderBy() {
+	req := &inventoryV1.ListPartsRequest{
+		OrderBy: "weight desc",
+	}
+
+	res, err := s.api.ListParts(s.ctx, req)
+
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	s.Require().Nil(res)
+}
+
+func (s *APISuite) TestListPartsInvalidPageToken() {
+	req := &inventoryV1.ListPartsRequest{
+		PageToken: "broken",
+	}
+
+	s.inventoryService.On("ListParts", s.ctx, model.PartsFilter{}, mock.AnythingOfType("model.ListPartsParams")).
+		Return(model.PartsList{}, model.ErrInvalidPageToken)
+
+	res, err := s.api.ListParts(s.ctx, req)
+
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	s.Require().Nil(res)
+}
+
+func (s *APISuite) TestListPartsRangeFilter() {
+	var (
+		from = time.Now().Add(-time.Hour).UTC()
+
+		req = &inventoryV1.ListPartsRequest{
+			Filter: &inventoryV1.PartsFilter{
+				Price:         &inventoryV1.DoubleRange{Max: lo.ToPtr(5000.0)},
+				StockQuantity: &inventoryV1.Int64Range{Min: lo.ToPtr(int64(1))},
+				Dimensions: &inventoryV1.DimensionsRange{
+					Weight: &inventoryV1.DoubleRange{Max: lo.ToPtr(200.0)},
+				},
+				CreatedAt: &inventoryV1.TimestampRange{From: timestamppb.New(from)},
+			},
+		}
+
+		expectedFilter = model.PartsFilter{
+			UUIDs:                 []string{},
+			Names:                 []string{},
+			Categories:            []model.Category{},
+			ManufacturerCountries: []string{},
+			Tags:                  []string{},
+			Price:                 model.FloatRange{Max: lo.ToPtr(5000.0)},
+			StockQuantity:         model.Int64Range{Min: lo.ToPtr(int64(1))},
+			Dimensions: model.DimensionsRange{
+				Weight: model.FloatRange{Max: lo.ToPtr(200.0)},
+			},
+			CreatedAt: model.TimeRange{From: &from},
+		}
+	)
+
+	s.inventoryService.On("ListParts", s.ctx, expectedFilter, mock.AnythingOfType("model.ListPartsParams")).
+		Return(model.PartsList{Parts: []model.Part{testutils.CreatePart()}, TotalSize: 1}, nil)
+
+	res, err := s.api.ListParts(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().Len(res.Parts, 1)
+}
+
+func (s *APISuite) TestListPartsInvalidRange() {
+	req := &inventoryV1.ListPartsRequest{
+		Filter: &inventoryV1.PartsFilter{
+			Price: &inventoryV1.DoubleRange{Min: lo.ToPtr(10.0), Max: lo.ToPtr(1.0)},
+		},
+	}
+
+	s.inventoryService.On("ListParts", s.ctx, mock.AnythingOfType("model.PartsFilter"), mock.AnythingOfType("model.ListPartsParams")).
+		Return(model.PartsList{}, model.ErrInvalidPartsFilter)
+
+	res, err := s.api.ListParts(s.ctx, req)
+
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	s.Require().Nil(res)
+}
+
+func (s *APISuite) TestListPartsMetadataFilter() {
+	req := &inventoryV1.ListPartsRequest{
+		Filter: &inventoryV1.PartsFilter{
+			Metadata: []*inventoryV1.MetadataCondition{
+				{Key: "certified"},
+				{Key: "material", Value: &inventoryV1.Value{Kind: &inventoryV1.Value_StringValue{StringValue: "titanium"}}},
+			},
+		},
+	}
+
+	expectedConditions := []model.MetadataCondition{
+		{Key: "certified"},
+		{Key: "material", Value: &model.MetadataValue{StringValue: lo.ToPtr("titanium")}},
+	}
+
+	s.inventoryService.On("ListParts", s.ctx, mock.MatchedBy(func(filter model.PartsFilter) bool {
+		return reflect.DeepEqual(expectedConditions, filter.Metadata)
+	}), mock.AnythingOfType("model.ListPartsParams")).
+		Return(model.PartsList{Parts: []model.Part{testutils.CreatePart()}, TotalSize: 1}, nil)
+
+	res, err := s.api.ListParts(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().Len(res.Parts, 1)
+}
@@ -0,0 +1,25 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) ReleaseReservation(ctx context.Context, req *inventoryV1.ReleaseReservationRequest) (*inventoryV1.ReleaseReservationResponse, error) {
+	err := a.inventoryService.ReleaseReservation(ctx, req.GetReservationUuid())
+	if err != nil {
+		if errors.Is(err, model.ErrReservationNotFound) {
+			return nil, status.Errorf(codes.NotFound, "reservation with UUID %s not found", req.GetReservationUuid())
+		}
+
+		return nil, err
+	}
+
+	return &inventoryV1.ReleaseReservationResponse{}, nil
+}
@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (s *APISuite) TestReleaseReservationSuccess() {
+	reservationUUID := gofakeit.UUID()
+
+	s.inventoryService.On("ReleaseReservation", s.ctx, reservationUUID).Return(nil)
+
+	res, err := s.api.ReleaseReservation(s.ctx, &inventoryV1.ReleaseReservationRequest{
+		ReservationUuid: reservationUUID,
+	})
+
+	s.Require().NoError(err)
+	s.Require().NotNil(res)
+}
+
+func (s *APISuite) TestReleaseReservationNotFound() {
+	reservationUUID := gofakeit.UUID()
+
+	s.inventoryService.On("ReleaseReservation", s.ctx, reservationUUID).Return(model.ErrReservationNotFound)
+
+	res, err := s.api.ReleaseReservation(s.ctx, &inventoryV1.ReleaseReservationRequest{
+		ReservationUuid: reservationUUID,
+	})
+
+	s.Require().Error(err)
+	s.Require().Equal(codes.NotFound, status.Code(err))
+	s.Require().Nil(res)
+}
@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/converter"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) ReserveParts(ctx context.Context, req *inventoryV1.ReservePartsRequest) (*inventoryV1.ReservePartsResponse, error) {
+	reservation, err := a.inventoryService.ReserveParts(
+		ctx,
+		converter.ReservationItemsToModel(req.GetItems()),
+		req.GetTtl().AsDuration(),
+	)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInsufficientStock), errors.Is(err, model.ErrPartArchived):
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		case errors.Is(err, model.ErrPartNotFound):
+			return nil, status.Errorf(codes.NotFound, "%v", err)
+		default:
+			return nil, err
+		}
+	}
+
+	return &inventoryV1.ReservePartsResponse{
+		ReservationUuid: reservation.UUID,
+		ExpiresAt:       timestamppb.New(reservation.ExpiresAt),
+	}, nil
+}
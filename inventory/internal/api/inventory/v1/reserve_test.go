@@ -0,0 +1,83 @@
+package v1
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (s *APISuite) TestReservePartsSuccess() {
+	var (
+		partUUID = gofakeit.UUID()
+		req      = &inventoryV1.ReservePartsRequest{
+			Items: []*inventoryV1.ReservationItem{
+				{PartUuid: partUUID, Quantity: 2},
+			},
+			Ttl: durationpb.New(time.Minute),
+		}
+		reservation = model.Reservation{
+			UUID:      gofakeit.UUID(),
+			ExpiresAt: time.Now().Add(time.Minute).UTC(),
+		}
+	)
+
+	s.inventoryService.On("ReserveParts", s.ctx, []model.ReservationItem{
+		{PartUUID: partUUID, Quantity: 2},
+	}, time.Minute).Return(reservation, nil)
+
+	res, err := s.api.ReserveParts(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().Equal(reservation.UUID, res.GetReservationUuid())
+	s.Require().Equal(reservation.ExpiresAt, res.GetExpiresAt().AsTime())
+}
+
+func (s *APISuite) TestReservePartsInsufficientStock() {
+	var (
+		partUUID = gofakeit.UUID()
+		req      = &inventoryV1.ReservePartsRequest{
+			Items: []*inventoryV1.ReservationItem{
+				{PartUuid: partUUID, Quantity: 100},
+			},
+		}
+	)
+
+	s.inventoryService.On("ReserveParts", s.ctx, []model.ReservationItem{
+		{PartUUID: partUUID, Quantity: 100},
+	}, time.Duration(0)).Return(model.Reservation{}, fmt.Errorf("%w: part %s", model.ErrInsufficientStock, partUUID))
+
+	res, err := s.api.ReserveParts(s.ctx, req)
+
+	s.Require().Error(err)
+	s.Require().Equal(codes.FailedPrecondition, status.Code(err))
+	s.Require().Contains(err.Error(), partUUID)
+	s.Require().Nil(res)
+}
+
+func (s *APISuite) TestReservePartsPartNotFound() {
+	var (
+		partUUID = gofakeit.UUID()
+		req      = &inventoryV1.ReservePartsRequest{
+			Items: []*inventoryV1.ReservationItem{
+				{PartUuid: partUUID, Quantity: 1},
+			},
+		}
+	)
+
+	s.inventoryService.On("ReserveParts", s.ctx, []model.ReservationItem{
+		{PartUUID: partUUID, Quantity: 1},
+	}, time.Duration(0)).Return(model.Reservation{}, model.ErrPartNotFound)
+
+	res, err := s.api.ReserveParts(s.ctx, req)
+
+	s.Require().Error(err)
+	s.Require().Equal(codes.NotFound, status.Code(err))
+	s.Require().Nil(res)
+}
@@ -0,0 +1,27 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/converter"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) SearchParts(ctx context.Context, req *inventoryV1.SearchPartsRequest) (*inventoryV1.SearchPartsResponse, error) {
+	hits, err := a.inventoryService.SearchParts(ctx, req.GetQuery(), req.GetLimit())
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidSearchQuery) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return nil, err
+	}
+
+	return &inventoryV1.SearchPartsResponse{
+		Hits: converter.SearchHitsToProto(hits),
+	}, nil
+}
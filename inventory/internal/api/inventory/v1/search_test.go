@@ -0,0 +1,55 @@
+package v1
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/converter"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (s *APISuite) TestSearchPartsSuccess() {
+	var (
+		part = testutils.CreatePart()
+		hits = []model.SearchHit{{
+			Part:       part,
+			Score:      2.5,
+			Highlights: []model.SearchHighlight{{Field: "name", Snippet: "Ion <em>Thruster</em>"}},
+		}}
+		req = &inventoryV1.SearchPartsRequest{Query: "thruster", Limit: 5}
+	)
+
+	s.inventoryService.On("SearchParts", s.ctx, "thruster", int32(5)).Return(hits, nil)
+
+	res, err := s.api.SearchParts(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().Len(res.GetHits(), 1)
+	s.Require().Equal(converter.PartToProto(part), res.GetHits()[0].GetPart())
+	s.Require().Equal(2.5, res.GetHits()[0].GetScore())
+	s.Require().Equal("Ion <em>Thruster</em>", res.GetHits()[0].GetHighlights()[0].GetSnippet())
+}
+
+func (s *APISuite) TestSearchPartsInvalidQuery() {
+	req := &inventoryV1.SearchPartsRequest{Query: " "}
+
+	s.inventoryService.On("SearchParts", s.ctx, " ", int32(0)).Return(nil, model.ErrInvalidSearchQuery)
+
+	res, err := s.api.SearchParts(s.ctx, req)
+
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	s.Require().Nil(res)
+}
+
+func (s *APISuite) TestSearchPartsNoHits() {
+	req := &inventoryV1.SearchPartsRequest{Query: "warp drive"}
+
+	s.inventoryService.On("SearchParts", s.ctx, "warp drive", int32(0)).Return(nil, nil)
+
+	res, err := s.api.SearchParts(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().Empty(res.GetHits())
+}
@@ -0,0 +1,36 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/converter"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) UpdatePart(ctx context.Context, req *inventoryV1.UpdatePartRequest) (*inventoryV1.UpdatePartResponse, error) {
+	info, err := converter.PartUpdateInfoToModel(req.GetPart(), req.GetUpdateMask())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	part, err := a.inventoryService.UpdatePart(ctx, req.GetUuid(), info)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidPart):
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		case errors.Is(err, model.ErrPartNotFound):
+			return nil, status.Errorf(codes.NotFound, "part with UUID %s not found", req.GetUuid())
+		default:
+			return nil, err
+		}
+	}
+
+	return &inventoryV1.UpdatePartResponse{
+		Part: converter.PartToProto(part),
+	}, nil
+}
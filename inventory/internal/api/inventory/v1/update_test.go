@@ -0,0 +1,74 @@
+package v1
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/converter"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (s *APISuite) TestUpdatePartSuccess() {
+	var (
+		uuid = gofakeit.UUID()
+		req  = &inventoryV1.UpdatePartRequest{
+			Uuid: uuid,
+			Part: &inventoryV1.PartInput{
+				Name:  "ignored",
+				Price: 99.9,
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"price"}},
+		}
+		updated = testutils.CreatePart()
+	)
+
+	// В PartUpdateInfo попадает только поле из маски
+	s.inventoryService.On("UpdatePart", s.ctx, uuid, model.PartUpdateInfo{
+		Price: lo.ToPtr(99.9),
+	}).Return(updated, nil)
+
+	res, err := s.api.UpdatePart(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().Equal(converter.PartToProto(updated), res.GetPart())
+}
+
+func (s *APISuite) TestUpdatePartUnknownMaskPath() {
+	req := &inventoryV1.UpdatePartRequest{
+		Uuid:       gofakeit.UUID(),
+		Part:       &inventoryV1.PartInput{},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"uuid"}},
+	}
+
+	res, err := s.api.UpdatePart(s.ctx, req)
+
+	s.Require().Error(err)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	s.Require().Nil(res)
+}
+
+func (s *APISuite) TestUpdatePartNotFound() {
+	var (
+		uuid = gofakeit.UUID()
+		req  = &inventoryV1.UpdatePartRequest{
+			Uuid:       uuid,
+			Part:       &inventoryV1.PartInput{StockQuantity: 5},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"stock_quantity"}},
+		}
+	)
+
+	s.inventoryService.On("UpdatePart", s.ctx, uuid, model.PartUpdateInfo{
+		StockQuantity: lo.ToPtr(int64(5)),
+	}).Return(model.Part{}, model.ErrPartNotFound)
+
+	res, err := s.api.UpdatePart(s.ctx, req)
+
+	s.Require().Error(err)
+	s.Require().Equal(codes.NotFound, status.Code(err))
+	s.Require().Nil(res)
+}
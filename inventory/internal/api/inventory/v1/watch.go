@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/converter"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) WatchParts(req *inventoryV1.WatchPartsRequest, stream grpc.ServerStreamingServer[inventoryV1.WatchPartsResponse]) error {
+	err := a.inventoryService.WatchParts(stream.Context(), converter.PartsFilterToModel(req.GetFilter()), req.AfterRevision,
+		func(event model.PartEvent) error {
+			return stream.Send(converter.PartEventToProto(event))
+		},
+	)
+
+	switch {
+	case err == nil, errors.Is(err, context.Canceled):
+		return nil
+	case errors.Is(err, model.ErrInvalidPartsFilter):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, model.ErrRevisionCompacted), errors.Is(err, model.ErrRevisionNotFound):
+		// Клиенту нужно заново получить каталог через ListParts и подписаться без after_revision
+		return status.Errorf(codes.OutOfRange, "revision %d: %v", req.GetAfterRevision(), err)
+	default:
+		return err
+	}
+}
@@ -0,0 +1,86 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+// watchStream - серверный поток, запоминающий отправленные сообщения
+type watchStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*inventoryV1.WatchPartsResponse
+}
+
+func (w *watchStream) Context() context.Context {
+	return w.ctx
+}
+
+func (w *watchStream) Send(resp *inventoryV1.WatchPartsResponse) error {
+	w.sent = append(w.sent, resp)
+	return nil
+}
+
+func (s *APISuite) TestWatchPartsSendsEvents() {
+	var (
+		part   = testutils.CreatePart()
+		stream = &watchStream{ctx: s.ctx}
+		req    = &inventoryV1.WatchPartsRequest{
+			Filter:        &inventoryV1.PartsFilter{Tags: []string{"hot"}},
+			AfterRevision: lo.ToPtr(int64(41)),
+		}
+	)
+
+	s.inventoryService.On("WatchParts", s.ctx, model.PartsFilter{
+		UUIDs:                 []string{},
+		Names:                 []string{},
+		Categories:            []model.Category{},
+		ManufacturerCountries: []string{},
+		Tags:                  []string{"hot"},
+	}, lo.ToPtr(int64(41)), mock.Anything).
+		Run(func(args mock.Arguments) {
+			send := args.Get(3).(func(model.PartEvent) error)
+			s.Require().NoError(send(model.PartEvent{Revision: 42, Type: model.PartEventUpdated, Part: part}))
+		}).
+		Return(context.Canceled)
+
+	err := s.api.WatchParts(req, stream)
+
+	s.Require().NoError(err)
+	s.Require().Len(stream.sent, 1)
+	s.Require().Equal(int64(42), stream.sent[0].GetRevision())
+	s.Require().Equal(inventoryV1.PartEventType_PART_EVENT_TYPE_UPDATED, stream.sent[0].GetType())
+	s.Require().Equal(part.UUID, stream.sent[0].GetPart().GetUuid())
+}
+
+func (s *APISuite) TestWatchPartsCompactedRevision() {
+	stream := &watchStream{ctx: s.ctx}
+	req := &inventoryV1.WatchPartsRequest{AfterRevision: lo.ToPtr(int64(1))}
+
+	s.inventoryService.On("WatchParts", s.ctx, mock.Anything, lo.ToPtr(int64(1)), mock.Anything).
+		Return(model.ErrRevisionCompacted)
+
+	err := s.api.WatchParts(req, stream)
+
+	s.Require().Equal(codes.OutOfRange, status.Code(err))
+}
+
+func (s *APISuite) TestWatchPartsInvalidFilter() {
+	stream := &watchStream{ctx: s.ctx}
+
+	s.inventoryService.On("WatchParts", s.ctx, mock.Anything, (*int64)(nil), mock.Anything).
+		Return(model.ErrInvalidPartsFilter)
+
+	err := s.api.WatchParts(&inventoryV1.WatchPartsRequest{}, stream)
+
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
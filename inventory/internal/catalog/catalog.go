@@ -0,0 +1,34 @@
+// Package catalog читает и пишет каталог деталей в форматах JSON Lines и CSV.
+package catalog
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+// Encode записывает детали в выбранном формате.
+func Encode(format model.CatalogFormat, w io.Writer, parts []model.Part) error {
+	switch format {
+	case model.CatalogFormatJSONL:
+		return encodeJSONL(w, parts)
+	case model.CatalogFormatCSV:
+		return encodeCSV(w, parts)
+	default:
+		return fmt.Errorf("%w: %q", model.ErrUnknownCatalogFormat, format)
+	}
+}
+
+// Decode разбирает файл каталога. Ошибки отдельных строк возвращаются в ImportRow.Err,
+// а ошибка функции означает, что файл целиком не читается (например, неверный заголовок CSV).
+func Decode(format model.CatalogFormat, r io.Reader) ([]model.ImportRow, error) {
+	switch format {
+	case model.CatalogFormatJSONL:
+		return decodeJSONL(r)
+	case model.CatalogFormatCSV:
+		return decodeCSV(r)
+	default:
+		return nil, fmt.Errorf("%w: %q", model.ErrUnknownCatalogFormat, format)
+	}
+}
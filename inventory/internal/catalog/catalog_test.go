@@ -0,0 +1,59 @@
+package catalog
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func catalogPart() model.Part {
+	part := testutils.CreatePart()
+	part.CreatedAt = part.CreatedAt.UTC()
+	part.UpdatedAt = lo.ToPtr(part.UpdatedAt.UTC())
+	part.Metadata = model.Metadata{
+		"material": {StringValue: lo.ToPtr("titanium, grade 5")},
+		"stages":   {Int64Value: lo.ToPtr(int64(2))},
+		"ratio":    {DoubleValue: lo.ToPtr(2.0)},
+		"reusable": {BoolValue: lo.ToPtr(true)},
+	}
+	part.Tags = []string{"a;b", "quoted \"tag\""}
+	return part
+}
+
+func (s *CatalogSuite) TestRoundTrip() {
+	for _, format := range []model.CatalogFormat{model.CatalogFormatJSONL, model.CatalogFormatCSV} {
+		s.Run(string(format), func() {
+			// Arrange
+			archived := catalogPart()
+			archived.Archived = true
+			archived.UpdatedAt = nil
+			parts := []model.Part{catalogPart(), archived}
+
+			var buf bytes.Buffer
+			s.Require().NoError(Encode(format, &buf, parts))
+
+			// Act
+			rows, err := Decode(format, &buf)
+
+			// Assert
+			s.Require().NoError(err)
+			s.Require().Len(rows, 2)
+			for i, row := range rows {
+				s.Require().NoError(row.Err)
+				s.Require().Equal(parts[i], row.Part)
+			}
+		})
+	}
+}
+
+func (s *CatalogSuite) TestUnknownFormat() {
+	_, err := Decode("xml", strings.NewReader(""))
+	s.Require().ErrorIs(err, model.ErrUnknownCatalogFormat)
+
+	err = Encode("xml", &bytes.Buffer{}, nil)
+	s.Require().ErrorIs(err, model.ErrUnknownCatalogFormat)
+}
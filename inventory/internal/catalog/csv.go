@@ -0,0 +1,238 @@
+package catalog
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+// csvColumns - колонки CSV в порядке экспорта. Теги и метаданные хранятся
+// в ячейках как JSON, чтобы не терять разделители и типы значений.
+var csvColumns = []string{
+	"uuid",
+	"name",
+	"description",
+	"price",
+	"stock_quantity",
+	"category",
+	"length",
+	"width",
+	"height",
+	"weight",
+	"manufacturer_name",
+	"manufacturer_country",
+	"manufacturer_website",
+	"tags",
+	"metadata",
+	"created_at",
+	"updated_at",
+	"archived",
+}
+
+func encodeCSV(w io.Writer, parts []model.Part) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvColumns); err != nil {
+		return err
+	}
+
+	for _, part := range parts {
+		rec := toRecord(part)
+
+		tags, err := json.Marshal(rec.Tags)
+		if err != nil {
+			return err
+		}
+		var metadata []byte
+		if len(rec.Metadata) > 0 {
+			if metadata, err = json.Marshal(rec.Metadata); err != nil {
+				return err
+			}
+		}
+
+		if err = cw.Write([]string{
+			rec.UUID,
+			rec.Name,
+			rec.Description,
+			formatFloat(rec.Price),
+			strconv.FormatInt(rec.StockQuantity, 10),
+			rec.Category,
+			formatFloat(rec.Dimensions.Length),
+			formatFloat(rec.Dimensions.Width),
+			formatFloat(rec.Dimensions.Height),
+			formatFloat(rec.Dimensions.Weight),
+			rec.Manufacturer.Name,
+			rec.Manufacturer.Country,
+			rec.Manufacturer.Website,
+			string(tags),
+			string(metadata),
+			formatTime(rec.CreatedAt),
+			formatTime(rec.UpdatedAt),
+			strconv.FormatBool(rec.Archived),
+		}); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func decodeCSV(r io.Reader) ([]model.ImportRow, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read csv header: %w", err)
+	}
+
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		if !slices.Contains(csvColumns, name) {
+			return nil, fmt.Errorf("unknown csv column %q", name)
+		}
+		if _, ok := index[name]; ok {
+			return nil, fmt.Errorf("duplicate csv column %q", name)
+		}
+		index[name] = i
+	}
+
+	var rows []model.ImportRow
+	for {
+		fields, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		line, _ := cr.FieldPos(0)
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, err
+			}
+			rows = append(rows, model.ImportRow{Line: parseErr.StartLine, Err: parseErr.Err})
+			continue
+		}
+		if len(fields) != len(header) {
+			rows = append(rows, model.ImportRow{
+				Line: line,
+				Err:  fmt.Errorf("expected %d fields, got %d", len(header), len(fields)),
+			})
+			continue
+		}
+
+		rec, err := parseCSVRecord(func(column string) string {
+			if i, ok := index[column]; ok {
+				return fields[i]
+			}
+			return ""
+		})
+		if err != nil {
+			rows = append(rows, model.ImportRow{Line: line, Err: err})
+			continue
+		}
+		rows = append(rows, model.ImportRow{Line: line, Part: rec.toModel()})
+	}
+
+	return rows, nil
+}
+
+func parseCSVRecord(field func(column string) string) (record, error) {
+	rec := record{
+		UUID:        field("uuid"),
+		Name:        field("name"),
+		Description: field("description"),
+		Category:    field("category"),
+		Manufacturer: manufacturer{
+			Name:    field("manufacturer_name"),
+			Country: field("manufacturer_country"),
+			Website: field("manufacturer_website"),
+		},
+	}
+
+	var err error
+	floats := []struct {
+		column string
+		dst    *float64
+	}{
+		{"price", &rec.Price},
+		{"length", &rec.Dimensions.Length},
+		{"width", &rec.Dimensions.Width},
+		{"height", &rec.Dimensions.Height},
+		{"weight", &rec.Dimensions.Weight},
+	}
+	for _, f := range floats {
+		if *f.dst, err = parseFloat(field(f.column)); err != nil {
+			return record{}, fmt.Errorf("%s: %w", f.column, err)
+		}
+	}
+
+	if v := field("stock_quantity"); v != "" {
+		if rec.StockQuantity, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return record{}, fmt.Errorf("stock_quantity: %w", err)
+		}
+	}
+	if v := field("tags"); v != "" {
+		if err = json.Unmarshal([]byte(v), &rec.Tags); err != nil {
+			return record{}, fmt.Errorf("tags: %w", err)
+		}
+	}
+	if v := field("metadata"); v != "" {
+		if err = json.Unmarshal([]byte(v), &rec.Metadata); err != nil {
+			return record{}, fmt.Errorf("metadata: %w", err)
+		}
+	}
+	times := []struct {
+		column string
+		dst    **time.Time
+	}{
+		{"created_at", &rec.CreatedAt},
+		{"updated_at", &rec.UpdatedAt},
+	}
+	for _, t := range times {
+		v := field(t.column)
+		if v == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return record{}, fmt.Errorf("%s: %w", t.column, err)
+		}
+		*t.dst = &parsed
+	}
+	if v := field("archived"); v != "" {
+		if rec.Archived, err = strconv.ParseBool(v); err != nil {
+			return record{}, fmt.Errorf("archived: %w", err)
+		}
+	}
+
+	return rec, nil
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func parseFloat(v string) (float64, error) {
+	if v == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(v, 64)
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
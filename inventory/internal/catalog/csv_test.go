@@ -0,0 +1,62 @@
+package catalog
+
+import (
+	"strings"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+func (s *CatalogSuite) TestDecodeCSVSubsetOfColumns() {
+	// Arrange - колонки в произвольном порядке, отсутствующие получают нулевые значения
+	data := "name,uuid,price,tags\n" +
+		"Engine,1,10.5,\"[\"\"hot\"\"]\"\n" +
+		"Wing,2,,\n"
+
+	// Act
+	rows, err := Decode(model.CatalogFormatCSV, strings.NewReader(data))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(rows, 2)
+	s.Require().Equal(2, rows[0].Line)
+	s.Require().Equal("1", rows[0].Part.UUID)
+	s.Require().Equal(10.5, rows[0].Part.Price)
+	s.Require().Equal([]string{"hot"}, rows[0].Part.Tags)
+	s.Require().Equal(3, rows[1].Line)
+	s.Require().Zero(rows[1].Part.Price)
+}
+
+func (s *CatalogSuite) TestDecodeCSVRowErrors() {
+	// Arrange
+	data := "uuid,name,price,archived\n" +
+		"1,Engine,abc,false\n" +
+		"2,Wing\n" +
+		"3,Fuel,1,maybe\n" +
+		"4,Porthole,2,true\n"
+
+	// Act
+	rows, err := Decode(model.CatalogFormatCSV, strings.NewReader(data))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(rows, 4)
+	s.Require().ErrorContains(rows[0].Err, "price")
+	s.Require().ErrorContains(rows[1].Err, "expected 4 fields")
+	s.Require().ErrorContains(rows[2].Err, "archived")
+	s.Require().NoError(rows[3].Err)
+	s.Require().Equal(5, rows[3].Line)
+	s.Require().True(rows[3].Part.Archived)
+}
+
+func (s *CatalogSuite) TestDecodeCSVUnknownColumn() {
+	_, err := Decode(model.CatalogFormatCSV, strings.NewReader("uuid,colour\n1,red\n"))
+
+	s.Require().ErrorContains(err, "colour")
+}
+
+func (s *CatalogSuite) TestDecodeCSVEmpty() {
+	rows, err := Decode(model.CatalogFormatCSV, strings.NewReader(""))
+
+	s.Require().NoError(err)
+	s.Require().Empty(rows)
+}
@@ -0,0 +1,52 @@
+package catalog
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+// maxLineSize ограничивает длину одной строки JSON Lines.
+const maxLineSize = 1 << 20
+
+func encodeJSONL(w io.Writer, parts []model.Part) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	for _, part := range parts {
+		if err := enc.Encode(toRecord(part)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeJSONL(r io.Reader) ([]model.ImportRow, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+
+	var (
+		rows []model.ImportRow
+		line int
+	)
+	for scanner.Scan() {
+		line++
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		var rec record
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&rec); err != nil {
+			rows = append(rows, model.ImportRow{Line: line, Err: err})
+			continue
+		}
+		rows = append(rows, model.ImportRow{Line: line, Part: rec.toModel()})
+	}
+
+	return rows, scanner.Err()
+}
@@ -0,0 +1,40 @@
+package catalog
+
+import (
+	"strings"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+func (s *CatalogSuite) TestDecodeJSONLRowErrors() {
+	// Arrange - пустая строка пропускается, битые строки не мешают остальным
+	data := strings.Join([]string{
+		`{"uuid":"1","name":"Engine","category":"ENGINE"}`,
+		``,
+		`{"uuid":"2","name":`,
+		`{"uuid":"3","colour":"red"}`,
+		`{"uuid":"4","name":"Wing","category":"WING","tags":["x"]}`,
+	}, "\n")
+
+	// Act
+	rows, err := Decode(model.CatalogFormatJSONL, strings.NewReader(data))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(rows, 4)
+
+	s.Require().NoError(rows[0].Err)
+	s.Require().Equal(1, rows[0].Line)
+	s.Require().Equal("Engine", rows[0].Part.Name)
+	s.Require().Equal(model.CategoryEngine, rows[0].Part.Category)
+
+	s.Require().Error(rows[1].Err)
+	s.Require().Equal(3, rows[1].Line)
+
+	s.Require().ErrorContains(rows[2].Err, "colour")
+	s.Require().Equal(4, rows[2].Line)
+
+	s.Require().NoError(rows[3].Err)
+	s.Require().Equal(5, rows[3].Line)
+	s.Require().Equal([]string{"x"}, rows[3].Part.Tags)
+}
@@ -0,0 +1,129 @@
+package catalog
+
+import (
+	"time"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+// record - деталь в файле каталога. Формат одинаков для JSON Lines и для
+// JSON-ячеек CSV, поэтому экспорт и повторный импорт не теряют данных.
+type record struct {
+	UUID          string                   `json:"uuid"`
+	Name          string                   `json:"name"`
+	Description   string                   `json:"description"`
+	Price         float64                  `json:"price"`
+	StockQuantity int64                    `json:"stock_quantity"`
+	Category      string                   `json:"category"`
+	Dimensions    dimensions               `json:"dimensions"`
+	Manufacturer  manufacturer             `json:"manufacturer"`
+	Tags          []string                 `json:"tags"`
+	Metadata      map[string]metadataValue `json:"metadata,omitempty"`
+	CreatedAt     *time.Time               `json:"created_at,omitempty"`
+	UpdatedAt     *time.Time               `json:"updated_at,omitempty"`
+	Archived      bool                     `json:"archived"`
+}
+
+type dimensions struct {
+	Length float64 `json:"length"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+	Weight float64 `json:"weight"`
+}
+
+type manufacturer struct {
+	Name    string `json:"name"`
+	Country string `json:"country"`
+	Website string `json:"website"`
+}
+
+// metadataValue хранит тип значения явно: иначе JSON не отличит int64 от double.
+type metadataValue struct {
+	String *string  `json:"string,omitempty"`
+	Int64  *int64   `json:"int64,omitempty"`
+	Double *float64 `json:"double,omitempty"`
+	Bool   *bool    `json:"bool,omitempty"`
+}
+
+func toRecord(part model.Part) record {
+	rec := record{
+		UUID:          part.UUID,
+		Name:          part.Name,
+		Description:   part.Description,
+		Price:         part.Price,
+		StockQuantity: part.StockQuantity,
+		Category:      string(part.Category),
+		Dimensions: dimensions{
+			Length: part.Dimensions.Length,
+			Width:  part.Dimensions.Width,
+			Height: part.Dimensions.Height,
+			Weight: part.Dimensions.Weight,
+		},
+		Manufacturer: manufacturer{
+			Name:    part.Manufacturer.Name,
+			Country: part.Manufacturer.Country,
+			Website: part.Manufacturer.Website,
+		},
+		Tags:      part.Tags,
+		UpdatedAt: part.UpdatedAt,
+		Archived:  part.Archived,
+	}
+	if rec.Tags == nil {
+		rec.Tags = []string{}
+	}
+	if !part.CreatedAt.IsZero() {
+		rec.CreatedAt = &part.CreatedAt
+	}
+	if len(part.Metadata) > 0 {
+		rec.Metadata = make(map[string]metadataValue, len(part.Metadata))
+		for key, value := range part.Metadata {
+			rec.Metadata[key] = metadataValue{
+				String: value.StringValue,
+				Int64:  value.Int64Value,
+				Double: value.DoubleValue,
+				Bool:   value.BoolValue,
+			}
+		}
+	}
+	return rec
+}
+
+func (rec record) toModel() model.Part {
+	part := model.Part{
+		UUID:          rec.UUID,
+		Name:          rec.Name,
+		Description:   rec.Description,
+		Price:         rec.Price,
+		StockQuantity: rec.StockQuantity,
+		Category:      model.Category(rec.Category),
+		Dimensions: model.Dimensions{
+			Length: rec.Dimensions.Length,
+			Width:  rec.Dimensions.Width,
+			Height: rec.Dimensions.Height,
+			Weight: rec.Dimensions.Weight,
+		},
+		Manufacturer: model.Manufacturer{
+			Name:    rec.Manufacturer.Name,
+			Country: rec.Manufacturer.Country,
+			Website: rec.Manufacturer.Website,
+		},
+		Tags:      rec.Tags,
+		UpdatedAt: rec.UpdatedAt,
+		Archived:  rec.Archived,
+	}
+	if rec.CreatedAt != nil {
+		part.CreatedAt = *rec.CreatedAt
+	}
+	if len(rec.Metadata) > 0 {
+		part.Metadata = make(model.Metadata, len(rec.Metadata))
+		for key, value := range rec.Metadata {
+			part.Metadata[key] = model.MetadataValue{
+				StringValue: value.String,
+				Int64Value:  value.Int64,
+				DoubleValue: value.Double,
+				BoolValue:   value.Bool,
+			}
+		}
+	}
+	return part
+}
@@ -0,0 +1,18 @@
+package converter
+
+import (
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func PartsBatchToProto(batch model.PartsBatch) *inventoryV1.BatchGetPartsResponse {
+	parts := make(map[string]*inventoryV1.Part, len(batch.Parts))
+	for uuid, part := range batch.Parts {
+		parts[uuid] = PartToProto(part)
+	}
+
+	return &inventoryV1.BatchGetPartsResponse{
+		Parts:        parts,
+		MissingUuids: batch.MissingUUIDs,
+	}
+}
@@ -0,0 +1,56 @@
+package converter
+
+import (
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func CatalogFormatToModel(format inventoryV1.CatalogFormat) model.CatalogFormat {
+	switch format {
+	case inventoryV1.CatalogFormat_CATALOG_FORMAT_JSONL:
+		return model.CatalogFormatJSONL
+	case inventoryV1.CatalogFormat_CATALOG_FORMAT_CSV:
+		return model.CatalogFormatCSV
+	default:
+		return ""
+	}
+}
+
+func ImportOptionsToModel(opts *inventoryV1.ImportPartsOptions) model.ImportOptions {
+	return model.ImportOptions{
+		DryRun: opts.GetDryRun(),
+		Prune:  opts.GetPrune(),
+	}
+}
+
+func ImportReportToProto(report model.ImportReport) *inventoryV1.ImportPartsResponse {
+	errs := make([]*inventoryV1.ImportError, 0, len(report.Errors))
+	for _, e := range report.Errors {
+		errs = append(errs, &inventoryV1.ImportError{
+			Line:    int32(e.Line),
+			Uuid:    e.UUID,
+			Message: e.Message,
+		})
+	}
+
+	return &inventoryV1.ImportPartsResponse{
+		Added:     importChangesToProto(report.Added),
+		Changed:   importChangesToProto(report.Changed),
+		Removed:   importChangesToProto(report.Removed),
+		Archived:  importChangesToProto(report.Archived),
+		Unchanged: int32(report.Unchanged),
+		Errors:    errs,
+	}
+}
+
+func importChangesToProto(changes []model.ImportChange) []*inventoryV1.ImportChange {
+	result := make([]*inventoryV1.ImportChange, 0, len(changes))
+	for _, c := range changes {
+		result = append(result, &inventoryV1.ImportChange{
+			Line:   int32(c.Line),
+			Uuid:   c.UUID,
+			Fields: c.Fields,
+		})
+	}
+	return result
+}
@@ -0,0 +1,32 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func PartEventToProto(event model.PartEvent) *inventoryV1.WatchPartsResponse {
+	return &inventoryV1.WatchPartsResponse{
+		Revision:   event.Revision,
+		Type:       partEventTypeToProto(event.Type),
+		Part:       PartToProto(event.Part),
+		OccurredAt: timestamppb.New(event.OccurredAt),
+	}
+}
+
+func partEventTypeToProto(eventType model.PartEventType) inventoryV1.PartEventType {
+	switch eventType {
+	case model.PartEventCreated:
+		return inventoryV1.PartEventType_PART_EVENT_TYPE_CREATED
+	case model.PartEventUpdated:
+		return inventoryV1.PartEventType_PART_EVENT_TYPE_UPDATED
+	case model.PartEventDeleted:
+		return inventoryV1.PartEventType_PART_EVENT_TYPE_DELETED
+	case model.PartEventRemoved:
+		return inventoryV1.PartEventType_PART_EVENT_TYPE_REMOVED
+	default:
+		return inventoryV1.PartEventType_PART_EVENT_TYPE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,33 @@
+package converter
+
+import (
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func PartFacetsToProto(facets model.PartFacets) *inventoryV1.GetPartFacetsResponse {
+	categories := make([]*inventoryV1.CategoryFacet, 0, len(facets.Categories))
+	for _, c := range facets.Categories {
+		categories = append(categories, &inventoryV1.CategoryFacet{
+			Category: categoryToProto(model.Category(c.Value)),
+			Count:    c.Count,
+		})
+	}
+
+	return &inventoryV1.GetPartFacetsResponse{
+		Categories:            categories,
+		ManufacturerCountries: facetCountsToProto(facets.ManufacturerCountries),
+		Tags:                  facetCountsToProto(facets.Tags),
+	}
+}
+
+func facetCountsToProto(counts []model.FacetCount) []*inventoryV1.FacetCount {
+	result := make([]*inventoryV1.FacetCount, 0, len(counts))
+	for _, c := range counts {
+		result = append(result, &inventoryV1.FacetCount{
+			Value: c.Value,
+			Count: c.Count,
+		})
+	}
+	return result
+}
@@ -0,0 +1,52 @@
+package converter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func ListPartsParamsToModel(req *inventoryV1.ListPartsRequest) (model.ListPartsParams, error) {
+	order, err := partsOrderToModel(req.GetOrderBy())
+	if err != nil {
+		return model.ListPartsParams{}, err
+	}
+
+	return model.ListPartsParams{
+		PageSize:  req.GetPageSize(),
+		PageToken: req.GetPageToken(),
+		OrderBy:   order,
+	}, nil
+}
+
+// partsOrderToModel разбирает order_by вида "<поле> [asc|desc]"; пустая строка — сортировка по created_at.
+func partsOrderToModel(orderBy string) (model.PartsOrder, error) {
+	fields := strings.Fields(orderBy)
+	if len(fields) == 0 {
+		return model.PartsOrder{Field: model.PartsOrderByCreatedAt}, nil
+	}
+	if len(fields) > 2 {
+		return model.PartsOrder{}, fmt.Errorf("%w: %q", model.ErrInvalidOrderBy, orderBy)
+	}
+
+	order := model.PartsOrder{Field: model.PartsOrderField(fields[0])}
+	switch order.Field {
+	case model.PartsOrderByCreatedAt, model.PartsOrderByName, model.PartsOrderByPrice, model.PartsOrderByStockQuantity:
+	default:
+		return model.PartsOrder{}, fmt.Errorf("%w: unknown field %q", model.ErrInvalidOrderBy, fields[0])
+	}
+
+	if len(fields) == 2 {
+		switch fields[1] {
+		case "asc":
+		case "desc":
+			order.Desc = true
+		default:
+			return model.PartsOrder{}, fmt.Errorf("%w: unknown direction %q", model.ErrInvalidOrderBy, fields[1])
+		}
+	}
+
+	return order, nil
+}
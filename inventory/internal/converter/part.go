@@ -1,6 +1,7 @@
 package converter
 
 import (
+	"github.com/samber/lo"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
@@ -26,6 +27,7 @@ func PartToProto(part model.Part) *inventoryV1.Part {
 		Metadata:      metadataToProto(part.Metadata),
 		CreatedAt:     timestamppb.New(part.CreatedAt),
 		UpdatedAt:     updatedAt,
+		Archived:      part.Archived,
 	}
 }
 
@@ -57,7 +59,7 @@ func PartsFilterToModel(filter *inventoryV1.PartsFilter) model.PartsFilter {
 	partsCategories := make([]model.Category, 0, len(filter.Categories))
 	if len(filter.Categories) > 0 {
 		for _, c := range filter.Categories {
-			partsCategories = append(partsCategories, model.Category(c.String()))
+			partsCategories = append(partsCategories, categoryToModel(c))
 		}
 	}
 
@@ -67,9 +69,62 @@ func PartsFilterToModel(filter *inventoryV1.PartsFilter) model.PartsFilter {
 		Categories:            partsCategories,
 		ManufacturerCountries: partsManufacturerCountries,
 		Tags:                  partsTags,
+		IncludeArchived:       filter.GetIncludeArchived(),
+		Price:                 floatRangeToModel(filter.GetPrice()),
+		StockQuantity:         int64RangeToModel(filter.GetStockQuantity()),
+		Dimensions: model.DimensionsRange{
+			Length: floatRangeToModel(filter.GetDimensions().GetLength()),
+			Width:  floatRangeToModel(filter.GetDimensions().GetWidth()),
+			Height: floatRangeToModel(filter.GetDimensions().GetHeight()),
+			Weight: floatRangeToModel(filter.GetDimensions().GetWeight()),
+		},
+		CreatedAt: timeRangeToModel(filter.GetCreatedAt()),
+		UpdatedAt: timeRangeToModel(filter.GetUpdatedAt()),
+		Metadata:  metadataConditionsToModel(filter.GetMetadata()),
 	}
 }
 
+func metadataConditionsToModel(conditions []*inventoryV1.MetadataCondition) []model.MetadataCondition {
+	if len(conditions) == 0 {
+		return nil
+	}
+
+	res := make([]model.MetadataCondition, 0, len(conditions))
+	for _, c := range conditions {
+		condition := model.MetadataCondition{Key: c.GetKey()}
+		if c.GetValue() != nil {
+			condition.Value = lo.ToPtr(metadataValueToModel(c.GetValue()))
+		}
+		res = append(res, condition)
+	}
+	return res
+}
+
+func floatRangeToModel(r *inventoryV1.DoubleRange) model.FloatRange {
+	if r == nil {
+		return model.FloatRange{}
+	}
+	return model.FloatRange{Min: r.Min, Max: r.Max}
+}
+
+func int64RangeToModel(r *inventoryV1.Int64Range) model.Int64Range {
+	if r == nil {
+		return model.Int64Range{}
+	}
+	return model.Int64Range{Min: r.Min, Max: r.Max}
+}
+
+func timeRangeToModel(r *inventoryV1.TimestampRange) model.TimeRange {
+	var result model.TimeRange
+	if r.GetFrom() != nil {
+		result.From = lo.ToPtr(r.GetFrom().AsTime())
+	}
+	if r.GetTo() != nil {
+		result.To = lo.ToPtr(r.GetTo().AsTime())
+	}
+	return result
+}
+
 func PartsToProto(parts []model.Part) []*inventoryV1.Part {
 	result := make([]*inventoryV1.Part, 0, len(parts))
 	for _, part := range parts {
@@ -93,6 +148,21 @@ func categoryToProto(category model.Category) inventoryV1.Category {
 	}
 }
 
+func categoryToModel(category inventoryV1.Category) model.Category {
+	switch category {
+	case inventoryV1.Category_CATEGORY_ENGINE:
+		return model.CategoryEngine
+	case inventoryV1.Category_CATEGORY_FUEL:
+		return model.CategoryFuel
+	case inventoryV1.Category_CATEGORY_PORTHOLE:
+		return model.CategoryPorthole
+	case inventoryV1.Category_CATEGORY_WING:
+		return model.CategoryWing
+	default:
+		return model.CategoryUnspecified
+	}
+}
+
 func dimensionsToProto(dims model.Dimensions) *inventoryV1.Dimensions {
 	return &inventoryV1.Dimensions{
 		Length: dims.Length,
@@ -111,26 +181,28 @@ func manufacturerToProto(man model.Manufacturer) *inventoryV1.Manufacturer {
 }
 
 func metadataToProto(meta model.Metadata) map[string]*inventoryV1.Value {
-	var val *inventoryV1.Value
+	if len(meta) == 0 {
+		return nil
+	}
+
+	res := make(map[string]*inventoryV1.Value, len(meta))
+	for key, value := range meta {
+		res[key] = metadataValueToProto(value)
+	}
+	return res
+}
+
+func metadataValueToProto(value model.MetadataValue) *inventoryV1.Value {
 	switch {
-	case meta.StringValue != nil:
-		val = &inventoryV1.Value{
-			Kind: &inventoryV1.Value_StringValue{StringValue: *meta.StringValue},
-		}
-	case meta.Int64Value != nil:
-		val = &inventoryV1.Value{
-			Kind: &inventoryV1.Value_Int64Value{Int64Value: *meta.Int64Value},
-		}
-	case meta.DoubleValue != nil:
-		val = &inventoryV1.Value{
-			Kind: &inventoryV1.Value_DoubleValue{DoubleValue: *meta.DoubleValue},
-		}
-	case meta.BoolValue != nil:
-		val = &inventoryV1.Value{
-			Kind: &inventoryV1.Value_BoolValue{BoolValue: *meta.BoolValue},
-		}
+	case value.StringValue != nil:
+		return &inventoryV1.Value{Kind: &inventoryV1.Value_StringValue{StringValue: *value.StringValue}}
+	case value.Int64Value != nil:
+		return &inventoryV1.Value{Kind: &inventoryV1.Value_Int64Value{Int64Value: *value.Int64Value}}
+	case value.DoubleValue != nil:
+		return &inventoryV1.Value{Kind: &inventoryV1.Value_DoubleValue{DoubleValue: *value.DoubleValue}}
+	case value.BoolValue != nil:
+		return &inventoryV1.Value{Kind: &inventoryV1.Value_BoolValue{BoolValue: *value.BoolValue}}
 	default:
-		val = &inventoryV1.Value{}
+		return &inventoryV1.Value{}
 	}
-	return map[string]*inventoryV1.Value{"value": val}
 }
@@ -0,0 +1,105 @@
+package converter
+
+import (
+	"fmt"
+
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func PartInputToModel(input *inventoryV1.PartInput) model.Part {
+	return model.Part{
+		Name:          input.GetName(),
+		Description:   input.GetDescription(),
+		Price:         input.GetPrice(),
+		StockQuantity: input.GetStockQuantity(),
+		Category:      categoryToModel(input.GetCategory()),
+		Dimensions:    dimensionsToModel(input.GetDimensions()),
+		Manufacturer:  manufacturerToModel(input.GetManufacturer()),
+		Tags:          input.GetTags(),
+		Metadata:      metadataToModel(input.GetMetadata()),
+	}
+}
+
+// PartUpdateInfoToModel переносит в PartUpdateInfo только поля из маски.
+// Поддерживаются пути верхнего уровня PartInput; вложенные объекты заменяются целиком.
+func PartUpdateInfoToModel(input *inventoryV1.PartInput, mask *fieldmaskpb.FieldMask) (model.PartUpdateInfo, error) {
+	if len(mask.GetPaths()) == 0 {
+		return model.PartUpdateInfo{}, fmt.Errorf("%w: update mask must not be empty", model.ErrInvalidPart)
+	}
+
+	var info model.PartUpdateInfo
+	for _, path := range mask.GetPaths() {
+		switch path {
+		case "name":
+			info.Name = lo.ToPtr(input.GetName())
+		case "description":
+			info.Description = lo.ToPtr(input.GetDescription())
+		case "price":
+			info.Price = lo.ToPtr(input.GetPrice())
+		case "stock_quantity":
+			info.StockQuantity = lo.ToPtr(input.GetStockQuantity())
+		case "category":
+			info.Category = lo.ToPtr(categoryToModel(input.GetCategory()))
+		case "dimensions":
+			info.Dimensions = lo.ToPtr(dimensionsToModel(input.GetDimensions()))
+		case "manufacturer":
+			info.Manufacturer = lo.ToPtr(manufacturerToModel(input.GetManufacturer()))
+		case "tags":
+			info.Tags = lo.ToPtr(input.GetTags())
+		case "metadata":
+			info.Metadata = lo.ToPtr(metadataToModel(input.GetMetadata()))
+		default:
+			return model.PartUpdateInfo{}, fmt.Errorf("%w: unsupported update mask path %q", model.ErrInvalidPart, path)
+		}
+	}
+
+	return info, nil
+}
+
+func dimensionsToModel(dims *inventoryV1.Dimensions) model.Dimensions {
+	return model.Dimensions{
+		Length: dims.GetLength(),
+		Width:  dims.GetWidth(),
+		Height: dims.GetHeight(),
+		Weight: dims.GetWeight(),
+	}
+}
+
+func manufacturerToModel(man *inventoryV1.Manufacturer) model.Manufacturer {
+	return model.Manufacturer{
+		Name:    man.GetName(),
+		Country: man.GetCountry(),
+		Website: man.GetWebsite(),
+	}
+}
+
+func metadataToModel(metadata map[string]*inventoryV1.Value) model.Metadata {
+	if len(metadata) == 0 {
+		return nil
+	}
+
+	res := make(model.Metadata, len(metadata))
+	for key, value := range metadata {
+		res[key] = metadataValueToModel(value)
+	}
+	return res
+}
+
+func metadataValueToModel(value *inventoryV1.Value) model.MetadataValue {
+	var res model.MetadataValue
+	switch v := value.GetKind().(type) {
+	case *inventoryV1.Value_StringValue:
+		res.StringValue = lo.ToPtr(v.StringValue)
+	case *inventoryV1.Value_Int64Value:
+		res.Int64Value = lo.ToPtr(v.Int64Value)
+	case *inventoryV1.Value_DoubleValue:
+		res.DoubleValue = lo.ToPtr(v.DoubleValue)
+	case *inventoryV1.Value_BoolValue:
+		res.BoolValue = lo.ToPtr(v.BoolValue)
+	}
+	return res
+}
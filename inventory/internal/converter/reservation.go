@@ -0,0 +1,18 @@
+package converter
+
+import (
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func ReservationItemsToModel(items []*inventoryV1.ReservationItem) []model.ReservationItem {
+	result := make([]model.ReservationItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, model.ReservationItem{
+			PartUUID: item.GetPartUuid(),
+			Quantity: item.GetQuantity(),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,26 @@
+package converter
+
+import (
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func SearchHitsToProto(hits []model.SearchHit) []*inventoryV1.SearchHit {
+	result := make([]*inventoryV1.SearchHit, 0, len(hits))
+	for _, hit := range hits {
+		highlights := make([]*inventoryV1.Highlight, 0, len(hit.Highlights))
+		for _, h := range hit.Highlights {
+			highlights = append(highlights, &inventoryV1.Highlight{
+				Field:   h.Field,
+				Snippet: h.Snippet,
+			})
+		}
+
+		result = append(result, &inventoryV1.SearchHit{
+			Part:       PartToProto(hit.Part),
+			Score:      hit.Score,
+			Highlights: highlights,
+		})
+	}
+	return result
+}
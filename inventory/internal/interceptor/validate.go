@@ -27,3 +27,33 @@ func Validate() grpc.UnaryServerInterceptor {
 		return resp, err
 	}
 }
+
+// ValidateStream проверяет каждое сообщение, полученное от клиента в потоковом методе.
+func ValidateStream() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &validatingStream{ServerStream: ss})
+	}
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if v, ok := m.(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,7 @@
+package model
+
+// PartsBatch - результат получения деталей по списку UUID
+type PartsBatch struct {
+	Parts        map[string]Part // Найденные детали по UUID
+	MissingUUIDs []string        // UUID, которых нет в каталоге, в порядке запроса без повторов
+}
@@ -0,0 +1,44 @@
+package model
+
+type CatalogFormat string
+
+const (
+	CatalogFormatJSONL CatalogFormat = "jsonl"
+	CatalogFormatCSV   CatalogFormat = "csv"
+)
+
+// ImportRow - строка файла каталога: разобранная деталь или ошибка разбора
+type ImportRow struct {
+	Line int
+	Part Part
+	Err  error
+}
+
+type ImportOptions struct {
+	DryRun bool // Только посчитать изменения, ничего не записывая
+	Prune  bool // Удалить детали, которых нет в файле; зарезервированные детали архивируются
+}
+
+// ImportReport - итог импорта (или того, что импорт сделал бы в режиме dry-run)
+type ImportReport struct {
+	Added     []ImportChange
+	Changed   []ImportChange
+	Removed   []ImportChange
+	Archived  []ImportChange // Детали, которые prune не удалил из-за активных резервов
+	Unchanged int
+	Errors    []ImportError
+}
+
+// ImportChange - деталь, затронутая импортом. UUID пуст у новой детали без UUID в режиме dry-run
+type ImportChange struct {
+	Line   int
+	UUID   string
+	Fields []string // Изменённые поля (только для Changed)
+}
+
+// ImportError - ошибка строки; Line равен 0, если ошибка не относится к строке файла (например, при удалении)
+type ImportError struct {
+	Line    int
+	UUID    string
+	Message string
+}
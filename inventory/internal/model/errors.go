@@ -3,7 +3,26 @@ package model
 import "errors"
 
 var (
-	ErrPartNotFound       = errors.New("part not found")
-	ErrPartsNotFound      = errors.New("parts not found")
-	ErrPartsInternalError = errors.New("internal error while getting parts")
+	ErrPartNotFound         = errors.New("part not found")
+	ErrPartsNotFound        = errors.New("parts not found")
+	ErrPartsInternalError   = errors.New("internal error while getting parts")
+	ErrInvalidPart          = errors.New("invalid part")
+	ErrPartArchived         = errors.New("part is archived")
+	ErrPartReserved         = errors.New("part has active reservations")
+	ErrInvalidPageToken     = errors.New("invalid page token")
+	ErrInvalidOrderBy       = errors.New("invalid order_by")
+	ErrInvalidPartsFilter   = errors.New("invalid parts filter")
+	ErrInvalidSearchQuery   = errors.New("invalid search query")
+	ErrUnknownCatalogFormat = errors.New("unknown catalog format")
+	ErrRevisionCompacted    = errors.New("revision is older than the retained event history")
+	ErrRevisionNotFound     = errors.New("revision is newer than the latest event")
+)
+
+// Reservation errors
+var (
+	ErrInsufficientStock   = errors.New("insufficient stock")
+	ErrStockBelowReserved  = errors.New("stock quantity is less than reserved quantity")
+	ErrReservationNotFound = errors.New("reservation not found")
+	ErrReservationExpired  = errors.New("reservation expired")
+	ErrReservationReleased = errors.New("reservation already released")
 )
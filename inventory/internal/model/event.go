@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+type PartEventType string
+
+const (
+	PartEventCreated PartEventType = "created"
+	PartEventUpdated PartEventType = "updated"
+	PartEventDeleted PartEventType = "deleted"
+	// PartEventRemoved - деталь после изменения перестала подходить под фильтр подписки.
+	// В хранилище не пишется: его формирует WatchParts для конкретного подписчика
+	PartEventRemoved PartEventType = "removed"
+)
+
+// PartEvent - изменение каталога. Revision строго возрастает в порядке изменений,
+// Part - состояние детали после изменения (для deleted - последнее состояние перед удалением).
+type PartEvent struct {
+	Revision   int64
+	Type       PartEventType
+	Part       Part
+	OccurredAt time.Time
+}
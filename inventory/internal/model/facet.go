@@ -0,0 +1,22 @@
+package model
+
+type FacetField string
+
+const (
+	FacetCategory            FacetField = "category"
+	FacetManufacturerCountry FacetField = "manufacturer_country"
+	FacetTag                 FacetField = "tag"
+)
+
+// FacetCount - сколько деталей имеют значение фасета
+type FacetCount struct {
+	Value string
+	Count int64
+}
+
+// PartFacets - счётчики фасетов каталога; отсортированы по убыванию количества, затем по значению
+type PartFacets struct {
+	Categories            []FacetCount
+	ManufacturerCountries []FacetCount
+	Tags                  []FacetCount
+}
@@ -0,0 +1,117 @@
+package model
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// Match проверяет деталь на соответствие фильтру так же, как это делает ListParts.
+func (filter PartsFilter) Match(part Part) bool {
+	// Архивные детали скрыты, пока их не запросили явно
+	if part.Archived && !filter.IncludeArchived {
+		return false
+	}
+
+	if len(filter.UUIDs) > 0 && !slices.Contains(filter.UUIDs, part.UUID) {
+		return false
+	}
+
+	// Фильтрация по имени
+	if len(filter.Names) > 0 && !slices.Contains(filter.Names, part.Name) {
+		return false
+	}
+
+	// Фильтрация по категории
+	if len(filter.Categories) > 0 && !slices.Contains(filter.Categories, part.Category) {
+		return false
+	}
+
+	// Фильтрация по странам
+	if len(filter.ManufacturerCountries) > 0 && !slices.Contains(filter.ManufacturerCountries, part.Manufacturer.Country) {
+		return false
+	}
+
+	// Фильтрация по тегам (если хотя бы один тег совпадает)
+	if len(filter.Tags) > 0 && !hasCommonElement(filter.Tags, part.Tags) {
+		return false
+	}
+
+	// Фильтрация по диапазонам
+	if !inRange(part.Price, filter.Price.Min, filter.Price.Max) ||
+		!inRange(part.StockQuantity, filter.StockQuantity.Min, filter.StockQuantity.Max) {
+		return false
+	}
+
+	dims := filter.Dimensions
+	if !inRange(part.Dimensions.Length, dims.Length.Min, dims.Length.Max) ||
+		!inRange(part.Dimensions.Width, dims.Width.Min, dims.Width.Max) ||
+		!inRange(part.Dimensions.Height, dims.Height.Min, dims.Height.Max) ||
+		!inRange(part.Dimensions.Weight, dims.Weight.Min, dims.Weight.Max) {
+		return false
+	}
+
+	if !inTimeRange(&part.CreatedAt, filter.CreatedAt) || !inTimeRange(part.UpdatedAt, filter.UpdatedAt) {
+		return false
+	}
+
+	// Фильтрация по метаданным (должны выполняться все условия)
+	for _, c := range filter.Metadata {
+		value, ok := part.Metadata[c.Key]
+		if !ok || (c.Value != nil && !equalMetadataValue(value, *c.Value)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// equalMetadataValue сравнивает значения с учётом типа: int64 5 не равно double 5.
+func equalMetadataValue(a, b MetadataValue) bool {
+	return equalPtr(a.StringValue, b.StringValue) &&
+		equalPtr(a.Int64Value, b.Int64Value) &&
+		equalPtr(a.DoubleValue, b.DoubleValue) &&
+		equalPtr(a.BoolValue, b.BoolValue)
+}
+
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func inRange[T cmp.Ordered](v T, minValue, maxValue *T) bool {
+	if minValue != nil && v < *minValue {
+		return false
+	}
+	if maxValue != nil && v > *maxValue {
+		return false
+	}
+	return true
+}
+
+func inTimeRange(t *time.Time, r TimeRange) bool {
+	if r.From == nil && r.To == nil {
+		return true
+	}
+	if t == nil {
+		return false
+	}
+	if r.From != nil && t.Before(*r.From) {
+		return false
+	}
+	if r.To != nil && t.After(*r.To) {
+		return false
+	}
+	return true
+}
+
+func hasCommonElement(a, b []string) bool {
+	for _, v := range a {
+		if slices.Contains(b, v) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package model
+
+import "time"
+
+type PartsOrderField string
+
+const (
+	PartsOrderByCreatedAt     PartsOrderField = "created_at"
+	PartsOrderByName          PartsOrderField = "name"
+	PartsOrderByPrice         PartsOrderField = "price"
+	PartsOrderByStockQuantity PartsOrderField = "stock_quantity"
+)
+
+// PartsOrder - порядок выдачи; при равных значениях детали упорядочиваются по UUID в том же направлении
+type PartsOrder struct {
+	Field PartsOrderField
+	Desc  bool
+}
+
+// ListPartsParams - параметры страницы, которые передаёт клиент
+type ListPartsParams struct {
+	PageSize  int32 // 0 — вернуть все детали одной страницей
+	PageToken string
+	OrderBy   PartsOrder
+}
+
+// PartsList - страница деталей для клиента
+type PartsList struct {
+	Parts         []Part
+	NextPageToken string
+	TotalSize     int32
+}
+
+// PartsPageRequest - запрос страницы к хранилищу
+type PartsPageRequest struct {
+	PageSize int32
+	OrderBy  PartsOrder
+	After    *PartsCursor // Последняя деталь предыдущей страницы
+}
+
+// PartsPage - страница деталей из хранилища
+type PartsPage struct {
+	Parts     []Part
+	TotalSize int32
+	Next      *PartsCursor // nil, если страница последняя
+}
+
+// PartsCursor - значения ключа сортировки последней выданной детали
+type PartsCursor struct {
+	UUID          string
+	Name          string
+	Price         float64
+	StockQuantity int64
+	CreatedAt     time.Time
+}
+
+func NewPartsCursor(part Part) *PartsCursor {
+	return &PartsCursor{
+		UUID:          part.UUID,
+		Name:          part.Name,
+		Price:         part.Price,
+		StockQuantity: part.StockQuantity,
+		CreatedAt:     part.CreatedAt,
+	}
+}
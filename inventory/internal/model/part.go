@@ -15,6 +15,7 @@ type Part struct {
 	Metadata      Metadata
 	CreatedAt     time.Time
 	UpdatedAt     *time.Time
+	Archived      bool
 }
 
 type Category string
@@ -41,7 +42,11 @@ type Manufacturer struct {
 	Website string
 }
 
-type Metadata struct {
+// Metadata - гибкие метаданные детали по ключам
+type Metadata map[string]MetadataValue
+
+// MetadataValue - значение метаданных; задано ровно одно из полей
+type MetadataValue struct {
 	StringValue *string
 	Int64Value  *int64
 	DoubleValue *float64
@@ -54,4 +59,53 @@ type PartsFilter struct {
 	Categories            []Category
 	ManufacturerCountries []string
 	Tags                  []string
+	IncludeArchived       bool
+	Price                 FloatRange
+	StockQuantity         Int64Range
+	Dimensions            DimensionsRange
+	CreatedAt             TimeRange
+	UpdatedAt             TimeRange           // Детали без обновлений под диапазон не попадают
+	Metadata              []MetadataCondition // Деталь должна удовлетворять всем условиям
+}
+
+// MetadataCondition - условие на метаданные: наличие ключа или, если задан Value, равенство значения
+type MetadataCondition struct {
+	Key   string
+	Value *MetadataValue
+}
+
+// FloatRange - диапазон значений с включёнными границами; nil означает "без ограничения"
+type FloatRange struct {
+	Min *float64
+	Max *float64
+}
+
+type Int64Range struct {
+	Min *int64
+	Max *int64
+}
+
+type TimeRange struct {
+	From *time.Time
+	To   *time.Time
+}
+
+type DimensionsRange struct {
+	Length FloatRange
+	Width  FloatRange
+	Height FloatRange
+	Weight FloatRange
+}
+
+// PartUpdateInfo - изменения детали: nil означает "оставить поле без изменений"
+type PartUpdateInfo struct {
+	Name          *string
+	Description   *string
+	Price         *float64
+	StockQuantity *int64
+	Category      *Category
+	Dimensions    *Dimensions
+	Manufacturer  *Manufacturer
+	Tags          *[]string
+	Metadata      *Metadata
 }
@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+type Reservation struct {
+	UUID      string
+	Items     []ReservationItem
+	Status    ReservationStatus
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UpdatedAt *time.Time
+}
+
+type ReservationItem struct {
+	PartUUID string
+	Quantity int64
+}
+
+type ReservationStatus string
+
+const (
+	ReservationStatusActive    ReservationStatus = "ACTIVE"    // Детали зарезервированы до ExpiresAt
+	ReservationStatusCommitted ReservationStatus = "COMMITTED" // Детали списаны со склада
+	ReservationStatusReleased  ReservationStatus = "RELEASED"  // Резерв снят, детали возвращены на склад
+	ReservationStatusExpired   ReservationStatus = "EXPIRED"   // Срок резерва истёк, детали возвращены на склад
+)
@@ -0,0 +1,14 @@
+package model
+
+// SearchHit - найденная деталь с оценкой релевантности
+type SearchHit struct {
+	Part       Part
+	Score      float64
+	Highlights []SearchHighlight
+}
+
+// SearchHighlight - фрагмент поля, в котором найденные слова обёрнуты в <em></em>
+type SearchHighlight struct {
+	Field   string // name, description, tags или manufacturer_name
+	Snippet string
+}
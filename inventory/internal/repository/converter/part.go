@@ -19,6 +19,7 @@ func PartToModel(part repoModel.Part) model.Part {
 		Metadata:      partMetadataToModel(part.Metadata),
 		CreatedAt:     part.CreatedAt,
 		UpdatedAt:     part.UpdatedAt,
+		Archived:      part.Archived,
 	}
 }
 
@@ -40,10 +41,74 @@ func partManufacturerToModel(manufacturer repoModel.Manufacturer) model.Manufact
 }
 
 func partMetadataToModel(metadata repoModel.Metadata) model.Metadata {
-	return model.Metadata{
-		StringValue: metadata.StringValue,
-		Int64Value:  metadata.Int64Value,
-		DoubleValue: metadata.DoubleValue,
-		BoolValue:   metadata.BoolValue,
+	if metadata == nil {
+		return nil
+	}
+
+	res := make(model.Metadata, len(metadata))
+	for key, value := range metadata {
+		res[key] = model.MetadataValue{
+			StringValue: value.StringValue,
+			Int64Value:  value.Int64Value,
+			DoubleValue: value.DoubleValue,
+			BoolValue:   value.BoolValue,
+		}
+	}
+	return res
+}
+
+func PartToRepoModel(part model.Part) repoModel.Part {
+	return repoModel.Part{
+		UUID:          part.UUID,
+		Name:          part.Name,
+		Description:   part.Description,
+		Price:         part.Price,
+		StockQuantity: part.StockQuantity,
+		Category:      repoModel.Category(part.Category),
+		Dimensions:    DimensionsToRepoModel(part.Dimensions),
+		Manufacturer:  ManufacturerToRepoModel(part.Manufacturer),
+		Tags:          part.Tags,
+		Metadata:      MetadataToRepoModel(part.Metadata),
+		CreatedAt:     part.CreatedAt,
+		UpdatedAt:     part.UpdatedAt,
+		Archived:      part.Archived,
+	}
+}
+
+func DimensionsToRepoModel(dimensions model.Dimensions) repoModel.Dimensions {
+	return repoModel.Dimensions{
+		Length: dimensions.Length,
+		Width:  dimensions.Width,
+		Height: dimensions.Height,
+		Weight: dimensions.Weight,
+	}
+}
+
+func ManufacturerToRepoModel(manufacturer model.Manufacturer) repoModel.Manufacturer {
+	return repoModel.Manufacturer{
+		Name:    manufacturer.Name,
+		Country: manufacturer.Country,
+		Website: manufacturer.Website,
+	}
+}
+
+func MetadataToRepoModel(metadata model.Metadata) repoModel.Metadata {
+	if metadata == nil {
+		return nil
+	}
+
+	res := make(repoModel.Metadata, len(metadata))
+	for key, value := range metadata {
+		res[key] = MetadataValueToRepoModel(value)
+	}
+	return res
+}
+
+func MetadataValueToRepoModel(value model.MetadataValue) repoModel.MetadataValue {
+	return repoModel.MetadataValue{
+		StringValue: value.StringValue,
+		Int64Value:  value.Int64Value,
+		DoubleValue: value.DoubleValue,
+		BoolValue:   value.BoolValue,
 	}
 }
@@ -0,0 +1,37 @@
+package converter
+
+import (
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+)
+
+func ReservationToModel(reservation repoModel.Reservation) model.Reservation {
+	items := make([]model.ReservationItem, 0, len(reservation.Items))
+	for _, item := range reservation.Items {
+		items = append(items, model.ReservationItem{
+			PartUUID: item.PartUUID,
+			Quantity: item.Quantity,
+		})
+	}
+
+	return model.Reservation{
+		UUID:      reservation.UUID,
+		Items:     items,
+		Status:    model.ReservationStatus(reservation.Status),
+		ExpiresAt: reservation.ExpiresAt,
+		CreatedAt: reservation.CreatedAt,
+		UpdatedAt: reservation.UpdatedAt,
+	}
+}
+
+func ReservationItemsToRepoModel(items []model.ReservationItem) []repoModel.ReservationItem {
+	result := make([]repoModel.ReservationItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, repoModel.ReservationItem{
+			PartUUID: item.PartUUID,
+			Quantity: item.Quantity,
+		})
+	}
+
+	return result
+}
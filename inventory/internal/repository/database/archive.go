@@ -0,0 +1,47 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+// ArchivePart снимает деталь с продажи. Повторная архивация не меняет updated_at.
+func (r *repository) ArchivePart(ctx context.Context, uuid string) (_ model.Part, err error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return model.Part{}, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	res, err := tx.ExecContext(ctx,
+		`UPDATE parts SET archived = TRUE, updated_at = $1 WHERE uuid = $2 AND archived = FALSE`,
+		time.Now().UTC(), uuid,
+	)
+	if err != nil {
+		return model.Part{}, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return model.Part{}, err
+	}
+	if affected > 0 {
+		if err = appendUpdatedEvents(ctx, tx, uuid); err != nil {
+			return model.Part{}, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return model.Part{}, err
+	}
+	r.invalidateIndex()
+	r.notifier.Notify()
+
+	return r.GetPart(ctx, uuid)
+}
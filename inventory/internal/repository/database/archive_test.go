@@ -0,0 +1,45 @@
+package database
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func (s *RepositorySuite) TestArchivePartSuccess() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	s.insert(repoPart)
+
+	// Act
+	res, err := s.repo.ArchivePart(s.ctx, repoPart.UUID)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().True(res.Archived)
+	s.Require().True(res.UpdatedAt.After(*repoPart.UpdatedAt))
+
+	// Архивная деталь пропадает из выдачи по умолчанию и недоступна для резерва
+	_, err = s.repo.ListParts(s.ctx, model.PartsFilter{}, model.PartsPageRequest{})
+	s.Require().ErrorIs(err, model.ErrPartsNotFound)
+
+	page, err := s.repo.ListParts(s.ctx, model.PartsFilter{IncludeArchived: true}, model.PartsPageRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(page.Parts, 1)
+
+	_, err = s.repo.ReserveParts(s.ctx, []model.ReservationItem{
+		{PartUUID: repoPart.UUID, Quantity: 1},
+	}, time.Now().Add(time.Hour))
+	s.Require().ErrorIs(err, model.ErrPartArchived)
+}
+
+func (s *RepositorySuite) TestArchivePartNotFound() {
+	// Act
+	_, err := s.repo.ArchivePart(s.ctx, gofakeit.UUID())
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+}
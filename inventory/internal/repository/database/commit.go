@@ -0,0 +1,60 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+)
+
+// CommitReservation окончательно списывает детали резерва. Повторное подтверждение
+// не считается ошибкой.
+func (r *repository) CommitReservation(ctx context.Context, reservationUUID string) (err error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	status, expiresAt, err := getReservationState(ctx, tx, reservationUUID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+
+	switch status {
+	case repoModel.ReservationStatusCommitted:
+		return tx.Commit()
+	case repoModel.ReservationStatusReleased:
+		return model.ErrReservationReleased
+	case repoModel.ReservationStatusExpired:
+		return model.ErrReservationExpired
+	}
+
+	if !now.Before(expiresAt) {
+		if err = closeReservation(ctx, tx, reservationUUID, repoModel.ReservationStatusActive, repoModel.ReservationStatusExpired, now); err != nil {
+			return err
+		}
+		if err = tx.Commit(); err != nil {
+			return err
+		}
+		r.notifier.Notify()
+		return model.ErrReservationExpired
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`UPDATE reservations SET status = $1, updated_at = $2 WHERE uuid = $3 AND status = $4`,
+		string(repoModel.ReservationStatusCommitted), now, reservationUUID, string(repoModel.ReservationStatusActive),
+	)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,77 @@
+package database
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func (s *RepositorySuite) TestCommitReservationSuccess() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	repoPart.StockQuantity = 4
+	s.insert(repoPart)
+
+	reservation, err := s.repo.ReserveParts(s.ctx, []model.ReservationItem{
+		{PartUUID: repoPart.UUID, Quantity: 3},
+	}, time.Now().Add(time.Hour))
+	s.Require().NoError(err)
+
+	// Act
+	err = s.repo.CommitReservation(s.ctx, reservation.UUID)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), s.stock(repoPart.UUID))
+	s.Require().Equal(string(model.ReservationStatusCommitted), s.reservationStatus(reservation.UUID))
+	s.Require().NoError(s.repo.CommitReservation(s.ctx, reservation.UUID))
+}
+
+func (s *RepositorySuite) TestCommitReservationExpired() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	repoPart.StockQuantity = 2
+	s.insert(repoPart)
+
+	reservation, err := s.repo.ReserveParts(s.ctx, []model.ReservationItem{
+		{PartUUID: repoPart.UUID, Quantity: 2},
+	}, time.Now().Add(-time.Minute))
+	s.Require().NoError(err)
+
+	// Act
+	err = s.repo.CommitReservation(s.ctx, reservation.UUID)
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrReservationExpired)
+	s.Require().Equal(int64(2), s.stock(repoPart.UUID))
+	s.Require().Equal(string(model.ReservationStatusExpired), s.reservationStatus(reservation.UUID))
+}
+
+func (s *RepositorySuite) TestCommitReservationReleased() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	s.insert(repoPart)
+
+	reservation, err := s.repo.ReserveParts(s.ctx, []model.ReservationItem{
+		{PartUUID: repoPart.UUID, Quantity: 1},
+	}, time.Now().Add(time.Hour))
+	s.Require().NoError(err)
+	s.Require().NoError(s.repo.ReleaseReservation(s.ctx, reservation.UUID))
+
+	// Act
+	err = s.repo.CommitReservation(s.ctx, reservation.UUID)
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrReservationReleased)
+}
+
+func (s *RepositorySuite) TestCommitReservationNotFound() {
+	// Act
+	err := s.repo.CommitReservation(s.ctx, gofakeit.UUID())
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrReservationNotFound)
+}
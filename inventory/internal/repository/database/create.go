@@ -0,0 +1,28 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoConverter "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/converter"
+	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+)
+
+func (r *repository) CreatePart(ctx context.Context, part model.Part) (model.Part, error) {
+	now := time.Now().UTC()
+
+	repoPart := repoConverter.PartToRepoModel(part)
+	repoPart.UUID = uuid.NewString()
+	repoPart.CreatedAt = now
+	repoPart.UpdatedAt = &now
+	repoPart.Archived = false
+
+	if err := r.insertParts(ctx, []repoModel.Part{repoPart}); err != nil {
+		return model.Part{}, err
+	}
+
+	return repoConverter.PartToModel(repoPart), nil
+}
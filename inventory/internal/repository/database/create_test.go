@@ -0,0 +1,26 @@
+package database
+
+import (
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func (s *RepositorySuite) TestCreatePartSuccess() {
+	// Arrange
+	part := testutils.CreatePart()
+
+	// Act
+	res, err := s.repo.CreatePart(s.ctx, part)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().NotEmpty(res.UUID)
+	s.Require().NotNil(res.UpdatedAt)
+
+	saved, err := s.repo.GetPart(s.ctx, res.UUID)
+	s.Require().NoError(err)
+	s.Require().Equal(part.Name, saved.Name)
+	s.Require().Equal(part.Price, saved.Price)
+	s.Require().Equal(part.Tags, saved.Tags)
+	s.Require().False(saved.Archived)
+	s.Require().True(res.CreatedAt.Equal(saved.CreatedAt))
+}
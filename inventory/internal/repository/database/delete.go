@@ -0,0 +1,63 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+)
+
+func (r *repository) DeletePart(ctx context.Context, uuid string) (err error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	part, err := getPart(ctx, tx, uuid)
+	if err != nil {
+		return err
+	}
+
+	// Пока деталь держит действующий резерв, её нельзя удалить: заказ ещё может быть оплачен
+	var reserved int
+	err = tx.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM reservation_items ri
+		JOIN reservations r ON r.uuid = ri.reservation_uuid
+		WHERE ri.part_uuid = $1 AND r.status = $2 AND r.expires_at > $3`,
+		uuid, string(repoModel.ReservationStatusActive), time.Now().UTC(),
+	).Scan(&reserved)
+	if err != nil {
+		return err
+	}
+	if reserved > 0 {
+		return model.ErrPartReserved
+	}
+
+	// Теги и метаданные удаляем явно: SQLite по умолчанию не применяет ON DELETE CASCADE
+	if _, err = tx.ExecContext(ctx, `DELETE FROM part_tags WHERE part_uuid = $1`, uuid); err != nil {
+		return err
+	}
+	if _, err = tx.ExecContext(ctx, `DELETE FROM part_metadata WHERE part_uuid = $1`, uuid); err != nil {
+		return err
+	}
+	if _, err = tx.ExecContext(ctx, `DELETE FROM parts WHERE uuid = $1`, uuid); err != nil {
+		return err
+	}
+	if err = appendPartEvent(ctx, tx, model.PartEventDeleted, part); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	r.invalidateIndex()
+	r.notifier.Notify()
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package database
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func (s *RepositorySuite) TestDeletePartSuccess() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	s.insert(repoPart)
+
+	// Act
+	err := s.repo.DeletePart(s.ctx, repoPart.UUID)
+
+	// Assert
+	s.Require().NoError(err)
+
+	_, err = s.repo.GetPart(s.ctx, repoPart.UUID)
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+
+	var tags int
+	s.Require().NoError(s.db.QueryRowContext(s.ctx,
+		`SELECT COUNT(*) FROM part_tags WHERE part_uuid = $1`, repoPart.UUID,
+	).Scan(&tags))
+	s.Require().Zero(tags)
+}
+
+func (s *RepositorySuite) TestDeletePartReserved() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	s.insert(repoPart)
+
+	_, err := s.repo.ReserveParts(s.ctx, []model.ReservationItem{
+		{PartUUID: repoPart.UUID, Quantity: 1},
+	}, time.Now().Add(time.Hour))
+	s.Require().NoError(err)
+
+	// Act
+	err = s.repo.DeletePart(s.ctx, repoPart.UUID)
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrPartReserved)
+}
+
+func (s *RepositorySuite) TestDeletePartNotFound() {
+	// Act
+	err := s.repo.DeletePart(s.ctx, gofakeit.UUID())
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+}
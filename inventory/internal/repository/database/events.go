@@ -0,0 +1,125 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoConverter "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/converter"
+	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/repository/watch"
+)
+
+func (r *repository) PartEvents(ctx context.Context, afterRevision int64, limit int) ([]model.PartEvent, error) {
+	revision, err := currentRevision(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	if afterRevision > revision {
+		return nil, model.ErrRevisionNotFound
+	}
+	if afterRevision == revision {
+		return nil, nil
+	}
+
+	query := `SELECT revision, type, part, occurred_at FROM part_events WHERE revision > $1 ORDER BY revision`
+	args := []any{afterRevision}
+	if limit > 0 {
+		query += ` LIMIT $2`
+		args = append(args, limit)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var events []model.PartEvent
+	for rows.Next() {
+		var (
+			event     model.PartEvent
+			eventType string
+			data      string
+			part      repoModel.Part
+		)
+		if err = rows.Scan(&event.Revision, &eventType, &data, &event.OccurredAt); err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal([]byte(data), &part); err != nil {
+			return nil, err
+		}
+		event.Type = model.PartEventType(eventType)
+		event.Part = repoConverter.PartToModel(part)
+		events = append(events, event)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Ревизии идут без пропусков, поэтому разрыв после afterRevision означает, что события уже удалены
+	if len(events) == 0 || events[0].Revision != afterRevision+1 {
+		return nil, model.ErrRevisionCompacted
+	}
+
+	return events, nil
+}
+
+func (r *repository) PartsRevision(ctx context.Context) (int64, error) {
+	return currentRevision(ctx, r.db)
+}
+
+func (r *repository) WaitPartEvents() <-chan struct{} {
+	return r.notifier.Wait()
+}
+
+func currentRevision(ctx context.Context, q querier) (int64, error) {
+	var revision int64
+	err := q.QueryRowContext(ctx, `SELECT revision FROM part_revision WHERE id = 1`).Scan(&revision)
+	return revision, err
+}
+
+// appendPartEvent записывает событие в транзакции изменения. Увеличение счётчика блокирует
+// его строку до коммита, поэтому конкурентные записи получают ревизии в порядке коммитов.
+func appendPartEvent(ctx context.Context, tx *sql.Tx, eventType model.PartEventType, part repoModel.Part) error {
+	if _, err := tx.ExecContext(ctx, `UPDATE part_revision SET revision = revision + 1 WHERE id = 1`); err != nil {
+		return err
+	}
+	revision, err := currentRevision(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(part)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO part_events (revision, type, part_uuid, part, occurred_at) VALUES ($1, $2, $3, $4, $5)`,
+		revision, string(eventType), part.UUID, string(data), time.Now().UTC(),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM part_events WHERE revision <= $1`, revision-watch.DefaultRetention)
+	return err
+}
+
+// appendUpdatedEvents записывает события updated с текущим состоянием деталей внутри транзакции.
+func appendUpdatedEvents(ctx context.Context, tx *sql.Tx, partUUIDs ...string) error {
+	for _, partUUID := range partUUIDs {
+		part, err := getPart(ctx, tx, partUUID)
+		if err != nil {
+			return err
+		}
+		if err = appendPartEvent(ctx, tx, model.PartEventUpdated, part); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package database
+
+import (
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func (s *RepositorySuite) TestPartEventsFollowWrites() {
+	// Arrange
+	created, err := s.repo.CreatePart(s.ctx, testutils.CreatePart())
+	s.Require().NoError(err)
+	_, err = s.repo.UpdatePart(s.ctx, created.UUID, model.PartUpdateInfo{Tags: lo.ToPtr([]string{"hot"})})
+	s.Require().NoError(err)
+	_, err = s.repo.ArchivePart(s.ctx, created.UUID)
+	s.Require().NoError(err)
+	_, err = s.repo.ArchivePart(s.ctx, created.UUID) // повторная архивация ничего не меняет
+	s.Require().NoError(err)
+	s.Require().NoError(s.repo.DeletePart(s.ctx, created.UUID))
+
+	// Act
+	events, err := s.repo.PartEvents(s.ctx, 0, 0)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(
+		[]model.PartEventType{model.PartEventCreated, model.PartEventUpdated, model.PartEventUpdated, model.PartEventDeleted},
+		lo.Map(events, func(e model.PartEvent, _ int) model.PartEventType { return e.Type }),
+	)
+	s.Require().Equal([]string{"hot"}, events[1].Part.Tags)
+	s.Require().Equal(created.Metadata, events[1].Part.Metadata)
+	s.Require().True(events[2].Part.Archived)
+	s.Require().Equal(created.UUID, events[3].Part.UUID)
+
+	revision, err := s.repo.PartsRevision(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(4), revision)
+}
+
+func (s *RepositorySuite) TestPartEventsOnStockChanges() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	repoPart.StockQuantity = 10
+	s.insert(repoPart)
+
+	reservation, err := s.repo.ReserveParts(s.ctx,
+		[]model.ReservationItem{{PartUUID: repoPart.UUID, Quantity: 4}},
+		time.Now().Add(time.Minute),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.repo.ReleaseReservation(s.ctx, reservation.UUID))
+
+	// Act - первое событие - создание детали в s.insert
+	events, err := s.repo.PartEvents(s.ctx, 1, 0)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(events, 2)
+	s.Require().Equal(int64(6), events[0].Part.StockQuantity)
+	s.Require().Equal(int64(10), events[1].Part.StockQuantity)
+}
+
+func (s *RepositorySuite) TestPartEventsLimitAndResume() {
+	// Arrange
+	s.insert(testutils.CreateRepoPart(), testutils.CreateRepoPart(), testutils.CreateRepoPart())
+
+	// Act
+	first, err := s.repo.PartEvents(s.ctx, 0, 2)
+	s.Require().NoError(err)
+	rest, err := s.repo.PartEvents(s.ctx, first[len(first)-1].Revision, 2)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(first, 2)
+	s.Require().Len(rest, 1)
+	s.Require().Equal(int64(3), rest[0].Revision)
+}
+
+func (s *RepositorySuite) TestPartEventsRevisionErrors() {
+	// Arrange - имитируем удаление старых событий по сроку хранения
+	s.insert(testutils.CreateRepoPart(), testutils.CreateRepoPart())
+	_, err := s.db.ExecContext(s.ctx, `DELETE FROM part_events WHERE revision = 1`)
+	s.Require().NoError(err)
+
+	// Act
+	_, compactedErr := s.repo.PartEvents(s.ctx, 0, 0)
+	_, futureErr := s.repo.PartEvents(s.ctx, 3, 0)
+	events, err := s.repo.PartEvents(s.ctx, 1, 0)
+
+	// Assert
+	s.Require().ErrorIs(compactedErr, model.ErrRevisionCompacted)
+	s.Require().ErrorIs(futureErr, model.ErrRevisionNotFound)
+	s.Require().NoError(err)
+	s.Require().Len(events, 1)
+}
+
+func (s *RepositorySuite) TestWaitPartEventsClosedAfterWrite() {
+	// Arrange
+	wait := s.repo.WaitPartEvents()
+
+	// Act
+	s.insert(testutils.CreateRepoPart())
+
+	// Assert
+	select {
+	case <-wait:
+	default:
+		s.Fail("waiter was not notified")
+	}
+}
@@ -0,0 +1,47 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+// CountParts группирует подходящие под фильтр детали по значению поля.
+func (r *repository) CountParts(ctx context.Context, filter model.PartsFilter, field model.FacetField) ([]model.FacetCount, error) {
+	where, args := buildFilter(filter)
+
+	var query string
+	switch field {
+	case model.FacetCategory:
+		query = `SELECT p.category AS value, COUNT(*) AS cnt FROM parts p`
+	case model.FacetManufacturerCountry:
+		query = `SELECT p.manufacturer_country AS value, COUNT(*) AS cnt FROM parts p`
+	case model.FacetTag:
+		query = `SELECT t.tag AS value, COUNT(DISTINCT p.uuid) AS cnt FROM parts p JOIN part_tags t ON t.part_uuid = p.uuid`
+	default:
+		return nil, fmt.Errorf("unknown facet field %q", field)
+	}
+
+	if where != "" {
+		query += ` WHERE ` + where
+	}
+	query += ` GROUP BY value ORDER BY cnt DESC, value`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var result []model.FacetCount
+	for rows.Next() {
+		var count model.FacetCount
+		if err = rows.Scan(&count.Value, &count.Count); err != nil {
+			return nil, err
+		}
+		result = append(result, count)
+	}
+
+	return result, rows.Err()
+}
@@ -0,0 +1,54 @@
+package database
+
+import (
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func (s *RepositorySuite) TestCountParts() {
+	// Arrange
+	usEngine := testutils.CreateRepoPartWithUUID("us-engine")
+	usEngine.Category = repoModel.CategoryEngine
+	usEngine.Manufacturer.Country = "USA"
+	usEngine.Tags = []string{"power", "heavy"}
+
+	usFuel := testutils.CreateRepoPartWithUUID("us-fuel")
+	usFuel.Category = repoModel.CategoryFuel
+	usFuel.Manufacturer.Country = "USA"
+	usFuel.Tags = []string{"liquid", "heavy"}
+
+	germanEngine := testutils.CreateRepoPartWithUUID("german-engine")
+	germanEngine.Category = repoModel.CategoryEngine
+	germanEngine.Manufacturer.Country = "Germany"
+	germanEngine.Tags = []string{"power"}
+
+	s.insert(usEngine, usFuel, germanEngine)
+
+	// Act
+	categories, err := s.repo.CountParts(s.ctx, model.PartsFilter{}, model.FacetCategory)
+	s.Require().NoError(err)
+	tags, err := s.repo.CountParts(s.ctx, model.PartsFilter{ManufacturerCountries: []string{"USA"}}, model.FacetTag)
+	s.Require().NoError(err)
+	countries, err := s.repo.CountParts(s.ctx, model.PartsFilter{Tags: []string{"power"}}, model.FacetManufacturerCountry)
+	s.Require().NoError(err)
+
+	// Assert
+	s.Require().Equal([]model.FacetCount{{Value: "ENGINE", Count: 2}, {Value: "FUEL", Count: 1}}, categories)
+	s.Require().Equal([]model.FacetCount{{Value: "heavy", Count: 2}, {Value: "liquid", Count: 1}, {Value: "power", Count: 1}}, tags)
+	s.Require().Equal([]model.FacetCount{{Value: "Germany", Count: 1}, {Value: "USA", Count: 1}}, countries)
+}
+
+func (s *RepositorySuite) TestCountPartsSkipsArchived() {
+	// Arrange
+	archived := testutils.CreateRepoPartWithUUID("archived")
+	archived.Archived = true
+	s.insert(archived)
+
+	// Act
+	counts, err := s.repo.CountParts(s.ctx, model.PartsFilter{}, model.FacetCategory)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Empty(counts)
+}
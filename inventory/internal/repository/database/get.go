@@ -0,0 +1,44 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoConverter "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/converter"
+	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+)
+
+func (r *repository) GetPart(ctx context.Context, uuid string) (model.Part, error) {
+	part, err := getPart(ctx, r.db, uuid)
+	if err != nil {
+		return model.Part{}, err
+	}
+
+	return repoConverter.PartToModel(part), nil
+}
+
+// getPart читает деталь вместе с тегами и метаданными.
+func getPart(ctx context.Context, q querier, uuid string) (repoModel.Part, error) {
+	part, err := scanPart(q.QueryRowContext(ctx,
+		`SELECT `+partColumns+` FROM parts p WHERE p.uuid = $1`,
+		uuid,
+	))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return repoModel.Part{}, model.ErrPartNotFound
+		}
+		return repoModel.Part{}, err
+	}
+
+	parts := []repoModel.Part{part}
+	if err = loadTags(ctx, q, parts); err != nil {
+		return repoModel.Part{}, err
+	}
+	if err = loadMetadata(ctx, q, parts); err != nil {
+		return repoModel.Part{}, err
+	}
+
+	return parts[0], nil
+}
@@ -0,0 +1,71 @@
+package database
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func (s *RepositorySuite) TestGetPartSuccess() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	s.insert(repoPart)
+
+	// Act
+	res, err := s.repo.GetPart(s.ctx, repoPart.UUID)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(repoPart.UUID, res.UUID)
+	s.Require().Equal(repoPart.Name, res.Name)
+	s.Require().Equal(repoPart.Price, res.Price)
+	s.Require().Equal(repoPart.StockQuantity, res.StockQuantity)
+	s.Require().Equal(model.Category(repoPart.Category), res.Category)
+	s.Require().Equal(repoPart.Dimensions.Weight, res.Dimensions.Weight)
+	s.Require().Equal(repoPart.Manufacturer.Country, res.Manufacturer.Country)
+	s.Require().Equal(repoPart.Tags, res.Tags)
+	s.Require().Len(res.Metadata, 1)
+	s.Require().Equal(*repoPart.Metadata["material"].StringValue, *res.Metadata["material"].StringValue)
+	s.Require().Nil(res.Metadata["material"].Int64Value)
+	s.Require().True(repoPart.CreatedAt.Equal(res.CreatedAt))
+	s.Require().NotNil(res.UpdatedAt)
+	s.Require().True(repoPart.UpdatedAt.Equal(*res.UpdatedAt))
+}
+
+func (s *RepositorySuite) TestGetPartNotFound() {
+	// Act
+	res, err := s.repo.GetPart(s.ctx, gofakeit.UUID())
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+	s.Require().Equal(model.Part{}, res)
+}
+
+func (s *RepositorySuite) TestGetPartMetadataKeepsEveryKey() {
+	// Arrange - несколько ключей, в том числе с одинаковым типом значения
+	repoPart := testutils.CreateRepoPart()
+	repoPart.Metadata = repoModel.Metadata{
+		"material":      {StringValue: lo.ToPtr("titanium")},
+		"coating":       {StringValue: lo.ToPtr("ceramic")},
+		"serial_number": {Int64Value: lo.ToPtr(int64(42))},
+		"tolerance":     {DoubleValue: lo.ToPtr(0.05)},
+		"certified":     {BoolValue: lo.ToPtr(false)},
+	}
+	s.insert(repoPart)
+
+	// Act
+	res, err := s.repo.GetPart(s.ctx, repoPart.UUID)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(model.Metadata{
+		"material":      {StringValue: lo.ToPtr("titanium")},
+		"coating":       {StringValue: lo.ToPtr("ceramic")},
+		"serial_number": {Int64Value: lo.ToPtr(int64(42))},
+		"tolerance":     {DoubleValue: lo.ToPtr(0.05)},
+		"certified":     {BoolValue: lo.ToPtr(false)},
+	}, res.Metadata)
+}
@@ -0,0 +1,93 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/repository/seed"
+)
+
+func (r *repository) InitParts(ctx context.Context) error {
+	return r.insertParts(ctx, seed.Parts())
+}
+
+func (r *repository) insertParts(ctx context.Context, parts []repoModel.Part) (err error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	for _, part := range parts {
+		if err = insertPart(ctx, tx, part); err != nil {
+			return err
+		}
+		if err = appendPartEvent(ctx, tx, model.PartEventCreated, part); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	r.invalidateIndex()
+	r.notifier.Notify()
+
+	return nil
+}
+
+func insertPart(ctx context.Context, tx *sql.Tx, part repoModel.Part) error {
+	var updatedAt sql.NullTime
+	if part.UpdatedAt != nil {
+		updatedAt = sql.NullTime{Time: part.UpdatedAt.UTC(), Valid: true}
+	}
+
+	_, err := tx.ExecContext(ctx,
+		`INSERT INTO parts (
+			uuid, name, description, price, stock_quantity, category,
+			length, width, height, weight,
+			manufacturer_name, manufacturer_country, manufacturer_website,
+			created_at, updated_at, archived
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)`,
+		part.UUID, part.Name, part.Description, part.Price, part.StockQuantity, string(part.Category),
+		part.Dimensions.Length, part.Dimensions.Width, part.Dimensions.Height, part.Dimensions.Weight,
+		part.Manufacturer.Name, part.Manufacturer.Country, part.Manufacturer.Website,
+		part.CreatedAt.UTC(), updatedAt, part.Archived,
+	)
+	if err != nil {
+		return err
+	}
+
+	for i, tag := range part.Tags {
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO part_tags (part_uuid, tag_index, tag) VALUES ($1, $2, $3)`,
+			part.UUID, i, tag,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return insertMetadata(ctx, tx, part.UUID, part.Metadata)
+}
+
+func insertMetadata(ctx context.Context, tx *sql.Tx, partUUID string, metadata repoModel.Metadata) error {
+	for key, value := range metadata {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO part_metadata (part_uuid, key, string_value, int64_value, double_value, bool_value)
+			VALUES ($1, $2, $3, $4, $5, $6)`,
+			partUUID, key, value.StringValue, value.Int64Value, value.DoubleValue, value.BoolValue,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package database
+
+import (
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+func (s *RepositorySuite) TestInitPartsPersistsGeneratedParts() {
+	// Act
+	err := s.repo.InitParts(s.ctx)
+
+	// Assert
+	s.Require().NoError(err)
+
+	page, err := s.repo.ListParts(s.ctx, model.PartsFilter{}, model.PartsPageRequest{})
+	s.Require().NoError(err)
+	s.Require().NotEmpty(page.Parts)
+
+	part, err := s.repo.GetPart(s.ctx, page.Parts[0].UUID)
+	s.Require().NoError(err)
+	s.Require().Equal(page.Parts[0], part)
+}
@@ -0,0 +1,225 @@
+package database
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoConverter "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/converter"
+)
+
+func (r *repository) ListParts(ctx context.Context, filter model.PartsFilter, page model.PartsPageRequest) (model.PartsPage, error) {
+	where, args := buildFilter(filter)
+
+	countQuery := `SELECT COUNT(*) FROM parts p`
+	if where != "" {
+		countQuery += ` WHERE ` + where
+	}
+
+	var total int32
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		return model.PartsPage{}, err
+	}
+	if total == 0 {
+		return model.PartsPage{}, model.ErrPartsNotFound
+	}
+
+	column, direction := orderColumn(page.OrderBy), "ASC"
+	if page.OrderBy.Desc {
+		direction = "DESC"
+	}
+
+	var conds []string
+	if where != "" {
+		conds = append(conds, where)
+	}
+	if page.After != nil {
+		conds = append(conds, afterCondition(&args, column, page.OrderBy, *page.After))
+	}
+
+	query := `SELECT ` + partColumns + ` FROM parts p`
+	if len(conds) > 0 {
+		query += ` WHERE ` + strings.Join(conds, " AND ")
+	}
+	query += ` ORDER BY ` + column + ` ` + direction + `, p.uuid ` + direction
+
+	// Запрашиваем на одну деталь больше, чтобы понять, есть ли следующая страница
+	if page.PageSize > 0 {
+		args = append(args, page.PageSize+1)
+		query += ` LIMIT ` + placeholder(len(args))
+	}
+
+	parts, err := r.queryParts(ctx, query, args...)
+	if err != nil {
+		return model.PartsPage{}, err
+	}
+
+	hasNext := page.PageSize > 0 && len(parts) > int(page.PageSize)
+	if hasNext {
+		parts = parts[:page.PageSize]
+	}
+
+	if err = loadTags(ctx, r.db, parts); err != nil {
+		return model.PartsPage{}, err
+	}
+	if err = loadMetadata(ctx, r.db, parts); err != nil {
+		return model.PartsPage{}, err
+	}
+
+	result := model.PartsPage{
+		Parts:     make([]model.Part, 0, len(parts)),
+		TotalSize: total,
+	}
+	for _, part := range parts {
+		result.Parts = append(result.Parts, repoConverter.PartToModel(part))
+	}
+	if hasNext {
+		result.Next = model.NewPartsCursor(result.Parts[len(result.Parts)-1])
+	}
+
+	return result, nil
+}
+
+func orderColumn(order model.PartsOrder) string {
+	switch order.Field {
+	case model.PartsOrderByName:
+		return "p.name"
+	case model.PartsOrderByPrice:
+		return "p.price"
+	case model.PartsOrderByStockQuantity:
+		return "p.stock_quantity"
+	default:
+		return "p.created_at"
+	}
+}
+
+// afterCondition отбирает детали, идущие в порядке сортировки строго после курсора.
+func afterCondition(args *[]any, column string, order model.PartsOrder, after model.PartsCursor) string {
+	var value any
+	switch order.Field {
+	case model.PartsOrderByName:
+		value = after.Name
+	case model.PartsOrderByPrice:
+		value = after.Price
+	case model.PartsOrderByStockQuantity:
+		value = after.StockQuantity
+	default:
+		value = after.CreatedAt.UTC()
+	}
+
+	op := ">"
+	if order.Desc {
+		op = "<"
+	}
+
+	*args = append(*args, value)
+	cond := `(` + column + ` ` + op + ` ` + placeholder(len(*args))
+	*args = append(*args, value)
+	cond += ` OR (` + column + ` = ` + placeholder(len(*args))
+	*args = append(*args, after.UUID)
+	cond += ` AND p.uuid ` + op + ` ` + placeholder(len(*args)) + `))`
+
+	return cond
+}
+
+// buildFilter собирает условие WHERE по фильтру; пустые поля фильтра не ограничивают выборку.
+func buildFilter(filter model.PartsFilter) (string, []any) {
+	var (
+		conds []string
+		args  []any
+	)
+
+	// Архивные детали скрыты, пока их не запросили явно
+	if !filter.IncludeArchived {
+		conds = append(conds, `p.archived = FALSE`)
+	}
+
+	if len(filter.UUIDs) > 0 {
+		conds = append(conds, `p.uuid IN (`+placeholders(&args, filter.UUIDs)+`)`)
+	}
+
+	if len(filter.Names) > 0 {
+		conds = append(conds, `p.name IN (`+placeholders(&args, filter.Names)+`)`)
+	}
+
+	if len(filter.Categories) > 0 {
+		categories := make([]string, 0, len(filter.Categories))
+		for _, c := range filter.Categories {
+			categories = append(categories, string(c))
+		}
+		conds = append(conds, `p.category IN (`+placeholders(&args, categories)+`)`)
+	}
+
+	if len(filter.ManufacturerCountries) > 0 {
+		conds = append(conds, `p.manufacturer_country IN (`+placeholders(&args, filter.ManufacturerCountries)+`)`)
+	}
+
+	// Деталь подходит, если совпадает хотя бы один тег
+	if len(filter.Tags) > 0 {
+		conds = append(conds, `EXISTS (SELECT 1 FROM part_tags t WHERE t.part_uuid = p.uuid AND t.tag IN (`+
+			placeholders(&args, filter.Tags)+`))`)
+	}
+
+	conds = appendRange(conds, &args, "p.price", filter.Price.Min, filter.Price.Max)
+	conds = appendRange(conds, &args, "p.stock_quantity", filter.StockQuantity.Min, filter.StockQuantity.Max)
+	conds = appendRange(conds, &args, "p.length", filter.Dimensions.Length.Min, filter.Dimensions.Length.Max)
+	conds = appendRange(conds, &args, "p.width", filter.Dimensions.Width.Min, filter.Dimensions.Width.Max)
+	conds = appendRange(conds, &args, "p.height", filter.Dimensions.Height.Min, filter.Dimensions.Height.Max)
+	conds = appendRange(conds, &args, "p.weight", filter.Dimensions.Weight.Min, filter.Dimensions.Weight.Max)
+	conds = appendRange(conds, &args, "p.created_at", utc(filter.CreatedAt.From), utc(filter.CreatedAt.To))
+	// NULL в updated_at не проходит сравнение, поэтому детали без обновлений отсекаются сами
+	conds = appendRange(conds, &args, "p.updated_at", utc(filter.UpdatedAt.From), utc(filter.UpdatedAt.To))
+
+	// Каждое условие на метаданные — отдельный EXISTS: должны выполняться все
+	for _, c := range filter.Metadata {
+		args = append(args, c.Key)
+		cond := `EXISTS (SELECT 1 FROM part_metadata m WHERE m.part_uuid = p.uuid AND m.key = ` + placeholder(len(args))
+		if c.Value != nil {
+			cond += metadataValueCondition(&args, *c.Value)
+		}
+		conds = append(conds, cond+`)`)
+	}
+
+	return strings.Join(conds, " AND "), args
+}
+
+// metadataValueCondition сравнивает значение в колонке его типа: у значений другого типа там NULL.
+func metadataValueCondition(args *[]any, value model.MetadataValue) string {
+	var column string
+	switch {
+	case value.StringValue != nil:
+		column, *args = "m.string_value", append(*args, *value.StringValue)
+	case value.Int64Value != nil:
+		column, *args = "m.int64_value", append(*args, *value.Int64Value)
+	case value.DoubleValue != nil:
+		column, *args = "m.double_value", append(*args, *value.DoubleValue)
+	case value.BoolValue != nil:
+		column, *args = "m.bool_value", append(*args, *value.BoolValue)
+	default:
+		return ""
+	}
+	return ` AND ` + column + ` = ` + placeholder(len(*args))
+}
+
+// appendRange добавляет условия на границы диапазона; nil-граница не ограничивает выборку.
+func appendRange[T any](conds []string, args *[]any, column string, minValue, maxValue *T) []string {
+	if minValue != nil {
+		*args = append(*args, *minValue)
+		conds = append(conds, column+` >= `+placeholder(len(*args)))
+	}
+	if maxValue != nil {
+		*args = append(*args, *maxValue)
+		conds = append(conds, column+` <= `+placeholder(len(*args)))
+	}
+	return conds
+}
+
+func utc(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	return lo.ToPtr(t.UTC())
+}
@@ -0,0 +1,297 @@
+package database
+
+import (
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func (s *RepositorySuite) TestListPartsSuccess() {
+	// Arrange
+	s.insert(
+		testutils.CreateRepoPartWithUUID("uuid-1"),
+		testutils.CreateRepoPartWithUUID("uuid-2"),
+		testutils.CreateRepoPartWithUUID("uuid-3"),
+	)
+
+	// Act
+	result, err := s.repo.ListParts(s.ctx, model.PartsFilter{}, model.PartsPageRequest{})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(result.Parts, 3)
+	for _, part := range result.Parts {
+		s.Require().Len(part.Tags, 2)
+	}
+}
+
+func (s *RepositorySuite) TestListPartsFilterByUUIDs() {
+	// Arrange
+	s.insert(
+		testutils.CreateRepoPartWithUUID("uuid-1"),
+		testutils.CreateRepoPartWithUUID("uuid-2"),
+		testutils.CreateRepoPartWithUUID("uuid-3"),
+	)
+
+	// Act
+	result, err := s.repo.ListParts(s.ctx, model.PartsFilter{
+		UUIDs: []string{"uuid-1", "uuid-3"},
+	}, model.PartsPageRequest{})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]string{"uuid-1", "uuid-3"}, partUUIDs(result.Parts))
+}
+
+func (s *RepositorySuite) TestListPartsFilterByTags() {
+	// Arrange
+	enginePart := testutils.CreateRepoPartWithUUID("engine-uuid")
+	enginePart.Tags = []string{"engine", "propulsion", "power"}
+
+	fuelPart := testutils.CreateRepoPartWithUUID("fuel-uuid")
+	fuelPart.Tags = []string{"fuel", "liquid", "combustion"}
+
+	windowPart := testutils.CreateRepoPartWithUUID("window-uuid")
+	windowPart.Tags = []string{"glass"}
+
+	s.insert(enginePart, fuelPart, windowPart)
+
+	// Act
+	result, err := s.repo.ListParts(s.ctx, model.PartsFilter{
+		Tags: []string{"power", "combustion"}, // Ищем по любому из тегов
+	}, model.PartsPageRequest{})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]string{"engine-uuid", "fuel-uuid"}, partUUIDs(result.Parts))
+}
+
+func (s *RepositorySuite) TestListPartsMultipleFilters() {
+	// Arrange
+	usEnginePart := testutils.CreateRepoPartWithUUID("us-engine")
+	usEnginePart.Category = repoModel.CategoryEngine
+	usEnginePart.Manufacturer.Country = "USA"
+
+	germanEnginePart := testutils.CreateRepoPartWithUUID("german-engine")
+	germanEnginePart.Category = repoModel.CategoryEngine
+	germanEnginePart.Manufacturer.Country = "Germany"
+
+	usFuelPart := testutils.CreateRepoPartWithUUID("us-fuel")
+	usFuelPart.Category = repoModel.CategoryFuel
+	usFuelPart.Manufacturer.Country = "USA"
+
+	s.insert(usEnginePart, germanEnginePart, usFuelPart)
+
+	// Act
+	result, err := s.repo.ListParts(s.ctx, model.PartsFilter{
+		Names:                 []string{"Main Engine"},
+		Categories:            []model.Category{model.CategoryEngine},
+		ManufacturerCountries: []string{"USA"},
+	}, model.PartsPageRequest{})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(result.Parts, 1)
+	s.Require().Equal("us-engine", result.Parts[0].UUID)
+}
+
+func (s *RepositorySuite) TestListPartsNotFound() {
+	// Act
+	result, err := s.repo.ListParts(s.ctx, model.PartsFilter{
+		UUIDs: []string{"non-existent-uuid"},
+	}, model.PartsPageRequest{})
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrPartsNotFound)
+	s.Require().Empty(result.Parts)
+}
+
+func partUUIDs(parts []model.Part) []string {
+	uuids := make([]string, 0, len(parts))
+	for _, part := range parts {
+		uuids = append(uuids, part.UUID)
+	}
+	return uuids
+}
+
+func (s *RepositorySuite) TestListPartsPagination() {
+	// Arrange - у двух деталей одинаковая цена, их порядок определяет UUID
+	prices := map[string]float64{"uuid-1": 300, "uuid-2": 100, "uuid-3": 200, "uuid-4": 200, "uuid-5": 50}
+	for uuid, price := range prices {
+		part := testutils.CreateRepoPartWithUUID(uuid)
+		part.Price = price
+		s.insert(part)
+	}
+
+	order := model.PartsOrder{Field: model.PartsOrderByPrice, Desc: true}
+
+	// Act - листаем по две детали, пока есть следующая страница
+	var (
+		uuids []string
+		pages int
+		after *model.PartsCursor
+	)
+	for {
+		page, err := s.repo.ListParts(s.ctx, model.PartsFilter{}, model.PartsPageRequest{
+			PageSize: 2,
+			OrderBy:  order,
+			After:    after,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(int32(5), page.TotalSize)
+
+		pages++
+		uuids = append(uuids, partUUIDs(page.Parts)...)
+		if page.Next == nil {
+			break
+		}
+		after = page.Next
+	}
+
+	// Assert
+	s.Require().Equal(3, pages)
+	s.Require().Equal([]string{"uuid-1", "uuid-4", "uuid-3", "uuid-2", "uuid-5"}, uuids)
+}
+
+func (s *RepositorySuite) TestListPartsPaginationByCreatedAt() {
+	// Arrange
+	base := time.Now().UTC().Truncate(time.Second)
+	for i, uuid := range []string{"uuid-3", "uuid-1", "uuid-2"} {
+		part := testutils.CreateRepoPartWithUUID(uuid)
+		part.CreatedAt = base.Add(time.Duration(i) * time.Minute)
+		s.insert(part)
+	}
+
+	// Act
+	first, err := s.repo.ListParts(s.ctx, model.PartsFilter{}, model.PartsPageRequest{PageSize: 2})
+	s.Require().NoError(err)
+	second, err := s.repo.ListParts(s.ctx, model.PartsFilter{}, model.PartsPageRequest{PageSize: 2, After: first.Next})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"uuid-3", "uuid-1"}, partUUIDs(first.Parts))
+	s.Require().Equal([]string{"uuid-2"}, partUUIDs(second.Parts))
+	s.Require().Nil(second.Next)
+}
+
+func (s *RepositorySuite) TestListPartsFilterByRanges() {
+	// Arrange
+	now := time.Now().UTC()
+
+	cheapEngine := testutils.CreateRepoPartWithUUID("cheap-engine")
+	cheapEngine.Price = 4000
+	cheapEngine.StockQuantity = 3
+	cheapEngine.Dimensions.Weight = 150
+	cheapEngine.CreatedAt = now.Add(-time.Hour)
+
+	expensiveEngine := testutils.CreateRepoPartWithUUID("expensive-engine")
+	expensiveEngine.Price = 9000
+	expensiveEngine.StockQuantity = 3
+	expensiveEngine.Dimensions.Weight = 150
+	expensiveEngine.CreatedAt = now.Add(-time.Hour)
+
+	outOfStock := testutils.CreateRepoPartWithUUID("out-of-stock")
+	outOfStock.Price = 4000
+	outOfStock.StockQuantity = 0
+	outOfStock.Dimensions.Weight = 150
+	outOfStock.CreatedAt = now.Add(-time.Hour)
+
+	heavy := testutils.CreateRepoPartWithUUID("heavy")
+	heavy.Price = 5000 // Граница диапазона включается
+	heavy.StockQuantity = 3
+	heavy.Dimensions.Weight = 250
+	heavy.CreatedAt = now.Add(-time.Hour)
+
+	oldPart := testutils.CreateRepoPartWithUUID("old")
+	oldPart.Price = 4000
+	oldPart.StockQuantity = 3
+	oldPart.Dimensions.Weight = 150
+	oldPart.CreatedAt = now.Add(-48 * time.Hour)
+
+	s.insert(cheapEngine, expensiveEngine, outOfStock, heavy, oldPart)
+
+	filter := model.PartsFilter{
+		Price:         model.FloatRange{Max: lo.ToPtr(5000.0)},
+		StockQuantity: model.Int64Range{Min: lo.ToPtr(int64(1))},
+		Dimensions:    model.DimensionsRange{Weight: model.FloatRange{Max: lo.ToPtr(200.0)}},
+		CreatedAt:     model.TimeRange{From: lo.ToPtr(now.Add(-24 * time.Hour))},
+	}
+
+	// Act
+	result, err := s.repo.ListParts(s.ctx, filter, model.PartsPageRequest{})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"cheap-engine"}, partUUIDs(result.Parts))
+	s.Require().Equal(int32(1), result.TotalSize)
+
+	filter.Dimensions = model.DimensionsRange{}
+	result, err = s.repo.ListParts(s.ctx, filter, model.PartsPageRequest{})
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]string{"cheap-engine", "heavy"}, partUUIDs(result.Parts))
+}
+
+func (s *RepositorySuite) TestListPartsFilterByUpdatedAtSkipsNeverUpdated() {
+	// Arrange
+	updated := testutils.CreateRepoPartWithUUID("updated")
+	updated.UpdatedAt = lo.ToPtr(time.Now().UTC())
+
+	neverUpdated := testutils.CreateRepoPartWithUUID("never-updated")
+	neverUpdated.UpdatedAt = nil
+
+	s.insert(updated, neverUpdated)
+
+	// Act
+	result, err := s.repo.ListParts(s.ctx, model.PartsFilter{
+		UpdatedAt: model.TimeRange{From: lo.ToPtr(time.Now().Add(-time.Hour))},
+	}, model.PartsPageRequest{})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"updated"}, partUUIDs(result.Parts))
+}
+
+func (s *RepositorySuite) TestListPartsFilterByMetadata() {
+	// Arrange
+	titanium := testutils.CreateRepoPartWithUUID("titanium")
+	titanium.Metadata = repoModel.Metadata{
+		"material":  {StringValue: lo.ToPtr("titanium")},
+		"certified": {BoolValue: lo.ToPtr(true)},
+	}
+
+	steel := testutils.CreateRepoPartWithUUID("steel")
+	steel.Metadata = repoModel.Metadata{
+		"material":  {StringValue: lo.ToPtr("steel")},
+		"certified": {BoolValue: lo.ToPtr(true)},
+	}
+
+	noMetadata := testutils.CreateRepoPartWithUUID("no-metadata")
+	noMetadata.Metadata = nil
+
+	s.insert(titanium, steel, noMetadata)
+
+	// Act - ключ certified должен быть, material должен равняться titanium
+	result, err := s.repo.ListParts(s.ctx, model.PartsFilter{
+		Metadata: []model.MetadataCondition{
+			{Key: "certified"},
+			{Key: "material", Value: &model.MetadataValue{StringValue: lo.ToPtr("titanium")}},
+		},
+	}, model.PartsPageRequest{})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(result.Parts, 1)
+	s.Require().Equal("titanium", result.Parts[0].UUID)
+
+	// Значение другого типа не совпадает, даже если "похоже"
+	_, err = s.repo.ListParts(s.ctx, model.PartsFilter{
+		Metadata: []model.MetadataCondition{
+			{Key: "certified", Value: &model.MetadataValue{StringValue: lo.ToPtr("true")}},
+		},
+	}, model.PartsPageRequest{})
+	s.Require().ErrorIs(err, model.ErrPartsNotFound)
+}
@@ -0,0 +1,64 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+)
+
+// ReleaseReservation возвращает детали на склад. Повторное снятие уже снятого
+// или истёкшего резерва не считается ошибкой.
+func (r *repository) ReleaseReservation(ctx context.Context, reservationUUID string) (err error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	status, _, err := getReservationState(ctx, tx, reservationUUID)
+	if err != nil {
+		return err
+	}
+
+	switch status {
+	case repoModel.ReservationStatusActive, repoModel.ReservationStatusCommitted:
+		if err = closeReservation(ctx, tx, reservationUUID, status, repoModel.ReservationStatusReleased, time.Now().UTC()); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	r.notifier.Notify()
+
+	return nil
+}
+
+func getReservationState(ctx context.Context, tx *sql.Tx, reservationUUID string) (repoModel.ReservationStatus, time.Time, error) {
+	var (
+		status    string
+		expiresAt time.Time
+	)
+
+	err := tx.QueryRowContext(ctx,
+		`SELECT status, expires_at FROM reservations WHERE uuid = $1`,
+		reservationUUID,
+	).Scan(&status, &expiresAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", time.Time{}, model.ErrReservationNotFound
+		}
+		return "", time.Time{}, err
+	}
+
+	return repoModel.ReservationStatus(status), expiresAt, nil
+}
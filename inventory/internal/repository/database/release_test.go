@@ -0,0 +1,78 @@
+package database
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func (s *RepositorySuite) TestReleaseReservationSuccess() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	repoPart.StockQuantity = 4
+	s.insert(repoPart)
+
+	reservation, err := s.repo.ReserveParts(s.ctx, []model.ReservationItem{
+		{PartUUID: repoPart.UUID, Quantity: 4},
+	}, time.Now().Add(time.Hour))
+	s.Require().NoError(err)
+
+	// Act
+	err = s.repo.ReleaseReservation(s.ctx, reservation.UUID)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(int64(4), s.stock(repoPart.UUID))
+	s.Require().Equal(string(model.ReservationStatusReleased), s.reservationStatus(reservation.UUID))
+}
+
+func (s *RepositorySuite) TestReleaseReservationCommitted() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	repoPart.StockQuantity = 4
+	s.insert(repoPart)
+
+	reservation, err := s.repo.ReserveParts(s.ctx, []model.ReservationItem{
+		{PartUUID: repoPart.UUID, Quantity: 3},
+	}, time.Now().Add(time.Hour))
+	s.Require().NoError(err)
+	s.Require().NoError(s.repo.CommitReservation(s.ctx, reservation.UUID))
+
+	// Act
+	err = s.repo.ReleaseReservation(s.ctx, reservation.UUID)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(int64(4), s.stock(repoPart.UUID))
+}
+
+func (s *RepositorySuite) TestReleaseReservationTwice() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	repoPart.StockQuantity = 4
+	s.insert(repoPart)
+
+	reservation, err := s.repo.ReserveParts(s.ctx, []model.ReservationItem{
+		{PartUUID: repoPart.UUID, Quantity: 1},
+	}, time.Now().Add(time.Hour))
+	s.Require().NoError(err)
+	s.Require().NoError(s.repo.ReleaseReservation(s.ctx, reservation.UUID))
+
+	// Act - повторное снятие не должно вернуть детали ещё раз
+	err = s.repo.ReleaseReservation(s.ctx, reservation.UUID)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(int64(4), s.stock(repoPart.UUID))
+}
+
+func (s *RepositorySuite) TestReleaseReservationNotFound() {
+	// Act
+	err := s.repo.ReleaseReservation(s.ctx, gofakeit.UUID())
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrReservationNotFound)
+}
@@ -0,0 +1,35 @@
+package database
+
+import (
+	"database/sql"
+	"sync"
+
+	def "github.com/baryshnikkov/rocket-factory/inventory/internal/repository"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/repository/search"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/repository/watch"
+)
+
+var _ def.InventoryRepository = (*repository)(nil)
+
+// repository — реализация InventoryRepository поверх SQL-базы (PostgreSQL или SQLite).
+// Схема создаётся миграциями из пакета migrations, см. Open.
+type repository struct {
+	db *sql.DB
+
+	// Поисковый индекс строится по данным базы и перестраивается, когда каталог меняется
+	indexMu      sync.Mutex
+	index        *search.Index
+	indexVersion string
+
+	// Будит подписчиков WatchParts после коммита; изменения других экземпляров сервиса
+	// подписчики замечают опросом
+	notifier *watch.Notifier
+}
+
+func NewRepository(db *sql.DB) *repository {
+	return &repository{
+		db:       db,
+		index:    search.NewIndex(),
+		notifier: watch.NewNotifier(),
+	}
+}
@@ -0,0 +1,244 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoConverter "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/converter"
+	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+)
+
+func (r *repository) ReserveParts(ctx context.Context, items []model.ReservationItem, expiresAt time.Time) (_ model.Reservation, err error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return model.Reservation{}, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	now := time.Now().UTC()
+	if err = expireReservations(ctx, tx, now); err != nil {
+		return model.Reservation{}, err
+	}
+
+	// Списание с условием на остаток атомарно: при нехватке строка не обновляется.
+	// Строки блокируются в порядке UUID, чтобы резервы с общими деталями не ждали друг друга по кругу
+	ordered := slices.Clone(items)
+	slices.SortStableFunc(ordered, func(a, b model.ReservationItem) int {
+		return strings.Compare(a.PartUUID, b.PartUUID)
+	})
+	for _, item := range ordered {
+		var res sql.Result
+		res, err = tx.ExecContext(ctx,
+			`UPDATE parts SET stock_quantity = stock_quantity - $1 WHERE uuid = $2 AND stock_quantity >= $3 AND archived = FALSE`,
+			item.Quantity, item.PartUUID, item.Quantity,
+		)
+		if err != nil {
+			return model.Reservation{}, err
+		}
+
+		var affected int64
+		if affected, err = res.RowsAffected(); err != nil {
+			return model.Reservation{}, err
+		}
+		if affected == 0 {
+			return model.Reservation{}, reserveError(ctx, tx, item.PartUUID)
+		}
+	}
+
+	partUUIDs := make([]string, 0, len(items))
+	for _, item := range items {
+		if !slices.Contains(partUUIDs, item.PartUUID) {
+			partUUIDs = append(partUUIDs, item.PartUUID)
+		}
+	}
+	if err = appendUpdatedEvents(ctx, tx, partUUIDs...); err != nil {
+		return model.Reservation{}, err
+	}
+
+	reservation := repoModel.Reservation{
+		UUID:      uuid.NewString(),
+		Items:     repoConverter.ReservationItemsToRepoModel(items),
+		Status:    repoModel.ReservationStatusActive,
+		ExpiresAt: expiresAt.UTC(),
+		CreatedAt: now,
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO reservations (uuid, status, expires_at, created_at) VALUES ($1, $2, $3, $4)`,
+		reservation.UUID, string(reservation.Status), reservation.ExpiresAt, reservation.CreatedAt,
+	)
+	if err != nil {
+		return model.Reservation{}, err
+	}
+
+	for i, item := range reservation.Items {
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO reservation_items (reservation_uuid, item_index, part_uuid, quantity) VALUES ($1, $2, $3, $4)`,
+			reservation.UUID, i, item.PartUUID, item.Quantity,
+		)
+		if err != nil {
+			return model.Reservation{}, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return model.Reservation{}, err
+	}
+	r.notifier.Notify()
+
+	return repoConverter.ReservationToModel(reservation), nil
+}
+
+// reserveError определяет, почему не удалось списать деталь: её нет в каталоге, она в архиве или не хватает остатка.
+func reserveError(ctx context.Context, tx *sql.Tx, partUUID string) error {
+	var archived bool
+	err := tx.QueryRowContext(ctx, `SELECT archived FROM parts WHERE uuid = $1`, partUUID).Scan(&archived)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%w: %s", model.ErrPartNotFound, partUUID)
+	}
+	if err != nil {
+		return err
+	}
+	if archived {
+		return fmt.Errorf("%w: %s", model.ErrPartArchived, partUUID)
+	}
+
+	return fmt.Errorf("%w: part %s", model.ErrInsufficientStock, partUUID)
+}
+
+// expireReservations переводит просроченные активные резервы в EXPIRED и возвращает детали на склад,
+// а закрытые резервы старше ReservationRetention удаляет.
+func expireReservations(ctx context.Context, tx *sql.Tx, now time.Time) error {
+	if err := pruneReservations(ctx, tx, now.Add(-repoModel.ReservationRetention)); err != nil {
+		return err
+	}
+
+	rows, err := tx.QueryContext(ctx,
+		`SELECT uuid FROM reservations WHERE status = $1 AND expires_at <= $2`,
+		string(repoModel.ReservationStatusActive), now,
+	)
+	if err != nil {
+		return err
+	}
+
+	var expired []string
+	for rows.Next() {
+		var reservationUUID string
+		if err = rows.Scan(&reservationUUID); err != nil {
+			_ = rows.Close()
+			return err
+		}
+		expired = append(expired, reservationUUID)
+	}
+	if err = rows.Close(); err != nil {
+		return err
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	for _, reservationUUID := range expired {
+		if err = closeReservation(ctx, tx, reservationUUID, repoModel.ReservationStatusActive, repoModel.ReservationStatusExpired, now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pruneReservations удаляет снятые и истёкшие резервы, закрытые не позже closedBefore.
+// Позиции удаляются явно: SQLite по умолчанию не применяет ON DELETE CASCADE
+func pruneReservations(ctx context.Context, tx *sql.Tx, closedBefore time.Time) error {
+	const closed = `SELECT uuid FROM reservations WHERE status IN ($1, $2) AND updated_at <= $3`
+	released, expired := string(repoModel.ReservationStatusReleased), string(repoModel.ReservationStatusExpired)
+
+	_, err := tx.ExecContext(ctx,
+		`DELETE FROM reservation_items WHERE reservation_uuid IN (`+closed+`)`,
+		released, expired, closedBefore,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`DELETE FROM reservations WHERE status IN ($1, $2) AND updated_at <= $3`,
+		released, expired, closedBefore,
+	)
+	return err
+}
+
+// closeReservation переводит резерв из статуса from в статус to и возвращает его детали на склад.
+// Переход выполняется условным UPDATE, поэтому при конкурентном изменении статуса детали не вернутся дважды.
+func closeReservation(ctx context.Context, tx *sql.Tx, reservationUUID string, from, to repoModel.ReservationStatus, now time.Time) error {
+	res, err := tx.ExecContext(ctx,
+		`UPDATE reservations SET status = $1, updated_at = $2 WHERE uuid = $3 AND status = $4`,
+		string(to), now, reservationUUID, string(from),
+	)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return nil
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`UPDATE parts SET stock_quantity = stock_quantity + (
+			SELECT SUM(ri.quantity) FROM reservation_items ri
+			WHERE ri.reservation_uuid = $1 AND ri.part_uuid = parts.uuid
+		) WHERE uuid IN (SELECT part_uuid FROM reservation_items WHERE reservation_uuid = $2)`,
+		reservationUUID, reservationUUID,
+	)
+	if err != nil {
+		return err
+	}
+
+	partUUIDs, err := reservedPartUUIDs(ctx, tx, reservationUUID)
+	if err != nil {
+		return err
+	}
+
+	return appendUpdatedEvents(ctx, tx, partUUIDs...)
+}
+
+// reservedPartUUIDs возвращает детали резерва, которые ещё есть в каталоге.
+func reservedPartUUIDs(ctx context.Context, tx *sql.Tx, reservationUUID string) ([]string, error) {
+	rows, err := tx.QueryContext(ctx,
+		`SELECT DISTINCT ri.part_uuid FROM reservation_items ri
+		JOIN parts p ON p.uuid = ri.part_uuid
+		WHERE ri.reservation_uuid = $1
+		ORDER BY ri.part_uuid`,
+		reservationUUID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var partUUIDs []string
+	for rows.Next() {
+		var partUUID string
+		if err = rows.Scan(&partUUID); err != nil {
+			return nil, err
+		}
+		partUUIDs = append(partUUIDs, partUUID)
+	}
+
+	return partUUIDs, rows.Err()
+}
@@ -0,0 +1,138 @@
+package database
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func (s *RepositorySuite) TestReservePartsSuccess() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	repoPart.StockQuantity = 10
+	s.insert(repoPart)
+
+	// Act
+	res, err := s.repo.ReserveParts(s.ctx, []model.ReservationItem{
+		{PartUUID: repoPart.UUID, Quantity: 3},
+	}, time.Now().Add(time.Hour))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().NotEmpty(res.UUID)
+	s.Require().Equal(model.ReservationStatusActive, res.Status)
+	s.Require().Equal(int64(7), s.stock(repoPart.UUID))
+	s.Require().Equal(string(model.ReservationStatusActive), s.reservationStatus(res.UUID))
+}
+
+func (s *RepositorySuite) TestReservePartsInsufficientStock() {
+	// Arrange
+	first := testutils.CreateRepoPart()
+	first.StockQuantity = 5
+	second := testutils.CreateRepoPart()
+	second.StockQuantity = 1
+	s.insert(first, second)
+
+	// Act - второй позиции не хватает, транзакция откатывается целиком
+	_, err := s.repo.ReserveParts(s.ctx, []model.ReservationItem{
+		{PartUUID: first.UUID, Quantity: 2},
+		{PartUUID: second.UUID, Quantity: 2},
+	}, time.Now().Add(time.Hour))
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrInsufficientStock)
+	s.Require().Equal(int64(5), s.stock(first.UUID))
+	s.Require().Equal(int64(1), s.stock(second.UUID))
+}
+
+func (s *RepositorySuite) TestReservePartsPartNotFound() {
+	// Act
+	_, err := s.repo.ReserveParts(s.ctx, []model.ReservationItem{
+		{PartUUID: gofakeit.UUID(), Quantity: 1},
+	}, time.Now().Add(time.Hour))
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+}
+
+func (s *RepositorySuite) TestReservePartsReturnsExpiredStock() {
+	// Arrange - просроченный резерв держит весь остаток
+	repoPart := testutils.CreateRepoPart()
+	repoPart.StockQuantity = 2
+	s.insert(repoPart)
+
+	expired, err := s.repo.ReserveParts(s.ctx, []model.ReservationItem{
+		{PartUUID: repoPart.UUID, Quantity: 2},
+	}, time.Now().Add(-time.Minute))
+	s.Require().NoError(err)
+
+	// Act
+	_, err = s.repo.ReserveParts(s.ctx, []model.ReservationItem{
+		{PartUUID: repoPart.UUID, Quantity: 2},
+	}, time.Now().Add(time.Hour))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(int64(0), s.stock(repoPart.UUID))
+	s.Require().Equal(string(model.ReservationStatusExpired), s.reservationStatus(expired.UUID))
+}
+
+func (s *RepositorySuite) TestReservePartsPrunesClosedReservations() {
+	// Arrange - резерв снят раньше срока хранения закрытых резервов
+	repoPart := testutils.CreateRepoPart()
+	repoPart.StockQuantity = 4
+	s.insert(repoPart)
+
+	released, err := s.repo.ReserveParts(s.ctx, []model.ReservationItem{
+		{PartUUID: repoPart.UUID, Quantity: 2},
+	}, time.Now().Add(time.Hour))
+	s.Require().NoError(err)
+	s.Require().NoError(s.repo.ReleaseReservation(s.ctx, released.UUID))
+	_, err = s.db.ExecContext(s.ctx, `UPDATE reservations SET updated_at = $1 WHERE uuid = $2`,
+		time.Now().UTC().Add(-repoModel.ReservationRetention-time.Minute), released.UUID)
+	s.Require().NoError(err)
+
+	// Act
+	_, err = s.repo.ReserveParts(s.ctx, []model.ReservationItem{
+		{PartUUID: repoPart.UUID, Quantity: 1},
+	}, time.Now().Add(time.Hour))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().ErrorIs(s.repo.ReleaseReservation(s.ctx, released.UUID), model.ErrReservationNotFound)
+
+	var items int
+	s.Require().NoError(s.db.QueryRowContext(s.ctx,
+		`SELECT COUNT(*) FROM reservation_items WHERE reservation_uuid = $1`, released.UUID,
+	).Scan(&items))
+	s.Require().Zero(items)
+}
+
+func (s *RepositorySuite) TestReservePartsKeepsItemOrder() {
+	// Arrange - позиции в запросе идут не по порядку UUID
+	first := testutils.CreateRepoPart()
+	first.UUID = "ffffffff-0000-0000-0000-000000000000"
+	first.StockQuantity = 1
+	second := testutils.CreateRepoPart()
+	second.UUID = "00000000-0000-0000-0000-000000000000"
+	second.StockQuantity = 1
+	s.insert(first, second)
+
+	items := []model.ReservationItem{
+		{PartUUID: first.UUID, Quantity: 1},
+		{PartUUID: second.UUID, Quantity: 1},
+	}
+
+	// Act
+	res, err := s.repo.ReserveParts(s.ctx, items, time.Now().Add(time.Hour))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(items, res.Items)
+	s.Require().Zero(s.stock(first.UUID))
+	s.Require().Zero(s.stock(second.UUID))
+}
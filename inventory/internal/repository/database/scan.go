@@ -0,0 +1,192 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+
+	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+)
+
+const partColumns = `p.uuid, p.name, p.description, p.price, p.stock_quantity, p.category,
+	p.length, p.width, p.height, p.weight,
+	p.manufacturer_name, p.manufacturer_country, p.manufacturer_website,
+	p.created_at, p.updated_at, p.archived`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// querier - общее у *sql.DB и *sql.Tx: позволяет читать детали как вне транзакции, так и внутри неё.
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+func scanPart(row rowScanner) (repoModel.Part, error) {
+	var (
+		part      repoModel.Part
+		category  string
+		updatedAt sql.NullTime
+	)
+
+	err := row.Scan(
+		&part.UUID,
+		&part.Name,
+		&part.Description,
+		&part.Price,
+		&part.StockQuantity,
+		&category,
+		&part.Dimensions.Length,
+		&part.Dimensions.Width,
+		&part.Dimensions.Height,
+		&part.Dimensions.Weight,
+		&part.Manufacturer.Name,
+		&part.Manufacturer.Country,
+		&part.Manufacturer.Website,
+		&part.CreatedAt,
+		&updatedAt,
+		&part.Archived,
+	)
+	if err != nil {
+		return repoModel.Part{}, err
+	}
+
+	part.Category = repoModel.Category(category)
+	if updatedAt.Valid {
+		part.UpdatedAt = &updatedAt.Time
+	}
+
+	return part, nil
+}
+
+// queryParts выполняет запрос, выбирающий partColumns, и сканирует детали без тегов и метаданных.
+func (r *repository) queryParts(ctx context.Context, query string, args ...any) ([]repoModel.Part, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var parts []repoModel.Part
+	for rows.Next() {
+		part, scanErr := scanPart(rows)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		parts = append(parts, part)
+	}
+
+	return parts, rows.Err()
+}
+
+// loadTags заполняет теги у переданных деталей одним запросом, сохраняя исходный порядок тегов.
+func loadTags(ctx context.Context, q querier, parts []repoModel.Part) error {
+	if len(parts) == 0 {
+		return nil
+	}
+
+	uuids := make([]string, 0, len(parts))
+	index := make(map[string]int, len(parts))
+	for i, part := range parts {
+		uuids = append(uuids, part.UUID)
+		index[part.UUID] = i
+	}
+
+	var args []any
+	query := `SELECT part_uuid, tag FROM part_tags WHERE part_uuid IN (` + placeholders(&args, uuids) + `)
+		ORDER BY part_uuid, tag_index`
+
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var partUUID, tag string
+		if err = rows.Scan(&partUUID, &tag); err != nil {
+			return err
+		}
+		i := index[partUUID]
+		parts[i].Tags = append(parts[i].Tags, tag)
+	}
+
+	return rows.Err()
+}
+
+// loadMetadata заполняет метаданные у переданных деталей одним запросом.
+func loadMetadata(ctx context.Context, q querier, parts []repoModel.Part) error {
+	if len(parts) == 0 {
+		return nil
+	}
+
+	uuids := make([]string, 0, len(parts))
+	index := make(map[string]int, len(parts))
+	for i, part := range parts {
+		uuids = append(uuids, part.UUID)
+		index[part.UUID] = i
+	}
+
+	var args []any
+	query := `SELECT part_uuid, key, string_value, int64_value, double_value, bool_value
+		FROM part_metadata WHERE part_uuid IN (` + placeholders(&args, uuids) + `)`
+
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var (
+			partUUID, key string
+			stringValue   sql.NullString
+			int64Value    sql.NullInt64
+			doubleValue   sql.NullFloat64
+			boolValue     sql.NullBool
+		)
+		if err = rows.Scan(&partUUID, &key, &stringValue, &int64Value, &doubleValue, &boolValue); err != nil {
+			return err
+		}
+
+		var value repoModel.MetadataValue
+		if stringValue.Valid {
+			value.StringValue = &stringValue.String
+		}
+		if int64Value.Valid {
+			value.Int64Value = &int64Value.Int64
+		}
+		if doubleValue.Valid {
+			value.DoubleValue = &doubleValue.Float64
+		}
+		if boolValue.Valid {
+			value.BoolValue = &boolValue.Bool
+		}
+
+		i := index[partUUID]
+		if parts[i].Metadata == nil {
+			parts[i].Metadata = repoModel.Metadata{}
+		}
+		parts[i].Metadata[key] = value
+	}
+
+	return rows.Err()
+}
+
+// placeholders добавляет значения в args и возвращает список плейсхолдеров $N через запятую.
+// Нумерация продолжается с текущей длины args, поэтому плейсхолдеры должны
+// появляться в запросе в том же порядке, в котором добавляются аргументы.
+func placeholders[T any](args *[]any, values []T) string {
+	items := make([]string, 0, len(values))
+	for _, v := range values {
+		*args = append(*args, v)
+		items = append(items, placeholder(len(*args)))
+	}
+	return strings.Join(items, ", ")
+}
+
+func placeholder(n int) string {
+	return "$" + strconv.Itoa(n)
+}
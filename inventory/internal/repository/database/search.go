@@ -0,0 +1,113 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoConverter "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/converter"
+	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+)
+
+func (r *repository) SearchParts(ctx context.Context, query string, limit int) ([]model.SearchHit, error) {
+	if err := r.refreshIndex(ctx); err != nil {
+		return nil, err
+	}
+
+	hits := r.index.Search(query, limit)
+	if len(hits) == 0 {
+		return nil, nil
+	}
+
+	uuids := make([]string, 0, len(hits))
+	for _, hit := range hits {
+		uuids = append(uuids, hit.UUID)
+	}
+
+	var args []any
+	parts, err := r.queryParts(ctx,
+		`SELECT `+partColumns+` FROM parts p WHERE p.uuid IN (`+placeholders(&args, uuids)+`)`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = loadTags(ctx, r.db, parts); err != nil {
+		return nil, err
+	}
+	if err = loadMetadata(ctx, r.db, parts); err != nil {
+		return nil, err
+	}
+
+	byUUID := make(map[string]repoModel.Part, len(parts))
+	for _, part := range parts {
+		byUUID[part.UUID] = part
+	}
+
+	// Деталь могли удалить между поиском по индексу и чтением из базы
+	result := make([]model.SearchHit, 0, len(hits))
+	for _, hit := range hits {
+		part, ok := byUUID[hit.UUID]
+		if !ok {
+			continue
+		}
+		result = append(result, model.SearchHit{
+			Part:       repoConverter.PartToModel(part),
+			Score:      hit.Score,
+			Highlights: hit.Highlights,
+		})
+	}
+
+	return result, nil
+}
+
+// invalidateIndex заставляет следующий поиск перестроить индекс после записи этого экземпляра.
+func (r *repository) invalidateIndex() {
+	r.indexMu.Lock()
+	defer r.indexMu.Unlock()
+
+	r.indexVersion = ""
+}
+
+// refreshIndex перестраивает индекс, если каталог изменился с прошлой сборки.
+// Записи других экземпляров сервиса меняют число деталей или максимум created_at/updated_at,
+// поэтому тоже попадают в индекс.
+func (r *repository) refreshIndex(ctx context.Context) error {
+	r.indexMu.Lock()
+	defer r.indexMu.Unlock()
+
+	var (
+		count                int64
+		createdAt, updatedAt sql.NullString
+	)
+	err := r.db.QueryRowContext(ctx,
+		`SELECT COUNT(*), MAX(created_at), MAX(updated_at) FROM parts`,
+	).Scan(&count, &createdAt, &updatedAt)
+	if err != nil {
+		return err
+	}
+
+	version := fmt.Sprintf("%d/%s/%s", count, createdAt.String, updatedAt.String)
+	if version == r.indexVersion {
+		return nil
+	}
+
+	parts, err := r.queryParts(ctx, `SELECT `+partColumns+` FROM parts p WHERE p.archived = FALSE`)
+	if err != nil {
+		return err
+	}
+	if err = loadTags(ctx, r.db, parts); err != nil {
+		return err
+	}
+
+	modelParts := make([]model.Part, 0, len(parts))
+	for _, part := range parts {
+		modelParts = append(modelParts, repoConverter.PartToModel(part))
+	}
+
+	r.index.Reset(modelParts)
+	r.indexVersion = version
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package database
+
+import (
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func (s *RepositorySuite) TestSearchParts() {
+	// Arrange
+	thruster := testutils.CreateRepoPartWithUUID("thruster")
+	thruster.Name = "Ion Thruster"
+	thruster.Description = "Electric propulsion for long missions"
+
+	tank := testutils.CreateRepoPartWithUUID("tank")
+	tank.Name = "Fuel Tank"
+	tank.Description = "Stores propellant for the main thruster"
+
+	s.insert(thruster, tank)
+
+	// Act
+	hits, err := s.repo.SearchParts(s.ctx, "Thruster", 10)
+
+	// Assert - совпадение в названии важнее совпадения в описании
+	s.Require().NoError(err)
+	s.Require().Len(hits, 2)
+	s.Require().Equal("thruster", hits[0].Part.UUID)
+	s.Require().Equal("tank", hits[1].Part.UUID)
+	s.Require().Equal(thruster.Tags, hits[0].Part.Tags)
+	s.Require().Contains(hits[1].Highlights, model.SearchHighlight{
+		Field:   "description",
+		Snippet: "Stores propellant for the main <em>thruster</em>",
+	})
+}
+
+func (s *RepositorySuite) TestSearchPartsSeesCatalogChanges() {
+	// Arrange
+	part := testutils.CreateRepoPartWithUUID("part")
+	part.Name = "Fuel Tank"
+	s.insert(part)
+
+	hits, err := s.repo.SearchParts(s.ctx, "fuel", 10)
+	s.Require().NoError(err)
+	s.Require().Len(hits, 1)
+
+	// Act - переименовываем и добавляем деталь после того, как индекс уже построен
+	_, err = s.repo.UpdatePart(s.ctx, "part", model.PartUpdateInfo{Name: lo.ToPtr("Oxidizer Tank")})
+	s.Require().NoError(err)
+
+	wing := testutils.CreateRepoPartWithUUID("wing")
+	wing.Name = "Delta Wing"
+	s.insert(wing)
+
+	// Assert
+	hits, err = s.repo.SearchParts(s.ctx, "fuel", 10)
+	s.Require().NoError(err)
+	s.Require().Empty(hits)
+
+	hits, err = s.repo.SearchParts(s.ctx, "oxidiser", 10)
+	s.Require().NoError(err)
+	s.Require().Len(hits, 1)
+
+	hits, err = s.repo.SearchParts(s.ctx, "wing", 10)
+	s.Require().NoError(err)
+	s.Require().Len(hits, 1)
+
+	// Архивные детали в поиск не попадают
+	_, err = s.repo.ArchivePart(s.ctx, "wing")
+	s.Require().NoError(err)
+	hits, err = s.repo.SearchParts(s.ctx, "wing", 10)
+	s.Require().NoError(err)
+	s.Require().Empty(hits)
+}
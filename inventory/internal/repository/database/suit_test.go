@@ -0,0 +1,59 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/migrations"
+	sharedDatabase "github.com/baryshnikkov/rocket-factory/shared/pkg/database"
+)
+
+type RepositorySuite struct {
+	suite.Suite
+	ctx context.Context
+
+	db   *sql.DB
+	repo *repository
+}
+
+func (s *RepositorySuite) SetupTest() {
+	s.ctx = context.Background()
+
+	db, err := sharedDatabase.Open(s.ctx, sharedDatabase.DriverSQLite, ":memory:", migrations.FS)
+	s.Require().NoError(err)
+
+	s.db = db
+	s.repo = NewRepository(db)
+}
+
+func (s *RepositorySuite) TearDownTest() {
+	s.Require().NoError(s.db.Close())
+}
+
+func (s *RepositorySuite) insert(parts ...repoModel.Part) {
+	s.Require().NoError(s.repo.insertParts(s.ctx, parts))
+}
+
+func TestRepositoryInventoryDatabaseIntegration(t *testing.T) {
+	suite.Run(t, new(RepositorySuite))
+}
+
+func (s *RepositorySuite) stock(partUUID string) int64 {
+	part, err := s.repo.GetPart(s.ctx, partUUID)
+	s.Require().NoError(err)
+
+	return part.StockQuantity
+}
+
+func (s *RepositorySuite) reservationStatus(reservationUUID string) string {
+	var status string
+	s.Require().NoError(s.db.QueryRowContext(s.ctx,
+		`SELECT status FROM reservations WHERE uuid = $1`, reservationUUID,
+	).Scan(&status))
+
+	return status
+}
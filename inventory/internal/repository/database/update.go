@@ -0,0 +1,122 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoConverter "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/converter"
+)
+
+func (r *repository) UpdatePart(ctx context.Context, uuid string, info model.PartUpdateInfo) (_ model.Part, err error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return model.Part{}, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var (
+		sets []string
+		args []any
+	)
+	set := func(column string, value any) {
+		args = append(args, value)
+		sets = append(sets, column+` = `+placeholder(len(args)))
+	}
+
+	if info.Name != nil {
+		set("name", *info.Name)
+	}
+	if info.Description != nil {
+		set("description", *info.Description)
+	}
+	if info.Price != nil {
+		set("price", *info.Price)
+	}
+	if info.StockQuantity != nil {
+		set("stock_quantity", *info.StockQuantity)
+	}
+	if info.Category != nil {
+		set("category", string(*info.Category))
+	}
+	if info.Dimensions != nil {
+		set("length", info.Dimensions.Length)
+		set("width", info.Dimensions.Width)
+		set("height", info.Dimensions.Height)
+		set("weight", info.Dimensions.Weight)
+	}
+	if info.Manufacturer != nil {
+		set("manufacturer_name", info.Manufacturer.Name)
+		set("manufacturer_country", info.Manufacturer.Country)
+		set("manufacturer_website", info.Manufacturer.Website)
+	}
+	set("updated_at", time.Now().UTC())
+
+	args = append(args, uuid)
+	res, err := tx.ExecContext(ctx,
+		`UPDATE parts SET `+strings.Join(sets, ", ")+` WHERE uuid = `+placeholder(len(args)),
+		args...,
+	)
+	if err != nil {
+		return model.Part{}, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return model.Part{}, err
+	}
+	if affected == 0 {
+		return model.Part{}, model.ErrPartNotFound
+	}
+
+	if info.Tags != nil {
+		if err = replaceTags(ctx, tx, uuid, *info.Tags); err != nil {
+			return model.Part{}, err
+		}
+	}
+
+	if info.Metadata != nil {
+		if _, err = tx.ExecContext(ctx, `DELETE FROM part_metadata WHERE part_uuid = $1`, uuid); err != nil {
+			return model.Part{}, err
+		}
+		if err = insertMetadata(ctx, tx, uuid, repoConverter.MetadataToRepoModel(*info.Metadata)); err != nil {
+			return model.Part{}, err
+		}
+	}
+
+	if err = appendUpdatedEvents(ctx, tx, uuid); err != nil {
+		return model.Part{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return model.Part{}, err
+	}
+	r.invalidateIndex()
+	r.notifier.Notify()
+
+	return r.GetPart(ctx, uuid)
+}
+
+func replaceTags(ctx context.Context, tx *sql.Tx, partUUID string, tags []string) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM part_tags WHERE part_uuid = $1`, partUUID); err != nil {
+		return err
+	}
+
+	for i, tag := range tags {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO part_tags (part_uuid, tag_index, tag) VALUES ($1, $2, $3)`,
+			partUUID, i, tag,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package database
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func (s *RepositorySuite) TestUpdatePartSuccess() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	s.insert(repoPart)
+
+	// Act - меняем только цену, размеры и теги
+	res, err := s.repo.UpdatePart(s.ctx, repoPart.UUID, model.PartUpdateInfo{
+		Price:      lo.ToPtr(42.5),
+		Dimensions: &model.Dimensions{Length: 1, Width: 2, Height: 3, Weight: 4},
+		Tags:       lo.ToPtr([]string{"new", "tags"}),
+	})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(42.5, res.Price)
+	s.Require().Equal(model.Dimensions{Length: 1, Width: 2, Height: 3, Weight: 4}, res.Dimensions)
+	s.Require().Equal([]string{"new", "tags"}, res.Tags)
+	s.Require().Equal(repoPart.Name, res.Name)
+	s.Require().Equal(repoPart.StockQuantity, res.StockQuantity)
+	s.Require().NotNil(res.UpdatedAt)
+	s.Require().True(res.UpdatedAt.After(*repoPart.UpdatedAt))
+}
+
+func (s *RepositorySuite) TestUpdatePartNotFound() {
+	// Act
+	_, err := s.repo.UpdatePart(s.ctx, gofakeit.UUID(), model.PartUpdateInfo{
+		Price: lo.ToPtr(1.0),
+	})
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+}
+
+func (s *RepositorySuite) TestUpdatePartReplacesMetadata() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	s.insert(repoPart)
+
+	// Act
+	res, err := s.repo.UpdatePart(s.ctx, repoPart.UUID, model.PartUpdateInfo{
+		Metadata: &model.Metadata{
+			"thrust_kn": {DoubleValue: lo.ToPtr(845.0)},
+			"reusable":  {BoolValue: lo.ToPtr(true)},
+		},
+	})
+
+	// Assert - старый ключ material удалён, новые сохранены
+	s.Require().NoError(err)
+	s.Require().Equal(model.Metadata{
+		"thrust_kn": {DoubleValue: lo.ToPtr(845.0)},
+		"reusable":  {BoolValue: lo.ToPtr(true)},
+	}, res.Metadata)
+	s.Require().Equal(repoPart.Tags, res.Tags)
+}
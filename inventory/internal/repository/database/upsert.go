@@ -0,0 +1,149 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoConverter "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/converter"
+	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+)
+
+func (r *repository) UpsertPart(ctx context.Context, part model.Part) (_ model.Part, err error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return model.Part{}, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	now := time.Now().UTC()
+
+	repoPart := repoConverter.PartToRepoModel(part)
+	if repoPart.UUID == "" {
+		repoPart.UUID = uuid.NewString()
+	}
+	repoPart.UpdatedAt = &now
+
+	var createdAt time.Time
+	err = tx.QueryRowContext(ctx, `SELECT created_at FROM parts WHERE uuid = $1`, repoPart.UUID).Scan(&createdAt)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		if repoPart.CreatedAt.IsZero() {
+			repoPart.CreatedAt = now
+		}
+		if err = insertPart(ctx, tx, repoPart); err != nil {
+			return model.Part{}, err
+		}
+		if repoPart.StockQuantity, err = setAvailableStock(ctx, tx, repoPart.UUID, repoPart.StockQuantity); err != nil {
+			return model.Part{}, err
+		}
+		if err = appendPartEvent(ctx, tx, model.PartEventCreated, repoPart); err != nil {
+			return model.Part{}, err
+		}
+	case err != nil:
+		return model.Part{}, err
+	default:
+		repoPart.CreatedAt = createdAt
+		_, err = tx.ExecContext(ctx,
+			`UPDATE parts SET
+				name = $1, description = $2, price = $3, stock_quantity = $4, category = $5,
+				length = $6, width = $7, height = $8, weight = $9,
+				manufacturer_name = $10, manufacturer_country = $11, manufacturer_website = $12,
+				updated_at = $13, archived = $14
+			WHERE uuid = $15`,
+			repoPart.Name, repoPart.Description, repoPart.Price, repoPart.StockQuantity, string(repoPart.Category),
+			repoPart.Dimensions.Length, repoPart.Dimensions.Width, repoPart.Dimensions.Height, repoPart.Dimensions.Weight,
+			repoPart.Manufacturer.Name, repoPart.Manufacturer.Country, repoPart.Manufacturer.Website,
+			now, repoPart.Archived, repoPart.UUID,
+		)
+		if err != nil {
+			return model.Part{}, err
+		}
+		if err = replaceTags(ctx, tx, repoPart.UUID, repoPart.Tags); err != nil {
+			return model.Part{}, err
+		}
+		if _, err = tx.ExecContext(ctx, `DELETE FROM part_metadata WHERE part_uuid = $1`, repoPart.UUID); err != nil {
+			return model.Part{}, err
+		}
+		if err = insertMetadata(ctx, tx, repoPart.UUID, repoPart.Metadata); err != nil {
+			return model.Part{}, err
+		}
+		if _, err = setAvailableStock(ctx, tx, repoPart.UUID, repoPart.StockQuantity); err != nil {
+			return model.Part{}, err
+		}
+		if err = appendUpdatedEvents(ctx, tx, repoPart.UUID); err != nil {
+			return model.Part{}, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return model.Part{}, err
+	}
+	r.invalidateIndex()
+	r.notifier.Notify()
+
+	return r.GetPart(ctx, repoPart.UUID)
+}
+
+// setAvailableStock записывает остаток детали за вычетом активных резервов и возвращает его.
+// Строка детали к этому моменту уже изменена в транзакции, поэтому конкурентный резерв дождётся
+// коммита и спишет свои детали уже с нового остатка.
+func setAvailableStock(ctx context.Context, tx *sql.Tx, partUUID string, total int64) (int64, error) {
+	var reserved int64
+	err := tx.QueryRowContext(ctx,
+		`SELECT COALESCE(SUM(ri.quantity), 0) FROM reservation_items ri
+		JOIN reservations r ON r.uuid = ri.reservation_uuid
+		WHERE ri.part_uuid = $1 AND r.status = $2`,
+		partUUID, string(repoModel.ReservationStatusActive),
+	).Scan(&reserved)
+	if err != nil {
+		return 0, err
+	}
+	if total < reserved {
+		return 0, fmt.Errorf("%w: %d < %d", model.ErrStockBelowReserved, total, reserved)
+	}
+
+	available := total - reserved
+	if _, err = tx.ExecContext(ctx, `UPDATE parts SET stock_quantity = $1 WHERE uuid = $2`, available, partUUID); err != nil {
+		return 0, err
+	}
+
+	return available, nil
+}
+
+func (r *repository) ReservedQuantities(ctx context.Context) (map[string]int64, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT ri.part_uuid, SUM(ri.quantity) FROM reservation_items ri
+		JOIN reservations r ON r.uuid = ri.reservation_uuid
+		WHERE r.status = $1
+		GROUP BY ri.part_uuid`,
+		string(repoModel.ReservationStatusActive),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	reserved := make(map[string]int64)
+	for rows.Next() {
+		var (
+			partUUID string
+			quantity int64
+		)
+		if err = rows.Scan(&partUUID, &quantity); err != nil {
+			return nil, err
+		}
+		reserved[partUUID] = quantity
+	}
+
+	return reserved, rows.Err()
+}
@@ -0,0 +1,101 @@
+package database
+
+import (
+	"time"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func (s *RepositorySuite) TestUpsertPartCreatesWithGivenUUID() {
+	// Arrange - архивный признак из файла сохраняется как есть
+	part := testutils.CreatePart()
+	part.Archived = true
+
+	// Act
+	res, err := s.repo.UpsertPart(s.ctx, part)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(part.UUID, res.UUID)
+	s.Require().True(res.Archived)
+	s.Require().True(part.CreatedAt.Equal(res.CreatedAt))
+	s.Require().Equal(part.Tags, res.Tags)
+	s.Require().Equal(part.Metadata, res.Metadata)
+}
+
+func (s *RepositorySuite) TestUpsertPartReplacesExisting() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	s.insert(repoPart)
+
+	part := testutils.CreatePart()
+	part.UUID = repoPart.UUID
+	part.Name = "Replaced"
+	part.Tags = []string{"only"}
+
+	// Act
+	res, err := s.repo.UpsertPart(s.ctx, part)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal("Replaced", res.Name)
+	s.Require().Equal([]string{"only"}, res.Tags)
+	s.Require().Equal(part.Metadata, res.Metadata)
+	s.Require().True(repoPart.CreatedAt.Equal(res.CreatedAt))
+
+	hits, err := s.repo.SearchParts(s.ctx, "replaced", 10)
+	s.Require().NoError(err)
+	s.Require().Len(hits, 1)
+}
+
+func (s *RepositorySuite) TestUpsertPartKeepsReservedStock() {
+	// Arrange - 3 из 10 единиц в резерве, файл задаёт весь остаток
+	repoPart := testutils.CreateRepoPart()
+	repoPart.StockQuantity = 10
+	s.insert(repoPart)
+
+	_, err := s.repo.ReserveParts(s.ctx, []model.ReservationItem{
+		{PartUUID: repoPart.UUID, Quantity: 3},
+	}, time.Now().Add(time.Hour))
+	s.Require().NoError(err)
+
+	part := testutils.CreatePart()
+	part.UUID = repoPart.UUID
+	part.StockQuantity = 12
+
+	// Act
+	res, err := s.repo.UpsertPart(s.ctx, part)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(int64(9), res.StockQuantity)
+	s.Require().Equal(int64(9), s.stock(repoPart.UUID))
+
+	reserved, err := s.repo.ReservedQuantities(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]int64{repoPart.UUID: 3}, reserved)
+}
+
+func (s *RepositorySuite) TestUpsertPartStockBelowReserved() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	repoPart.StockQuantity = 10
+	s.insert(repoPart)
+
+	_, err := s.repo.ReserveParts(s.ctx, []model.ReservationItem{
+		{PartUUID: repoPart.UUID, Quantity: 3},
+	}, time.Now().Add(time.Hour))
+	s.Require().NoError(err)
+
+	part := testutils.CreatePart()
+	part.UUID = repoPart.UUID
+	part.StockQuantity = 2
+
+	// Act
+	_, err = s.repo.UpsertPart(s.ctx, part)
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrStockBelowReserved)
+	s.Require().Equal(int64(7), s.stock(repoPart.UUID))
+}
@@ -9,6 +9,8 @@ import (
 
 	model "github.com/baryshnikkov/rocket-factory/inventory/internal/model"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // InventoryRepository is an autogenerated mock type for the InventoryRepository type
@@ -24,6 +26,274 @@ func (_m *InventoryRepository) EXPECT() *InventoryRepository_Expecter {
 	return &InventoryRepository_Expecter{mock: &_m.Mock}
 }
 
+// ArchivePart provides a mock function with given fields: ctx, uuid
+func (_m *InventoryRepository) ArchivePart(ctx context.Context, uuid string) (model.Part, error) {
+	ret := _m.Called(ctx, uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchivePart")
+	}
+
+	var r0 model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.Part, error)); ok {
+		return rf(ctx, uuid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.Part); ok {
+		r0 = rf(ctx, uuid)
+	} else {
+		r0 = ret.Get(0).(model.Part)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, uuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_ArchivePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArchivePart'
+type InventoryRepository_ArchivePart_Call struct {
+	*mock.Call
+}
+
+// ArchivePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+func (_e *InventoryRepository_Expecter) ArchivePart(ctx interface{}, uuid interface{}) *InventoryRepository_ArchivePart_Call {
+	return &InventoryRepository_ArchivePart_Call{Call: _e.mock.On("ArchivePart", ctx, uuid)}
+}
+
+func (_c *InventoryRepository_ArchivePart_Call) Run(run func(ctx context.Context, uuid string)) *InventoryRepository_ArchivePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_ArchivePart_Call) Return(_a0 model.Part, _a1 error) *InventoryRepository_ArchivePart_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_ArchivePart_Call) RunAndReturn(run func(context.Context, string) (model.Part, error)) *InventoryRepository_ArchivePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CommitReservation provides a mock function with given fields: ctx, reservationUUID
+func (_m *InventoryRepository) CommitReservation(ctx context.Context, reservationUUID string) error {
+	ret := _m.Called(ctx, reservationUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, reservationUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryRepository_CommitReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitReservation'
+type InventoryRepository_CommitReservation_Call struct {
+	*mock.Call
+}
+
+// CommitReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reservationUUID string
+func (_e *InventoryRepository_Expecter) CommitReservation(ctx interface{}, reservationUUID interface{}) *InventoryRepository_CommitReservation_Call {
+	return &InventoryRepository_CommitReservation_Call{Call: _e.mock.On("CommitReservation", ctx, reservationUUID)}
+}
+
+func (_c *InventoryRepository_CommitReservation_Call) Run(run func(ctx context.Context, reservationUUID string)) *InventoryRepository_CommitReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_CommitReservation_Call) Return(_a0 error) *InventoryRepository_CommitReservation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryRepository_CommitReservation_Call) RunAndReturn(run func(context.Context, string) error) *InventoryRepository_CommitReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountParts provides a mock function with given fields: ctx, filter, field
+func (_m *InventoryRepository) CountParts(ctx context.Context, filter model.PartsFilter, field model.FacetField) ([]model.FacetCount, error) {
+	ret := _m.Called(ctx, filter, field)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountParts")
+	}
+
+	var r0 []model.FacetCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PartsFilter, model.FacetField) ([]model.FacetCount, error)); ok {
+		return rf(ctx, filter, field)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.PartsFilter, model.FacetField) []model.FacetCount); ok {
+		r0 = rf(ctx, filter, field)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.FacetCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.PartsFilter, model.FacetField) error); ok {
+		r1 = rf(ctx, filter, field)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_CountParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountParts'
+type InventoryRepository_CountParts_Call struct {
+	*mock.Call
+}
+
+// CountParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.PartsFilter
+//   - field model.FacetField
+func (_e *InventoryRepository_Expecter) CountParts(ctx interface{}, filter interface{}, field interface{}) *InventoryRepository_CountParts_Call {
+	return &InventoryRepository_CountParts_Call{Call: _e.mock.On("CountParts", ctx, filter, field)}
+}
+
+func (_c *InventoryRepository_CountParts_Call) Run(run func(ctx context.Context, filter model.PartsFilter, field model.FacetField)) *InventoryRepository_CountParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PartsFilter), args[2].(model.FacetField))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_CountParts_Call) Return(_a0 []model.FacetCount, _a1 error) *InventoryRepository_CountParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_CountParts_Call) RunAndReturn(run func(context.Context, model.PartsFilter, model.FacetField) ([]model.FacetCount, error)) *InventoryRepository_CountParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePart provides a mock function with given fields: ctx, part
+func (_m *InventoryRepository) CreatePart(ctx context.Context, part model.Part) (model.Part, error) {
+	ret := _m.Called(ctx, part)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePart")
+	}
+
+	var r0 model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Part) (model.Part, error)); ok {
+		return rf(ctx, part)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Part) model.Part); ok {
+		r0 = rf(ctx, part)
+	} else {
+		r0 = ret.Get(0).(model.Part)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Part) error); ok {
+		r1 = rf(ctx, part)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_CreatePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePart'
+type InventoryRepository_CreatePart_Call struct {
+	*mock.Call
+}
+
+// CreatePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - part model.Part
+func (_e *InventoryRepository_Expecter) CreatePart(ctx interface{}, part interface{}) *InventoryRepository_CreatePart_Call {
+	return &InventoryRepository_CreatePart_Call{Call: _e.mock.On("CreatePart", ctx, part)}
+}
+
+func (_c *InventoryRepository_CreatePart_Call) Run(run func(ctx context.Context, part model.Part)) *InventoryRepository_CreatePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Part))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_CreatePart_Call) Return(_a0 model.Part, _a1 error) *InventoryRepository_CreatePart_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_CreatePart_Call) RunAndReturn(run func(context.Context, model.Part) (model.Part, error)) *InventoryRepository_CreatePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePart provides a mock function with given fields: ctx, uuid
+func (_m *InventoryRepository) DeletePart(ctx context.Context, uuid string) error {
+	ret := _m.Called(ctx, uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, uuid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryRepository_DeletePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePart'
+type InventoryRepository_DeletePart_Call struct {
+	*mock.Call
+}
+
+// DeletePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+func (_e *InventoryRepository_Expecter) DeletePart(ctx interface{}, uuid interface{}) *InventoryRepository_DeletePart_Call {
+	return &InventoryRepository_DeletePart_Call{Call: _e.mock.On("DeletePart", ctx, uuid)}
+}
+
+func (_c *InventoryRepository_DeletePart_Call) Run(run func(ctx context.Context, uuid string)) *InventoryRepository_DeletePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_DeletePart_Call) Return(_a0 error) *InventoryRepository_DeletePart_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryRepository_DeletePart_Call) RunAndReturn(run func(context.Context, string) error) *InventoryRepository_DeletePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPart provides a mock function with given fields: ctx, UUID
 func (_m *InventoryRepository) GetPart(ctx context.Context, UUID string) (model.Part, error) {
 	ret := _m.Called(ctx, UUID)
@@ -81,9 +351,22 @@ func (_c *InventoryRepository_GetPart_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
-// InitParts provides a mock function with no fields
-func (_m *InventoryRepository) InitParts() {
-	_m.Called()
+// InitParts provides a mock function with given fields: ctx
+func (_m *InventoryRepository) InitParts(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InitParts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
 }
 
 // InventoryRepository_InitParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InitParts'
@@ -92,50 +375,49 @@ type InventoryRepository_InitParts_Call struct {
 }
 
 // InitParts is a helper method to define mock.On call
-func (_e *InventoryRepository_Expecter) InitParts() *InventoryRepository_InitParts_Call {
-	return &InventoryRepository_InitParts_Call{Call: _e.mock.On("InitParts")}
+//   - ctx context.Context
+func (_e *InventoryRepository_Expecter) InitParts(ctx interface{}) *InventoryRepository_InitParts_Call {
+	return &InventoryRepository_InitParts_Call{Call: _e.mock.On("InitParts", ctx)}
 }
 
-func (_c *InventoryRepository_InitParts_Call) Run(run func()) *InventoryRepository_InitParts_Call {
+func (_c *InventoryRepository_InitParts_Call) Run(run func(ctx context.Context)) *InventoryRepository_InitParts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *InventoryRepository_InitParts_Call) Return() *InventoryRepository_InitParts_Call {
-	_c.Call.Return()
+func (_c *InventoryRepository_InitParts_Call) Return(_a0 error) *InventoryRepository_InitParts_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *InventoryRepository_InitParts_Call) RunAndReturn(run func()) *InventoryRepository_InitParts_Call {
-	_c.Run(run)
+func (_c *InventoryRepository_InitParts_Call) RunAndReturn(run func(context.Context) error) *InventoryRepository_InitParts_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// ListParts provides a mock function with given fields: ctx, filter
-func (_m *InventoryRepository) ListParts(ctx context.Context, filter model.PartsFilter) ([]model.Part, error) {
-	ret := _m.Called(ctx, filter)
+// ListParts provides a mock function with given fields: ctx, filter, page
+func (_m *InventoryRepository) ListParts(ctx context.Context, filter model.PartsFilter, page model.PartsPageRequest) (model.PartsPage, error) {
+	ret := _m.Called(ctx, filter, page)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListParts")
 	}
 
-	var r0 []model.Part
+	var r0 model.PartsPage
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, model.PartsFilter) ([]model.Part, error)); ok {
-		return rf(ctx, filter)
+	if rf, ok := ret.Get(0).(func(context.Context, model.PartsFilter, model.PartsPageRequest) (model.PartsPage, error)); ok {
+		return rf(ctx, filter, page)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, model.PartsFilter) []model.Part); ok {
-		r0 = rf(ctx, filter)
+	if rf, ok := ret.Get(0).(func(context.Context, model.PartsFilter, model.PartsPageRequest) model.PartsPage); ok {
+		r0 = rf(ctx, filter, page)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.Part)
-		}
+		r0 = ret.Get(0).(model.PartsPage)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, model.PartsFilter) error); ok {
-		r1 = rf(ctx, filter)
+	if rf, ok := ret.Get(1).(func(context.Context, model.PartsFilter, model.PartsPageRequest) error); ok {
+		r1 = rf(ctx, filter, page)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -151,23 +433,525 @@ type InventoryRepository_ListParts_Call struct {
 // ListParts is a helper method to define mock.On call
 //   - ctx context.Context
 //   - filter model.PartsFilter
-func (_e *InventoryRepository_Expecter) ListParts(ctx interface{}, filter interface{}) *InventoryRepository_ListParts_Call {
-	return &InventoryRepository_ListParts_Call{Call: _e.mock.On("ListParts", ctx, filter)}
+//   - page model.PartsPageRequest
+func (_e *InventoryRepository_Expecter) ListParts(ctx interface{}, filter interface{}, page interface{}) *InventoryRepository_ListParts_Call {
+	return &InventoryRepository_ListParts_Call{Call: _e.mock.On("ListParts", ctx, filter, page)}
+}
+
+func (_c *InventoryRepository_ListParts_Call) Run(run func(ctx context.Context, filter model.PartsFilter, page model.PartsPageRequest)) *InventoryRepository_ListParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PartsFilter), args[2].(model.PartsPageRequest))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_ListParts_Call) Return(_a0 model.PartsPage, _a1 error) *InventoryRepository_ListParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_ListParts_Call) RunAndReturn(run func(context.Context, model.PartsFilter, model.PartsPageRequest) (model.PartsPage, error)) *InventoryRepository_ListParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PartEvents provides a mock function with given fields: ctx, afterRevision, limit
+func (_m *InventoryRepository) PartEvents(ctx context.Context, afterRevision int64, limit int) ([]model.PartEvent, error) {
+	ret := _m.Called(ctx, afterRevision, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PartEvents")
+	}
+
+	var r0 []model.PartEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) ([]model.PartEvent, error)); ok {
+		return rf(ctx, afterRevision, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) []model.PartEvent); ok {
+		r0 = rf(ctx, afterRevision, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.PartEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int) error); ok {
+		r1 = rf(ctx, afterRevision, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_PartEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PartEvents'
+type InventoryRepository_PartEvents_Call struct {
+	*mock.Call
+}
+
+// PartEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - afterRevision int64
+//   - limit int
+func (_e *InventoryRepository_Expecter) PartEvents(ctx interface{}, afterRevision interface{}, limit interface{}) *InventoryRepository_PartEvents_Call {
+	return &InventoryRepository_PartEvents_Call{Call: _e.mock.On("PartEvents", ctx, afterRevision, limit)}
 }
 
-func (_c *InventoryRepository_ListParts_Call) Run(run func(ctx context.Context, filter model.PartsFilter)) *InventoryRepository_ListParts_Call {
+func (_c *InventoryRepository_PartEvents_Call) Run(run func(ctx context.Context, afterRevision int64, limit int)) *InventoryRepository_PartEvents_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(model.PartsFilter))
+		run(args[0].(context.Context), args[1].(int64), args[2].(int))
 	})
 	return _c
 }
 
-func (_c *InventoryRepository_ListParts_Call) Return(_a0 []model.Part, _a1 error) *InventoryRepository_ListParts_Call {
+func (_c *InventoryRepository_PartEvents_Call) Return(_a0 []model.PartEvent, _a1 error) *InventoryRepository_PartEvents_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *InventoryRepository_ListParts_Call) RunAndReturn(run func(context.Context, model.PartsFilter) ([]model.Part, error)) *InventoryRepository_ListParts_Call {
+func (_c *InventoryRepository_PartEvents_Call) RunAndReturn(run func(context.Context, int64, int) ([]model.PartEvent, error)) *InventoryRepository_PartEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PartsRevision provides a mock function with given fields: ctx
+func (_m *InventoryRepository) PartsRevision(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PartsRevision")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_PartsRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PartsRevision'
+type InventoryRepository_PartsRevision_Call struct {
+	*mock.Call
+}
+
+// PartsRevision is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *InventoryRepository_Expecter) PartsRevision(ctx interface{}) *InventoryRepository_PartsRevision_Call {
+	return &InventoryRepository_PartsRevision_Call{Call: _e.mock.On("PartsRevision", ctx)}
+}
+
+func (_c *InventoryRepository_PartsRevision_Call) Run(run func(ctx context.Context)) *InventoryRepository_PartsRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_PartsRevision_Call) Return(_a0 int64, _a1 error) *InventoryRepository_PartsRevision_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_PartsRevision_Call) RunAndReturn(run func(context.Context) (int64, error)) *InventoryRepository_PartsRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseReservation provides a mock function with given fields: ctx, reservationUUID
+func (_m *InventoryRepository) ReleaseReservation(ctx context.Context, reservationUUID string) error {
+	ret := _m.Called(ctx, reservationUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, reservationUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryRepository_ReleaseReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseReservation'
+type InventoryRepository_ReleaseReservation_Call struct {
+	*mock.Call
+}
+
+// ReleaseReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reservationUUID string
+func (_e *InventoryRepository_Expecter) ReleaseReservation(ctx interface{}, reservationUUID interface{}) *InventoryRepository_ReleaseReservation_Call {
+	return &InventoryRepository_ReleaseReservation_Call{Call: _e.mock.On("ReleaseReservation", ctx, reservationUUID)}
+}
+
+func (_c *InventoryRepository_ReleaseReservation_Call) Run(run func(ctx context.Context, reservationUUID string)) *InventoryRepository_ReleaseReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_ReleaseReservation_Call) Return(_a0 error) *InventoryRepository_ReleaseReservation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryRepository_ReleaseReservation_Call) RunAndReturn(run func(context.Context, string) error) *InventoryRepository_ReleaseReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveParts provides a mock function with given fields: ctx, items, expiresAt
+func (_m *InventoryRepository) ReserveParts(ctx context.Context, items []model.ReservationItem, expiresAt time.Time) (model.Reservation, error) {
+	ret := _m.Called(ctx, items, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveParts")
+	}
+
+	var r0 model.Reservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []model.ReservationItem, time.Time) (model.Reservation, error)); ok {
+		return rf(ctx, items, expiresAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []model.ReservationItem, time.Time) model.Reservation); ok {
+		r0 = rf(ctx, items, expiresAt)
+	} else {
+		r0 = ret.Get(0).(model.Reservation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []model.ReservationItem, time.Time) error); ok {
+		r1 = rf(ctx, items, expiresAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_ReserveParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveParts'
+type InventoryRepository_ReserveParts_Call struct {
+	*mock.Call
+}
+
+// ReserveParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - items []model.ReservationItem
+//   - expiresAt time.Time
+func (_e *InventoryRepository_Expecter) ReserveParts(ctx interface{}, items interface{}, expiresAt interface{}) *InventoryRepository_ReserveParts_Call {
+	return &InventoryRepository_ReserveParts_Call{Call: _e.mock.On("ReserveParts", ctx, items, expiresAt)}
+}
+
+func (_c *InventoryRepository_ReserveParts_Call) Run(run func(ctx context.Context, items []model.ReservationItem, expiresAt time.Time)) *InventoryRepository_ReserveParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]model.ReservationItem), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_ReserveParts_Call) Return(_a0 model.Reservation, _a1 error) *InventoryRepository_ReserveParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_ReserveParts_Call) RunAndReturn(run func(context.Context, []model.ReservationItem, time.Time) (model.Reservation, error)) *InventoryRepository_ReserveParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReservedQuantities provides a mock function with given fields: ctx
+func (_m *InventoryRepository) ReservedQuantities(ctx context.Context) (map[string]int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReservedQuantities")
+	}
+
+	var r0 map[string]int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]int64); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_ReservedQuantities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReservedQuantities'
+type InventoryRepository_ReservedQuantities_Call struct {
+	*mock.Call
+}
+
+// ReservedQuantities is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *InventoryRepository_Expecter) ReservedQuantities(ctx interface{}) *InventoryRepository_ReservedQuantities_Call {
+	return &InventoryRepository_ReservedQuantities_Call{Call: _e.mock.On("ReservedQuantities", ctx)}
+}
+
+func (_c *InventoryRepository_ReservedQuantities_Call) Run(run func(ctx context.Context)) *InventoryRepository_ReservedQuantities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_ReservedQuantities_Call) Return(_a0 map[string]int64, _a1 error) *InventoryRepository_ReservedQuantities_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_ReservedQuantities_Call) RunAndReturn(run func(context.Context) (map[string]int64, error)) *InventoryRepository_ReservedQuantities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchParts provides a mock function with given fields: ctx, query, limit
+func (_m *InventoryRepository) SearchParts(ctx context.Context, query string, limit int) ([]model.SearchHit, error) {
+	ret := _m.Called(ctx, query, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchParts")
+	}
+
+	var r0 []model.SearchHit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]model.SearchHit, error)); ok {
+		return rf(ctx, query, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []model.SearchHit); ok {
+		r0 = rf(ctx, query, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SearchHit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, query, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_SearchParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchParts'
+type InventoryRepository_SearchParts_Call struct {
+	*mock.Call
+}
+
+// SearchParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+//   - limit int
+func (_e *InventoryRepository_Expecter) SearchParts(ctx interface{}, query interface{}, limit interface{}) *InventoryRepository_SearchParts_Call {
+	return &InventoryRepository_SearchParts_Call{Call: _e.mock.On("SearchParts", ctx, query, limit)}
+}
+
+func (_c *InventoryRepository_SearchParts_Call) Run(run func(ctx context.Context, query string, limit int)) *InventoryRepository_SearchParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_SearchParts_Call) Return(_a0 []model.SearchHit, _a1 error) *InventoryRepository_SearchParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_SearchParts_Call) RunAndReturn(run func(context.Context, string, int) ([]model.SearchHit, error)) *InventoryRepository_SearchParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePart provides a mock function with given fields: ctx, uuid, info
+func (_m *InventoryRepository) UpdatePart(ctx context.Context, uuid string, info model.PartUpdateInfo) (model.Part, error) {
+	ret := _m.Called(ctx, uuid, info)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePart")
+	}
+
+	var r0 model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.PartUpdateInfo) (model.Part, error)); ok {
+		return rf(ctx, uuid, info)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.PartUpdateInfo) model.Part); ok {
+		r0 = rf(ctx, uuid, info)
+	} else {
+		r0 = ret.Get(0).(model.Part)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.PartUpdateInfo) error); ok {
+		r1 = rf(ctx, uuid, info)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_UpdatePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePart'
+type InventoryRepository_UpdatePart_Call struct {
+	*mock.Call
+}
+
+// UpdatePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+//   - info model.PartUpdateInfo
+func (_e *InventoryRepository_Expecter) UpdatePart(ctx interface{}, uuid interface{}, info interface{}) *InventoryRepository_UpdatePart_Call {
+	return &InventoryRepository_UpdatePart_Call{Call: _e.mock.On("UpdatePart", ctx, uuid, info)}
+}
+
+func (_c *InventoryRepository_UpdatePart_Call) Run(run func(ctx context.Context, uuid string, info model.PartUpdateInfo)) *InventoryRepository_UpdatePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(model.PartUpdateInfo))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_UpdatePart_Call) Return(_a0 model.Part, _a1 error) *InventoryRepository_UpdatePart_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_UpdatePart_Call) RunAndReturn(run func(context.Context, string, model.PartUpdateInfo) (model.Part, error)) *InventoryRepository_UpdatePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertPart provides a mock function with given fields: ctx, part
+func (_m *InventoryRepository) UpsertPart(ctx context.Context, part model.Part) (model.Part, error) {
+	ret := _m.Called(ctx, part)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertPart")
+	}
+
+	var r0 model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Part) (model.Part, error)); ok {
+		return rf(ctx, part)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Part) model.Part); ok {
+		r0 = rf(ctx, part)
+	} else {
+		r0 = ret.Get(0).(model.Part)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Part) error); ok {
+		r1 = rf(ctx, part)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_UpsertPart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertPart'
+type InventoryRepository_UpsertPart_Call struct {
+	*mock.Call
+}
+
+// UpsertPart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - part model.Part
+func (_e *InventoryRepository_Expecter) UpsertPart(ctx interface{}, part interface{}) *InventoryRepository_UpsertPart_Call {
+	return &InventoryRepository_UpsertPart_Call{Call: _e.mock.On("UpsertPart", ctx, part)}
+}
+
+func (_c *InventoryRepository_UpsertPart_Call) Run(run func(ctx context.Context, part model.Part)) *InventoryRepository_UpsertPart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Part))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_UpsertPart_Call) Return(_a0 model.Part, _a1 error) *InventoryRepository_UpsertPart_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_UpsertPart_Call) RunAndReturn(run func(context.Context, model.Part) (model.Part, error)) *InventoryRepository_UpsertPart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WaitPartEvents provides a mock function with no fields
+func (_m *InventoryRepository) WaitPartEvents() <-chan struct{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WaitPartEvents")
+	}
+
+	var r0 <-chan struct{}
+	if rf, ok := ret.Get(0).(func() <-chan struct{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan struct{})
+		}
+	}
+
+	return r0
+}
+
+// InventoryRepository_WaitPartEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WaitPartEvents'
+type InventoryRepository_WaitPartEvents_Call struct {
+	*mock.Call
+}
+
+// WaitPartEvents is a helper method to define mock.On call
+func (_e *InventoryRepository_Expecter) WaitPartEvents() *InventoryRepository_WaitPartEvents_Call {
+	return &InventoryRepository_WaitPartEvents_Call{Call: _e.mock.On("WaitPartEvents")}
+}
+
+func (_c *InventoryRepository_WaitPartEvents_Call) Run(run func()) *InventoryRepository_WaitPartEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_WaitPartEvents_Call) Return(_a0 <-chan struct{}) *InventoryRepository_WaitPartEvents_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryRepository_WaitPartEvents_Call) RunAndReturn(run func() <-chan struct{}) *InventoryRepository_WaitPartEvents_Call {
 	_c.Call.Return(run)
 	return _c
 }
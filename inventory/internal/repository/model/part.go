@@ -15,6 +15,7 @@ type Part struct {
 	Metadata      Metadata
 	CreatedAt     time.Time
 	UpdatedAt     *time.Time
+	Archived      bool
 }
 
 type Category string
@@ -41,7 +42,11 @@ type Manufacturer struct {
 	Website string
 }
 
-type Metadata struct {
+// Metadata - гибкие метаданные детали по ключам
+type Metadata map[string]MetadataValue
+
+// MetadataValue - значение метаданных; задано ровно одно из полей
+type MetadataValue struct {
 	StringValue *string
 	Int64Value  *int64
 	DoubleValue *float64
@@ -0,0 +1,30 @@
+package model
+
+import "time"
+
+type Reservation struct {
+	UUID      string
+	Items     []ReservationItem
+	Status    ReservationStatus
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UpdatedAt *time.Time
+}
+
+type ReservationItem struct {
+	PartUUID string
+	Quantity int64
+}
+
+// ReservationRetention - сколько хранятся снятые и истёкшие резервы: в этот срок повторное снятие
+// не считается ошибкой, потом резерв удаляется
+const ReservationRetention = 24 * time.Hour
+
+type ReservationStatus string
+
+const (
+	ReservationStatusActive    ReservationStatus = "ACTIVE"
+	ReservationStatusCommitted ReservationStatus = "COMMITTED"
+	ReservationStatusReleased  ReservationStatus = "RELEASED"
+	ReservationStatusExpired   ReservationStatus = "EXPIRED"
+)
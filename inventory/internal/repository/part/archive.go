@@ -0,0 +1,31 @@
+package part
+
+import (
+	"context"
+	"time"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoConverter "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/converter"
+)
+
+// ArchivePart снимает деталь с продажи. Повторная архивация не меняет updated_at.
+func (r *repository) ArchivePart(_ context.Context, uuid string) (model.Part, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	part, ok := r.data[uuid]
+	if !ok {
+		return model.Part{}, model.ErrPartNotFound
+	}
+
+	if !part.Archived {
+		now := time.Now()
+		part.Archived = true
+		part.UpdatedAt = &now
+		r.putPart(part)
+		r.index.Delete(uuid)
+		r.events.Append(model.PartEventUpdated, repoConverter.PartToModel(part))
+	}
+
+	return repoConverter.PartToModel(part), nil
+}
@@ -0,0 +1,54 @@
+package part
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func (s *RepositorySuite) TestArchivePartSuccess() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	s.insert(repoPart)
+
+	// Act
+	res, err := s.repo.ArchivePart(s.ctx, repoPart.UUID)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().True(res.Archived)
+	s.Require().True(res.UpdatedAt.After(*repoPart.UpdatedAt))
+
+	// Архивная деталь пропадает из выдачи по умолчанию
+	_, err = s.repo.ListParts(s.ctx, model.PartsFilter{}, model.PartsPageRequest{})
+	s.Require().ErrorIs(err, model.ErrPartsNotFound)
+
+	page, err := s.repo.ListParts(s.ctx, model.PartsFilter{IncludeArchived: true}, model.PartsPageRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(page.Parts, 1)
+}
+
+func (s *RepositorySuite) TestArchivePartTwice() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	s.insert(repoPart)
+
+	first, err := s.repo.ArchivePart(s.ctx, repoPart.UUID)
+	s.Require().NoError(err)
+
+	// Act
+	second, err := s.repo.ArchivePart(s.ctx, repoPart.UUID)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(first.UpdatedAt, second.UpdatedAt)
+}
+
+func (s *RepositorySuite) TestArchivePartNotFound() {
+	// Act
+	_, err := s.repo.ArchivePart(s.ctx, gofakeit.UUID())
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+}
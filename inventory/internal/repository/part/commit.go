@@ -0,0 +1,43 @@
+package part
+
+import (
+	"context"
+	"time"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+)
+
+// CommitReservation окончательно списывает детали резерва. Повторное подтверждение
+// не считается ошибкой.
+func (r *repository) CommitReservation(_ context.Context, reservationUUID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	reservation, ok := r.reservations[reservationUUID]
+	if !ok {
+		return model.ErrReservationNotFound
+	}
+
+	now := time.Now()
+
+	switch reservation.Status {
+	case repoModel.ReservationStatusCommitted:
+		return nil
+	case repoModel.ReservationStatusReleased:
+		return model.ErrReservationReleased
+	case repoModel.ReservationStatusExpired:
+		return model.ErrReservationExpired
+	}
+
+	if !now.Before(reservation.ExpiresAt) {
+		r.closeReservation(reservationUUID, repoModel.ReservationStatusExpired, now)
+		return model.ErrReservationExpired
+	}
+
+	reservation.Status = repoModel.ReservationStatusCommitted
+	reservation.UpdatedAt = &now
+	r.reservations[reservationUUID] = reservation
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package part
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func (s *RepositorySuite) TestCommitReservationSuccess() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	repoPart.StockQuantity = 4
+	s.insert(repoPart)
+
+	reservation, err := s.repo.ReserveParts(s.ctx, []model.ReservationItem{
+		{PartUUID: repoPart.UUID, Quantity: 3},
+	}, time.Now().Add(time.Hour))
+	s.Require().NoError(err)
+
+	// Act
+	err = s.repo.CommitReservation(s.ctx, reservation.UUID)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), s.repo.data[repoPart.UUID].StockQuantity)
+	s.Require().Equal(repoModel.ReservationStatusCommitted, s.repo.reservations[reservation.UUID].Status)
+}
+
+func (s *RepositorySuite) TestCommitReservationExpired() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	repoPart.StockQuantity = 0
+	s.insert(repoPart)
+
+	reservation := repoModel.Reservation{
+		UUID:      gofakeit.UUID(),
+		Items:     []repoModel.ReservationItem{{PartUUID: repoPart.UUID, Quantity: 2}},
+		Status:    repoModel.ReservationStatusActive,
+		ExpiresAt: time.Now().Add(-time.Minute),
+	}
+	s.repo.putReservation(reservation)
+
+	// Act
+	err := s.repo.CommitReservation(s.ctx, reservation.UUID)
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrReservationExpired)
+	s.Require().Equal(int64(2), s.repo.data[repoPart.UUID].StockQuantity)
+	s.Require().Equal(repoModel.ReservationStatusExpired, s.repo.reservations[reservation.UUID].Status)
+}
+
+func (s *RepositorySuite) TestCommitReservationReleased() {
+	// Arrange
+	reservation := repoModel.Reservation{
+		UUID:      gofakeit.UUID(),
+		Status:    repoModel.ReservationStatusReleased,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	s.repo.putReservation(reservation)
+
+	// Act
+	err := s.repo.CommitReservation(s.ctx, reservation.UUID)
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrReservationReleased)
+}
+
+func (s *RepositorySuite) TestCommitReservationNotFound() {
+	// Act
+	err := s.repo.CommitReservation(s.ctx, gofakeit.UUID())
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrReservationNotFound)
+}
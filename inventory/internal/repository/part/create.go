@@ -0,0 +1,32 @@
+package part
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoConverter "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/converter"
+)
+
+func (r *repository) CreatePart(_ context.Context, part model.Part) (model.Part, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+
+	repoPart := repoConverter.PartToRepoModel(part)
+	repoPart.UUID = uuid.NewString()
+	repoPart.CreatedAt = now
+	repoPart.UpdatedAt = &now
+	repoPart.Archived = false
+
+	r.putPart(repoPart)
+
+	created := repoConverter.PartToModel(repoPart)
+	r.index.Put(created)
+	r.events.Append(model.PartEventCreated, created)
+
+	return created, nil
+}
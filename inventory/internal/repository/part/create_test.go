@@ -0,0 +1,28 @@
+package part
+
+import (
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func (s *RepositorySuite) TestCreatePartSuccess() {
+	// Arrange
+	part := testutils.CreatePart()
+	part.Archived = true
+
+	// Act
+	res, err := s.repo.CreatePart(s.ctx, part)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().NotEmpty(res.UUID)
+	s.Require().NotEqual(part.UUID, res.UUID)
+	s.Require().Equal(part.Name, res.Name)
+	s.Require().Equal(part.Price, res.Price)
+	s.Require().False(res.Archived)
+	s.Require().NotNil(res.UpdatedAt)
+	s.Require().Equal(res.CreatedAt, *res.UpdatedAt)
+
+	saved, ok := s.repo.data[res.UUID]
+	s.Require().True(ok)
+	s.Require().Equal(part.Tags, saved.Tags)
+}
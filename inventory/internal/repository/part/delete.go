@@ -0,0 +1,40 @@
+package part
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoConverter "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/converter"
+	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+)
+
+func (r *repository) DeletePart(_ context.Context, uuid string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.data[uuid]; !ok {
+		return model.ErrPartNotFound
+	}
+
+	// Пока деталь держит действующий резерв, её нельзя удалить: заказ ещё может быть оплачен
+	now := time.Now()
+	for _, reservation := range r.reservations {
+		if reservation.Status != repoModel.ReservationStatusActive || !now.Before(reservation.ExpiresAt) {
+			continue
+		}
+		if slices.ContainsFunc(reservation.Items, func(item repoModel.ReservationItem) bool {
+			return item.PartUUID == uuid
+		}) {
+			return model.ErrPartReserved
+		}
+	}
+
+	part := r.data[uuid]
+	r.removePart(uuid)
+	r.index.Delete(uuid)
+	r.events.Append(model.PartEventDeleted, repoConverter.PartToModel(part))
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package part
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func (s *RepositorySuite) TestDeletePartSuccess() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	s.insert(repoPart)
+
+	// Act
+	err := s.repo.DeletePart(s.ctx, repoPart.UUID)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().NotContains(s.repo.data, repoPart.UUID)
+}
+
+func (s *RepositorySuite) TestDeletePartReserved() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	s.insert(repoPart)
+
+	_, err := s.repo.ReserveParts(s.ctx, []model.ReservationItem{
+		{PartUUID: repoPart.UUID, Quantity: 1},
+	}, time.Now().Add(time.Hour))
+	s.Require().NoError(err)
+
+	// Act
+	err = s.repo.DeletePart(s.ctx, repoPart.UUID)
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrPartReserved)
+	s.Require().Contains(s.repo.data, repoPart.UUID)
+}
+
+func (s *RepositorySuite) TestDeletePartNotFound() {
+	// Act
+	err := s.repo.DeletePart(s.ctx, gofakeit.UUID())
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+}
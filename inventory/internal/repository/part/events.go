@@ -0,0 +1,19 @@
+package part
+
+import (
+	"context"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+func (r *repository) PartEvents(_ context.Context, afterRevision int64, limit int) ([]model.PartEvent, error) {
+	return r.events.Since(afterRevision, limit)
+}
+
+func (r *repository) PartsRevision(_ context.Context) (int64, error) {
+	return r.events.Revision(), nil
+}
+
+func (r *repository) WaitPartEvents() <-chan struct{} {
+	return r.events.Wait()
+}
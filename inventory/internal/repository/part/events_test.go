@@ -0,0 +1,64 @@
+package part
+
+import (
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func (s *RepositorySuite) TestPartEventsFollowWrites() {
+	// Arrange
+	created, err := s.repo.CreatePart(s.ctx, testutils.CreatePart())
+	s.Require().NoError(err)
+	_, err = s.repo.UpdatePart(s.ctx, created.UUID, model.PartUpdateInfo{Price: lo.ToPtr(1.5)})
+	s.Require().NoError(err)
+	_, err = s.repo.ArchivePart(s.ctx, created.UUID)
+	s.Require().NoError(err)
+	_, err = s.repo.ArchivePart(s.ctx, created.UUID) // повторная архивация ничего не меняет
+	s.Require().NoError(err)
+	s.Require().NoError(s.repo.DeletePart(s.ctx, created.UUID))
+
+	// Act
+	events, err := s.repo.PartEvents(s.ctx, 0, 0)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(events, 4)
+	s.Require().Equal(
+		[]model.PartEventType{model.PartEventCreated, model.PartEventUpdated, model.PartEventUpdated, model.PartEventDeleted},
+		lo.Map(events, func(e model.PartEvent, _ int) model.PartEventType { return e.Type }),
+	)
+	s.Require().Equal(1.5, events[1].Part.Price)
+	s.Require().True(events[2].Part.Archived)
+	s.Require().Equal(created.UUID, events[3].Part.UUID)
+
+	revision, err := s.repo.PartsRevision(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(events[3].Revision, revision)
+}
+
+func (s *RepositorySuite) TestPartEventsOnStockChanges() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	repoPart.StockQuantity = 10
+	s.insert(repoPart)
+
+	reservation, err := s.repo.ReserveParts(s.ctx,
+		[]model.ReservationItem{{PartUUID: repoPart.UUID, Quantity: 4}},
+		time.Now().Add(time.Minute),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.repo.ReleaseReservation(s.ctx, reservation.UUID))
+
+	// Act
+	events, err := s.repo.PartEvents(s.ctx, 0, 0)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(events, 2)
+	s.Require().Equal(int64(6), events[0].Part.StockQuantity)
+	s.Require().Equal(int64(10), events[1].Part.StockQuantity)
+}
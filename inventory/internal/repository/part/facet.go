@@ -0,0 +1,43 @@
+package part
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+// CountParts группирует подходящие под фильтр детали по значению поля.
+func (r *repository) CountParts(_ context.Context, filter model.PartsFilter, field model.FacetField) ([]model.FacetCount, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	counts := make(map[string]int64)
+	for _, modelPart := range r.matchingParts(filter) {
+		switch field {
+		case model.FacetCategory:
+			counts[string(modelPart.Category)]++
+		case model.FacetManufacturerCountry:
+			counts[modelPart.Manufacturer.Country]++
+		case model.FacetTag:
+			// Повторяющийся тег детали считается один раз
+			for _, tag := range slices.Compact(slices.Sorted(slices.Values(modelPart.Tags))) {
+				counts[tag]++
+			}
+		}
+	}
+
+	result := make([]model.FacetCount, 0, len(counts))
+	for value, count := range counts {
+		result = append(result, model.FacetCount{Value: value, Count: count})
+	}
+	slices.SortFunc(result, func(a, b model.FacetCount) int {
+		if c := cmp.Compare(b.Count, a.Count); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Value, b.Value)
+	})
+
+	return result, nil
+}
@@ -0,0 +1,51 @@
+package part
+
+import (
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func (s *RepositorySuite) TestCountParts() {
+	// Arrange
+	usEngine := testutils.CreateRepoPartWithUUID("us-engine")
+	usEngine.Category = repoModel.CategoryEngine
+	usEngine.Manufacturer.Country = "USA"
+	usEngine.Tags = []string{"power", "heavy", "power"}
+
+	usFuel := testutils.CreateRepoPartWithUUID("us-fuel")
+	usFuel.Category = repoModel.CategoryFuel
+	usFuel.Manufacturer.Country = "USA"
+	usFuel.Tags = []string{"liquid", "heavy"}
+
+	germanEngine := testutils.CreateRepoPartWithUUID("german-engine")
+	germanEngine.Category = repoModel.CategoryEngine
+	germanEngine.Manufacturer.Country = "Germany"
+	germanEngine.Tags = []string{"power"}
+
+	for _, part := range []repoModel.Part{usEngine, usFuel, germanEngine} {
+		s.insert(part)
+	}
+
+	// Act
+	categories, err := s.repo.CountParts(s.ctx, model.PartsFilter{}, model.FacetCategory)
+	s.Require().NoError(err)
+	tags, err := s.repo.CountParts(s.ctx, model.PartsFilter{}, model.FacetTag)
+	s.Require().NoError(err)
+	usCountries, err := s.repo.CountParts(s.ctx, model.PartsFilter{ManufacturerCountries: []string{"USA"}}, model.FacetManufacturerCountry)
+	s.Require().NoError(err)
+
+	// Assert
+	s.Require().Equal([]model.FacetCount{{Value: "ENGINE", Count: 2}, {Value: "FUEL", Count: 1}}, categories)
+	s.Require().Equal([]model.FacetCount{{Value: "heavy", Count: 2}, {Value: "power", Count: 2}, {Value: "liquid", Count: 1}}, tags)
+	s.Require().Equal([]model.FacetCount{{Value: "USA", Count: 2}}, usCountries)
+}
+
+func (s *RepositorySuite) TestCountPartsEmpty() {
+	// Act
+	counts, err := s.repo.CountParts(s.ctx, model.PartsFilter{}, model.FacetCategory)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Empty(counts)
+}
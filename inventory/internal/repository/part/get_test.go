@@ -13,7 +13,7 @@ func (s *RepositorySuite) TestGetPartSuccess() {
 
 	uuid := repoPart.UUID
 
-	s.repo.data[uuid] = repoPart
+	s.insert(repoPart)
 
 	// Act
 	res, err := s.repo.GetPart(s.ctx, uuid)
@@ -50,7 +50,7 @@ func (s *RepositorySuite) TestGetPartEmptyUUID() {
 func (s *RepositorySuite) TestGetPartNilContext() {
 	// Arrange
 	repoPart := testutils.CreateRepoPart()
-	s.repo.data[repoPart.UUID] = repoPart
+	s.insert(repoPart)
 
 	// Act - передаем nil контекст (метод его игнорирует, но тестируем)
 	res, err := s.repo.GetPart(nil, repoPart.UUID)
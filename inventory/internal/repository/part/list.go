@@ -1,6 +1,7 @@
 package part
 
 import (
+	"cmp"
 	"context"
 	"slices"
 
@@ -8,58 +9,97 @@ import (
 	repoConverter "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/converter"
 )
 
-func (r *repository) ListParts(_ context.Context, filter model.PartsFilter) ([]model.Part, error) {
+func (r *repository) ListParts(_ context.Context, filter model.PartsFilter, page model.PartsPageRequest) (model.PartsPage, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	var result []model.Part
-	for _, part := range r.data {
-		modelPart := repoConverter.PartToModel(part)
-		if matchesFilter(modelPart, filter) {
-			result = append(result, modelPart)
-		}
+	matched := r.matchingParts(filter)
+	if len(matched) == 0 {
+		return model.PartsPage{}, model.ErrPartsNotFound
+	}
+
+	// Порядок map случаен, поэтому сортируем всегда — иначе страницы не будут стабильными
+	slices.SortFunc(matched, func(a, b model.Part) int {
+		return comparePartKeys(*model.NewPartsCursor(a), *model.NewPartsCursor(b), page.OrderBy)
+	})
+
+	start := 0
+	if page.After != nil {
+		start, _ = slices.BinarySearchFunc(matched, *page.After, func(part model.Part, after model.PartsCursor) int {
+			if comparePartKeys(*model.NewPartsCursor(part), after, page.OrderBy) <= 0 {
+				return -1
+			}
+			return 1
+		})
+	}
+
+	result := model.PartsPage{
+		Parts:     matched[start:],
+		TotalSize: int32(len(matched)),
 	}
 
-	if len(result) == 0 {
-		return nil, model.ErrPartsNotFound
+	if page.PageSize > 0 && len(result.Parts) > int(page.PageSize) {
+		result.Parts = result.Parts[:page.PageSize]
+		result.Next = model.NewPartsCursor(result.Parts[len(result.Parts)-1])
 	}
 
 	return result, nil
 }
 
-func matchesFilter(part model.Part, filter model.PartsFilter) bool {
-	if len(filter.UUIDs) > 0 && !slices.Contains(filter.UUIDs, part.UUID) {
-		return false
+// comparePartKeys сравнивает детали по ключу сортировки, а при равенстве — по UUID.
+func comparePartKeys(a, b model.PartsCursor, order model.PartsOrder) int {
+	var c int
+	switch order.Field {
+	case model.PartsOrderByName:
+		c = cmp.Compare(a.Name, b.Name)
+	case model.PartsOrderByPrice:
+		c = cmp.Compare(a.Price, b.Price)
+	case model.PartsOrderByStockQuantity:
+		c = cmp.Compare(a.StockQuantity, b.StockQuantity)
+	default:
+		c = a.CreatedAt.Compare(b.CreatedAt)
 	}
-
-	// Фильтрация по имени
-	if len(filter.Names) > 0 && !slices.Contains(filter.Names, part.Name) {
-		return false
+	if c == 0 {
+		c = cmp.Compare(a.UUID, b.UUID)
 	}
 
-	// Фильтрация по категории
-	if len(filter.Categories) > 0 && !slices.Contains(filter.Categories, part.Category) {
-		return false
+	if order.Desc {
+		return -c
 	}
+	return c
+}
 
-	// Фильтрация по странам
-	if len(filter.ManufacturerCountries) > 0 && !slices.Contains(filter.ManufacturerCountries, part.Manufacturer.Country) {
-		return false
+// matchingParts возвращает детали, подходящие под фильтр. Если фильтр задаёт индексируемые
+// поля, проверяются только кандидаты из пересечения вторичных индексов, иначе - весь каталог.
+// Вызывается под блокировкой на чтение.
+func (r *repository) matchingParts(filter model.PartsFilter) []model.Part {
+	candidates, ok := r.lookup.candidates(filter)
+	if !ok {
+		return r.scanParts(filter)
 	}
 
-	// Фильтрация по тегам (если хотя бы один тег совпадает)
-	if len(filter.Tags) > 0 && !hasCommonElement(filter.Tags, part.Tags) {
-		return false
+	matched := make([]model.Part, 0, len(candidates))
+	for uuid := range candidates {
+		part, exists := r.data[uuid]
+		if !exists {
+			continue
+		}
+		modelPart := repoConverter.PartToModel(part)
+		if filter.Match(modelPart) {
+			matched = append(matched, modelPart)
+		}
 	}
-
-	return true
+	return matched
 }
 
-func hasCommonElement(a, b []string) bool {
-	for _, v := range a {
-		if slices.Contains(b, v) {
-			return true
+// scanParts проверяет фильтр на каждой детали каталога.
+func (r *repository) scanParts(filter model.PartsFilter) []model.Part {
+	var matched []model.Part
+	for _, part := range r.data {
+		modelPart := repoConverter.PartToModel(part)
+		if filter.Match(modelPart) {
+			matched = append(matched, modelPart)
 		}
 	}
-	return false
+	return matched
 }
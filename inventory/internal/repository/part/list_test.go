@@ -1,7 +1,10 @@
 package part
 
 import (
+	"time"
+
 	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
 
 	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
 	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
@@ -14,18 +17,18 @@ func (s *RepositorySuite) TestListPartsSuccess() {
 	part2 := testutils.CreateRepoPartWithUUID("uuid-2")
 	part3 := testutils.CreateRepoPartWithUUID("uuid-3")
 
-	s.repo.data["uuid-1"] = part1
-	s.repo.data["uuid-2"] = part2
-	s.repo.data["uuid-3"] = part3
+	s.insert(part1)
+	s.insert(part2)
+	s.insert(part3)
 
 	filter := model.PartsFilter{} // Пустой фильтр - все детали
 
 	// Act
-	result, err := s.repo.ListParts(s.ctx, filter)
+	result, err := s.repo.ListParts(s.ctx, filter, model.PartsPageRequest{})
 
 	// Assert
 	s.Require().NoError(err)
-	s.Require().Len(result, 3)
+	s.Require().Len(result.Parts, 3)
 }
 
 func (s *RepositorySuite) TestListPartsFilterByUUIDs() {
@@ -34,24 +37,24 @@ func (s *RepositorySuite) TestListPartsFilterByUUIDs() {
 	part2 := testutils.CreateRepoPartWithUUID("uuid-2")
 	part3 := testutils.CreateRepoPartWithUUID("uuid-3")
 
-	s.repo.data["uuid-1"] = part1
-	s.repo.data["uuid-2"] = part2
-	s.repo.data["uuid-3"] = part3
+	s.insert(part1)
+	s.insert(part2)
+	s.insert(part3)
 
 	filter := model.PartsFilter{
 		UUIDs: []string{"uuid-1", "uuid-3"},
 	}
 
 	// Act
-	result, err := s.repo.ListParts(s.ctx, filter)
+	result, err := s.repo.ListParts(s.ctx, filter, model.PartsPageRequest{})
 
 	// Assert
 	s.Require().NoError(err)
-	s.Require().Len(result, 2)
+	s.Require().Len(result.Parts, 2)
 
 	// Собираем UUIDs из результата для проверки
-	resultUUIDs := make([]string, 0, len(result))
-	for _, part := range result {
+	resultUUIDs := make([]string, 0, len(result.Parts))
+	for _, part := range result.Parts {
 		resultUUIDs = append(resultUUIDs, part.UUID)
 	}
 
@@ -67,21 +70,21 @@ func (s *RepositorySuite) TestListPartsFilterByCategory() {
 	fuelPart := testutils.CreateRepoPartWithUUID("fuel-uuid")
 	fuelPart.Category = repoModel.CategoryFuel
 
-	s.repo.data["engine-uuid"] = enginePart
-	s.repo.data["fuel-uuid"] = fuelPart
+	s.insert(enginePart)
+	s.insert(fuelPart)
 
 	filter := model.PartsFilter{
 		Categories: []model.Category{model.CategoryEngine},
 	}
 
 	// Act
-	result, err := s.repo.ListParts(s.ctx, filter)
+	result, err := s.repo.ListParts(s.ctx, filter, model.PartsPageRequest{})
 
 	// Assert
 	s.Require().NoError(err)
-	s.Require().Len(result, 1)
-	s.Require().Equal("engine-uuid", result[0].UUID)
-	s.Require().Equal(model.CategoryEngine, result[0].Category)
+	s.Require().Len(result.Parts, 1)
+	s.Require().Equal("engine-uuid", result.Parts[0].UUID)
+	s.Require().Equal(model.CategoryEngine, result.Parts[0].Category)
 }
 
 func (s *RepositorySuite) TestListPartsFilterByCountry() {
@@ -92,21 +95,21 @@ func (s *RepositorySuite) TestListPartsFilterByCountry() {
 	germanPart := testutils.CreateRepoPartWithUUID("german-uuid")
 	germanPart.Manufacturer.Country = "Germany"
 
-	s.repo.data["us-uuid"] = usPart
-	s.repo.data["german-uuid"] = germanPart
+	s.insert(usPart)
+	s.insert(germanPart)
 
 	filter := model.PartsFilter{
 		ManufacturerCountries: []string{"USA"},
 	}
 
 	// Act
-	result, err := s.repo.ListParts(s.ctx, filter)
+	result, err := s.repo.ListParts(s.ctx, filter, model.PartsPageRequest{})
 
 	// Assert
 	s.Require().NoError(err)
-	s.Require().Len(result, 1)
-	s.Require().Equal("us-uuid", result[0].UUID)
-	s.Require().Equal("USA", result[0].Manufacturer.Country)
+	s.Require().Len(result.Parts, 1)
+	s.Require().Equal("us-uuid", result.Parts[0].UUID)
+	s.Require().Equal("USA", result.Parts[0].Manufacturer.Country)
 }
 
 func (s *RepositorySuite) TestListPartsFilterByTags() {
@@ -117,19 +120,19 @@ func (s *RepositorySuite) TestListPartsFilterByTags() {
 	fuelPart := testutils.CreateRepoPartWithUUID("fuel-uuid")
 	fuelPart.Tags = []string{"fuel", "liquid", "combustion"}
 
-	s.repo.data["engine-uuid"] = enginePart
-	s.repo.data["fuel-uuid"] = fuelPart
+	s.insert(enginePart)
+	s.insert(fuelPart)
 
 	filter := model.PartsFilter{
 		Tags: []string{"power", "combustion"}, // Ищем по любому из тегов
 	}
 
 	// Act
-	result, err := s.repo.ListParts(s.ctx, filter)
+	result, err := s.repo.ListParts(s.ctx, filter, model.PartsPageRequest{})
 
 	// Assert
 	s.Require().NoError(err)
-	s.Require().Len(result, 2) // Обе детали должны подойти
+	s.Require().Len(result.Parts, 2) // Обе детали должны подойти
 }
 
 func (s *RepositorySuite) TestListPartsNotFound() {
@@ -139,12 +142,12 @@ func (s *RepositorySuite) TestListPartsNotFound() {
 	}
 
 	// Act
-	result, err := s.repo.ListParts(s.ctx, filter)
+	result, err := s.repo.ListParts(s.ctx, filter, model.PartsPageRequest{})
 
 	// Assert
 	s.Require().Error(err)
 	s.Require().Equal(model.ErrPartsNotFound, err)
-	s.Require().Nil(result)
+	s.Require().Empty(result.Parts)
 }
 
 func (s *RepositorySuite) TestListPartsMultipleFilters() {
@@ -164,9 +167,9 @@ func (s *RepositorySuite) TestListPartsMultipleFilters() {
 	usFuelPart.Manufacturer.Country = "USA"
 	usFuelPart.Tags = []string{"fuel", "liquid"}
 
-	s.repo.data["us-engine"] = usEnginePart
-	s.repo.data["german-engine"] = germanEnginePart
-	s.repo.data["us-fuel"] = usFuelPart
+	s.insert(usEnginePart)
+	s.insert(germanEnginePart)
+	s.insert(usFuelPart)
 
 	// Фильтр: USA + Engine
 	filter := model.PartsFilter{
@@ -175,30 +178,30 @@ func (s *RepositorySuite) TestListPartsMultipleFilters() {
 	}
 
 	// Act
-	result, err := s.repo.ListParts(s.ctx, filter)
+	result, err := s.repo.ListParts(s.ctx, filter, model.PartsPageRequest{})
 
 	// Assert
 	s.Require().NoError(err)
-	s.Require().Len(result, 1)
-	s.Require().Equal("us-engine", result[0].UUID)
-	s.Require().Equal(model.CategoryEngine, result[0].Category)
-	s.Require().Equal("USA", result[0].Manufacturer.Country)
+	s.Require().Len(result.Parts, 1)
+	s.Require().Equal("us-engine", result.Parts[0].UUID)
+	s.Require().Equal(model.CategoryEngine, result.Parts[0].Category)
+	s.Require().Equal("USA", result.Parts[0].Manufacturer.Country)
 }
 
 func (s *RepositorySuite) TestListPartsConcurrentAccess() {
 	// Arrange
 	for i := 0; i < 10; i++ {
 		part := testutils.CreateRepoPartWithUUID(gofakeit.UUID())
-		s.repo.data[part.UUID] = part
+		s.insert(part)
 	}
 
 	// Act - запускаем несколько горутин
 	done := make(chan bool, 5)
 	for i := 0; i < 5; i++ {
 		go func() {
-			result, err := s.repo.ListParts(s.ctx, model.PartsFilter{})
+			result, err := s.repo.ListParts(s.ctx, model.PartsFilter{}, model.PartsPageRequest{})
 			s.Require().NoError(err)
-			s.Require().Len(result, 10)
+			s.Require().Len(result.Parts, 10)
 			done <- true
 		}()
 	}
@@ -208,3 +211,191 @@ func (s *RepositorySuite) TestListPartsConcurrentAccess() {
 		<-done
 	}
 }
+
+func (s *RepositorySuite) TestListPartsPagination() {
+	// Arrange - у двух деталей одинаковая цена, их порядок определяет UUID
+	prices := map[string]float64{"uuid-1": 300, "uuid-2": 100, "uuid-3": 200, "uuid-4": 200, "uuid-5": 50}
+	for uuid, price := range prices {
+		part := testutils.CreateRepoPartWithUUID(uuid)
+		part.Price = price
+		s.insert(part)
+	}
+
+	order := model.PartsOrder{Field: model.PartsOrderByPrice, Desc: true}
+
+	// Act - листаем по две детали, пока есть следующая страница
+	var (
+		uuids []string
+		pages int
+		after *model.PartsCursor
+	)
+	for {
+		page, err := s.repo.ListParts(s.ctx, model.PartsFilter{}, model.PartsPageRequest{
+			PageSize: 2,
+			OrderBy:  order,
+			After:    after,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(int32(5), page.TotalSize)
+
+		pages++
+		for _, part := range page.Parts {
+			uuids = append(uuids, part.UUID)
+		}
+		if page.Next == nil {
+			break
+		}
+		after = page.Next
+	}
+
+	// Assert
+	s.Require().Equal(3, pages)
+	s.Require().Equal([]string{"uuid-1", "uuid-4", "uuid-3", "uuid-2", "uuid-5"}, uuids)
+}
+
+func (s *RepositorySuite) TestListPartsOrderByName() {
+	// Arrange
+	for uuid, name := range map[string]string{"uuid-1": "Wing", "uuid-2": "Engine", "uuid-3": "Porthole"} {
+		part := testutils.CreateRepoPartWithUUID(uuid)
+		part.Name = name
+		s.insert(part)
+	}
+
+	// Act
+	result, err := s.repo.ListParts(s.ctx, model.PartsFilter{}, model.PartsPageRequest{
+		OrderBy: model.PartsOrder{Field: model.PartsOrderByName},
+	})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Nil(result.Next)
+	s.Require().Len(result.Parts, 3)
+	s.Require().Equal("Engine", result.Parts[0].Name)
+	s.Require().Equal("Porthole", result.Parts[1].Name)
+	s.Require().Equal("Wing", result.Parts[2].Name)
+}
+
+func (s *RepositorySuite) TestListPartsFilterByRanges() {
+	// Arrange
+	now := time.Now()
+
+	cheapEngine := testutils.CreateRepoPartWithUUID("cheap-engine")
+	cheapEngine.Price = 4000
+	cheapEngine.StockQuantity = 3
+	cheapEngine.Dimensions.Weight = 150
+	cheapEngine.CreatedAt = now.Add(-time.Hour)
+
+	expensiveEngine := testutils.CreateRepoPartWithUUID("expensive-engine")
+	expensiveEngine.Price = 9000
+	expensiveEngine.StockQuantity = 3
+	expensiveEngine.Dimensions.Weight = 150
+	expensiveEngine.CreatedAt = now.Add(-time.Hour)
+
+	outOfStock := testutils.CreateRepoPartWithUUID("out-of-stock")
+	outOfStock.Price = 4000
+	outOfStock.StockQuantity = 0
+	outOfStock.Dimensions.Weight = 150
+	outOfStock.CreatedAt = now.Add(-time.Hour)
+
+	heavy := testutils.CreateRepoPartWithUUID("heavy")
+	heavy.Price = 5000 // Граница диапазона включается
+	heavy.StockQuantity = 3
+	heavy.Dimensions.Weight = 250
+	heavy.CreatedAt = now.Add(-time.Hour)
+
+	oldPart := testutils.CreateRepoPartWithUUID("old")
+	oldPart.Price = 4000
+	oldPart.StockQuantity = 3
+	oldPart.Dimensions.Weight = 150
+	oldPart.CreatedAt = now.Add(-48 * time.Hour)
+
+	for _, part := range []repoModel.Part{cheapEngine, expensiveEngine, outOfStock, heavy, oldPart} {
+		s.insert(part)
+	}
+
+	filter := model.PartsFilter{
+		Price:         model.FloatRange{Max: lo.ToPtr(5000.0)},
+		StockQuantity: model.Int64Range{Min: lo.ToPtr(int64(1))},
+		Dimensions:    model.DimensionsRange{Weight: model.FloatRange{Max: lo.ToPtr(200.0)}},
+		CreatedAt:     model.TimeRange{From: lo.ToPtr(now.Add(-24 * time.Hour))},
+	}
+
+	// Act
+	result, err := s.repo.ListParts(s.ctx, filter, model.PartsPageRequest{})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(result.Parts, 1)
+	s.Require().Equal("cheap-engine", result.Parts[0].UUID)
+
+	// Граница max включается
+	filter.Dimensions = model.DimensionsRange{}
+	result, err = s.repo.ListParts(s.ctx, filter, model.PartsPageRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(result.Parts, 2)
+}
+
+func (s *RepositorySuite) TestListPartsFilterByUpdatedAtSkipsNeverUpdated() {
+	// Arrange
+	updated := testutils.CreateRepoPartWithUUID("updated")
+	updated.UpdatedAt = lo.ToPtr(time.Now())
+
+	neverUpdated := testutils.CreateRepoPartWithUUID("never-updated")
+	neverUpdated.UpdatedAt = nil
+
+	s.insert(updated)
+	s.insert(neverUpdated)
+
+	// Act
+	result, err := s.repo.ListParts(s.ctx, model.PartsFilter{
+		UpdatedAt: model.TimeRange{From: lo.ToPtr(time.Now().Add(-time.Hour))},
+	}, model.PartsPageRequest{})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(result.Parts, 1)
+	s.Require().Equal("updated", result.Parts[0].UUID)
+}
+
+func (s *RepositorySuite) TestListPartsFilterByMetadata() {
+	// Arrange
+	titanium := testutils.CreateRepoPartWithUUID("titanium")
+	titanium.Metadata = repoModel.Metadata{
+		"material":  {StringValue: lo.ToPtr("titanium")},
+		"certified": {BoolValue: lo.ToPtr(true)},
+	}
+
+	steel := testutils.CreateRepoPartWithUUID("steel")
+	steel.Metadata = repoModel.Metadata{
+		"material":  {StringValue: lo.ToPtr("steel")},
+		"certified": {BoolValue: lo.ToPtr(true)},
+	}
+
+	noMetadata := testutils.CreateRepoPartWithUUID("no-metadata")
+	noMetadata.Metadata = nil
+
+	s.insert(titanium)
+	s.insert(steel)
+	s.insert(noMetadata)
+
+	// Act - ключ certified должен быть, material должен равняться titanium
+	result, err := s.repo.ListParts(s.ctx, model.PartsFilter{
+		Metadata: []model.MetadataCondition{
+			{Key: "certified"},
+			{Key: "material", Value: &model.MetadataValue{StringValue: lo.ToPtr("titanium")}},
+		},
+	}, model.PartsPageRequest{})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(result.Parts, 1)
+	s.Require().Equal("titanium", result.Parts[0].UUID)
+
+	// Значение другого типа не совпадает, даже если "похоже"
+	_, err = s.repo.ListParts(s.ctx, model.PartsFilter{
+		Metadata: []model.MetadataCondition{
+			{Key: "certified", Value: &model.MetadataValue{StringValue: lo.ToPtr("true")}},
+		},
+	}, model.PartsPageRequest{})
+	s.Require().ErrorIs(err, model.ErrPartsNotFound)
+}
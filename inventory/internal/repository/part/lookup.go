@@ -0,0 +1,139 @@
+package part
+
+import (
+	"slices"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+)
+
+type uuidSet = map[string]struct{}
+
+// valueIndex - значение поля → UUID деталей с этим значением.
+type valueIndex map[string]uuidSet
+
+func (idx valueIndex) add(value, uuid string) {
+	set, ok := idx[value]
+	if !ok {
+		set = make(uuidSet)
+		idx[value] = set
+	}
+	set[uuid] = struct{}{}
+}
+
+func (idx valueIndex) remove(value, uuid string) {
+	set := idx[value]
+	delete(set, uuid)
+	if len(set) == 0 {
+		delete(idx, value)
+	}
+}
+
+// union возвращает UUID деталей, у которых поле равно любому из значений.
+// Для одного значения возвращается сам набор индекса - его нельзя изменять.
+func (idx valueIndex) union(values []string) uuidSet {
+	if len(values) == 1 {
+		return idx[values[0]]
+	}
+
+	result := make(uuidSet)
+	for _, value := range values {
+		for uuid := range idx[value] {
+			result[uuid] = struct{}{}
+		}
+	}
+	return result
+}
+
+// lookup - вторичные индексы по полям, которые фильтр сравнивает на равенство.
+// Изменяется только вместе с data, под блокировкой репозитория на запись.
+type lookup struct {
+	categories valueIndex
+	countries  valueIndex
+	names      valueIndex
+	tags       valueIndex
+}
+
+func newLookup() *lookup {
+	return &lookup{
+		categories: make(valueIndex),
+		countries:  make(valueIndex),
+		names:      make(valueIndex),
+		tags:       make(valueIndex),
+	}
+}
+
+func (l *lookup) add(part repoModel.Part) {
+	l.categories.add(string(part.Category), part.UUID)
+	l.countries.add(part.Manufacturer.Country, part.UUID)
+	l.names.add(part.Name, part.UUID)
+	for _, tag := range part.Tags {
+		l.tags.add(tag, part.UUID)
+	}
+}
+
+func (l *lookup) remove(part repoModel.Part) {
+	l.categories.remove(string(part.Category), part.UUID)
+	l.countries.remove(part.Manufacturer.Country, part.UUID)
+	l.names.remove(part.Name, part.UUID)
+	for _, tag := range part.Tags {
+		l.tags.remove(tag, part.UUID)
+	}
+}
+
+// candidates пересекает наборы индексов по условиям фильтра. Возвращает false, если
+// фильтр не задаёт ни одного индексируемого поля и нужен полный просмотр каталога.
+// Остальные условия фильтра кандидаты ещё должны пройти.
+func (l *lookup) candidates(filter model.PartsFilter) (uuidSet, bool) {
+	var sets []uuidSet
+
+	if len(filter.UUIDs) > 0 {
+		set := make(uuidSet, len(filter.UUIDs))
+		for _, uuid := range filter.UUIDs {
+			set[uuid] = struct{}{}
+		}
+		sets = append(sets, set)
+	}
+	if len(filter.Categories) > 0 {
+		categories := make([]string, 0, len(filter.Categories))
+		for _, category := range filter.Categories {
+			categories = append(categories, string(category))
+		}
+		sets = append(sets, l.categories.union(categories))
+	}
+	if len(filter.ManufacturerCountries) > 0 {
+		sets = append(sets, l.countries.union(filter.ManufacturerCountries))
+	}
+	if len(filter.Names) > 0 {
+		sets = append(sets, l.names.union(filter.Names))
+	}
+	if len(filter.Tags) > 0 {
+		sets = append(sets, l.tags.union(filter.Tags))
+	}
+
+	if len(sets) == 0 {
+		return nil, false
+	}
+
+	// Обходим самый маленький набор и проверяем вхождение в остальные
+	slices.SortFunc(sets, func(a, b uuidSet) int {
+		return len(a) - len(b)
+	})
+
+	result := make(uuidSet, len(sets[0]))
+	for uuid := range sets[0] {
+		if inAll(uuid, sets[1:]) {
+			result[uuid] = struct{}{}
+		}
+	}
+	return result, true
+}
+
+func inAll(uuid string, sets []uuidSet) bool {
+	for _, set := range sets {
+		if _, ok := set[uuid]; !ok {
+			return false
+		}
+	}
+	return true
+}
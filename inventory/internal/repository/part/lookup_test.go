@@ -0,0 +1,134 @@
+package part
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+var lookupCategories = []repoModel.Category{
+	repoModel.CategoryEngine,
+	repoModel.CategoryFuel,
+	repoModel.CategoryPorthole,
+	repoModel.CategoryWing,
+}
+
+// newLookupRepo заполняет репозиторий n деталями с предсказуемо распределёнными полями.
+func newLookupRepo(n int) *repository {
+	r := NewRepository()
+	rnd := rand.New(rand.NewPCG(1, 2))
+	for i := range n {
+		part := testutils.CreateRepoPartWithUUID(fmt.Sprintf("uuid-%06d", i))
+		part.Name = fmt.Sprintf("name-%d", rnd.IntN(1000))
+		part.Category = lookupCategories[rnd.IntN(len(lookupCategories))]
+		part.Manufacturer.Country = fmt.Sprintf("country-%d", rnd.IntN(20))
+		part.Tags = []string{fmt.Sprintf("tag-%d", rnd.IntN(200)), fmt.Sprintf("tag-%d", rnd.IntN(200))}
+		part.Archived = rnd.IntN(10) == 0
+		r.putPart(part)
+	}
+	return r
+}
+
+func sortedUUIDs(parts []model.Part) []string {
+	return slices.Sorted(slices.Values(lo.Map(parts, func(p model.Part, _ int) string { return p.UUID })))
+}
+
+func (s *RepositorySuite) TestLookupFollowsWrites() {
+	// Arrange
+	created, err := s.repo.CreatePart(s.ctx, testutils.CreatePart())
+	s.Require().NoError(err)
+	engines := model.PartsFilter{Categories: []model.Category{model.CategoryEngine}}
+	s.Require().Len(s.repo.matchingParts(engines), 1)
+
+	// Act - меняем категорию и теги
+	_, err = s.repo.UpdatePart(s.ctx, created.UUID, model.PartUpdateInfo{
+		Category: lo.ToPtr(model.CategoryWing),
+		Tags:     lo.ToPtr([]string{"fresh"}),
+	})
+	s.Require().NoError(err)
+
+	// Assert
+	s.Require().Empty(s.repo.matchingParts(engines))
+	s.Require().Len(s.repo.matchingParts(model.PartsFilter{Categories: []model.Category{model.CategoryWing}}), 1)
+	s.Require().Empty(s.repo.matchingParts(model.PartsFilter{Tags: created.Tags}))
+	s.Require().Len(s.repo.matchingParts(model.PartsFilter{Tags: []string{"fresh"}}), 1)
+
+	// Act - удаление убирает деталь из всех индексов
+	s.Require().NoError(s.repo.DeletePart(s.ctx, created.UUID))
+
+	// Assert
+	s.Require().Empty(s.repo.lookup.categories)
+	s.Require().Empty(s.repo.lookup.countries)
+	s.Require().Empty(s.repo.lookup.names)
+	s.Require().Empty(s.repo.lookup.tags)
+}
+
+func (s *RepositorySuite) TestLookupIgnoresExternalTagChanges() {
+	// Arrange
+	part := testutils.CreateRepoPart()
+	tags := []string{"a", "b"}
+	part.Tags = tags
+	s.insert(part)
+
+	// Act - вызывающий код меняет свой срез после записи
+	tags[0] = "changed"
+
+	// Assert
+	s.Require().Len(s.repo.matchingParts(model.PartsFilter{Tags: []string{"a"}}), 1)
+	s.Require().Empty(s.repo.matchingParts(model.PartsFilter{Tags: []string{"changed"}}))
+}
+
+func (s *RepositorySuite) TestLookupMatchesScan() {
+	// Arrange
+	s.repo = newLookupRepo(2000)
+	filters := map[string]model.PartsFilter{
+		"category":          {Categories: []model.Category{model.CategoryFuel, model.CategoryWing}},
+		"country and tag":   {ManufacturerCountries: []string{"country-3"}, Tags: []string{"tag-7", "tag-8"}},
+		"name":              {Names: []string{"name-1", "name-2", "unknown"}},
+		"uuids and archive": {UUIDs: []string{"uuid-000001", "uuid-000002", "missing"}, IncludeArchived: true},
+		"index and range":   {Categories: []model.Category{model.CategoryEngine}, Price: model.FloatRange{Max: lo.ToPtr(5000.0)}},
+		"no matches":        {Tags: []string{"tag-1"}, Names: []string{"unknown"}},
+	}
+
+	for name, filter := range filters {
+		s.Run(name, func() {
+			// Act
+			indexed := s.repo.matchingParts(filter)
+
+			// Assert
+			_, usedIndex := s.repo.lookup.candidates(filter)
+			s.Require().True(usedIndex)
+			s.Require().Equal(sortedUUIDs(s.repo.scanParts(filter)), sortedUUIDs(indexed))
+		})
+	}
+}
+
+func BenchmarkMatchingParts(b *testing.B) {
+	r := newLookupRepo(50000)
+	filters := map[string]model.PartsFilter{
+		"category":        {Categories: []model.Category{model.CategoryFuel}},
+		"country+tag":     {ManufacturerCountries: []string{"country-3"}, Tags: []string{"tag-7"}},
+		"name":            {Names: []string{"name-42"}},
+		"category+prices": {Categories: []model.Category{model.CategoryWing}, Price: model.FloatRange{Max: lo.ToPtr(500.0)}},
+	}
+
+	for name, filter := range filters {
+		b.Run(name+"/index", func(b *testing.B) {
+			for b.Loop() {
+				_ = r.matchingParts(filter)
+			}
+		})
+		b.Run(name+"/scan", func(b *testing.B) {
+			for b.Loop() {
+				_ = r.scanParts(filter)
+			}
+		})
+	}
+}
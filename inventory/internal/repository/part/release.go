@@ -0,0 +1,28 @@
+package part
+
+import (
+	"context"
+	"time"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+)
+
+// ReleaseReservation возвращает детали на склад. Повторное снятие уже снятого
+// или истёкшего резерва не считается ошибкой.
+func (r *repository) ReleaseReservation(_ context.Context, reservationUUID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	reservation, ok := r.reservations[reservationUUID]
+	if !ok {
+		return model.ErrReservationNotFound
+	}
+
+	switch reservation.Status {
+	case repoModel.ReservationStatusActive, repoModel.ReservationStatusCommitted:
+		r.closeReservation(reservationUUID, repoModel.ReservationStatusReleased, time.Now())
+	}
+
+	return nil
+}
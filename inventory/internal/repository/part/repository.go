@@ -1,21 +1,53 @@
 package part
 
 import (
+	"slices"
 	"sync"
 
 	def "github.com/baryshnikkov/rocket-factory/inventory/internal/repository"
 	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/repository/search"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/repository/watch"
 )
 
 var _ def.InventoryRepository = (*repository)(nil)
 
 type repository struct {
-	mu   sync.RWMutex
-	data map[string]repoModel.Part
+	mu           sync.RWMutex
+	data         map[string]repoModel.Part
+	reservations map[string]repoModel.Reservation
+	expiries     deadlineQueue         // Резервы по сроку действия
+	closed       []reservationDeadline // Закрытые резервы по сроку удаления
+	index        *search.Index
+	lookup       *lookup
+	events       *watch.Log
 }
 
 func NewRepository() *repository {
 	return &repository{
-		data: make(map[string]repoModel.Part),
+		data:         make(map[string]repoModel.Part),
+		reservations: make(map[string]repoModel.Reservation),
+		index:        search.NewIndex(),
+		lookup:       newLookup(),
+		events:       watch.NewLog(watch.DefaultRetention),
 	}
 }
+
+// putPart сохраняет деталь и обновляет вторичные индексы. Вызывается под блокировкой на запись.
+// Теги копируются, чтобы изменение среза снаружи не рассогласовало индекс.
+func (r *repository) putPart(part repoModel.Part) {
+	part.Tags = slices.Clone(part.Tags)
+	if old, ok := r.data[part.UUID]; ok {
+		r.lookup.remove(old)
+	}
+	r.data[part.UUID] = part
+	r.lookup.add(part)
+}
+
+// removePart удаляет деталь вместе с записями вторичных индексов. Вызывается под блокировкой на запись.
+func (r *repository) removePart(uuid string) {
+	if old, ok := r.data[uuid]; ok {
+		r.lookup.remove(old)
+	}
+	delete(r.data, uuid)
+}
@@ -0,0 +1,78 @@
+package part
+
+import (
+	"container/heap"
+	"time"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoConverter "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/converter"
+	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+)
+
+// reservationDeadline - момент, когда резерв нужно проверить: истечь активный или удалить закрытый
+type reservationDeadline struct {
+	uuid string
+	at   time.Time
+}
+
+// deadlineQueue - очередь резервов по возрастанию срока (container/heap)
+type deadlineQueue []reservationDeadline
+
+func (q deadlineQueue) Len() int           { return len(q) }
+func (q deadlineQueue) Less(i, j int) bool { return q[i].at.Before(q[j].at) }
+func (q deadlineQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+
+func (q *deadlineQueue) Push(x any) { *q = append(*q, x.(reservationDeadline)) }
+
+func (q *deadlineQueue) Pop() any {
+	old := *q
+	last := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return last
+}
+
+// putReservation сохраняет новый резерв и ставит его в очередь на истечение.
+// Вызывается под блокировкой на запись.
+func (r *repository) putReservation(reservation repoModel.Reservation) {
+	r.reservations[reservation.UUID] = reservation
+	heap.Push(&r.expiries, reservationDeadline{uuid: reservation.UUID, at: reservation.ExpiresAt})
+}
+
+// expireReservations переводит просроченные активные резервы в EXPIRED и возвращает детали на склад,
+// а закрытые резервы старше ReservationRetention удаляет. Просматриваются только резервы с наступившим
+// сроком. Вызывается под блокировкой на запись.
+func (r *repository) expireReservations(now time.Time) {
+	for r.expiries.Len() > 0 && !now.Before(r.expiries[0].at) {
+		deadline := heap.Pop(&r.expiries).(reservationDeadline)
+		// Подтверждённые и снятые резервы остаются в очереди до срока и здесь пропускаются
+		if reservation, ok := r.reservations[deadline.uuid]; ok && reservation.Status == repoModel.ReservationStatusActive {
+			r.closeReservation(deadline.uuid, repoModel.ReservationStatusExpired, now)
+		}
+	}
+
+	for len(r.closed) > 0 && !now.Before(r.closed[0].at) {
+		delete(r.reservations, r.closed[0].uuid)
+		r.closed = r.closed[1:]
+	}
+}
+
+// closeReservation возвращает детали резерва на склад и переводит его в указанный статус.
+// Закрытый резерв удаляется через ReservationRetention. Вызывается под блокировкой на запись.
+func (r *repository) closeReservation(reservationUUID string, status repoModel.ReservationStatus, now time.Time) {
+	reservation := r.reservations[reservationUUID]
+	for _, item := range reservation.Items {
+		part, ok := r.data[item.PartUUID]
+		if !ok {
+			continue
+		}
+		part.StockQuantity += item.Quantity
+		r.putPart(part)
+		r.events.Append(model.PartEventUpdated, repoConverter.PartToModel(part))
+	}
+
+	reservation.Status = status
+	reservation.UpdatedAt = &now
+	r.reservations[reservationUUID] = reservation
+	// Резервы закрываются в порядке времени, поэтому очередь на удаление уже упорядочена
+	r.closed = append(r.closed, reservationDeadline{uuid: reservationUUID, at: now.Add(repoModel.ReservationRetention)})
+}
@@ -0,0 +1,66 @@
+package part
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoConverter "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/converter"
+	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+)
+
+func (r *repository) ReserveParts(_ context.Context, items []model.ReservationItem, expiresAt time.Time) (model.Reservation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	r.expireReservations(now)
+
+	// Сначала проверяем остатки по всем позициям, чтобы не списать склад частично
+	stock := make(map[string]int64, len(items))
+	for _, item := range items {
+		part, ok := r.data[item.PartUUID]
+		if !ok {
+			return model.Reservation{}, fmt.Errorf("%w: %s", model.ErrPartNotFound, item.PartUUID)
+		}
+		if part.Archived {
+			return model.Reservation{}, fmt.Errorf("%w: %s", model.ErrPartArchived, item.PartUUID)
+		}
+
+		available, seen := stock[item.PartUUID]
+		if !seen {
+			available = part.StockQuantity
+		}
+		if available < item.Quantity {
+			return model.Reservation{}, fmt.Errorf("%w: part %s", model.ErrInsufficientStock, item.PartUUID)
+		}
+		stock[item.PartUUID] = available - item.Quantity
+	}
+
+	for _, item := range items {
+		quantity, ok := stock[item.PartUUID]
+		if !ok {
+			continue
+		}
+		delete(stock, item.PartUUID)
+
+		part := r.data[item.PartUUID]
+		part.StockQuantity = quantity
+		r.putPart(part)
+		r.events.Append(model.PartEventUpdated, repoConverter.PartToModel(part))
+	}
+
+	reservation := repoModel.Reservation{
+		UUID:      uuid.NewString(),
+		Items:     repoConverter.ReservationItemsToRepoModel(items),
+		Status:    repoModel.ReservationStatusActive,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	}
+	r.putReservation(reservation)
+
+	return repoConverter.ReservationToModel(reservation), nil
+}
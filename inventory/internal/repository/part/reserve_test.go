@@ -0,0 +1,144 @@
+package part
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func (s *RepositorySuite) TestReservePartsSuccess() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	repoPart.StockQuantity = 10
+	s.insert(repoPart)
+
+	expiresAt := time.Now().Add(time.Hour)
+
+	// Act
+	res, err := s.repo.ReserveParts(s.ctx, []model.ReservationItem{
+		{PartUUID: repoPart.UUID, Quantity: 3},
+	}, expiresAt)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().NotEmpty(res.UUID)
+	s.Require().Equal(model.ReservationStatusActive, res.Status)
+	s.Require().Equal(expiresAt, res.ExpiresAt)
+	s.Require().Equal(int64(7), s.repo.data[repoPart.UUID].StockQuantity)
+}
+
+func (s *RepositorySuite) TestReservePartsInsufficientStock() {
+	// Arrange
+	first := testutils.CreateRepoPart()
+	first.StockQuantity = 5
+	second := testutils.CreateRepoPart()
+	second.StockQuantity = 1
+	s.insert(first)
+	s.insert(second)
+
+	// Act - второй позиции не хватает, первая не должна списаться
+	res, err := s.repo.ReserveParts(s.ctx, []model.ReservationItem{
+		{PartUUID: first.UUID, Quantity: 2},
+		{PartUUID: second.UUID, Quantity: 2},
+	}, time.Now().Add(time.Hour))
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrInsufficientStock)
+	s.Require().Empty(res)
+	s.Require().Equal(int64(5), s.repo.data[first.UUID].StockQuantity)
+	s.Require().Equal(int64(1), s.repo.data[second.UUID].StockQuantity)
+	s.Require().Empty(s.repo.reservations)
+}
+
+func (s *RepositorySuite) TestReservePartsDuplicateItems() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	repoPart.StockQuantity = 3
+	s.insert(repoPart)
+
+	// Act - суммарно запрошено больше, чем есть на складе
+	_, err := s.repo.ReserveParts(s.ctx, []model.ReservationItem{
+		{PartUUID: repoPart.UUID, Quantity: 2},
+		{PartUUID: repoPart.UUID, Quantity: 2},
+	}, time.Now().Add(time.Hour))
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrInsufficientStock)
+	s.Require().Equal(int64(3), s.repo.data[repoPart.UUID].StockQuantity)
+}
+
+func (s *RepositorySuite) TestReservePartsPartNotFound() {
+	// Act
+	_, err := s.repo.ReserveParts(s.ctx, []model.ReservationItem{
+		{PartUUID: gofakeit.UUID(), Quantity: 1},
+	}, time.Now().Add(time.Hour))
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+}
+
+func (s *RepositorySuite) TestReservePartsReturnsExpiredStock() {
+	// Arrange - просроченный резерв держит весь остаток
+	repoPart := testutils.CreateRepoPart()
+	repoPart.StockQuantity = 0
+	s.insert(repoPart)
+
+	expired := repoModel.Reservation{
+		UUID:      gofakeit.UUID(),
+		Items:     []repoModel.ReservationItem{{PartUUID: repoPart.UUID, Quantity: 2}},
+		Status:    repoModel.ReservationStatusActive,
+		ExpiresAt: time.Now().Add(-time.Minute),
+	}
+	s.repo.putReservation(expired)
+
+	// Act
+	_, err := s.repo.ReserveParts(s.ctx, []model.ReservationItem{
+		{PartUUID: repoPart.UUID, Quantity: 2},
+	}, time.Now().Add(time.Hour))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(int64(0), s.repo.data[repoPart.UUID].StockQuantity)
+	s.Require().Equal(repoModel.ReservationStatusExpired, s.repo.reservations[expired.UUID].Status)
+}
+
+func (s *RepositorySuite) TestReservePartsArchived() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	repoPart.Archived = true
+	s.insert(repoPart)
+
+	// Act
+	_, err := s.repo.ReserveParts(s.ctx, []model.ReservationItem{
+		{PartUUID: repoPart.UUID, Quantity: 1},
+	}, time.Now().Add(time.Hour))
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrPartArchived)
+}
+
+func (s *RepositorySuite) TestExpireReservationsPrunesClosed() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	repoPart.StockQuantity = 5
+	s.insert(repoPart)
+
+	reservation, err := s.repo.ReserveParts(s.ctx, []model.ReservationItem{
+		{PartUUID: repoPart.UUID, Quantity: 2},
+	}, time.Now().Add(time.Hour))
+	s.Require().NoError(err)
+	s.Require().NoError(s.repo.ReleaseReservation(s.ctx, reservation.UUID))
+
+	// Act
+	s.repo.expireReservations(time.Now().Add(repoModel.ReservationRetention))
+
+	// Assert - снятый резерв удалён, и очереди не держат его
+	s.Require().NotContains(s.repo.reservations, reservation.UUID)
+	s.Require().Empty(s.repo.expiries)
+	s.Require().Empty(s.repo.closed)
+	s.Require().Equal(int64(5), s.repo.data[repoPart.UUID].StockQuantity)
+}
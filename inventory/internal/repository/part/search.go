@@ -0,0 +1,30 @@
+package part
+
+import (
+	"context"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoConverter "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/converter"
+)
+
+func (r *repository) SearchParts(_ context.Context, query string, limit int) ([]model.SearchHit, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	hits := r.index.Search(query, limit)
+
+	result := make([]model.SearchHit, 0, len(hits))
+	for _, hit := range hits {
+		part, ok := r.data[hit.UUID]
+		if !ok {
+			continue
+		}
+		result = append(result, model.SearchHit{
+			Part:       repoConverter.PartToModel(part),
+			Score:      hit.Score,
+			Highlights: hit.Highlights,
+		})
+	}
+
+	return result, nil
+}
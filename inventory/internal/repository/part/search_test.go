@@ -0,0 +1,65 @@
+package part
+
+import (
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func (s *RepositorySuite) TestSearchPartsKeepsIndexInSync() {
+	// Arrange
+	thruster := testutils.CreatePart()
+	thruster.Name = "Ion Thruster"
+	created, err := s.repo.CreatePart(s.ctx, thruster)
+	s.Require().NoError(err)
+
+	tank := testutils.CreatePart()
+	tank.Name = "Fuel Tank"
+	_, err = s.repo.CreatePart(s.ctx, tank)
+	s.Require().NoError(err)
+
+	// Act
+	hits, err := s.repo.SearchParts(s.ctx, "thrustr", 10)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(hits, 1)
+	s.Require().Equal(created.UUID, hits[0].Part.UUID)
+	s.Require().Positive(hits[0].Score)
+	s.Require().Contains(hits[0].Highlights, model.SearchHighlight{Field: "name", Snippet: "Ion <em>Thruster</em>"})
+
+	// Переименование, архивация и удаление сразу видны в поиске
+	_, err = s.repo.UpdatePart(s.ctx, created.UUID, model.PartUpdateInfo{Name: lo.ToPtr("Plasma Engine")})
+	s.Require().NoError(err)
+	hits, err = s.repo.SearchParts(s.ctx, "thruster", 10)
+	s.Require().NoError(err)
+	s.Require().Empty(hits)
+
+	hits, err = s.repo.SearchParts(s.ctx, "plasma", 10)
+	s.Require().NoError(err)
+	s.Require().Len(hits, 1)
+
+	_, err = s.repo.ArchivePart(s.ctx, created.UUID)
+	s.Require().NoError(err)
+	hits, err = s.repo.SearchParts(s.ctx, "plasma", 10)
+	s.Require().NoError(err)
+	s.Require().Empty(hits)
+}
+
+func (s *RepositorySuite) TestSearchPartsAfterDelete() {
+	// Arrange
+	part := testutils.CreatePart()
+	part.Name = "Fuel Tank"
+	created, err := s.repo.CreatePart(s.ctx, part)
+	s.Require().NoError(err)
+
+	// Act
+	err = s.repo.DeletePart(s.ctx, created.UUID)
+
+	// Assert
+	s.Require().NoError(err)
+	hits, err := s.repo.SearchParts(s.ctx, "fuel", 10)
+	s.Require().NoError(err)
+	s.Require().Empty(hits)
+}
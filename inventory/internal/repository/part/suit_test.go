@@ -8,6 +8,8 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/repository/search"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/repository/watch"
 )
 
 type RepositorySuite struct {
@@ -20,8 +22,12 @@ type RepositorySuite struct {
 func (s *RepositorySuite) SetupTest() {
 	s.ctx = context.Background()
 	s.repo = &repository{
-		data: make(map[string]repoModel.Part), // ← реальные данные
-		mu:   sync.RWMutex{},
+		data:         make(map[string]repoModel.Part), // ← реальные данные
+		reservations: make(map[string]repoModel.Reservation),
+		index:        search.NewIndex(),
+		lookup:       newLookup(),
+		events:       watch.NewLog(watch.DefaultRetention),
+		mu:           sync.RWMutex{},
 	}
 }
 
@@ -30,3 +36,10 @@ func (s *RepositorySuite) TearDownSuite() {}
 func TestRepositoryInventoryIntegration(t *testing.T) {
 	suite.Run(t, new(RepositorySuite))
 }
+
+// insert кладёт детали в хранилище в обход поискового индекса и ленты событий.
+func (s *RepositorySuite) insert(parts ...repoModel.Part) {
+	for _, part := range parts {
+		s.repo.putPart(part)
+	}
+}
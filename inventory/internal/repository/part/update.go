@@ -0,0 +1,64 @@
+package part
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoConverter "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/converter"
+	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+)
+
+func (r *repository) UpdatePart(_ context.Context, uuid string, info model.PartUpdateInfo) (model.Part, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	part, ok := r.data[uuid]
+	if !ok {
+		return model.Part{}, model.ErrPartNotFound
+	}
+
+	applyPartUpdate(&part, info)
+
+	now := time.Now()
+	part.UpdatedAt = &now
+
+	r.putPart(part)
+
+	updated := repoConverter.PartToModel(part)
+	r.index.Put(updated)
+	r.events.Append(model.PartEventUpdated, updated)
+
+	return updated, nil
+}
+
+func applyPartUpdate(part *repoModel.Part, info model.PartUpdateInfo) {
+	if info.Name != nil {
+		part.Name = *info.Name
+	}
+	if info.Description != nil {
+		part.Description = *info.Description
+	}
+	if info.Price != nil {
+		part.Price = *info.Price
+	}
+	if info.StockQuantity != nil {
+		part.StockQuantity = *info.StockQuantity
+	}
+	if info.Category != nil {
+		part.Category = repoModel.Category(*info.Category)
+	}
+	if info.Dimensions != nil {
+		part.Dimensions = repoConverter.DimensionsToRepoModel(*info.Dimensions)
+	}
+	if info.Manufacturer != nil {
+		part.Manufacturer = repoConverter.ManufacturerToRepoModel(*info.Manufacturer)
+	}
+	if info.Tags != nil {
+		part.Tags = slices.Clone(*info.Tags)
+	}
+	if info.Metadata != nil {
+		part.Metadata = repoConverter.MetadataToRepoModel(*info.Metadata)
+	}
+}
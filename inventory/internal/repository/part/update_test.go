@@ -0,0 +1,42 @@
+package part
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func (s *RepositorySuite) TestUpdatePartSuccess() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	s.insert(repoPart)
+
+	// Act - меняем только цену и теги
+	res, err := s.repo.UpdatePart(s.ctx, repoPart.UUID, model.PartUpdateInfo{
+		Price: lo.ToPtr(42.5),
+		Tags:  lo.ToPtr([]string{"new"}),
+	})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(42.5, res.Price)
+	s.Require().Equal([]string{"new"}, res.Tags)
+	s.Require().Equal(repoPart.Name, res.Name)
+	s.Require().Equal(repoPart.StockQuantity, res.StockQuantity)
+	s.Require().NotNil(res.UpdatedAt)
+	s.Require().True(res.UpdatedAt.After(*repoPart.UpdatedAt))
+	s.Require().Equal(42.5, s.repo.data[repoPart.UUID].Price)
+}
+
+func (s *RepositorySuite) TestUpdatePartNotFound() {
+	// Act
+	res, err := s.repo.UpdatePart(s.ctx, gofakeit.UUID(), model.PartUpdateInfo{
+		Price: lo.ToPtr(1.0),
+	})
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+	s.Require().Equal(model.Part{}, res)
+}
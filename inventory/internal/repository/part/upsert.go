@@ -0,0 +1,72 @@
+package part
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	repoConverter "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/converter"
+	repoModel "github.com/baryshnikkov/rocket-factory/inventory/internal/repository/model"
+)
+
+func (r *repository) UpsertPart(_ context.Context, part model.Part) (model.Part, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+
+	repoPart := repoConverter.PartToRepoModel(part)
+	repoPart.Tags = slices.Clone(part.Tags)
+	if repoPart.UUID == "" {
+		repoPart.UUID = uuid.NewString()
+	}
+	eventType := model.PartEventCreated
+	if existing, ok := r.data[repoPart.UUID]; ok {
+		repoPart.CreatedAt = existing.CreatedAt
+		eventType = model.PartEventUpdated
+	} else if repoPart.CreatedAt.IsZero() {
+		repoPart.CreatedAt = now
+	}
+	repoPart.UpdatedAt = &now
+
+	reserved := r.reservedQuantities()[repoPart.UUID]
+	if repoPart.StockQuantity < reserved {
+		return model.Part{}, fmt.Errorf("%w: %d < %d", model.ErrStockBelowReserved, repoPart.StockQuantity, reserved)
+	}
+	repoPart.StockQuantity -= reserved
+
+	r.putPart(repoPart)
+
+	saved := repoConverter.PartToModel(repoPart)
+	r.index.Put(saved)
+	r.events.Append(eventType, saved)
+
+	return saved, nil
+}
+
+func (r *repository) ReservedQuantities(_ context.Context) (map[string]int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.reservedQuantities(), nil
+}
+
+// reservedQuantities считает детали в активных резервах, включая истёкшие, но ещё не закрытые:
+// при закрытии они вернутся на склад. Вызывается под блокировкой.
+func (r *repository) reservedQuantities() map[string]int64 {
+	reserved := make(map[string]int64)
+	for _, reservation := range r.reservations {
+		if reservation.Status != repoModel.ReservationStatusActive {
+			continue
+		}
+		for _, item := range reservation.Items {
+			reserved[item.PartUUID] += item.Quantity
+		}
+	}
+
+	return reserved
+}
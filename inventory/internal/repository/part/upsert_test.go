@@ -0,0 +1,116 @@
+package part
+
+import (
+	"time"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func (s *RepositorySuite) TestUpsertPartCreatesWithGivenUUID() {
+	// Arrange - архивный признак из файла сохраняется как есть
+	part := testutils.CreatePart()
+	part.Archived = true
+
+	// Act
+	res, err := s.repo.UpsertPart(s.ctx, part)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(part.UUID, res.UUID)
+	s.Require().True(res.Archived)
+	s.Require().Equal(part.CreatedAt, res.CreatedAt)
+
+	saved, ok := s.repo.data[part.UUID]
+	s.Require().True(ok)
+	s.Require().Equal(part.Name, saved.Name)
+	s.Require().Equal(part.Tags, saved.Tags)
+}
+
+func (s *RepositorySuite) TestUpsertPartGeneratesUUID() {
+	// Arrange
+	part := testutils.CreatePart()
+	part.UUID = ""
+
+	// Act
+	res, err := s.repo.UpsertPart(s.ctx, part)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().NotEmpty(res.UUID)
+	s.Require().Contains(s.repo.data, res.UUID)
+}
+
+func (s *RepositorySuite) TestUpsertPartReplacesExisting() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	s.insert(repoPart)
+
+	part := testutils.CreatePart()
+	part.UUID = repoPart.UUID
+	part.Name = "Replaced"
+
+	// Act
+	res, err := s.repo.UpsertPart(s.ctx, part)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal("Replaced", res.Name)
+	s.Require().Equal(repoPart.CreatedAt, res.CreatedAt)
+	s.Require().Len(s.repo.data, 1)
+
+	hits := s.repo.index.Search("replaced", 10)
+	s.Require().Len(hits, 1)
+	s.Require().Equal(repoPart.UUID, hits[0].UUID)
+}
+
+func (s *RepositorySuite) TestUpsertPartKeepsReservedStock() {
+	// Arrange - 3 из 10 единиц в резерве, файл задаёт весь остаток
+	repoPart := testutils.CreateRepoPart()
+	repoPart.StockQuantity = 10
+	s.insert(repoPart)
+
+	_, err := s.repo.ReserveParts(s.ctx, []model.ReservationItem{
+		{PartUUID: repoPart.UUID, Quantity: 3},
+	}, time.Now().Add(time.Hour))
+	s.Require().NoError(err)
+
+	part := testutils.CreatePart()
+	part.UUID = repoPart.UUID
+	part.StockQuantity = 12
+
+	// Act
+	res, err := s.repo.UpsertPart(s.ctx, part)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(int64(9), res.StockQuantity)
+	s.Require().Equal(int64(9), s.repo.data[repoPart.UUID].StockQuantity)
+
+	reserved, err := s.repo.ReservedQuantities(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]int64{repoPart.UUID: 3}, reserved)
+}
+
+func (s *RepositorySuite) TestUpsertPartStockBelowReserved() {
+	// Arrange
+	repoPart := testutils.CreateRepoPart()
+	repoPart.StockQuantity = 10
+	s.insert(repoPart)
+
+	_, err := s.repo.ReserveParts(s.ctx, []model.ReservationItem{
+		{PartUUID: repoPart.UUID, Quantity: 3},
+	}, time.Now().Add(time.Hour))
+	s.Require().NoError(err)
+
+	part := testutils.CreatePart()
+	part.UUID = repoPart.UUID
+	part.StockQuantity = 2
+
+	// Act
+	_, err = s.repo.UpsertPart(s.ctx, part)
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrStockBelowReserved)
+	s.Require().Equal(int64(7), s.repo.data[repoPart.UUID].StockQuantity)
+}
@@ -2,12 +2,37 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
 )
 
 type InventoryRepository interface {
 	GetPart(ctx context.Context, UUID string) (model.Part, error)
-	ListParts(ctx context.Context, filter model.PartsFilter) ([]model.Part, error)
-	InitParts()
+	ListParts(ctx context.Context, filter model.PartsFilter, page model.PartsPageRequest) (model.PartsPage, error)
+	SearchParts(ctx context.Context, query string, limit int) ([]model.SearchHit, error)
+	CountParts(ctx context.Context, filter model.PartsFilter, field model.FacetField) ([]model.FacetCount, error)
+	InitParts(ctx context.Context) error
+	CreatePart(ctx context.Context, part model.Part) (model.Part, error)
+	UpdatePart(ctx context.Context, uuid string, info model.PartUpdateInfo) (model.Part, error)
+	ArchivePart(ctx context.Context, uuid string) (model.Part, error)
+	DeletePart(ctx context.Context, uuid string) error
+	// UpsertPart сохраняет деталь с её UUID: создаёт новую или полностью заменяет существующую.
+	// Дата создания существующей детали сохраняется. StockQuantity - весь остаток на складе:
+	// в каталог записывается остаток за вычетом активных резервов, а если резервов больше -
+	// ErrStockBelowReserved.
+	UpsertPart(ctx context.Context, part model.Part) (model.Part, error)
+	// ReservedQuantities возвращает, сколько единиц каждой детали держат активные резервы.
+	ReservedQuantities(ctx context.Context) (map[string]int64, error)
+
+	// PartEvents возвращает до limit событий каталога с ревизией больше afterRevision.
+	PartEvents(ctx context.Context, afterRevision int64, limit int) ([]model.PartEvent, error)
+	// PartsRevision возвращает ревизию последнего изменения каталога.
+	PartsRevision(ctx context.Context) (int64, error)
+	// WaitPartEvents возвращает канал, который закроется при следующем изменении каталога.
+	WaitPartEvents() <-chan struct{}
+
+	ReserveParts(ctx context.Context, items []model.ReservationItem, expiresAt time.Time) (model.Reservation, error)
+	ReleaseReservation(ctx context.Context, reservationUUID string) error
+	CommitReservation(ctx context.Context, reservationUUID string) error
 }
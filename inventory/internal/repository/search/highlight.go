@@ -0,0 +1,93 @@
+package search
+
+import (
+	"strings"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+const (
+	highlightOpen  = "<em>"
+	highlightClose = "</em>"
+	ellipsis       = "…"
+
+	// descriptionWindow - сколько слов описания показывать вокруг первого совпадения
+	descriptionWindow = 12
+)
+
+func (doc document) highlights(matched *[fieldCount]map[string]struct{}) []model.SearchHighlight {
+	if matched == nil {
+		return nil
+	}
+
+	var result []model.SearchHighlight
+	for f, texts := range doc.texts {
+		if len(matched[f]) == 0 {
+			continue
+		}
+
+		window := 0
+		if field(f) == fieldDescription {
+			window = descriptionWindow
+		}
+
+		for _, text := range texts {
+			if snippet, ok := mark(text, matched[f], window); ok {
+				result = append(result, model.SearchHighlight{Field: fieldNames[f], Snippet: snippet})
+			}
+		}
+	}
+
+	return result
+}
+
+// mark оборачивает совпавшие слова текста в теги подсветки. Если window > 0,
+// возвращается только окно из window слов вокруг первого совпадения.
+func mark(text string, matched map[string]struct{}, window int) (string, bool) {
+	tokens := tokenize(text)
+
+	first := -1
+	for i, t := range tokens {
+		if _, ok := matched[t.term]; ok {
+			first = i
+			break
+		}
+	}
+	if first < 0 {
+		return "", false
+	}
+
+	from, to := 0, len(tokens)
+	if window > 0 && len(tokens) > window {
+		from = max(0, first-window/2)
+		to = min(len(tokens), from+window)
+		from = max(0, to-window)
+	}
+
+	var b strings.Builder
+	if from > 0 {
+		b.WriteString(ellipsis)
+	}
+
+	pos := tokens[from].start
+	if from == 0 {
+		pos = 0
+	}
+	for _, t := range tokens[from:to] {
+		b.WriteString(text[pos:t.start])
+		if _, ok := matched[t.term]; ok {
+			b.WriteString(highlightOpen + text[t.start:t.end] + highlightClose)
+		} else {
+			b.WriteString(text[t.start:t.end])
+		}
+		pos = t.end
+	}
+
+	if to < len(tokens) {
+		b.WriteString(ellipsis)
+	} else {
+		b.WriteString(text[pos:])
+	}
+
+	return b.String(), true
+}
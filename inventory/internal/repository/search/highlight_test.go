@@ -0,0 +1,32 @@
+package search
+
+func (s *IndexSuite) TestMarkWholeText() {
+	snippet, ok := mark("Ion Thruster (ion)", map[string]struct{}{"ion": {}}, 0)
+
+	s.Require().True(ok)
+	s.Require().Equal("<em>Ion</em> Thruster (<em>ion</em>)", snippet)
+}
+
+func (s *IndexSuite) TestMarkWindow() {
+	text := "one two three four five six seven eight nine ten"
+
+	snippet, ok := mark(text, map[string]struct{}{"six": {}}, 4)
+
+	s.Require().True(ok)
+	s.Require().Equal("…four five <em>six</em> seven…", snippet)
+}
+
+func (s *IndexSuite) TestMarkWindowAtEnd() {
+	text := "one two three four five"
+
+	snippet, ok := mark(text, map[string]struct{}{"five": {}}, 2)
+
+	s.Require().True(ok)
+	s.Require().Equal("…four <em>five</em>", snippet)
+}
+
+func (s *IndexSuite) TestMarkNoMatch() {
+	_, ok := mark("Fuel Tank", map[string]struct{}{"engine": {}}, 0)
+
+	s.Require().False(ok)
+}
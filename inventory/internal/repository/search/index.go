@@ -0,0 +1,261 @@
+package search
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+type field int
+
+const (
+	fieldName field = iota
+	fieldDescription
+	fieldTags
+	fieldManufacturer
+	fieldCount
+)
+
+var (
+	fieldNames   = [fieldCount]string{"name", "description", "tags", "manufacturer_name"}
+	fieldWeights = [fieldCount]float64{3, 1, 2, 1.5}
+)
+
+// Веса совпадений: точное слово ценнее префикса, префикс ценнее опечатки.
+const (
+	exactWeight  = 1.0
+	prefixWeight = 0.8
+	typo1Weight  = 0.7
+	typo2Weight  = 0.5
+
+	minPrefixLen = 3
+)
+
+type document struct {
+	name  string
+	texts [fieldCount][]string
+	terms map[string][fieldCount]int // Частота слова в каждом поле
+}
+
+// Hit - результат поиска по индексу.
+type Hit struct {
+	UUID       string
+	Score      float64
+	Highlights []model.SearchHighlight
+}
+
+// Index - инвертированный индекс по названию, описанию, тегам и производителю деталей.
+// Архивные детали в индекс не попадают.
+type Index struct {
+	mu       sync.RWMutex
+	docs     map[string]document
+	postings map[string]map[string]struct{} // Слово -> UUID деталей
+}
+
+func NewIndex() *Index {
+	return &Index{
+		docs:     make(map[string]document),
+		postings: make(map[string]map[string]struct{}),
+	}
+}
+
+// Reset заново строит индекс по переданным деталям.
+func (idx *Index) Reset(parts []model.Part) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.docs = make(map[string]document, len(parts))
+	idx.postings = make(map[string]map[string]struct{})
+	for _, part := range parts {
+		idx.put(part)
+	}
+}
+
+// Put добавляет деталь в индекс или обновляет её.
+func (idx *Index) Put(part model.Part) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.remove(part.UUID)
+	idx.put(part)
+}
+
+func (idx *Index) Delete(uuid string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.remove(uuid)
+}
+
+func (idx *Index) put(part model.Part) {
+	if part.Archived {
+		return
+	}
+
+	doc := document{
+		name:  part.Name,
+		terms: make(map[string][fieldCount]int),
+	}
+	doc.texts[fieldName] = []string{part.Name}
+	doc.texts[fieldDescription] = []string{part.Description}
+	doc.texts[fieldTags] = slices.Clone(part.Tags)
+	doc.texts[fieldManufacturer] = []string{part.Manufacturer.Name}
+
+	for f, texts := range doc.texts {
+		for _, text := range texts {
+			for _, t := range tokenize(text) {
+				freq := doc.terms[t.term]
+				freq[f]++
+				doc.terms[t.term] = freq
+			}
+		}
+	}
+
+	for term := range doc.terms {
+		if idx.postings[term] == nil {
+			idx.postings[term] = make(map[string]struct{})
+		}
+		idx.postings[term][part.UUID] = struct{}{}
+	}
+	idx.docs[part.UUID] = doc
+}
+
+func (idx *Index) remove(uuid string) {
+	doc, ok := idx.docs[uuid]
+	if !ok {
+		return
+	}
+
+	for term := range doc.terms {
+		delete(idx.postings[term], uuid)
+		if len(idx.postings[term]) == 0 {
+			delete(idx.postings, term)
+		}
+	}
+	delete(idx.docs, uuid)
+}
+
+// Search ищет детали по словам запроса. Каждое слово запроса совпадает со словами индекса
+// точно, по префиксу или с опечатками; чем больше слов запроса нашлось, тем выше оценка.
+func (idx *Index) Search(query string, limit int) []Hit {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	queryTerms := terms(query)
+	if len(queryTerms) == 0 {
+		return nil
+	}
+
+	var (
+		scores  = make(map[string]float64)
+		matched = make(map[string]int)
+		// Совпавшие слова индекса по полям — для подсветки
+		highlight = make(map[string]*[fieldCount]map[string]struct{})
+		total     = float64(len(idx.docs))
+	)
+
+	for _, q := range queryTerms {
+		best := make(map[string]*[fieldCount]float64)
+
+		for term, weight := range idx.expand(q) {
+			postings := idx.postings[term]
+			idf := math.Log(1 + total/float64(len(postings)))
+
+			for uuid := range postings {
+				freq := idx.docs[uuid].terms[term]
+				for f := range fieldCount {
+					if freq[f] == 0 {
+						continue
+					}
+
+					if best[uuid] == nil {
+						best[uuid] = &[fieldCount]float64{}
+					}
+					score := weight * fieldWeights[f] * idf * (1 + math.Log(float64(freq[f])))
+					best[uuid][f] = max(best[uuid][f], score)
+
+					if highlight[uuid] == nil {
+						highlight[uuid] = &[fieldCount]map[string]struct{}{}
+					}
+					if highlight[uuid][f] == nil {
+						highlight[uuid][f] = make(map[string]struct{})
+					}
+					highlight[uuid][f][term] = struct{}{}
+				}
+			}
+		}
+
+		for uuid, fields := range best {
+			for _, score := range fields {
+				scores[uuid] += score
+			}
+			matched[uuid]++
+		}
+	}
+
+	hits := make([]Hit, 0, len(scores))
+	for uuid, score := range scores {
+		hits = append(hits, Hit{
+			UUID:  uuid,
+			Score: score * float64(matched[uuid]) / float64(len(queryTerms)),
+		})
+	}
+
+	slices.SortFunc(hits, func(a, b Hit) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(idx.docs[a.UUID].name, idx.docs[b.UUID].name); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.UUID, b.UUID)
+	})
+
+	if limit > 0 && len(hits) > limit {
+		hits = hits[:limit]
+	}
+
+	for i := range hits {
+		hits[i].Highlights = idx.docs[hits[i].UUID].highlights(highlight[hits[i].UUID])
+	}
+
+	return hits
+}
+
+// expand подбирает слова индекса, подходящие под слово запроса, с весом лучшего совпадения.
+func (idx *Index) expand(q string) map[string]float64 {
+	var (
+		result = make(map[string]float64)
+		edits  = maxEdits(q)
+	)
+
+	for term := range idx.postings {
+		var weight float64
+		switch {
+		case term == q:
+			weight = exactWeight
+		case len(q) >= minPrefixLen && strings.HasPrefix(term, q):
+			weight = prefixWeight
+		}
+
+		if weight < typo1Weight && edits > 0 {
+			switch d := editDistance(q, term, edits); {
+			case d > edits:
+			case d == 1:
+				weight = typo1Weight
+			case d == 2:
+				weight = typo2Weight
+			}
+		}
+
+		if weight > 0 {
+			result[term] = weight
+		}
+	}
+
+	return result
+}
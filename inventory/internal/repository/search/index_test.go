@@ -0,0 +1,132 @@
+package search
+
+import (
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+func part(uuid, name, description string, tags ...string) model.Part {
+	return model.Part{
+		UUID:         uuid,
+		Name:         name,
+		Description:  description,
+		Tags:         tags,
+		Manufacturer: model.Manufacturer{Name: "Orbital Works"},
+	}
+}
+
+func hitUUIDs(hits []Hit) []string {
+	uuids := make([]string, 0, len(hits))
+	for _, hit := range hits {
+		uuids = append(uuids, hit.UUID)
+	}
+	return uuids
+}
+
+func (s *IndexSuite) TestSearchRanksNameAboveDescription() {
+	// Arrange
+	s.index.Reset([]model.Part{
+		part("in-description", "Main Engine", "Works with any thruster assembly"),
+		part("in-name", "Ion Thruster", "Electric propulsion unit"),
+		part("no-match", "Fuel Tank", "Liquid oxygen tank"),
+	})
+
+	// Act
+	hits := s.index.Search("Thruster", 0)
+
+	// Assert
+	s.Require().Equal([]string{"in-name", "in-description"}, hitUUIDs(hits))
+	s.Require().Greater(hits[0].Score, hits[1].Score)
+}
+
+func (s *IndexSuite) TestSearchMoreMatchedWordsRankHigher() {
+	// Arrange
+	s.index.Reset([]model.Part{
+		part("fuel-only", "Fuel Pump", "Pumps propellant"),
+		part("fuel-tank", "Fuel Tank", "Stores propellant"),
+	})
+
+	// Act
+	hits := s.index.Search("fuel tank", 0)
+
+	// Assert
+	s.Require().Equal([]string{"fuel-tank", "fuel-only"}, hitUUIDs(hits))
+}
+
+func (s *IndexSuite) TestSearchToleratesTyposAndPrefixes() {
+	// Arrange
+	s.index.Reset([]model.Part{
+		part("thruster", "Ion Thruster", ""),
+	})
+
+	// Act & Assert
+	s.Require().Equal([]string{"thruster"}, hitUUIDs(s.index.Search("THRUSTR", 0)), "пропущенная буква")
+	s.Require().Equal([]string{"thruster"}, hitUUIDs(s.index.Search("thrsuter", 0)), "две опечатки в длинном слове")
+	s.Require().Equal([]string{"thruster"}, hitUUIDs(s.index.Search("thru", 0)), "префикс")
+	s.Require().Empty(s.index.Search("ino", 0), "короткие слова должны совпадать точно")
+}
+
+func (s *IndexSuite) TestSearchTagsAndManufacturer() {
+	// Arrange
+	s.index.Reset([]model.Part{
+		part("tagged", "Wing", "", "aerodynamic", "carbon"),
+	})
+
+	// Act
+	byTag := s.index.Search("carbon", 0)
+	byManufacturer := s.index.Search("orbital", 0)
+
+	// Assert
+	s.Require().Equal([]string{"tagged"}, hitUUIDs(byTag))
+	s.Require().Equal([]model.SearchHighlight{{Field: "tags", Snippet: "<em>carbon</em>"}}, byTag[0].Highlights)
+	s.Require().Equal([]model.SearchHighlight{{Field: "manufacturer_name", Snippet: "<em>Orbital</em> Works"}}, byManufacturer[0].Highlights)
+}
+
+func (s *IndexSuite) TestSearchLimit() {
+	// Arrange
+	s.index.Reset([]model.Part{
+		part("uuid-1", "Engine A", ""),
+		part("uuid-2", "Engine B", ""),
+		part("uuid-3", "Engine C", ""),
+	})
+
+	// Act
+	hits := s.index.Search("engine", 2)
+
+	// Assert - при равной оценке порядок задаёт название
+	s.Require().Equal([]string{"uuid-1", "uuid-2"}, hitUUIDs(hits))
+}
+
+func (s *IndexSuite) TestPutReplacesAndDeleteRemoves() {
+	// Arrange
+	s.index.Put(part("uuid-1", "Fuel Tank", ""))
+
+	// Act - переименовываем деталь
+	s.index.Put(part("uuid-1", "Oxidizer Tank", ""))
+
+	// Assert
+	s.Require().Empty(s.index.Search("fuel", 0))
+	s.Require().Len(s.index.Search("oxidizer", 0), 1)
+
+	s.index.Delete("uuid-1")
+	s.Require().Empty(s.index.Search("tank", 0))
+	s.Require().Empty(s.index.postings)
+}
+
+func (s *IndexSuite) TestPutSkipsArchived() {
+	// Arrange
+	archived := part("uuid-1", "Fuel Tank", "")
+	s.index.Put(archived)
+
+	// Act
+	archived.Archived = true
+	s.index.Put(archived)
+
+	// Assert
+	s.Require().Empty(s.index.Search("fuel", 0))
+}
+
+func (s *IndexSuite) TestSearchEmptyQuery() {
+	s.index.Put(part("uuid-1", "Fuel Tank", ""))
+
+	s.Require().Empty(s.index.Search("  ,. ", 0))
+}
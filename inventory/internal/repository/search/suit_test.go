@@ -0,0 +1,21 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type IndexSuite struct {
+	suite.Suite
+
+	index *Index
+}
+
+func (s *IndexSuite) SetupTest() {
+	s.index = NewIndex()
+}
+
+func TestSearchIndex(t *testing.T) {
+	suite.Run(t, new(IndexSuite))
+}
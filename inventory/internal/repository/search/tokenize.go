@@ -0,0 +1,97 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// token - слово исходного текста и его границы в байтах.
+type token struct {
+	term       string
+	start, end int
+}
+
+// tokenize делит текст на слова из букв и цифр и приводит их к нижнему регистру.
+func tokenize(text string) []token {
+	var (
+		tokens []token
+		start  = -1
+	)
+
+	for i, r := range text {
+		isWordRune := unicode.IsLetter(r) || unicode.IsDigit(r)
+		switch {
+		case isWordRune && start < 0:
+			start = i
+		case !isWordRune && start >= 0:
+			tokens = append(tokens, token{term: strings.ToLower(text[start:i]), start: start, end: i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, token{term: strings.ToLower(text[start:]), start: start, end: len(text)})
+	}
+
+	return tokens
+}
+
+// terms возвращает уникальные слова запроса в порядке появления.
+func terms(text string) []string {
+	var (
+		result []string
+		seen   = make(map[string]struct{})
+	)
+	for _, t := range tokenize(text) {
+		if _, ok := seen[t.term]; ok {
+			continue
+		}
+		seen[t.term] = struct{}{}
+		result = append(result, t.term)
+	}
+	return result
+}
+
+// maxEdits - допустимое число опечаток: короткие слова должны совпадать точно.
+func maxEdits(term string) int {
+	switch n := len([]rune(term)); {
+	case n >= 8:
+		return 2
+	case n >= 4:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// editDistance - расстояние Левенштейна, но не больше limit+1: дальше считать незачем.
+func editDistance(a, b string, limit int) int {
+	ra, rb := []rune(a), []rune(b)
+	if d := len(ra) - len(rb); d > limit || -d > limit {
+		return limit + 1
+	}
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			rowMin = min(rowMin, curr[j])
+		}
+		if rowMin > limit {
+			return limit + 1
+		}
+		prev, curr = curr, prev
+	}
+
+	return min(prev[len(rb)], limit+1)
+}
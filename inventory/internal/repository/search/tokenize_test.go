@@ -0,0 +1,22 @@
+package search
+
+func (s *IndexSuite) TestTokenize() {
+	tokens := tokenize("Ion-Thruster, 2000N")
+
+	s.Require().Equal([]token{
+		{term: "ion", start: 0, end: 3},
+		{term: "thruster", start: 4, end: 12},
+		{term: "2000n", start: 14, end: 19},
+	}, tokens)
+}
+
+func (s *IndexSuite) TestTokenizeUnicode() {
+	s.Require().Equal([]string{"топливный", "бак"}, terms("Топливный БАК, бак"))
+}
+
+func (s *IndexSuite) TestEditDistance() {
+	s.Require().Equal(0, editDistance("engine", "engine", 2))
+	s.Require().Equal(1, editDistance("engin", "engine", 2))
+	s.Require().Equal(2, editDistance("thrsuter", "thruster", 2))
+	s.Require().Equal(3, editDistance("wing", "porthole", 2), "результат ограничен limit+1")
+}
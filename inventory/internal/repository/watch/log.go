@@ -0,0 +1,80 @@
+package watch
+
+import (
+	"sync"
+	"time"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+// DefaultRetention - сколько последних событий хранится для возобновления подписки.
+const DefaultRetention = 10000
+
+// Log - ограниченная история событий в памяти. Старые события вытесняются,
+// и подписчик, отставший больше чем на retention ревизий, получает ErrRevisionCompacted.
+type Log struct {
+	*Notifier
+
+	mu        sync.RWMutex
+	retention int
+	events    []model.PartEvent
+	revision  int64
+}
+
+func NewLog(retention int) *Log {
+	return &Log{
+		Notifier:  NewNotifier(),
+		retention: retention,
+	}
+}
+
+// Append присваивает событию следующую ревизию и будит ожидающих.
+func (l *Log) Append(eventType model.PartEventType, part model.Part) model.PartEvent {
+	l.mu.Lock()
+	l.revision++
+	event := model.PartEvent{
+		Revision:   l.revision,
+		Type:       eventType,
+		Part:       part,
+		OccurredAt: time.Now(),
+	}
+	l.events = append(l.events, event)
+	// Вытесняем пачкой, когда история выросла вдвое, чтобы не копировать её на каждой записи
+	if len(l.events) >= 2*l.retention {
+		l.events = append(l.events[:0:0], l.events[len(l.events)-l.retention:]...)
+	}
+	l.mu.Unlock()
+
+	l.Notify()
+
+	return event
+}
+
+func (l *Log) Revision() int64 {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.revision
+}
+
+// Since возвращает до limit событий с ревизией больше after (limit <= 0 - без ограничения).
+func (l *Log) Since(after int64, limit int) ([]model.PartEvent, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if after > l.revision {
+		return nil, model.ErrRevisionNotFound
+	}
+
+	oldest := l.revision - int64(len(l.events)) + 1
+	if after < oldest-1 {
+		return nil, model.ErrRevisionCompacted
+	}
+
+	events := l.events[after-oldest+1:]
+	if limit > 0 && len(events) > limit {
+		events = events[:limit]
+	}
+
+	return append([]model.PartEvent(nil), events...), nil
+}
@@ -0,0 +1,88 @@
+package watch
+
+import (
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+func (s *LogSuite) appendN(n int) {
+	for range n {
+		s.log.Append(model.PartEventUpdated, model.Part{UUID: "part"})
+	}
+}
+
+func (s *LogSuite) TestSinceReturnsEventsAfterRevision() {
+	// Arrange
+	s.appendN(3)
+
+	// Act
+	events, err := s.log.Since(1, 0)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(events, 2)
+	s.Require().Equal(int64(2), events[0].Revision)
+	s.Require().Equal(int64(3), events[1].Revision)
+	s.Require().Equal(int64(3), s.log.Revision())
+}
+
+func (s *LogSuite) TestSinceLimit() {
+	s.appendN(3)
+
+	events, err := s.log.Since(0, 2)
+
+	s.Require().NoError(err)
+	s.Require().Len(events, 2)
+	s.Require().Equal(int64(1), events[0].Revision)
+}
+
+func (s *LogSuite) TestSinceLatestRevisionIsEmpty() {
+	s.appendN(2)
+
+	events, err := s.log.Since(2, 0)
+
+	s.Require().NoError(err)
+	s.Require().Empty(events)
+}
+
+func (s *LogSuite) TestSinceFutureRevision() {
+	s.appendN(1)
+
+	_, err := s.log.Since(5, 0)
+
+	s.Require().ErrorIs(err, model.ErrRevisionNotFound)
+}
+
+func (s *LogSuite) TestSinceCompactedRevision() {
+	// Arrange - при retention 3 история сжимается до трёх последних событий на шестой записи
+	s.appendN(6)
+
+	// Act
+	_, err := s.log.Since(2, 0)
+	events, okErr := s.log.Since(3, 0)
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrRevisionCompacted)
+	s.Require().NoError(okErr)
+	s.Require().Len(events, 3)
+	s.Require().Equal(int64(4), events[0].Revision)
+}
+
+func (s *LogSuite) TestAppendWakesWaiters() {
+	// Arrange
+	wait := s.log.Wait()
+
+	// Act
+	s.appendN(1)
+
+	// Assert
+	select {
+	case <-wait:
+	default:
+		s.Fail("waiter was not notified")
+	}
+	select {
+	case <-s.log.Wait():
+		s.Fail("new wait channel must stay open")
+	default:
+	}
+}
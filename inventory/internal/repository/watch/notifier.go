@@ -0,0 +1,31 @@
+// Package watch содержит общие для хранилищ части ленты изменений каталога.
+package watch
+
+import "sync"
+
+// Notifier будит ожидающих после каждого изменения каталога.
+type Notifier struct {
+	mu sync.Mutex
+	ch chan struct{}
+}
+
+func NewNotifier() *Notifier {
+	return &Notifier{ch: make(chan struct{})}
+}
+
+// Wait возвращает канал, который закроется при следующем Notify.
+// Канал нужно получить до чтения событий, иначе изменение между чтением и ожиданием потеряется.
+func (n *Notifier) Wait() <-chan struct{} {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.ch
+}
+
+func (n *Notifier) Notify() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	close(n.ch)
+	n.ch = make(chan struct{})
+}
@@ -0,0 +1,21 @@
+package watch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LogSuite struct {
+	suite.Suite
+
+	log *Log
+}
+
+func (s *LogSuite) SetupTest() {
+	s.log = NewLog(3)
+}
+
+func TestWatchLog(t *testing.T) {
+	suite.Run(t, new(LogSuite))
+}
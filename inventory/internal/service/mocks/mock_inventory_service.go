@@ -9,6 +9,8 @@ import (
 
 	model "github.com/baryshnikkov/rocket-factory/inventory/internal/model"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // InventoryService is an autogenerated mock type for the InventoryService type
@@ -24,6 +26,329 @@ func (_m *InventoryService) EXPECT() *InventoryService_Expecter {
 	return &InventoryService_Expecter{mock: &_m.Mock}
 }
 
+// ArchivePart provides a mock function with given fields: ctx, uuid
+func (_m *InventoryService) ArchivePart(ctx context.Context, uuid string) (model.Part, error) {
+	ret := _m.Called(ctx, uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchivePart")
+	}
+
+	var r0 model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.Part, error)); ok {
+		return rf(ctx, uuid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.Part); ok {
+		r0 = rf(ctx, uuid)
+	} else {
+		r0 = ret.Get(0).(model.Part)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, uuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_ArchivePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArchivePart'
+type InventoryService_ArchivePart_Call struct {
+	*mock.Call
+}
+
+// ArchivePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+func (_e *InventoryService_Expecter) ArchivePart(ctx interface{}, uuid interface{}) *InventoryService_ArchivePart_Call {
+	return &InventoryService_ArchivePart_Call{Call: _e.mock.On("ArchivePart", ctx, uuid)}
+}
+
+func (_c *InventoryService_ArchivePart_Call) Run(run func(ctx context.Context, uuid string)) *InventoryService_ArchivePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InventoryService_ArchivePart_Call) Return(_a0 model.Part, _a1 error) *InventoryService_ArchivePart_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_ArchivePart_Call) RunAndReturn(run func(context.Context, string) (model.Part, error)) *InventoryService_ArchivePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BatchGetParts provides a mock function with given fields: ctx, uuids
+func (_m *InventoryService) BatchGetParts(ctx context.Context, uuids []string) (model.PartsBatch, error) {
+	ret := _m.Called(ctx, uuids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchGetParts")
+	}
+
+	var r0 model.PartsBatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (model.PartsBatch, error)); ok {
+		return rf(ctx, uuids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) model.PartsBatch); ok {
+		r0 = rf(ctx, uuids)
+	} else {
+		r0 = ret.Get(0).(model.PartsBatch)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, uuids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_BatchGetParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchGetParts'
+type InventoryService_BatchGetParts_Call struct {
+	*mock.Call
+}
+
+// BatchGetParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuids []string
+func (_e *InventoryService_Expecter) BatchGetParts(ctx interface{}, uuids interface{}) *InventoryService_BatchGetParts_Call {
+	return &InventoryService_BatchGetParts_Call{Call: _e.mock.On("BatchGetParts", ctx, uuids)}
+}
+
+func (_c *InventoryService_BatchGetParts_Call) Run(run func(ctx context.Context, uuids []string)) *InventoryService_BatchGetParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *InventoryService_BatchGetParts_Call) Return(_a0 model.PartsBatch, _a1 error) *InventoryService_BatchGetParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_BatchGetParts_Call) RunAndReturn(run func(context.Context, []string) (model.PartsBatch, error)) *InventoryService_BatchGetParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CommitReservation provides a mock function with given fields: ctx, reservationUUID
+func (_m *InventoryService) CommitReservation(ctx context.Context, reservationUUID string) error {
+	ret := _m.Called(ctx, reservationUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, reservationUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryService_CommitReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitReservation'
+type InventoryService_CommitReservation_Call struct {
+	*mock.Call
+}
+
+// CommitReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reservationUUID string
+func (_e *InventoryService_Expecter) CommitReservation(ctx interface{}, reservationUUID interface{}) *InventoryService_CommitReservation_Call {
+	return &InventoryService_CommitReservation_Call{Call: _e.mock.On("CommitReservation", ctx, reservationUUID)}
+}
+
+func (_c *InventoryService_CommitReservation_Call) Run(run func(ctx context.Context, reservationUUID string)) *InventoryService_CommitReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InventoryService_CommitReservation_Call) Return(_a0 error) *InventoryService_CommitReservation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryService_CommitReservation_Call) RunAndReturn(run func(context.Context, string) error) *InventoryService_CommitReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePart provides a mock function with given fields: ctx, part
+func (_m *InventoryService) CreatePart(ctx context.Context, part model.Part) (model.Part, error) {
+	ret := _m.Called(ctx, part)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePart")
+	}
+
+	var r0 model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Part) (model.Part, error)); ok {
+		return rf(ctx, part)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Part) model.Part); ok {
+		r0 = rf(ctx, part)
+	} else {
+		r0 = ret.Get(0).(model.Part)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Part) error); ok {
+		r1 = rf(ctx, part)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_CreatePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePart'
+type InventoryService_CreatePart_Call struct {
+	*mock.Call
+}
+
+// CreatePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - part model.Part
+func (_e *InventoryService_Expecter) CreatePart(ctx interface{}, part interface{}) *InventoryService_CreatePart_Call {
+	return &InventoryService_CreatePart_Call{Call: _e.mock.On("CreatePart", ctx, part)}
+}
+
+func (_c *InventoryService_CreatePart_Call) Run(run func(ctx context.Context, part model.Part)) *InventoryService_CreatePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Part))
+	})
+	return _c
+}
+
+func (_c *InventoryService_CreatePart_Call) Return(_a0 model.Part, _a1 error) *InventoryService_CreatePart_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_CreatePart_Call) RunAndReturn(run func(context.Context, model.Part) (model.Part, error)) *InventoryService_CreatePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePart provides a mock function with given fields: ctx, uuid
+func (_m *InventoryService) DeletePart(ctx context.Context, uuid string) error {
+	ret := _m.Called(ctx, uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, uuid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryService_DeletePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePart'
+type InventoryService_DeletePart_Call struct {
+	*mock.Call
+}
+
+// DeletePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+func (_e *InventoryService_Expecter) DeletePart(ctx interface{}, uuid interface{}) *InventoryService_DeletePart_Call {
+	return &InventoryService_DeletePart_Call{Call: _e.mock.On("DeletePart", ctx, uuid)}
+}
+
+func (_c *InventoryService_DeletePart_Call) Run(run func(ctx context.Context, uuid string)) *InventoryService_DeletePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InventoryService_DeletePart_Call) Return(_a0 error) *InventoryService_DeletePart_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryService_DeletePart_Call) RunAndReturn(run func(context.Context, string) error) *InventoryService_DeletePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportParts provides a mock function with given fields: ctx
+func (_m *InventoryService) ExportParts(ctx context.Context) ([]model.Part, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportParts")
+	}
+
+	var r0 []model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]model.Part, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []model.Part); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Part)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_ExportParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportParts'
+type InventoryService_ExportParts_Call struct {
+	*mock.Call
+}
+
+// ExportParts is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *InventoryService_Expecter) ExportParts(ctx interface{}) *InventoryService_ExportParts_Call {
+	return &InventoryService_ExportParts_Call{Call: _e.mock.On("ExportParts", ctx)}
+}
+
+func (_c *InventoryService_ExportParts_Call) Run(run func(ctx context.Context)) *InventoryService_ExportParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *InventoryService_ExportParts_Call) Return(_a0 []model.Part, _a1 error) *InventoryService_ExportParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_ExportParts_Call) RunAndReturn(run func(context.Context) ([]model.Part, error)) *InventoryService_ExportParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPart provides a mock function with given fields: ctx, orderUUID
 func (_m *InventoryService) GetPart(ctx context.Context, orderUUID string) (model.Part, error) {
 	ret := _m.Called(ctx, orderUUID)
@@ -81,25 +406,23 @@ func (_c *InventoryService_GetPart_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
-// ListParts provides a mock function with given fields: ctx, filter
-func (_m *InventoryService) ListParts(ctx context.Context, filter model.PartsFilter) ([]model.Part, error) {
+// GetPartFacets provides a mock function with given fields: ctx, filter
+func (_m *InventoryService) GetPartFacets(ctx context.Context, filter model.PartsFilter) (model.PartFacets, error) {
 	ret := _m.Called(ctx, filter)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListParts")
+		panic("no return value specified for GetPartFacets")
 	}
 
-	var r0 []model.Part
+	var r0 model.PartFacets
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, model.PartsFilter) ([]model.Part, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, model.PartsFilter) (model.PartFacets, error)); ok {
 		return rf(ctx, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, model.PartsFilter) []model.Part); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, model.PartsFilter) model.PartFacets); ok {
 		r0 = rf(ctx, filter)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.Part)
-		}
+		r0 = ret.Get(0).(model.PartFacets)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, model.PartsFilter) error); ok {
@@ -111,6 +434,121 @@ func (_m *InventoryService) ListParts(ctx context.Context, filter model.PartsFil
 	return r0, r1
 }
 
+// InventoryService_GetPartFacets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPartFacets'
+type InventoryService_GetPartFacets_Call struct {
+	*mock.Call
+}
+
+// GetPartFacets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.PartsFilter
+func (_e *InventoryService_Expecter) GetPartFacets(ctx interface{}, filter interface{}) *InventoryService_GetPartFacets_Call {
+	return &InventoryService_GetPartFacets_Call{Call: _e.mock.On("GetPartFacets", ctx, filter)}
+}
+
+func (_c *InventoryService_GetPartFacets_Call) Run(run func(ctx context.Context, filter model.PartsFilter)) *InventoryService_GetPartFacets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PartsFilter))
+	})
+	return _c
+}
+
+func (_c *InventoryService_GetPartFacets_Call) Return(_a0 model.PartFacets, _a1 error) *InventoryService_GetPartFacets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_GetPartFacets_Call) RunAndReturn(run func(context.Context, model.PartsFilter) (model.PartFacets, error)) *InventoryService_GetPartFacets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportParts provides a mock function with given fields: ctx, rows, opts
+func (_m *InventoryService) ImportParts(ctx context.Context, rows []model.ImportRow, opts model.ImportOptions) (model.ImportReport, error) {
+	ret := _m.Called(ctx, rows, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportParts")
+	}
+
+	var r0 model.ImportReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []model.ImportRow, model.ImportOptions) (model.ImportReport, error)); ok {
+		return rf(ctx, rows, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []model.ImportRow, model.ImportOptions) model.ImportReport); ok {
+		r0 = rf(ctx, rows, opts)
+	} else {
+		r0 = ret.Get(0).(model.ImportReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []model.ImportRow, model.ImportOptions) error); ok {
+		r1 = rf(ctx, rows, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_ImportParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportParts'
+type InventoryService_ImportParts_Call struct {
+	*mock.Call
+}
+
+// ImportParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rows []model.ImportRow
+//   - opts model.ImportOptions
+func (_e *InventoryService_Expecter) ImportParts(ctx interface{}, rows interface{}, opts interface{}) *InventoryService_ImportParts_Call {
+	return &InventoryService_ImportParts_Call{Call: _e.mock.On("ImportParts", ctx, rows, opts)}
+}
+
+func (_c *InventoryService_ImportParts_Call) Run(run func(ctx context.Context, rows []model.ImportRow, opts model.ImportOptions)) *InventoryService_ImportParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]model.ImportRow), args[2].(model.ImportOptions))
+	})
+	return _c
+}
+
+func (_c *InventoryService_ImportParts_Call) Return(_a0 model.ImportReport, _a1 error) *InventoryService_ImportParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_ImportParts_Call) RunAndReturn(run func(context.Context, []model.ImportRow, model.ImportOptions) (model.ImportReport, error)) *InventoryService_ImportParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListParts provides a mock function with given fields: ctx, filter, params
+func (_m *InventoryService) ListParts(ctx context.Context, filter model.PartsFilter, params model.ListPartsParams) (model.PartsList, error) {
+	ret := _m.Called(ctx, filter, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListParts")
+	}
+
+	var r0 model.PartsList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PartsFilter, model.ListPartsParams) (model.PartsList, error)); ok {
+		return rf(ctx, filter, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.PartsFilter, model.ListPartsParams) model.PartsList); ok {
+		r0 = rf(ctx, filter, params)
+	} else {
+		r0 = ret.Get(0).(model.PartsList)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.PartsFilter, model.ListPartsParams) error); ok {
+		r1 = rf(ctx, filter, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // InventoryService_ListParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListParts'
 type InventoryService_ListParts_Call struct {
 	*mock.Call
@@ -119,23 +557,296 @@ type InventoryService_ListParts_Call struct {
 // ListParts is a helper method to define mock.On call
 //   - ctx context.Context
 //   - filter model.PartsFilter
-func (_e *InventoryService_Expecter) ListParts(ctx interface{}, filter interface{}) *InventoryService_ListParts_Call {
-	return &InventoryService_ListParts_Call{Call: _e.mock.On("ListParts", ctx, filter)}
+//   - params model.ListPartsParams
+func (_e *InventoryService_Expecter) ListParts(ctx interface{}, filter interface{}, params interface{}) *InventoryService_ListParts_Call {
+	return &InventoryService_ListParts_Call{Call: _e.mock.On("ListParts", ctx, filter, params)}
 }
 
-func (_c *InventoryService_ListParts_Call) Run(run func(ctx context.Context, filter model.PartsFilter)) *InventoryService_ListParts_Call {
+func (_c *InventoryService_ListParts_Call) Run(run func(ctx context.Context, filter model.PartsFilter, params model.ListPartsParams)) *InventoryService_ListParts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(model.PartsFilter))
+		run(args[0].(context.Context), args[1].(model.PartsFilter), args[2].(model.ListPartsParams))
+	})
+	return _c
+}
+
+func (_c *InventoryService_ListParts_Call) Return(_a0 model.PartsList, _a1 error) *InventoryService_ListParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_ListParts_Call) RunAndReturn(run func(context.Context, model.PartsFilter, model.ListPartsParams) (model.PartsList, error)) *InventoryService_ListParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseReservation provides a mock function with given fields: ctx, reservationUUID
+func (_m *InventoryService) ReleaseReservation(ctx context.Context, reservationUUID string) error {
+	ret := _m.Called(ctx, reservationUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, reservationUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryService_ReleaseReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseReservation'
+type InventoryService_ReleaseReservation_Call struct {
+	*mock.Call
+}
+
+// ReleaseReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reservationUUID string
+func (_e *InventoryService_Expecter) ReleaseReservation(ctx interface{}, reservationUUID interface{}) *InventoryService_ReleaseReservation_Call {
+	return &InventoryService_ReleaseReservation_Call{Call: _e.mock.On("ReleaseReservation", ctx, reservationUUID)}
+}
+
+func (_c *InventoryService_ReleaseReservation_Call) Run(run func(ctx context.Context, reservationUUID string)) *InventoryService_ReleaseReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InventoryService_ReleaseReservation_Call) Return(_a0 error) *InventoryService_ReleaseReservation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryService_ReleaseReservation_Call) RunAndReturn(run func(context.Context, string) error) *InventoryService_ReleaseReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveParts provides a mock function with given fields: ctx, items, ttl
+func (_m *InventoryService) ReserveParts(ctx context.Context, items []model.ReservationItem, ttl time.Duration) (model.Reservation, error) {
+	ret := _m.Called(ctx, items, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveParts")
+	}
+
+	var r0 model.Reservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []model.ReservationItem, time.Duration) (model.Reservation, error)); ok {
+		return rf(ctx, items, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []model.ReservationItem, time.Duration) model.Reservation); ok {
+		r0 = rf(ctx, items, ttl)
+	} else {
+		r0 = ret.Get(0).(model.Reservation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []model.ReservationItem, time.Duration) error); ok {
+		r1 = rf(ctx, items, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_ReserveParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveParts'
+type InventoryService_ReserveParts_Call struct {
+	*mock.Call
+}
+
+// ReserveParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - items []model.ReservationItem
+//   - ttl time.Duration
+func (_e *InventoryService_Expecter) ReserveParts(ctx interface{}, items interface{}, ttl interface{}) *InventoryService_ReserveParts_Call {
+	return &InventoryService_ReserveParts_Call{Call: _e.mock.On("ReserveParts", ctx, items, ttl)}
+}
+
+func (_c *InventoryService_ReserveParts_Call) Run(run func(ctx context.Context, items []model.ReservationItem, ttl time.Duration)) *InventoryService_ReserveParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]model.ReservationItem), args[2].(time.Duration))
 	})
 	return _c
 }
 
-func (_c *InventoryService_ListParts_Call) Return(_a0 []model.Part, _a1 error) *InventoryService_ListParts_Call {
+func (_c *InventoryService_ReserveParts_Call) Return(_a0 model.Reservation, _a1 error) *InventoryService_ReserveParts_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *InventoryService_ListParts_Call) RunAndReturn(run func(context.Context, model.PartsFilter) ([]model.Part, error)) *InventoryService_ListParts_Call {
+func (_c *InventoryService_ReserveParts_Call) RunAndReturn(run func(context.Context, []model.ReservationItem, time.Duration) (model.Reservation, error)) *InventoryService_ReserveParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchParts provides a mock function with given fields: ctx, query, limit
+func (_m *InventoryService) SearchParts(ctx context.Context, query string, limit int32) ([]model.SearchHit, error) {
+	ret := _m.Called(ctx, query, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchParts")
+	}
+
+	var r0 []model.SearchHit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) ([]model.SearchHit, error)); ok {
+		return rf(ctx, query, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) []model.SearchHit); ok {
+		r0 = rf(ctx, query, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SearchHit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int32) error); ok {
+		r1 = rf(ctx, query, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_SearchParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchParts'
+type InventoryService_SearchParts_Call struct {
+	*mock.Call
+}
+
+// SearchParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+//   - limit int32
+func (_e *InventoryService_Expecter) SearchParts(ctx interface{}, query interface{}, limit interface{}) *InventoryService_SearchParts_Call {
+	return &InventoryService_SearchParts_Call{Call: _e.mock.On("SearchParts", ctx, query, limit)}
+}
+
+func (_c *InventoryService_SearchParts_Call) Run(run func(ctx context.Context, query string, limit int32)) *InventoryService_SearchParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *InventoryService_SearchParts_Call) Return(_a0 []model.SearchHit, _a1 error) *InventoryService_SearchParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_SearchParts_Call) RunAndReturn(run func(context.Context, string, int32) ([]model.SearchHit, error)) *InventoryService_SearchParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePart provides a mock function with given fields: ctx, uuid, info
+func (_m *InventoryService) UpdatePart(ctx context.Context, uuid string, info model.PartUpdateInfo) (model.Part, error) {
+	ret := _m.Called(ctx, uuid, info)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePart")
+	}
+
+	var r0 model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.PartUpdateInfo) (model.Part, error)); ok {
+		return rf(ctx, uuid, info)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.PartUpdateInfo) model.Part); ok {
+		r0 = rf(ctx, uuid, info)
+	} else {
+		r0 = ret.Get(0).(model.Part)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.PartUpdateInfo) error); ok {
+		r1 = rf(ctx, uuid, info)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_UpdatePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePart'
+type InventoryService_UpdatePart_Call struct {
+	*mock.Call
+}
+
+// UpdatePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+//   - info model.PartUpdateInfo
+func (_e *InventoryService_Expecter) UpdatePart(ctx interface{}, uuid interface{}, info interface{}) *InventoryService_UpdatePart_Call {
+	return &InventoryService_UpdatePart_Call{Call: _e.mock.On("UpdatePart", ctx, uuid, info)}
+}
+
+func (_c *InventoryService_UpdatePart_Call) Run(run func(ctx context.Context, uuid string, info model.PartUpdateInfo)) *InventoryService_UpdatePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(model.PartUpdateInfo))
+	})
+	return _c
+}
+
+func (_c *InventoryService_UpdatePart_Call) Return(_a0 model.Part, _a1 error) *InventoryService_UpdatePart_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_UpdatePart_Call) RunAndReturn(run func(context.Context, string, model.PartUpdateInfo) (model.Part, error)) *InventoryService_UpdatePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchParts provides a mock function with given fields: ctx, filter, afterRevision, send
+func (_m *InventoryService) WatchParts(ctx context.Context, filter model.PartsFilter, afterRevision *int64, send func(model.PartEvent) error) error {
+	ret := _m.Called(ctx, filter, afterRevision, send)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchParts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PartsFilter, *int64, func(model.PartEvent) error) error); ok {
+		r0 = rf(ctx, filter, afterRevision, send)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryService_WatchParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchParts'
+type InventoryService_WatchParts_Call struct {
+	*mock.Call
+}
+
+// WatchParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.PartsFilter
+//   - afterRevision *int64
+//   - send func(model.PartEvent) error
+func (_e *InventoryService_Expecter) WatchParts(ctx interface{}, filter interface{}, afterRevision interface{}, send interface{}) *InventoryService_WatchParts_Call {
+	return &InventoryService_WatchParts_Call{Call: _e.mock.On("WatchParts", ctx, filter, afterRevision, send)}
+}
+
+func (_c *InventoryService_WatchParts_Call) Run(run func(ctx context.Context, filter model.PartsFilter, afterRevision *int64, send func(model.PartEvent) error)) *InventoryService_WatchParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PartsFilter), args[2].(*int64), args[3].(func(model.PartEvent) error))
+	})
+	return _c
+}
+
+func (_c *InventoryService_WatchParts_Call) Return(_a0 error) *InventoryService_WatchParts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryService_WatchParts_Call) RunAndReturn(run func(context.Context, model.PartsFilter, *int64, func(model.PartEvent) error) error) *InventoryService_WatchParts_Call {
 	_c.Call.Return(run)
 	return _c
 }
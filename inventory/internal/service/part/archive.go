@@ -0,0 +1,16 @@
+package part
+
+import (
+	"context"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+func (s *service) ArchivePart(ctx context.Context, uuid string) (model.Part, error) {
+	part, err := s.inventoryRepository.ArchivePart(ctx, uuid)
+	if err != nil {
+		return model.Part{}, err
+	}
+
+	return part, nil
+}
@@ -0,0 +1,31 @@
+package part
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func (s *ServiceSuite) TestArchivePartSuccess() {
+	part := testutils.CreatePart()
+	part.Archived = true
+
+	s.inventoryRepository.On("ArchivePart", s.ctx, part.UUID).Return(part, nil)
+
+	res, err := s.service.ArchivePart(s.ctx, part.UUID)
+
+	s.NoError(err)
+	s.Equal(part, res)
+}
+
+func (s *ServiceSuite) TestArchivePartFail() {
+	uuid := gofakeit.UUID()
+
+	s.inventoryRepository.On("ArchivePart", s.ctx, uuid).Return(model.Part{}, model.ErrPartNotFound)
+
+	res, err := s.service.ArchivePart(s.ctx, uuid)
+
+	s.ErrorIs(err, model.ErrPartNotFound)
+	s.Empty(res)
+}
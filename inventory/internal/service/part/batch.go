@@ -0,0 +1,43 @@
+package part
+
+import (
+	"context"
+	"errors"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+// BatchGetParts получает детали по списку UUID одним запросом к хранилищу.
+// Архивные детали тоже возвращаются: решать, можно ли их использовать, должен вызывающий.
+func (s *service) BatchGetParts(ctx context.Context, uuids []string) (model.PartsBatch, error) {
+	unique := make([]string, 0, len(uuids))
+	seen := make(map[string]struct{}, len(uuids))
+	for _, uuid := range uuids {
+		if _, ok := seen[uuid]; ok {
+			continue
+		}
+		seen[uuid] = struct{}{}
+		unique = append(unique, uuid)
+	}
+
+	page, err := s.inventoryRepository.ListParts(
+		ctx,
+		model.PartsFilter{UUIDs: unique, IncludeArchived: true},
+		model.PartsPageRequest{},
+	)
+	if err != nil && !errors.Is(err, model.ErrPartsNotFound) {
+		return model.PartsBatch{}, err
+	}
+
+	batch := model.PartsBatch{Parts: make(map[string]model.Part, len(page.Parts))}
+	for _, part := range page.Parts {
+		batch.Parts[part.UUID] = part
+	}
+	for _, uuid := range unique {
+		if _, ok := batch.Parts[uuid]; !ok {
+			batch.MissingUUIDs = append(batch.MissingUUIDs, uuid)
+		}
+	}
+
+	return batch, nil
+}
@@ -0,0 +1,56 @@
+package part
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func (s *ServiceSuite) TestBatchGetPartsSuccess() {
+	var (
+		found    = testutils.CreatePart()
+		archived = testutils.CreatePart()
+		missing  = gofakeit.UUID()
+	)
+	archived.Archived = true
+
+	filter := model.PartsFilter{
+		UUIDs:           []string{found.UUID, missing, archived.UUID},
+		IncludeArchived: true,
+	}
+	s.inventoryRepository.On("ListParts", s.ctx, filter, model.PartsPageRequest{}).
+		Return(model.PartsPage{Parts: []model.Part{found, archived}, TotalSize: 2}, nil)
+
+	res, err := s.service.BatchGetParts(s.ctx, []string{found.UUID, missing, found.UUID, archived.UUID, missing})
+	s.NoError(err)
+	s.Equal(map[string]model.Part{found.UUID: found, archived.UUID: archived}, res.Parts)
+	s.Equal([]string{missing}, res.MissingUUIDs)
+}
+
+func (s *ServiceSuite) TestBatchGetPartsNoneFound() {
+	uuids := []string{gofakeit.UUID(), gofakeit.UUID()}
+
+	s.inventoryRepository.On("ListParts", s.ctx, model.PartsFilter{UUIDs: uuids, IncludeArchived: true}, model.PartsPageRequest{}).
+		Return(model.PartsPage{}, model.ErrPartsNotFound)
+
+	res, err := s.service.BatchGetParts(s.ctx, uuids)
+	s.NoError(err)
+	s.Empty(res.Parts)
+	s.Equal(uuids, res.MissingUUIDs)
+}
+
+func (s *ServiceSuite) TestBatchGetPartsFail() {
+	var (
+		repoErr = gofakeit.Error()
+		uuid    = gofakeit.UUID()
+	)
+
+	s.inventoryRepository.On("ListParts", s.ctx, model.PartsFilter{UUIDs: []string{uuid}, IncludeArchived: true}, model.PartsPageRequest{}).
+		Return(model.PartsPage{}, repoErr)
+
+	res, err := s.service.BatchGetParts(s.ctx, []string{uuid})
+	s.Error(err)
+	s.ErrorIs(err, repoErr)
+	s.Empty(res)
+}
@@ -0,0 +1,189 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+// ExportParts выгружает каталог с остатком, включающим активные резервы: такой же остаток
+// ожидает импорт, поэтому выгруженный файл загружается обратно без изменений.
+func (s *service) ExportParts(ctx context.Context) ([]model.Part, error) {
+	parts, _, err := s.exportParts(ctx)
+	return parts, err
+}
+
+func (s *service) exportParts(ctx context.Context) ([]model.Part, map[string]int64, error) {
+	page, err := s.inventoryRepository.ListParts(ctx,
+		model.PartsFilter{IncludeArchived: true},
+		model.PartsPageRequest{OrderBy: model.PartsOrder{Field: model.PartsOrderByCreatedAt}},
+	)
+	if errors.Is(err, model.ErrPartsNotFound) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reserved, err := s.inventoryRepository.ReservedQuantities(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range page.Parts {
+		page.Parts[i].StockQuantity += reserved[page.Parts[i].UUID]
+	}
+
+	return page.Parts, reserved, nil
+}
+
+// ImportParts сверяет строки файла с каталогом по UUID и применяет разницу.
+// Ошибочные строки попадают в отчёт и не прерывают импорт остальных.
+func (s *service) ImportParts(ctx context.Context, rows []model.ImportRow, opts model.ImportOptions) (model.ImportReport, error) {
+	existing, reserved, err := s.exportParts(ctx)
+	if err != nil {
+		return model.ImportReport{}, err
+	}
+	current := make(map[string]model.Part, len(existing))
+	for _, part := range existing {
+		current[part.UUID] = part
+	}
+
+	var report model.ImportReport
+	rowError := func(row model.ImportRow, format string, args ...any) {
+		report.Errors = append(report.Errors, model.ImportError{
+			Line:    row.Line,
+			UUID:    row.Part.UUID,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+
+	// seen - UUID из файла и строка, где он встретился впервые. Ошибочные строки
+	// тоже учитываются, чтобы prune не удалил деталь из-за опечатки в файле.
+	seen := make(map[string]int, len(rows))
+	for _, row := range rows {
+		part := row.Part
+		if part.UUID != "" {
+			if _, err = uuid.Parse(part.UUID); err != nil {
+				rowError(row, "invalid uuid: %v", err)
+				continue
+			}
+			if line, ok := seen[part.UUID]; ok {
+				rowError(row, "duplicate uuid, first seen on line %d", line)
+				continue
+			}
+			seen[part.UUID] = row.Line
+		}
+
+		if row.Err != nil {
+			rowError(row, "%v", row.Err)
+			continue
+		}
+		if err = validatePart(part); err != nil {
+			rowError(row, "%v", err)
+			continue
+		}
+
+		change := model.ImportChange{Line: row.Line, UUID: part.UUID}
+		old, exists := current[part.UUID]
+		if exists {
+			change.Fields = changedFields(old, part)
+			if len(change.Fields) == 0 {
+				report.Unchanged++
+				continue
+			}
+		}
+
+		if !opts.DryRun {
+			saved, err := s.inventoryRepository.UpsertPart(ctx, part)
+			if err != nil {
+				if ctx.Err() != nil {
+					return model.ImportReport{}, err
+				}
+				rowError(row, "%v", err)
+				continue
+			}
+			change.UUID = saved.UUID
+		}
+
+		if exists {
+			report.Changed = append(report.Changed, change)
+		} else {
+			report.Added = append(report.Added, change)
+		}
+	}
+
+	if !opts.Prune {
+		return report, nil
+	}
+
+	for _, part := range existing {
+		if _, ok := seen[part.UUID]; ok {
+			continue
+		}
+
+		// Деталь в активном резерве удалить нельзя: заказ ещё спишет или вернёт её на склад.
+		// Такая деталь снимается с продажи
+		held := reserved[part.UUID] > 0
+		if !held && !opts.DryRun {
+			err = s.inventoryRepository.DeletePart(ctx, part.UUID)
+			held = errors.Is(err, model.ErrPartReserved)
+			if err != nil && !held {
+				if ctx.Err() != nil {
+					return model.ImportReport{}, err
+				}
+				report.Errors = append(report.Errors, model.ImportError{UUID: part.UUID, Message: err.Error()})
+				continue
+			}
+		}
+		if !held {
+			report.Removed = append(report.Removed, model.ImportChange{UUID: part.UUID})
+			continue
+		}
+
+		if !part.Archived && !opts.DryRun {
+			if _, err = s.inventoryRepository.ArchivePart(ctx, part.UUID); err != nil {
+				if ctx.Err() != nil {
+					return model.ImportReport{}, err
+				}
+				report.Errors = append(report.Errors, model.ImportError{UUID: part.UUID, Message: err.Error()})
+				continue
+			}
+		}
+		report.Archived = append(report.Archived, model.ImportChange{UUID: part.UUID})
+	}
+
+	return report, nil
+}
+
+// changedFields возвращает имена полей, которыми деталь из файла отличается от сохранённой.
+// Даты создания и обновления не сравниваются: их ведёт хранилище.
+func changedFields(old, part model.Part) []string {
+	var fields []string
+	add := func(name string, changed bool) {
+		if changed {
+			fields = append(fields, name)
+		}
+	}
+
+	add("name", old.Name != part.Name)
+	add("description", old.Description != part.Description)
+	add("price", old.Price != part.Price)
+	add("stock_quantity", old.StockQuantity != part.StockQuantity)
+	add("category", old.Category != part.Category)
+	add("dimensions", old.Dimensions != part.Dimensions)
+	add("manufacturer", old.Manufacturer != part.Manufacturer)
+	add("tags", !slices.Equal(old.Tags, part.Tags))
+	add("metadata", !maps.EqualFunc(old.Metadata, part.Metadata, func(a, b model.MetadataValue) bool {
+		return reflect.DeepEqual(a, b)
+	}))
+	add("archived", old.Archived != part.Archived)
+
+	return fields
+}
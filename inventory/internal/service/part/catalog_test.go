@@ -0,0 +1,165 @@
+package part
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+var exportPage = model.PartsPageRequest{OrderBy: model.PartsOrder{Field: model.PartsOrderByCreatedAt}}
+
+// catalogFixture - каталог из трёх деталей и файл, в котором первая не изменилась,
+// у второй поменялась цена, третьей нет, зато есть новая деталь без UUID и ошибочные строки.
+// Первая деталь зарезервирована: в файле её остаток указан вместе с резервом. missingReserved -
+// сколько единиц третьей детали держат активные резервы.
+func (s *ServiceSuite) catalogFixture(missingReserved int64) (existing []model.Part, rows []model.ImportRow) {
+	same, changed, missing := testutils.CreatePart(), testutils.CreatePart(), testutils.CreatePart()
+	existing = []model.Part{same, changed, missing}
+
+	s.inventoryRepository.On("ListParts", s.ctx, model.PartsFilter{IncludeArchived: true}, exportPage).
+		Return(model.PartsPage{Parts: slices.Clone(existing), TotalSize: int32(len(existing))}, nil)
+	s.inventoryRepository.On("ReservedQuantities", s.ctx).
+		Return(map[string]int64{same.UUID: 2, missing.UUID: missingReserved}, nil)
+
+	same.StockQuantity += 2
+
+	edited := changed
+	edited.Price++
+	edited.Tags = []string{"new"}
+
+	added := testutils.CreatePart()
+	added.UUID = ""
+
+	invalid := testutils.CreatePart()
+	invalid.Name = ""
+
+	rows = []model.ImportRow{
+		{Line: 1, Part: same},
+		{Line: 2, Part: edited},
+		{Line: 3, Part: added},
+		{Line: 4, Part: invalid},
+		{Line: 5, Err: errors.New("unexpected end of JSON input")},
+		{Line: 6, Part: same},
+		{Line: 7, Part: model.Part{UUID: "not-a-uuid"}},
+	}
+	return existing, rows
+}
+
+func (s *ServiceSuite) TestImportPartsDryRun() {
+	existing, rows := s.catalogFixture(0)
+
+	report, err := s.service.ImportParts(s.ctx, rows, model.ImportOptions{DryRun: true, Prune: true})
+
+	s.Require().NoError(err)
+	s.Require().Equal(1, report.Unchanged)
+	s.Require().Equal([]model.ImportChange{{Line: 2, UUID: existing[1].UUID, Fields: []string{"price", "tags"}}}, report.Changed)
+	s.Require().Equal([]model.ImportChange{{Line: 3}}, report.Added)
+	s.Require().Equal([]model.ImportChange{{UUID: existing[2].UUID}}, report.Removed)
+
+	s.Require().Len(report.Errors, 4)
+	s.Require().Equal(4, report.Errors[0].Line)
+	s.Require().Contains(report.Errors[0].Message, "name must not be empty")
+	s.Require().Equal(5, report.Errors[1].Line)
+	s.Require().Contains(report.Errors[2].Message, "duplicate uuid, first seen on line 1")
+	s.Require().Contains(report.Errors[3].Message, "invalid uuid")
+}
+
+func (s *ServiceSuite) TestImportPartsApply() {
+	existing, rows := s.catalogFixture(0)
+	createdUUID := gofakeit.UUID()
+
+	s.inventoryRepository.On("UpsertPart", s.ctx, rows[1].Part).Return(rows[1].Part, nil)
+	s.inventoryRepository.On("UpsertPart", s.ctx, rows[2].Part).Return(model.Part{UUID: createdUUID}, nil)
+	s.inventoryRepository.On("DeletePart", s.ctx, existing[2].UUID).Return(nil)
+
+	report, err := s.service.ImportParts(s.ctx, rows, model.ImportOptions{Prune: true})
+
+	s.Require().NoError(err)
+	s.Require().Equal([]model.ImportChange{{Line: 3, UUID: createdUUID}}, report.Added)
+	s.Require().Len(report.Changed, 1)
+	s.Require().Equal([]model.ImportChange{{UUID: existing[2].UUID}}, report.Removed)
+	s.Require().Len(report.Errors, 4)
+}
+
+func (s *ServiceSuite) TestImportPartsPruneArchivesReservedPart() {
+	existing, rows := s.catalogFixture(1)
+
+	s.inventoryRepository.On("UpsertPart", s.ctx, rows[1].Part).Return(rows[1].Part, nil)
+	s.inventoryRepository.On("ArchivePart", s.ctx, existing[2].UUID).Return(existing[2], nil)
+
+	report, err := s.service.ImportParts(s.ctx, rows[:2], model.ImportOptions{Prune: true})
+
+	s.Require().NoError(err)
+	s.Require().Empty(report.Removed)
+	s.Require().Equal([]model.ImportChange{{UUID: existing[2].UUID}}, report.Archived)
+	s.inventoryRepository.AssertNotCalled(s.T(), "DeletePart", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestImportPartsPruneArchivesPartReservedConcurrently() {
+	existing, rows := s.catalogFixture(0)
+
+	s.inventoryRepository.On("UpsertPart", s.ctx, rows[1].Part).Return(rows[1].Part, nil)
+	s.inventoryRepository.On("DeletePart", s.ctx, existing[2].UUID).Return(model.ErrPartReserved)
+	s.inventoryRepository.On("ArchivePart", s.ctx, existing[2].UUID).Return(existing[2], nil)
+
+	report, err := s.service.ImportParts(s.ctx, rows[:2], model.ImportOptions{Prune: true})
+
+	s.Require().NoError(err)
+	s.Require().Empty(report.Removed)
+	s.Require().Empty(report.Errors)
+	s.Require().Equal([]model.ImportChange{{UUID: existing[2].UUID}}, report.Archived)
+}
+
+func (s *ServiceSuite) TestImportPartsWithoutPruneKeepsMissing() {
+	_, rows := s.catalogFixture(0)
+
+	report, err := s.service.ImportParts(s.ctx, rows, model.ImportOptions{DryRun: true})
+
+	s.Require().NoError(err)
+	s.Require().Empty(report.Removed)
+	s.inventoryRepository.AssertNotCalled(s.T(), "DeletePart", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestImportPartsUpsertError() {
+	_, rows := s.catalogFixture(0)
+	repoErr := errors.New("disk full")
+
+	s.inventoryRepository.On("UpsertPart", s.ctx, mock.Anything).Return(model.Part{}, repoErr)
+
+	report, err := s.service.ImportParts(s.ctx, rows[:3], model.ImportOptions{})
+
+	s.Require().NoError(err)
+	s.Require().Empty(report.Added)
+	s.Require().Empty(report.Changed)
+	s.Require().Len(report.Errors, 2)
+	s.Require().Equal(repoErr.Error(), report.Errors[0].Message)
+}
+
+func (s *ServiceSuite) TestExportPartsEmptyCatalog() {
+	s.inventoryRepository.On("ListParts", s.ctx, model.PartsFilter{IncludeArchived: true}, exportPage).
+		Return(model.PartsPage{}, model.ErrPartsNotFound)
+
+	parts, err := s.service.ExportParts(s.ctx)
+
+	s.Require().NoError(err)
+	s.Require().Empty(parts)
+}
+
+func (s *ServiceSuite) TestExportPartsIncludesReservedStock() {
+	part := testutils.CreatePart()
+	part.StockQuantity = 3
+
+	s.inventoryRepository.On("ListParts", s.ctx, model.PartsFilter{IncludeArchived: true}, exportPage).
+		Return(model.PartsPage{Parts: []model.Part{part}, TotalSize: 1}, nil)
+	s.inventoryRepository.On("ReservedQuantities", s.ctx).Return(map[string]int64{part.UUID: 4}, nil)
+
+	parts, err := s.service.ExportParts(s.ctx)
+
+	s.Require().NoError(err)
+	s.Require().Equal(int64(7), parts[0].StockQuantity)
+}
@@ -0,0 +1,9 @@
+package part
+
+import (
+	"context"
+)
+
+func (s *service) CommitReservation(ctx context.Context, reservationUUID string) error {
+	return s.inventoryRepository.CommitReservation(ctx, reservationUUID)
+}
@@ -0,0 +1,27 @@
+package part
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestCommitReservationSuccess() {
+	reservationUUID := gofakeit.UUID()
+
+	s.inventoryRepository.On("CommitReservation", s.ctx, reservationUUID).Return(nil)
+
+	err := s.service.CommitReservation(s.ctx, reservationUUID)
+
+	s.NoError(err)
+}
+
+func (s *ServiceSuite) TestCommitReservationFail() {
+	reservationUUID := gofakeit.UUID()
+
+	s.inventoryRepository.On("CommitReservation", s.ctx, reservationUUID).Return(model.ErrReservationExpired)
+
+	err := s.service.CommitReservation(s.ctx, reservationUUID)
+
+	s.ErrorIs(err, model.ErrReservationExpired)
+}
@@ -0,0 +1,20 @@
+package part
+
+import (
+	"context"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+func (s *service) CreatePart(ctx context.Context, part model.Part) (model.Part, error) {
+	if err := validatePart(part); err != nil {
+		return model.Part{}, err
+	}
+
+	created, err := s.inventoryRepository.CreatePart(ctx, part)
+	if err != nil {
+		return model.Part{}, err
+	}
+
+	return created, nil
+}
@@ -0,0 +1,45 @@
+package part
+
+import (
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func (s *ServiceSuite) TestCreatePartSuccess() {
+	part := testutils.CreatePart()
+	created := part
+
+	s.inventoryRepository.On("CreatePart", s.ctx, part).Return(created, nil)
+
+	res, err := s.service.CreatePart(s.ctx, part)
+
+	s.NoError(err)
+	s.Equal(created, res)
+}
+
+func (s *ServiceSuite) TestCreatePartInvalid() {
+	cases := map[string]func(part *model.Part){
+		"empty name":         func(part *model.Part) { part.Name = " " },
+		"negative price":     func(part *model.Part) { part.Price = -1 },
+		"negative stock":     func(part *model.Part) { part.StockQuantity = -1 },
+		"unknown category":   func(part *model.Part) { part.Category = model.CategoryUnspecified },
+		"negative dimension": func(part *model.Part) { part.Dimensions.Weight = -0.5 },
+		"empty tag":          func(part *model.Part) { part.Tags = []string{""} },
+		"empty metadata key": func(part *model.Part) { part.Metadata = model.Metadata{"": {BoolValue: lo.ToPtr(true)}} },
+		"metadata no value":  func(part *model.Part) { part.Metadata = model.Metadata{"material": {}} },
+	}
+
+	for name, mutate := range cases {
+		s.Run(name, func() {
+			part := testutils.CreatePart()
+			mutate(&part)
+
+			res, err := s.service.CreatePart(s.ctx, part)
+
+			s.ErrorIs(err, model.ErrInvalidPart)
+			s.Empty(res)
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package part
+
+import (
+	"context"
+)
+
+func (s *service) DeletePart(ctx context.Context, uuid string) error {
+	return s.inventoryRepository.DeletePart(ctx, uuid)
+}
@@ -0,0 +1,27 @@
+package part
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestDeletePartSuccess() {
+	uuid := gofakeit.UUID()
+
+	s.inventoryRepository.On("DeletePart", s.ctx, uuid).Return(nil)
+
+	err := s.service.DeletePart(s.ctx, uuid)
+
+	s.NoError(err)
+}
+
+func (s *ServiceSuite) TestDeletePartFail() {
+	uuid := gofakeit.UUID()
+
+	s.inventoryRepository.On("DeletePart", s.ctx, uuid).Return(model.ErrPartReserved)
+
+	err := s.service.DeletePart(s.ctx, uuid)
+
+	s.ErrorIs(err, model.ErrPartReserved)
+}
@@ -0,0 +1,42 @@
+package part
+
+import (
+	"context"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+// GetPartFacets считает фасеты по текущему фильтру. Счётчики каждого фасета считаются
+// без условия на этот же фасет: так видно, сколько деталей даст выбор другого значения.
+func (s *service) GetPartFacets(ctx context.Context, filter model.PartsFilter) (model.PartFacets, error) {
+	if err := validatePartsFilter(filter); err != nil {
+		return model.PartFacets{}, err
+	}
+
+	byCategory := filter
+	byCategory.Categories = nil
+	categories, err := s.inventoryRepository.CountParts(ctx, byCategory, model.FacetCategory)
+	if err != nil {
+		return model.PartFacets{}, err
+	}
+
+	byCountry := filter
+	byCountry.ManufacturerCountries = nil
+	countries, err := s.inventoryRepository.CountParts(ctx, byCountry, model.FacetManufacturerCountry)
+	if err != nil {
+		return model.PartFacets{}, err
+	}
+
+	byTag := filter
+	byTag.Tags = nil
+	tags, err := s.inventoryRepository.CountParts(ctx, byTag, model.FacetTag)
+	if err != nil {
+		return model.PartFacets{}, err
+	}
+
+	return model.PartFacets{
+		Categories:            categories,
+		ManufacturerCountries: countries,
+		Tags:                  tags,
+	}, nil
+}
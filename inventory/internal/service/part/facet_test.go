@@ -0,0 +1,53 @@
+package part
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestGetPartFacetsExcludesOwnFilter() {
+	filter := model.PartsFilter{
+		Categories:            []model.Category{model.CategoryEngine},
+		ManufacturerCountries: []string{"USA"},
+		Tags:                  []string{"power"},
+	}
+
+	byCategory := filter
+	byCategory.Categories = nil
+	byCountry := filter
+	byCountry.ManufacturerCountries = nil
+	byTag := filter
+	byTag.Tags = nil
+
+	categories := []model.FacetCount{{Value: "ENGINE", Count: 2}, {Value: "FUEL", Count: 1}}
+	countries := []model.FacetCount{{Value: "USA", Count: 2}}
+	tags := []model.FacetCount{{Value: "power", Count: 2}}
+
+	s.inventoryRepository.On("CountParts", s.ctx, byCategory, model.FacetCategory).Return(categories, nil)
+	s.inventoryRepository.On("CountParts", s.ctx, byCountry, model.FacetManufacturerCountry).Return(countries, nil)
+	s.inventoryRepository.On("CountParts", s.ctx, byTag, model.FacetTag).Return(tags, nil)
+
+	res, err := s.service.GetPartFacets(s.ctx, filter)
+	s.NoError(err)
+	s.Equal(model.PartFacets{Categories: categories, ManufacturerCountries: countries, Tags: tags}, res)
+}
+
+func (s *ServiceSuite) TestGetPartFacetsInvalidFilter() {
+	res, err := s.service.GetPartFacets(s.ctx, model.PartsFilter{
+		Price: model.FloatRange{Min: lo.ToPtr(2.0), Max: lo.ToPtr(1.0)},
+	})
+	s.ErrorIs(err, model.ErrInvalidPartsFilter)
+	s.Empty(res)
+}
+
+func (s *ServiceSuite) TestGetPartFacetsFail() {
+	repoErr := gofakeit.Error()
+
+	s.inventoryRepository.On("CountParts", s.ctx, model.PartsFilter{}, model.FacetCategory).Return(nil, repoErr)
+
+	res, err := s.service.GetPartFacets(s.ctx, model.PartsFilter{})
+	s.ErrorIs(err, repoErr)
+	s.Empty(res)
+}
@@ -4,13 +4,42 @@ import (
 	"context"
 
 	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/shared/pkg/pagetoken"
 )
 
-func (s *service) ListParts(ctx context.Context, filter model.PartsFilter) ([]model.Part, error) {
-	parts, err := s.inventoryRepository.ListParts(ctx, filter)
+func (s *service) ListParts(ctx context.Context, filter model.PartsFilter, params model.ListPartsParams) (model.PartsList, error) {
+	if err := validatePartsFilter(filter); err != nil {
+		return model.PartsList{}, err
+	}
+
+	page := model.PartsPageRequest{
+		PageSize: params.PageSize,
+		OrderBy:  params.OrderBy,
+	}
+
+	if params.PageToken != "" {
+		cursor, err := pagetoken.Decode[model.PartsCursor](params.PageToken, filter, params.OrderBy)
+		if err != nil {
+			return model.PartsList{}, model.ErrInvalidPageToken
+		}
+		page.After = &cursor
+	}
+
+	result, err := s.inventoryRepository.ListParts(ctx, filter, page)
 	if err != nil {
-		return nil, err
+		return model.PartsList{}, err
+	}
+
+	list := model.PartsList{
+		Parts:     result.Parts,
+		TotalSize: result.TotalSize,
+	}
+	if result.Next != nil {
+		list.NextPageToken, err = pagetoken.Encode(*result.Next, filter, params.OrderBy)
+		if err != nil {
+			return model.PartsList{}, err
+		}
 	}
 
-	return parts, nil
+	return list, nil
 }
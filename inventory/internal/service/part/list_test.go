@@ -1,10 +1,15 @@
 package part
 
 import (
+	"math"
+	"time"
+
 	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
 
 	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
 	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+	"github.com/baryshnikkov/rocket-factory/shared/pkg/pagetoken"
 )
 
 func (s *ServiceSuite) TestListPartsSuccess() {
@@ -14,11 +19,14 @@ func (s *ServiceSuite) TestListPartsSuccess() {
 
 	expectedParts := []model.Part{part}
 
-	s.inventoryRepository.On("ListParts", s.ctx, filter).Return(expectedParts, nil)
+	s.inventoryRepository.On("ListParts", s.ctx, filter, model.PartsPageRequest{}).
+		Return(model.PartsPage{Parts: expectedParts, TotalSize: 1}, nil)
 
-	res, err := s.service.ListParts(s.ctx, filter)
+	res, err := s.service.ListParts(s.ctx, filter, model.ListPartsParams{})
 	s.NoError(err)
-	s.Equal(expectedParts, res)
+	s.Equal(expectedParts, res.Parts)
+	s.Equal(int32(1), res.TotalSize)
+	s.Empty(res.NextPageToken)
 }
 
 func (s *ServiceSuite) TestListPartsFail() {
@@ -26,10 +34,93 @@ func (s *ServiceSuite) TestListPartsFail() {
 
 	filter := testutils.CreatePartsFilter()
 
-	s.inventoryRepository.On("ListParts", s.ctx, filter).Return([]model.Part{}, repoErr)
+	s.inventoryRepository.On("ListParts", s.ctx, filter, model.PartsPageRequest{}).Return(model.PartsPage{}, repoErr)
 
-	res, err := s.service.ListParts(s.ctx, filter)
+	res, err := s.service.ListParts(s.ctx, filter, model.ListPartsParams{})
 	s.Error(err)
 	s.ErrorIs(err, repoErr)
 	s.Empty(res)
 }
+
+func (s *ServiceSuite) TestListPartsNextPage() {
+	filter := testutils.CreatePartsFilter()
+	order := model.PartsOrder{Field: model.PartsOrderByPrice, Desc: true}
+
+	firstPart := testutils.CreatePart()
+	secondPart := testutils.CreatePart()
+	cursor := model.NewPartsCursor(firstPart)
+
+	s.inventoryRepository.On("ListParts", s.ctx, filter, model.PartsPageRequest{PageSize: 1, OrderBy: order}).
+		Return(model.PartsPage{Parts: []model.Part{firstPart}, TotalSize: 2, Next: cursor}, nil)
+
+	first, err := s.service.ListParts(s.ctx, filter, model.ListPartsParams{PageSize: 1, OrderBy: order})
+	s.Require().NoError(err)
+	s.Require().NotEmpty(first.NextPageToken)
+
+	// Токен следующей страницы превращается обратно в курсор
+	s.inventoryRepository.On("ListParts", s.ctx, filter, model.PartsPageRequest{PageSize: 1, OrderBy: order, After: cursor}).
+		Return(model.PartsPage{Parts: []model.Part{secondPart}, TotalSize: 2}, nil)
+
+	second, err := s.service.ListParts(s.ctx, filter, model.ListPartsParams{
+		PageSize:  1,
+		PageToken: first.NextPageToken,
+		OrderBy:   order,
+	})
+	s.Require().NoError(err)
+	s.Equal([]model.Part{secondPart}, second.Parts)
+	s.Empty(second.NextPageToken)
+}
+
+func (s *ServiceSuite) TestListPartsInvalidPageToken() {
+	filter := testutils.CreatePartsFilter()
+
+	res, err := s.service.ListParts(s.ctx, filter, model.ListPartsParams{PageToken: "not a token"})
+	s.ErrorIs(err, model.ErrInvalidPageToken)
+	s.Empty(res)
+}
+
+func (s *ServiceSuite) TestListPartsPageTokenFromOtherQuery() {
+	filter := testutils.CreatePartsFilter()
+	token, err := pagetoken.Encode(*model.NewPartsCursor(testutils.CreatePart()), filter, model.PartsOrder{})
+	s.Require().NoError(err)
+
+	// Токен, выданный для другой сортировки или другого фильтра, не принимается
+	_, err = s.service.ListParts(s.ctx, filter, model.ListPartsParams{
+		PageToken: token,
+		OrderBy:   model.PartsOrder{Field: model.PartsOrderByName},
+	})
+	s.ErrorIs(err, model.ErrInvalidPageToken)
+
+	_, err = s.service.ListParts(s.ctx, testutils.CreatePartsFilter(), model.ListPartsParams{PageToken: token})
+	s.ErrorIs(err, model.ErrInvalidPageToken)
+}
+
+func (s *ServiceSuite) TestListPartsInvalidRange() {
+	from := time.Now()
+	filters := []model.PartsFilter{
+		{Price: model.FloatRange{Min: lo.ToPtr(10.0), Max: lo.ToPtr(5.0)}},
+		{Price: model.FloatRange{Min: lo.ToPtr(math.NaN())}},
+		{StockQuantity: model.Int64Range{Min: lo.ToPtr(int64(2)), Max: lo.ToPtr(int64(1))}},
+		{Dimensions: model.DimensionsRange{Weight: model.FloatRange{Min: lo.ToPtr(200.0), Max: lo.ToPtr(100.0)}}},
+		{UpdatedAt: model.TimeRange{From: &from, To: lo.ToPtr(from.Add(-time.Hour))}},
+		{Metadata: []model.MetadataCondition{{Key: ""}}},
+		{Metadata: []model.MetadataCondition{{Key: "material", Value: &model.MetadataValue{}}}},
+	}
+
+	for _, filter := range filters {
+		res, err := s.service.ListParts(s.ctx, filter, model.ListPartsParams{})
+		s.ErrorIs(err, model.ErrInvalidPartsFilter)
+		s.Empty(res)
+	}
+}
+
+func (s *ServiceSuite) TestListPartsEqualRangeBounds() {
+	filter := model.PartsFilter{Price: model.FloatRange{Min: lo.ToPtr(100.0), Max: lo.ToPtr(100.0)}}
+
+	s.inventoryRepository.On("ListParts", s.ctx, filter, model.PartsPageRequest{}).
+		Return(model.PartsPage{Parts: []model.Part{testutils.CreatePart()}, TotalSize: 1}, nil)
+
+	res, err := s.service.ListParts(s.ctx, filter, model.ListPartsParams{})
+	s.NoError(err)
+	s.Len(res.Parts, 1)
+}
@@ -0,0 +1,9 @@
+package part
+
+import (
+	"context"
+)
+
+func (s *service) ReleaseReservation(ctx context.Context, reservationUUID string) error {
+	return s.inventoryRepository.ReleaseReservation(ctx, reservationUUID)
+}
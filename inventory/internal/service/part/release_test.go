@@ -0,0 +1,27 @@
+package part
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestReleaseReservationSuccess() {
+	reservationUUID := gofakeit.UUID()
+
+	s.inventoryRepository.On("ReleaseReservation", s.ctx, reservationUUID).Return(nil)
+
+	err := s.service.ReleaseReservation(s.ctx, reservationUUID)
+
+	s.NoError(err)
+}
+
+func (s *ServiceSuite) TestReleaseReservationFail() {
+	reservationUUID := gofakeit.UUID()
+
+	s.inventoryRepository.On("ReleaseReservation", s.ctx, reservationUUID).Return(model.ErrReservationNotFound)
+
+	err := s.service.ReleaseReservation(s.ctx, reservationUUID)
+
+	s.ErrorIs(err, model.ErrReservationNotFound)
+}
@@ -0,0 +1,40 @@
+package part
+
+import (
+	"context"
+	"time"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+// defaultReservationTTL - срок жизни резерва, если клиент его не указал
+const defaultReservationTTL = 15 * time.Minute
+
+func (s *service) ReserveParts(ctx context.Context, items []model.ReservationItem, ttl time.Duration) (model.Reservation, error) {
+	if ttl <= 0 {
+		ttl = defaultReservationTTL
+	}
+
+	reservation, err := s.inventoryRepository.ReserveParts(ctx, mergeReservationItems(items), time.Now().Add(ttl))
+	if err != nil {
+		return model.Reservation{}, err
+	}
+
+	return reservation, nil
+}
+
+// mergeReservationItems объединяет повторяющиеся детали в одну позицию, сохраняя порядок первого вхождения.
+func mergeReservationItems(items []model.ReservationItem) []model.ReservationItem {
+	result := make([]model.ReservationItem, 0, len(items))
+	index := make(map[string]int, len(items))
+	for _, item := range items {
+		if i, ok := index[item.PartUUID]; ok {
+			result[i].Quantity += item.Quantity
+			continue
+		}
+		index[item.PartUUID] = len(result)
+		result = append(result, item)
+	}
+
+	return result
+}
@@ -0,0 +1,69 @@
+package part
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestReservePartsSuccess() {
+	var (
+		partUUID = gofakeit.UUID()
+		items    = []model.ReservationItem{{PartUUID: partUUID, Quantity: 2}}
+		ttl      = time.Minute
+
+		expected = model.Reservation{
+			UUID:   gofakeit.UUID(),
+			Items:  items,
+			Status: model.ReservationStatusActive,
+		}
+	)
+
+	before := time.Now()
+	s.inventoryRepository.On("ReserveParts", s.ctx, items, mock.MatchedBy(func(expiresAt time.Time) bool {
+		return !expiresAt.Before(before.Add(ttl)) && expiresAt.Before(time.Now().Add(ttl))
+	})).Return(expected, nil)
+
+	res, err := s.service.ReserveParts(s.ctx, items, ttl)
+
+	s.NoError(err)
+	s.Equal(expected, res)
+}
+
+func (s *ServiceSuite) TestReservePartsMergesDuplicates() {
+	var (
+		first  = gofakeit.UUID()
+		second = gofakeit.UUID()
+		items  = []model.ReservationItem{
+			{PartUUID: first, Quantity: 1},
+			{PartUUID: second, Quantity: 2},
+			{PartUUID: first, Quantity: 3},
+		}
+		merged = []model.ReservationItem{
+			{PartUUID: first, Quantity: 4},
+			{PartUUID: second, Quantity: 2},
+		}
+	)
+
+	s.inventoryRepository.On("ReserveParts", s.ctx, merged, mock.AnythingOfType("time.Time")).
+		Return(model.Reservation{}, nil)
+
+	_, err := s.service.ReserveParts(s.ctx, items, 0)
+
+	s.NoError(err)
+}
+
+func (s *ServiceSuite) TestReservePartsFail() {
+	items := []model.ReservationItem{{PartUUID: gofakeit.UUID(), Quantity: 1}}
+
+	s.inventoryRepository.On("ReserveParts", s.ctx, items, mock.AnythingOfType("time.Time")).
+		Return(model.Reservation{}, model.ErrInsufficientStock)
+
+	res, err := s.service.ReserveParts(s.ctx, items, 0)
+
+	s.ErrorIs(err, model.ErrInsufficientStock)
+	s.Empty(res)
+}
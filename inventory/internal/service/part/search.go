@@ -0,0 +1,29 @@
+package part
+
+import (
+	"context"
+	"strings"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+func (s *service) SearchParts(ctx context.Context, query string, limit int32) ([]model.SearchHit, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, model.ErrInvalidSearchQuery
+	}
+
+	switch {
+	case limit <= 0:
+		limit = defaultSearchLimit
+	case limit > maxSearchLimit:
+		limit = maxSearchLimit
+	}
+
+	return s.inventoryRepository.SearchParts(ctx, query, int(limit))
+}
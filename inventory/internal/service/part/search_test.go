@@ -0,0 +1,41 @@
+package part
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func (s *ServiceSuite) TestSearchPartsSuccess() {
+	hits := []model.SearchHit{{Part: testutils.CreatePart(), Score: 1.5}}
+
+	s.inventoryRepository.On("SearchParts", s.ctx, "fuel tank", defaultSearchLimit).Return(hits, nil)
+
+	res, err := s.service.SearchParts(s.ctx, "  fuel tank ", 0)
+	s.NoError(err)
+	s.Equal(hits, res)
+}
+
+func (s *ServiceSuite) TestSearchPartsCapsLimit() {
+	s.inventoryRepository.On("SearchParts", s.ctx, "engine", maxSearchLimit).Return([]model.SearchHit{}, nil)
+
+	_, err := s.service.SearchParts(s.ctx, "engine", 1000)
+	s.NoError(err)
+}
+
+func (s *ServiceSuite) TestSearchPartsEmptyQuery() {
+	res, err := s.service.SearchParts(s.ctx, "   ", 10)
+	s.ErrorIs(err, model.ErrInvalidSearchQuery)
+	s.Empty(res)
+}
+
+func (s *ServiceSuite) TestSearchPartsFail() {
+	repoErr := gofakeit.Error()
+
+	s.inventoryRepository.On("SearchParts", s.ctx, "engine", 10).Return(nil, repoErr)
+
+	res, err := s.service.SearchParts(s.ctx, "engine", 10)
+	s.ErrorIs(err, repoErr)
+	s.Empty(res)
+}
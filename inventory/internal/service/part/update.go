@@ -0,0 +1,20 @@
+package part
+
+import (
+	"context"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+func (s *service) UpdatePart(ctx context.Context, uuid string, info model.PartUpdateInfo) (model.Part, error) {
+	if err := validatePartUpdate(info); err != nil {
+		return model.Part{}, err
+	}
+
+	part, err := s.inventoryRepository.UpdatePart(ctx, uuid, info)
+	if err != nil {
+		return model.Part{}, err
+	}
+
+	return part, nil
+}
@@ -0,0 +1,47 @@
+package part
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func (s *ServiceSuite) TestUpdatePartSuccess() {
+	var (
+		uuid = gofakeit.UUID()
+		info = model.PartUpdateInfo{Price: lo.ToPtr(10.0)}
+		part = testutils.CreatePart()
+	)
+
+	s.inventoryRepository.On("UpdatePart", s.ctx, uuid, info).Return(part, nil)
+
+	res, err := s.service.UpdatePart(s.ctx, uuid, info)
+
+	s.NoError(err)
+	s.Equal(part, res)
+}
+
+func (s *ServiceSuite) TestUpdatePartNegativeStock() {
+	res, err := s.service.UpdatePart(s.ctx, gofakeit.UUID(), model.PartUpdateInfo{
+		StockQuantity: lo.ToPtr(int64(-5)),
+	})
+
+	s.ErrorIs(err, model.ErrInvalidPart)
+	s.Empty(res)
+}
+
+func (s *ServiceSuite) TestUpdatePartNotFound() {
+	var (
+		uuid = gofakeit.UUID()
+		info = model.PartUpdateInfo{Name: lo.ToPtr("Wing")}
+	)
+
+	s.inventoryRepository.On("UpdatePart", s.ctx, uuid, info).Return(model.Part{}, model.ErrPartNotFound)
+
+	res, err := s.service.UpdatePart(s.ctx, uuid, info)
+
+	s.ErrorIs(err, model.ErrPartNotFound)
+	s.Empty(res)
+}
@@ -0,0 +1,142 @@
+package part
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+var knownCategories = []model.Category{
+	model.CategoryEngine,
+	model.CategoryFuel,
+	model.CategoryPorthole,
+	model.CategoryWing,
+}
+
+// validatePart проверяет инварианты новой детали.
+func validatePart(part model.Part) error {
+	return validatePartUpdate(model.PartUpdateInfo{
+		Name:          &part.Name,
+		Description:   &part.Description,
+		Price:         &part.Price,
+		StockQuantity: &part.StockQuantity,
+		Category:      &part.Category,
+		Dimensions:    &part.Dimensions,
+		Manufacturer:  &part.Manufacturer,
+		Tags:          &part.Tags,
+		Metadata:      &part.Metadata,
+	})
+}
+
+// validatePartUpdate проверяет инварианты только для изменяемых полей.
+func validatePartUpdate(info model.PartUpdateInfo) error {
+	if info.Name != nil && strings.TrimSpace(*info.Name) == "" {
+		return invalidPart("name must not be empty")
+	}
+
+	if info.Price != nil && !isNonNegative(*info.Price) {
+		return invalidPart("price must not be negative")
+	}
+
+	if info.StockQuantity != nil && *info.StockQuantity < 0 {
+		return invalidPart("stock quantity must not be negative")
+	}
+
+	if info.Category != nil && !slices.Contains(knownCategories, *info.Category) {
+		return invalidPart("category must be specified")
+	}
+
+	if info.Dimensions != nil {
+		d := info.Dimensions
+		if !isNonNegative(d.Length) || !isNonNegative(d.Width) || !isNonNegative(d.Height) || !isNonNegative(d.Weight) {
+			return invalidPart("dimensions must not be negative")
+		}
+	}
+
+	if info.Tags != nil && slices.Contains(*info.Tags, "") {
+		return invalidPart("tags must not be empty")
+	}
+
+	if info.Metadata != nil {
+		for key, value := range *info.Metadata {
+			if key == "" {
+				return invalidPart("metadata key must not be empty")
+			}
+			if !isSingleMetadataValue(value) {
+				return invalidPart(fmt.Sprintf("metadata %q must have exactly one value", key))
+			}
+		}
+	}
+
+	return nil
+}
+
+func isSingleMetadataValue(value model.MetadataValue) bool {
+	return lo.Count([]bool{
+		value.StringValue != nil,
+		value.Int64Value != nil,
+		value.DoubleValue != nil,
+		value.BoolValue != nil,
+	}, true) == 1
+}
+
+func isNonNegative(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0) && v >= 0
+}
+
+func invalidPart(reason string) error {
+	return fmt.Errorf("%w: %s", model.ErrInvalidPart, reason)
+}
+
+// validatePartsFilter проверяет, что у диапазонов фильтра min не больше max.
+func validatePartsFilter(filter model.PartsFilter) error {
+	floatRanges := map[string]model.FloatRange{
+		"price":             filter.Price,
+		"dimensions.length": filter.Dimensions.Length,
+		"dimensions.width":  filter.Dimensions.Width,
+		"dimensions.height": filter.Dimensions.Height,
+		"dimensions.weight": filter.Dimensions.Weight,
+	}
+	for name, r := range floatRanges {
+		if (r.Min != nil && math.IsNaN(*r.Min)) || (r.Max != nil && math.IsNaN(*r.Max)) {
+			return invalidFilter(name + " range must not contain NaN")
+		}
+		if r.Min != nil && r.Max != nil && *r.Min > *r.Max {
+			return invalidFilter(name + " min must not exceed max")
+		}
+	}
+
+	if r := filter.StockQuantity; r.Min != nil && r.Max != nil && *r.Min > *r.Max {
+		return invalidFilter("stock_quantity min must not exceed max")
+	}
+
+	timeRanges := map[string]model.TimeRange{
+		"created_at": filter.CreatedAt,
+		"updated_at": filter.UpdatedAt,
+	}
+	for name, r := range timeRanges {
+		if r.From != nil && r.To != nil && r.From.After(*r.To) {
+			return invalidFilter(name + " from must not be after to")
+		}
+	}
+
+	for _, c := range filter.Metadata {
+		if c.Key == "" {
+			return invalidFilter("metadata key must not be empty")
+		}
+		if c.Value != nil && !isSingleMetadataValue(*c.Value) {
+			return invalidFilter(fmt.Sprintf("metadata %q condition must have exactly one value", c.Key))
+		}
+	}
+
+	return nil
+}
+
+func invalidFilter(reason string) error {
+	return fmt.Errorf("%w: %s", model.ErrInvalidPartsFilter, reason)
+}
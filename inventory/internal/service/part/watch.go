@@ -0,0 +1,119 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+)
+
+const (
+	// watchBatchSize - сколько событий читается из хранилища за раз
+	watchBatchSize = 100
+	// watchPollInterval - как часто проверять новые события, если уведомление не пришло
+	// (например, каталог изменил другой экземпляр сервиса)
+	watchPollInterval = time.Second
+)
+
+// WatchParts передаёт в send события каталога после ревизии afterRevision (nil - только новые),
+// пока не отменён ctx или send не вернёт ошибку.
+func (s *service) WatchParts(ctx context.Context, filter model.PartsFilter, afterRevision *int64, send func(model.PartEvent) error) error {
+	if err := validatePartsFilter(filter); err != nil {
+		return err
+	}
+
+	// Архивация - тоже изменение детали: подписчик должен узнать, что деталь снята с продажи
+	filter.IncludeArchived = true
+
+	var after int64
+	if afterRevision != nil {
+		after = *afterRevision
+	} else {
+		revision, err := s.inventoryRepository.PartsRevision(ctx)
+		if err != nil {
+			return err
+		}
+		after = revision
+	}
+
+	// matched - детали, которые подписчик видит под фильтром. Начальный набор берётся из текущего
+	// каталога (читается после ревизии, так что повтор событий его лишь уточнит): когда изменённая
+	// деталь выпадает из фильтра, подписчик получает removed вместо молчаливого пропуска
+	matched, err := s.matchedParts(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	for {
+		// Канал берём до чтения, чтобы не пропустить изменение между чтением и ожиданием
+		changed := s.inventoryRepository.WaitPartEvents()
+
+		events, err := s.inventoryRepository.PartEvents(ctx, after, watchBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			after = event.Revision
+			event, ok := watchEvent(event, filter, matched)
+			if !ok {
+				continue
+			}
+			if err = send(event); err != nil {
+				return err
+			}
+		}
+
+		if len(events) == watchBatchSize {
+			continue
+		}
+
+		timer := time.NewTimer(watchPollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-changed:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// matchedParts возвращает UUID деталей каталога, подходящих под фильтр
+func (s *service) matchedParts(ctx context.Context, filter model.PartsFilter) (map[string]struct{}, error) {
+	page, err := s.inventoryRepository.ListParts(ctx, filter, model.PartsPageRequest{})
+	if err != nil && !errors.Is(err, model.ErrPartsNotFound) {
+		return nil, err
+	}
+
+	matched := make(map[string]struct{}, len(page.Parts))
+	for _, part := range page.Parts {
+		matched[part.UUID] = struct{}{}
+	}
+
+	return matched, nil
+}
+
+// watchEvent решает, что отправить подписчику по событию каталога, и обновляет matched.
+// Событие детали, которая под фильтр не подходила и не подходит, пропускается.
+func watchEvent(event model.PartEvent, filter model.PartsFilter, matched map[string]struct{}) (model.PartEvent, bool) {
+	_, wasMatched := matched[event.Part.UUID]
+	isMatched := filter.Match(event.Part)
+
+	switch {
+	case event.Type == model.PartEventDeleted:
+		delete(matched, event.Part.UUID)
+		return event, wasMatched || isMatched
+	case isMatched:
+		matched[event.Part.UUID] = struct{}{}
+		return event, true
+	case wasMatched:
+		delete(matched, event.Part.UUID)
+		event.Type = model.PartEventRemoved
+		return event, true
+	default:
+		return event, false
+	}
+}
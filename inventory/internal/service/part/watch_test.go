@@ -0,0 +1,146 @@
+package part
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
+	"github.com/baryshnikkov/rocket-factory/inventory/internal/testutils"
+)
+
+func partEvent(revision int64, eventType model.PartEventType, part model.Part) model.PartEvent {
+	return model.PartEvent{Revision: revision, Type: eventType, Part: part}
+}
+
+func (s *ServiceSuite) TestWatchPartsResumesAndFilters() {
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	engine := testutils.CreatePart()
+	wing := testutils.CreatePart()
+	wing.Category = model.CategoryWing
+	archived := engine
+	archived.Archived = true
+
+	filter := model.PartsFilter{Categories: []model.Category{model.CategoryEngine}}
+	watched := filter
+	watched.IncludeArchived = true
+
+	s.inventoryRepository.On("ListParts", mock.Anything, watched, model.PartsPageRequest{}).
+		Return(model.PartsPage{}, model.ErrPartsNotFound)
+	s.inventoryRepository.On("WaitPartEvents").Return((<-chan struct{})(make(chan struct{})))
+	s.inventoryRepository.On("PartEvents", mock.Anything, int64(2), watchBatchSize).Return([]model.PartEvent{
+		partEvent(3, model.PartEventUpdated, engine),
+		partEvent(4, model.PartEventCreated, wing),
+		partEvent(5, model.PartEventUpdated, archived),
+	}, nil)
+
+	var got []model.PartEvent
+	err := s.service.WatchParts(ctx, filter, lo.ToPtr(int64(2)),
+		func(event model.PartEvent) error {
+			got = append(got, event)
+			if len(got) == 2 {
+				cancel()
+			}
+			return nil
+		},
+	)
+
+	s.Require().ErrorIs(err, context.Canceled)
+	s.Require().Equal([]int64{3, 5}, lo.Map(got, func(e model.PartEvent, _ int) int64 { return e.Revision }))
+	s.Require().True(got[1].Part.Archived)
+}
+
+func (s *ServiceSuite) TestWatchPartsSendsRemovedWhenPartLeavesFilter() {
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	engine := testutils.CreatePart()
+	movedOut := engine
+	movedOut.Category = model.CategoryWing
+	stranger := testutils.CreatePart()
+	stranger.Category = model.CategoryWing
+
+	filter := model.PartsFilter{Categories: []model.Category{model.CategoryEngine}}
+	watched := filter
+	watched.IncludeArchived = true
+
+	s.inventoryRepository.On("ListParts", mock.Anything, watched, model.PartsPageRequest{}).
+		Return(model.PartsPage{Parts: []model.Part{engine}, TotalSize: 1}, nil)
+	s.inventoryRepository.On("WaitPartEvents").Return((<-chan struct{})(make(chan struct{})))
+	s.inventoryRepository.On("PartEvents", mock.Anything, int64(2), watchBatchSize).Return([]model.PartEvent{
+		partEvent(3, model.PartEventUpdated, stranger),
+		partEvent(4, model.PartEventUpdated, movedOut),
+		partEvent(5, model.PartEventUpdated, movedOut),
+		partEvent(6, model.PartEventUpdated, engine),
+	}, nil)
+
+	var got []model.PartEvent
+	err := s.service.WatchParts(ctx, filter, lo.ToPtr(int64(2)), func(event model.PartEvent) error {
+		got = append(got, event)
+		if len(got) == 2 {
+			cancel()
+		}
+		return nil
+	})
+
+	s.Require().ErrorIs(err, context.Canceled)
+	s.Require().Equal([]int64{4, 6}, lo.Map(got, func(e model.PartEvent, _ int) int64 { return e.Revision }))
+	s.Require().Equal(model.PartEventRemoved, got[0].Type)
+	s.Require().Equal(model.CategoryWing, got[0].Part.Category)
+	s.Require().Equal(model.PartEventUpdated, got[1].Type)
+}
+
+func (s *ServiceSuite) TestWatchPartsFromLatestRevisionWaitsForChanges() {
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	changed := make(chan struct{})
+	close(changed)
+	part := testutils.CreatePart()
+
+	s.inventoryRepository.On("PartsRevision", mock.Anything).Return(int64(7), nil)
+	s.inventoryRepository.On("ListParts", mock.Anything, model.PartsFilter{IncludeArchived: true}, model.PartsPageRequest{}).
+		Return(model.PartsPage{Parts: []model.Part{part}, TotalSize: 1}, nil)
+	s.inventoryRepository.On("WaitPartEvents").Return((<-chan struct{})(changed))
+	s.inventoryRepository.On("PartEvents", mock.Anything, int64(7), watchBatchSize).Return(nil, nil).Once()
+	s.inventoryRepository.On("PartEvents", mock.Anything, int64(7), watchBatchSize).
+		Return([]model.PartEvent{partEvent(8, model.PartEventDeleted, part)}, nil).Once()
+	// После отмены цикл может успеть ещё раз прочитать события: уведомление уже пришло
+	s.inventoryRepository.On("PartEvents", mock.Anything, int64(8), watchBatchSize).Return(nil, nil).Maybe()
+
+	var got []model.PartEvent
+	err := s.service.WatchParts(ctx, model.PartsFilter{}, nil, func(event model.PartEvent) error {
+		got = append(got, event)
+		cancel()
+		return nil
+	})
+
+	s.Require().ErrorIs(err, context.Canceled)
+	s.Require().Len(got, 1)
+	s.Require().Equal(model.PartEventDeleted, got[0].Type)
+}
+
+func (s *ServiceSuite) TestWatchPartsCompactedRevision() {
+	s.inventoryRepository.On("WaitPartEvents").Return((<-chan struct{})(make(chan struct{})))
+	s.inventoryRepository.On("ListParts", s.ctx, model.PartsFilter{IncludeArchived: true}, model.PartsPageRequest{}).
+		Return(model.PartsPage{}, model.ErrPartsNotFound)
+	s.inventoryRepository.On("PartEvents", s.ctx, int64(1), watchBatchSize).Return(nil, model.ErrRevisionCompacted)
+
+	err := s.service.WatchParts(s.ctx, model.PartsFilter{}, lo.ToPtr(int64(1)), func(model.PartEvent) error {
+		s.Fail("no events expected")
+		return nil
+	})
+
+	s.Require().ErrorIs(err, model.ErrRevisionCompacted)
+}
+
+func (s *ServiceSuite) TestWatchPartsInvalidFilter() {
+	filter := model.PartsFilter{Price: model.FloatRange{Min: lo.ToPtr(10.0), Max: lo.ToPtr(1.0)}}
+
+	err := s.service.WatchParts(s.ctx, filter, nil, func(model.PartEvent) error { return nil })
+
+	s.Require().ErrorIs(err, model.ErrInvalidPartsFilter)
+}
@@ -2,11 +2,25 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/baryshnikkov/rocket-factory/inventory/internal/model"
 )
 
 type InventoryService interface {
 	GetPart(ctx context.Context, orderUUID string) (model.Part, error)
-	ListParts(ctx context.Context, filter model.PartsFilter) ([]model.Part, error)
+	ListParts(ctx context.Context, filter model.PartsFilter, params model.ListPartsParams) (model.PartsList, error)
+	BatchGetParts(ctx context.Context, uuids []string) (model.PartsBatch, error)
+	SearchParts(ctx context.Context, query string, limit int32) ([]model.SearchHit, error)
+	GetPartFacets(ctx context.Context, filter model.PartsFilter) (model.PartFacets, error)
+	CreatePart(ctx context.Context, part model.Part) (model.Part, error)
+	UpdatePart(ctx context.Context, uuid string, info model.PartUpdateInfo) (model.Part, error)
+	ArchivePart(ctx context.Context, uuid string) (model.Part, error)
+	DeletePart(ctx context.Context, uuid string) error
+	ExportParts(ctx context.Context) ([]model.Part, error)
+	ImportParts(ctx context.Context, rows []model.ImportRow, opts model.ImportOptions) (model.ImportReport, error)
+	WatchParts(ctx context.Context, filter model.PartsFilter, afterRevision *int64, send func(model.PartEvent) error) error
+	ReserveParts(ctx context.Context, items []model.ReservationItem, ttl time.Duration) (model.Reservation, error)
+	ReleaseReservation(ctx context.Context, reservationUUID string) error
+	CommitReservation(ctx context.Context, reservationUUID string) error
 }
@@ -30,7 +30,7 @@ func CreatePart() model.Part {
 		},
 		Tags: []string{gofakeit.EmojiTag(), gofakeit.EmojiTag()},
 		Metadata: model.Metadata{
-			StringValue: lo.ToPtr(gofakeit.Word()),
+			"material": {StringValue: lo.ToPtr(gofakeit.Word())},
 		},
 		CreatedAt: gofakeit.Date(),
 		UpdatedAt: lo.ToPtr(gofakeit.Date()),
@@ -59,7 +59,7 @@ func CreateRepoPart() repoModel.Part {
 		},
 		Tags: []string{gofakeit.EmojiTag(), gofakeit.EmojiTag()},
 		Metadata: repoModel.Metadata{
-			StringValue: lo.ToPtr(gofakeit.Word()),
+			"material": {StringValue: lo.ToPtr(gofakeit.Word())},
 		},
 		CreatedAt: gofakeit.Date(),
 		UpdatedAt: lo.ToPtr(gofakeit.Date()),
@@ -0,0 +1,39 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS parts (
+    uuid                 VARCHAR(36)      PRIMARY KEY,
+    name                 VARCHAR(255)     NOT NULL,
+    description          TEXT             NOT NULL,
+    price                DOUBLE PRECISION NOT NULL,
+    stock_quantity       BIGINT           NOT NULL,
+    category             VARCHAR(32)      NOT NULL,
+    length               DOUBLE PRECISION NOT NULL,
+    width                DOUBLE PRECISION NOT NULL,
+    height               DOUBLE PRECISION NOT NULL,
+    weight               DOUBLE PRECISION NOT NULL,
+    manufacturer_name    VARCHAR(255)     NOT NULL,
+    manufacturer_country VARCHAR(255)     NOT NULL,
+    manufacturer_website VARCHAR(255)     NOT NULL,
+    metadata_string      TEXT,
+    metadata_int64       BIGINT,
+    metadata_double      DOUBLE PRECISION,
+    metadata_bool        BOOLEAN,
+    created_at           TIMESTAMP        NOT NULL,
+    updated_at           TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS parts_name_idx ON parts (name);
+CREATE INDEX IF NOT EXISTS parts_category_idx ON parts (category);
+CREATE INDEX IF NOT EXISTS parts_manufacturer_country_idx ON parts (manufacturer_country);
+
+CREATE TABLE IF NOT EXISTS part_tags (
+    part_uuid VARCHAR(36)  NOT NULL REFERENCES parts (uuid) ON DELETE CASCADE,
+    tag_index INTEGER      NOT NULL,
+    tag       VARCHAR(255) NOT NULL,
+    PRIMARY KEY (part_uuid, tag_index)
+);
+
+CREATE INDEX IF NOT EXISTS part_tags_tag_idx ON part_tags (tag);
+
+-- +goose Down
+DROP TABLE IF EXISTS part_tags;
+DROP TABLE IF EXISTS parts;
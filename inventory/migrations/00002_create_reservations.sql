@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS reservations (
+    uuid       VARCHAR(36) PRIMARY KEY,
+    status     VARCHAR(32) NOT NULL,
+    expires_at TIMESTAMP   NOT NULL,
+    created_at TIMESTAMP   NOT NULL,
+    updated_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS reservations_status_expires_at_idx ON reservations (status, expires_at);
+
+CREATE TABLE IF NOT EXISTS reservation_items (
+    reservation_uuid VARCHAR(36) NOT NULL REFERENCES reservations (uuid) ON DELETE CASCADE,
+    item_index       INTEGER     NOT NULL,
+    part_uuid        VARCHAR(36) NOT NULL,
+    quantity         BIGINT      NOT NULL,
+    PRIMARY KEY (reservation_uuid, item_index)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS reservation_items;
+DROP TABLE IF EXISTS reservations;
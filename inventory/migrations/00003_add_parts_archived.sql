@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE parts ADD COLUMN archived BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE parts DROP COLUMN archived;
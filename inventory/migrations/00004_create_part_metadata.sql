@@ -0,0 +1,35 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS part_metadata (
+    part_uuid    VARCHAR(36)  NOT NULL REFERENCES parts (uuid) ON DELETE CASCADE,
+    key          VARCHAR(255) NOT NULL,
+    string_value TEXT,
+    int64_value  BIGINT,
+    double_value DOUBLE PRECISION,
+    bool_value   BOOLEAN,
+    PRIMARY KEY (part_uuid, key)
+);
+
+CREATE INDEX IF NOT EXISTS part_metadata_key_idx ON part_metadata (key);
+
+-- Прежние колонки хранили значения без ключей: переносим их под именами полей Value
+INSERT INTO part_metadata (part_uuid, key, string_value)
+SELECT uuid, 'string_value', metadata_string FROM parts WHERE metadata_string IS NOT NULL;
+INSERT INTO part_metadata (part_uuid, key, int64_value)
+SELECT uuid, 'int64_value', metadata_int64 FROM parts WHERE metadata_int64 IS NOT NULL;
+INSERT INTO part_metadata (part_uuid, key, double_value)
+SELECT uuid, 'double_value', metadata_double FROM parts WHERE metadata_double IS NOT NULL;
+INSERT INTO part_metadata (part_uuid, key, bool_value)
+SELECT uuid, 'bool_value', metadata_bool FROM parts WHERE metadata_bool IS NOT NULL;
+
+ALTER TABLE parts DROP COLUMN metadata_string;
+ALTER TABLE parts DROP COLUMN metadata_int64;
+ALTER TABLE parts DROP COLUMN metadata_double;
+ALTER TABLE parts DROP COLUMN metadata_bool;
+
+-- +goose Down
+ALTER TABLE parts ADD COLUMN metadata_string TEXT;
+ALTER TABLE parts ADD COLUMN metadata_int64 BIGINT;
+ALTER TABLE parts ADD COLUMN metadata_double DOUBLE PRECISION;
+ALTER TABLE parts ADD COLUMN metadata_bool BOOLEAN;
+
+DROP TABLE IF EXISTS part_metadata;
@@ -0,0 +1,21 @@
+-- +goose Up
+-- Единственная строка со счётчиком ревизий. Запись увеличивает его внутри своей транзакции
+-- и держит блокировку строки до коммита, поэтому ревизии видны читателям строго по порядку.
+CREATE TABLE IF NOT EXISTS part_revision (
+    id       INTEGER PRIMARY KEY,
+    revision BIGINT  NOT NULL
+);
+
+INSERT INTO part_revision (id, revision) VALUES (1, 0);
+
+CREATE TABLE IF NOT EXISTS part_events (
+    revision    BIGINT      PRIMARY KEY,
+    type        VARCHAR(16) NOT NULL,
+    part_uuid   VARCHAR(36) NOT NULL,
+    part        TEXT        NOT NULL,
+    occurred_at TIMESTAMP   NOT NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS part_events;
+DROP TABLE IF EXISTS part_revision;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Закрытые резервы удаляются по сроку хранения, поиск идёт по статусу и времени закрытия
+CREATE INDEX IF NOT EXISTS reservations_status_updated_at_idx ON reservations (status, updated_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS reservations_status_updated_at_idx;
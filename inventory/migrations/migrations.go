@@ -0,0 +1,8 @@
+package migrations
+
+import "embed"
+
+// FS содержит версионированные SQL-миграции (формат goose) каталога деталей.
+//
+//go:embed *.sql
+var FS embed.FS
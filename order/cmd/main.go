@@ -20,8 +20,13 @@ import (
 	inventoryV1Client "github.com/baryshnikkov/rocket-factory/order/internal/client/grpc/inventory/v1"
 	paymentV1Client "github.com/baryshnikkov/rocket-factory/order/internal/client/grpc/payment/v1"
 	customMiddleware "github.com/baryshnikkov/rocket-factory/order/internal/middleware"
+	"github.com/baryshnikkov/rocket-factory/order/internal/repository"
+	orderDatabaseRepository "github.com/baryshnikkov/rocket-factory/order/internal/repository/database"
 	orderRepository "github.com/baryshnikkov/rocket-factory/order/internal/repository/order"
 	orderService "github.com/baryshnikkov/rocket-factory/order/internal/service/order"
+	"github.com/baryshnikkov/rocket-factory/order/internal/worker/expiration"
+	"github.com/baryshnikkov/rocket-factory/order/migrations"
+	sharedDatabase "github.com/baryshnikkov/rocket-factory/shared/pkg/database"
 	orderV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/openapi/order/v1"
 	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
 	paymentV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/payment/v1"
@@ -34,9 +39,33 @@ const (
 
 	grpcInventory = "localhost:50051"
 	grpcPayment   = "localhost:50052"
+
+	// Хранилище заказов: memory (по умолчанию), postgres или sqlite
+	storageEnv = "ORDER_STORAGE"
+	// Строка подключения к базе для postgres/sqlite
+	databaseDSNEnv = "ORDER_DATABASE_DSN"
+
+	// Срок, после которого неоплаченный заказ отменяется, например 30m
+	pendingTTLEnv     = "ORDER_PENDING_TTL"
+	defaultPendingTTL = 15 * time.Minute
+	// Как часто искать просроченные заказы
+	expiryIntervalEnv     = "ORDER_EXPIRY_INTERVAL"
+	defaultExpiryInterval = time.Minute
 )
 
 func main() {
+	// Создаем хранилище для данных о заказах
+	repository, idempotencyRepository, closeRepository, err := newRepository(context.Background())
+	if err != nil {
+		log.Printf("failed to init order repository: %v\n", err)
+		return
+	}
+	defer func() {
+		if err := closeRepository(); err != nil {
+			log.Printf("failed to close order repository: %v\n", err)
+		}
+	}()
+
 	// Подключение к gRPC Inventory-сервису
 	inventoryConn, err := grpc.NewClient(
 		grpcInventory,
@@ -60,11 +89,24 @@ func main() {
 
 	paymentClient := paymentV1Client.NewClient(paymentV1.NewPaymentServiceClient(paymentConn))
 
-	// Создаем хранилище для данных о заказах
-	repository := orderRepository.NewRepository()
 	service := orderService.NewService(repository, inventoryClient, paymentClient)
 	api := orderV1API.NewAPI(service)
 
+	pendingTTL, err := durationFromEnv(pendingTTLEnv, defaultPendingTTL)
+	if err != nil {
+		log.Printf("invalid %s: %v\n", pendingTTLEnv, err)
+		return
+	}
+	expiryInterval, err := durationFromEnv(expiryIntervalEnv, defaultExpiryInterval)
+	if err != nil {
+		log.Printf("invalid %s: %v\n", expiryIntervalEnv, err)
+		return
+	}
+
+	// Воркер отменяет заказы, не оплаченные за pendingTTL
+	expirationWorker := expiration.NewWorker(service, expiration.SystemClock(), pendingTTL, expiryInterval)
+	expirationWorker.Start(context.Background())
+
 	// Создаем OpenAPI сервер
 	orderServer, err := orderV1.NewServer(api)
 	if err != nil {
@@ -95,6 +137,7 @@ func main() {
 	r.Use(middleware.Recoverer)
 	r.Use(customMiddleware.RequestLogger)
 	r.Use(middleware.Timeout(10 * time.Second))
+	r.Use(customMiddleware.Idempotency(idempotencyRepository))
 
 	r.Mount("/", orderServer)
 
@@ -132,5 +175,46 @@ func main() {
 		log.Printf("❌ Ошибка при остановке сервера: %v\n", err)
 	}
 
+	err = expirationWorker.Stop(ctx)
+	if err != nil {
+		log.Printf("❌ Ошибка при остановке воркера просроченных заказов: %v\n", err)
+	}
+
 	log.Println("✅ Сервер остановлен")
 }
+
+// newRepository выбирает реализацию OrderRepository по переменной окружения ORDER_STORAGE.
+// Ключи идемпотентности хранятся там же, где и заказы.
+func newRepository(ctx context.Context) (repository.OrderRepository, repository.IdempotencyRepository, func() error, error) {
+	db, err := sharedDatabase.OpenFromEnv(ctx, storageEnv, databaseDSNEnv, migrations.FS)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if db == nil {
+		repo := orderRepository.NewRepository()
+		return repo, repo, func() error { return nil }, nil
+	}
+
+	log.Printf("📦 Хранилище заказов: %s\n", os.Getenv(storageEnv))
+
+	repo := orderDatabaseRepository.NewRepository(db)
+	return repo, repo, db.Close, nil
+}
+
+// durationFromEnv читает длительность из переменной окружения; пустое значение означает def
+func durationFromEnv(env string, def time.Duration) (time.Duration, error) {
+	value := os.Getenv(env)
+	if value == "" {
+		return def, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("must be positive, got %s", value)
+	}
+
+	return d, nil
+}
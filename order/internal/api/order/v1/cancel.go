@@ -27,6 +27,26 @@ func (a *api) CancelOrder(ctx context.Context, params orderV1.CancelOrderParams)
 				Code:    409,
 				Message: "Заказ уже отменён",
 			}, nil
+		case errors.Is(err, model.ErrOrderConflict):
+			return &orderV1.ConflictError{
+				Code:    409,
+				Message: "Заказ нельзя отменить в текущем статусе",
+			}, nil
+		case errors.Is(err, model.ErrRefundRejected), errors.Is(err, model.ErrPaymentNotFound):
+			return &orderV1.ValidationError{
+				Code:    422,
+				Message: "Платёжный сервис отклонил возврат оплаты",
+			}, nil
+		case errors.Is(err, model.ErrPaymentUnavailable):
+			return &orderV1.ServiceUnavailableError{
+				Code:    503,
+				Message: "Платёжный сервис недоступен, повторите отмену позже",
+			}, nil
+		case errors.Is(err, model.ErrRefundFailed):
+			return &orderV1.BadGatewayError{
+				Code:    502,
+				Message: "Ошибка платёжного сервиса при возврате оплаты",
+			}, nil
 		default:
 			return &orderV1.InternalServerError{
 				Code:    500,
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/google/uuid"
 
@@ -124,3 +125,37 @@ func (s *APISuite) TestCancelOrderInternalError() {
 	s.Require().Equal(expectedResponse.Code, res.(*orderV1.InternalServerError).Code)
 	s.Require().Equal(expectedResponse.Message, res.(*orderV1.InternalServerError).Message)
 }
+
+func (s *APISuite) TestCancelOrderConflict() {
+	uuidData := uuid.New()
+
+	s.orderService.On("CancelOrder", s.ctx, uuidData.String()).Return(model.ErrOrderConflict)
+
+	res, err := s.api.CancelOrder(s.ctx, orderV1.CancelOrderParams{OrderUUID: uuidData})
+
+	s.Require().NoError(err)
+	s.Require().IsType(&orderV1.ConflictError{}, res)
+	s.Require().Equal(409, res.(*orderV1.ConflictError).Code)
+}
+
+func (s *APISuite) TestCancelOrderRefundErrors() {
+	tests := []struct {
+		err      error
+		expected orderV1.CancelOrderRes
+	}{
+		{model.ErrRefundRejected, &orderV1.ValidationError{}},
+		{model.ErrPaymentNotFound, &orderV1.ValidationError{}},
+		{model.ErrPaymentUnavailable, &orderV1.ServiceUnavailableError{}},
+		{fmt.Errorf("%w: %w", model.ErrRefundFailed, errors.New("boom")), &orderV1.BadGatewayError{}},
+	}
+
+	for _, tt := range tests {
+		uuidData := uuid.New()
+		s.orderService.On("CancelOrder", s.ctx, uuidData.String()).Return(tt.err)
+
+		res, err := s.api.CancelOrder(s.ctx, orderV1.CancelOrderParams{OrderUUID: uuidData})
+
+		s.Require().NoError(err)
+		s.Require().IsType(tt.expected, res, tt.err.Error())
+	}
+}
@@ -5,18 +5,35 @@ import (
 	"errors"
 	"net/http"
 
+	"github.com/google/uuid"
+
 	"github.com/baryshnikkov/rocket-factory/order/internal/converter"
 	"github.com/baryshnikkov/rocket-factory/order/internal/model"
 	orderV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/openapi/order/v1"
 )
 
-func (a *api) CreateOrder(ctx context.Context, req *orderV1.CreateOrderRequest) (orderV1.CreateOrderRes, error) {
-	orderInfo, err := a.orderService.CreateOrder(ctx, req.GetUserUUID().String(), converter.UUIDsToStrings(req.GetPartUuids()))
+// CreateOrder создаёт заказ. Заголовок Idempotency-Key обрабатывает middleware.Idempotency.
+func (a *api) CreateOrder(ctx context.Context, req *orderV1.CreateOrderRequest, _ orderV1.CreateOrderParams) (orderV1.CreateOrderRes, error) {
+	orderInfo, err := a.orderService.CreateOrder(ctx, req.GetUserUUID().String(), converter.CreateOrderItemsToModel(req))
 	if err != nil {
 		if errors.Is(err, model.ErrPartsNotFound) {
 			return &orderV1.NotFoundError{
-				Code:    http.StatusNotFound,
-				Message: "Одна или несколько частей не найдены",
+				Code:      http.StatusNotFound,
+				Message:   "Одна или несколько частей не найдены",
+				PartUuids: partUUIDs(err),
+			}, nil
+		}
+		if errors.Is(err, model.ErrPartsUnavailable) {
+			return &orderV1.ValidationError{
+				Code:      http.StatusUnprocessableEntity,
+				Message:   "Одна или несколько частей сняты с продажи",
+				PartUuids: partUUIDs(err),
+			}, nil
+		}
+		if errors.Is(err, model.ErrInsufficientStock) {
+			return &orderV1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: "Недостаточно деталей на складе",
 			}, nil
 		}
 		return nil, err
@@ -27,3 +44,12 @@ func (a *api) CreateOrder(ctx context.Context, req *orderV1.CreateOrderRequest)
 		TotalPrice: orderInfo.TotalPrice,
 	}, nil
 }
+
+// partUUIDs возвращает UUID деталей из ошибки сервиса, если она их содержит.
+func partUUIDs(err error) []uuid.UUID {
+	var partsErr *model.PartsError
+	if !errors.As(err, &partsErr) {
+		return nil
+	}
+	return converter.StringsToUUIDs(partsErr.UUIDs)
+}
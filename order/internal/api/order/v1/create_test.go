@@ -36,14 +36,14 @@ func (s *APISuite) TestCreateOrderSuccess() {
 		}
 	)
 
-	partUUIDStrings := make([]string, len(partUUIDs))
+	items := make([]model.CreateOrderItem, len(partUUIDs))
 	for i, u := range partUUIDs {
-		partUUIDStrings[i] = u.String()
+		items[i] = model.CreateOrderItem{PartUUID: u.String(), Quantity: 1}
 	}
 
-	s.orderService.On("CreateOrder", s.ctx, userUUID.String(), partUUIDStrings).Return(orderInfo, nil)
+	s.orderService.On("CreateOrder", s.ctx, userUUID.String(), items).Return(orderInfo, nil)
 
-	res, err := s.api.CreateOrder(s.ctx, req)
+	res, err := s.api.CreateOrder(s.ctx, req, orderV1.CreateOrderParams{})
 
 	s.Require().NoError(err)
 	s.Require().NotNil(res)
@@ -52,6 +52,38 @@ func (s *APISuite) TestCreateOrderSuccess() {
 	s.Require().Equal(expectedResponse.TotalPrice, res.(*orderV1.CreateOrderResponse).TotalPrice)
 }
 
+func (s *APISuite) TestCreateOrderItemsAndLegacyPartUUIDs() {
+	var (
+		userUUID   = uuid.New()
+		legacyUUID = uuid.New()
+		itemUUID   = uuid.New()
+
+		req = &orderV1.CreateOrderRequest{
+			UserUUID:  userUUID,
+			PartUuids: []uuid.UUID{legacyUUID, legacyUUID},
+			Items:     []orderV1.CreateOrderItem{{PartUUID: itemUUID, Quantity: 4}},
+		}
+
+		orderInfo = model.OrderCreationInfo{
+			OrderUUID:  uuid.New().String(),
+			TotalPrice: 6000,
+		}
+	)
+
+	// Каждый UUID из part_uuids — одна единица; объединяет повторы сервис
+	s.orderService.On("CreateOrder", s.ctx, userUUID.String(), []model.CreateOrderItem{
+		{PartUUID: legacyUUID.String(), Quantity: 1},
+		{PartUUID: legacyUUID.String(), Quantity: 1},
+		{PartUUID: itemUUID.String(), Quantity: 4},
+	}).Return(orderInfo, nil)
+
+	res, err := s.api.CreateOrder(s.ctx, req, orderV1.CreateOrderParams{})
+
+	s.Require().NoError(err)
+	s.Require().IsType(&orderV1.CreateOrderResponse{}, res)
+	s.Require().Equal(orderInfo.TotalPrice, res.(*orderV1.CreateOrderResponse).TotalPrice)
+}
+
 func (s *APISuite) TestCreateOrderPartsNotFound() {
 	var (
 		userUUID  = uuid.New()
@@ -65,27 +97,73 @@ func (s *APISuite) TestCreateOrderPartsNotFound() {
 			},
 		}
 
-		expectedErr      = model.ErrPartsNotFound
+		expectedErr      = &model.PartsError{Err: model.ErrPartsNotFound, UUIDs: []string{partUUIDs[1].String()}}
 		expectedResponse = orderV1.NotFoundError{
-			Code:    http.StatusNotFound,
-			Message: "Одна или несколько частей не найдены",
+			Code:      http.StatusNotFound,
+			Message:   "Одна или несколько частей не найдены",
+			PartUuids: []uuid.UUID{partUUIDs[1]},
 		}
 	)
 
-	partUUIDStrings := make([]string, len(partUUIDs))
+	items := make([]model.CreateOrderItem, len(partUUIDs))
 	for i, u := range partUUIDs {
-		partUUIDStrings[i] = u.String()
+		items[i] = model.CreateOrderItem{PartUUID: u.String(), Quantity: 1}
 	}
 
-	s.orderService.On("CreateOrder", s.ctx, userUUID.String(), partUUIDStrings).Return(model.OrderCreationInfo{}, expectedErr)
+	s.orderService.On("CreateOrder", s.ctx, userUUID.String(), items).Return(model.OrderCreationInfo{}, expectedErr)
 
-	res, err := s.api.CreateOrder(s.ctx, req)
+	res, err := s.api.CreateOrder(s.ctx, req, orderV1.CreateOrderParams{})
 
 	s.Require().NoError(err)
 	s.Require().NotNil(res)
 	s.Require().IsType(&orderV1.NotFoundError{}, res)
 	s.Require().Equal(expectedResponse.Code, res.(*orderV1.NotFoundError).Code)
 	s.Require().Equal(expectedResponse.Message, res.(*orderV1.NotFoundError).Message)
+	s.Require().Equal(expectedResponse.PartUuids, res.(*orderV1.NotFoundError).PartUuids)
+}
+
+func (s *APISuite) TestCreateOrderPartsUnavailable() {
+	var (
+		userUUID = uuid.New()
+		partUUID = uuid.New()
+
+		req = &orderV1.CreateOrderRequest{
+			UserUUID: converter.StringToUUID(userUUID.String()),
+			Items:    []orderV1.CreateOrderItem{{PartUUID: partUUID, Quantity: 2}},
+		}
+	)
+
+	s.orderService.On("CreateOrder", s.ctx, userUUID.String(), []model.CreateOrderItem{{PartUUID: partUUID.String(), Quantity: 2}}).
+		Return(model.OrderCreationInfo{}, &model.PartsError{Err: model.ErrPartsUnavailable, UUIDs: []string{partUUID.String()}})
+
+	res, err := s.api.CreateOrder(s.ctx, req, orderV1.CreateOrderParams{})
+
+	s.Require().NoError(err)
+	s.Require().IsType(&orderV1.ValidationError{}, res)
+	s.Require().Equal(http.StatusUnprocessableEntity, res.(*orderV1.ValidationError).Code)
+	s.Require().Equal([]uuid.UUID{partUUID}, res.(*orderV1.ValidationError).PartUuids)
+}
+
+func (s *APISuite) TestCreateOrderReservedPartNotFound() {
+	var (
+		userUUID = uuid.New()
+		partUUID = uuid.New()
+
+		req = &orderV1.CreateOrderRequest{
+			UserUUID:  converter.StringToUUID(userUUID.String()),
+			PartUuids: []uuid.UUID{partUUID},
+		}
+	)
+
+	// Деталь удалили между проверкой и резервированием: UUID в ошибке нет
+	s.orderService.On("CreateOrder", s.ctx, userUUID.String(), []model.CreateOrderItem{{PartUUID: partUUID.String(), Quantity: 1}}).
+		Return(model.OrderCreationInfo{}, model.ErrPartsNotFound)
+
+	res, err := s.api.CreateOrder(s.ctx, req, orderV1.CreateOrderParams{})
+
+	s.Require().NoError(err)
+	s.Require().IsType(&orderV1.NotFoundError{}, res)
+	s.Require().Empty(res.(*orderV1.NotFoundError).PartUuids)
 }
 
 func (s *APISuite) TestCreateOrderInternalError() {
@@ -103,16 +181,38 @@ func (s *APISuite) TestCreateOrderInternalError() {
 		expectedErr = errors.New("database error")
 	)
 
-	partUUIDStrings := make([]string, len(partUUIDs))
+	items := make([]model.CreateOrderItem, len(partUUIDs))
 	for i, u := range partUUIDs {
-		partUUIDStrings[i] = u.String()
+		items[i] = model.CreateOrderItem{PartUUID: u.String(), Quantity: 1}
 	}
 
-	s.orderService.On("CreateOrder", s.ctx, userUUID.String(), partUUIDStrings).Return(model.OrderCreationInfo{}, expectedErr)
+	s.orderService.On("CreateOrder", s.ctx, userUUID.String(), items).Return(model.OrderCreationInfo{}, expectedErr)
 
-	res, err := s.api.CreateOrder(s.ctx, req)
+	res, err := s.api.CreateOrder(s.ctx, req, orderV1.CreateOrderParams{})
 
 	s.Require().Error(err)
 	s.Require().Equal(expectedErr, err)
 	s.Require().Nil(res)
 }
+
+func (s *APISuite) TestCreateOrderInsufficientStock() {
+	var (
+		userUUID = uuid.New()
+		partUUID = uuid.New()
+
+		req = &orderV1.CreateOrderRequest{
+			UserUUID:  converter.StringToUUID(userUUID.String()),
+			PartUuids: []uuid.UUID{partUUID},
+		}
+	)
+
+	s.orderService.On("CreateOrder", s.ctx, userUUID.String(), []model.CreateOrderItem{{PartUUID: partUUID.String(), Quantity: 1}}).
+		Return(model.OrderCreationInfo{}, model.ErrInsufficientStock)
+
+	res, err := s.api.CreateOrder(s.ctx, req, orderV1.CreateOrderParams{})
+
+	s.Require().NoError(err)
+	s.Require().IsType(&orderV1.ConflictError{}, res)
+	s.Require().Equal(http.StatusConflict, res.(*orderV1.ConflictError).Code)
+	s.Require().Equal("Недостаточно деталей на складе", res.(*orderV1.ConflictError).Message)
+}
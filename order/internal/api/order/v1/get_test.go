@@ -21,9 +21,12 @@ func (s *APISuite) TestGetOrderSuccess() {
 			OrderUUID: orderUUID,
 		}
 		order = model.OrderDto{
-			UUID:       orderUUID.String(),
-			UserUUID:   uuid.New().String(),
-			PartsUUIDs: []string{uuid.New().String(), uuid.New().String()},
+			UUID:     orderUUID.String(),
+			UserUUID: uuid.New().String(),
+			Items: []model.OrderItem{
+				{PartUUID: uuid.New().String(), Name: "Engine", Quantity: 2, UnitPrice: 500.25},
+				{PartUUID: uuid.New().String(), Name: "Wing", Quantity: 1, UnitPrice: 500.25},
+			},
 			TotalPrice: 1500.75,
 			Status:     model.OrderStatusPaid,
 			CreatedAt:  time.Now(),
@@ -41,6 +44,10 @@ func (s *APISuite) TestGetOrderSuccess() {
 	s.Require().NotNil(res)
 	s.Require().IsType(&orderV1.GetOrderResponse{}, res)
 	s.Require().Equal(expectedResponse.Data, res.(*orderV1.GetOrderResponse).Data)
+	s.Require().Len(res.(*orderV1.GetOrderResponse).Data.Items, 2)
+	s.Require().Equal(1000.5, res.(*orderV1.GetOrderResponse).Data.Items[0].TotalPrice)
+	// part_uuids повторяет UUID детали по числу единиц
+	s.Require().Len(res.(*orderV1.GetOrderResponse).Data.PartUuids, 3)
 }
 
 func (s *APISuite) TestGetOrderNotFound() {
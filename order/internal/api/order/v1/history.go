@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/converter"
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	orderV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+func (a *api) GetOrderHistory(ctx context.Context, params orderV1.GetOrderHistoryParams) (orderV1.GetOrderHistoryRes, error) {
+	history, err := a.orderService.GetOrderHistory(ctx, params.OrderUUID.String())
+	if err != nil {
+		if errors.Is(err, model.ErrOrderNotFound) {
+			return &orderV1.NotFoundError{
+				Code:    http.StatusNotFound,
+				Message: "Order by this UUID `" + params.OrderUUID.String() + "` not found",
+			}, nil
+		}
+		return &orderV1.InternalServerError{
+			Code:    http.StatusInternalServerError,
+			Message: "Внутренняя ошибка сервера",
+		}, nil
+	}
+
+	return converter.OrderHistoryToDTO(params.OrderUUID.String(), history), nil
+}
@@ -0,0 +1,63 @@
+package v1
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	orderV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+func (s *APISuite) TestGetOrderHistorySuccess() {
+	var (
+		orderUUID = uuid.New()
+		createdAt = time.Now()
+		history   = []model.OrderStatusTransition{
+			{To: model.OrderStatusPendingPayment, Actor: model.OrderActorUser, Reason: model.ReasonOrderCreated, CreatedAt: createdAt},
+			{From: lo.ToPtr(model.OrderStatusPendingPayment), To: model.OrderStatusCancelled, Actor: model.OrderActorUser, Reason: model.ReasonCancelledByUser, CreatedAt: createdAt},
+		}
+	)
+
+	s.orderService.On("GetOrderHistory", s.ctx, orderUUID.String()).Return(history, nil)
+
+	res, err := s.api.GetOrderHistory(s.ctx, orderV1.GetOrderHistoryParams{OrderUUID: orderUUID})
+
+	s.Require().NoError(err)
+	s.Require().IsType(&orderV1.GetOrderHistoryResponse{}, res)
+
+	resp := res.(*orderV1.GetOrderHistoryResponse)
+	s.Require().Equal(orderUUID, resp.OrderUUID)
+	s.Require().Len(resp.Transitions, 2)
+	s.Require().False(resp.Transitions[0].FromStatus.Set)
+	s.Require().Equal(orderV1.OrderStatusPENDINGPAYMENT, resp.Transitions[0].ToStatus)
+	s.Require().Equal(orderV1.OrderStatusPENDINGPAYMENT, resp.Transitions[1].FromStatus.Value)
+	s.Require().Equal(orderV1.OrderStatusCANCELLED, resp.Transitions[1].ToStatus)
+	s.Require().Equal(orderV1.OrderActorUser, resp.Transitions[1].Actor)
+	s.Require().Equal(model.ReasonCancelledByUser, resp.Transitions[1].Reason)
+}
+
+func (s *APISuite) TestGetOrderHistoryNotFound() {
+	orderUUID := uuid.New()
+
+	s.orderService.On("GetOrderHistory", s.ctx, orderUUID.String()).Return(nil, model.ErrOrderNotFound)
+
+	res, err := s.api.GetOrderHistory(s.ctx, orderV1.GetOrderHistoryParams{OrderUUID: orderUUID})
+
+	s.Require().NoError(err)
+	s.Require().IsType(&orderV1.NotFoundError{}, res)
+	s.Require().Equal(404, res.(*orderV1.NotFoundError).Code)
+}
+
+func (s *APISuite) TestGetOrderHistoryInternalError() {
+	orderUUID := uuid.New()
+
+	s.orderService.On("GetOrderHistory", s.ctx, orderUUID.String()).Return(nil, errors.New("db is down"))
+
+	res, err := s.api.GetOrderHistory(s.ctx, orderV1.GetOrderHistoryParams{OrderUUID: orderUUID})
+
+	s.Require().NoError(err)
+	s.Require().IsType(&orderV1.InternalServerError{}, res)
+}
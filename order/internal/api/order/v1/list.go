@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/converter"
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	orderV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+func (a *api) ListOrders(ctx context.Context, params orderV1.ListOrdersParams) (orderV1.ListOrdersRes, error) {
+	listParams, err := converter.ListOrdersParamsToModel(params)
+	if err != nil {
+		return &orderV1.BadRequestError{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		}, nil
+	}
+
+	list, err := a.orderService.ListOrders(ctx, converter.OrdersFilterToModel(params), listParams)
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidPageToken) {
+			return &orderV1.BadRequestError{
+				Code:    http.StatusBadRequest,
+				Message: "Токен страницы недействителен для этого фильтра и сортировки",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return converter.OrdersListToDTO(list), nil
+}
@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	orderV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+func (s *APISuite) TestListOrdersSuccess() {
+	var (
+		userUUID    = uuid.New()
+		partUUID    = uuid.New()
+		createdFrom = time.Now().Add(-time.Hour)
+
+		params = orderV1.ListOrdersParams{
+			UserUUID:    orderV1.NewOptUUID(userUUID),
+			Status:      []orderV1.OrderStatus{orderV1.OrderStatusPAID},
+			CreatedFrom: orderV1.NewOptDateTime(createdFrom),
+			PartUUID:    orderV1.NewOptUUID(partUUID),
+			PageSize:    orderV1.NewOptInt32(10),
+			OrderBy:     orderV1.NewOptString("total_price asc"),
+		}
+
+		filter = model.OrdersFilter{
+			UserUUID:    userUUID.String(),
+			Statuses:    []model.OrderStatus{model.OrderStatusPaid},
+			CreatedFrom: &createdFrom,
+			PartUUID:    partUUID.String(),
+		}
+		listParams = model.ListOrdersParams{
+			PageSize: 10,
+			OrderBy:  model.OrdersOrder{Field: model.OrdersOrderByTotalPrice},
+		}
+
+		list = model.OrdersList{
+			Orders:        []model.OrderDto{{UUID: uuid.New().String(), UserUUID: userUUID.String(), Status: model.OrderStatusPaid}},
+			NextPageToken: "next",
+			TotalSize:     11,
+		}
+	)
+
+	s.orderService.On("ListOrders", s.ctx, filter, listParams).Return(list, nil)
+
+	res, err := s.api.ListOrders(s.ctx, params)
+
+	s.Require().NoError(err)
+	s.Require().IsType(&orderV1.ListOrdersResponse{}, res)
+	body := res.(*orderV1.ListOrdersResponse)
+	s.Require().Len(body.Orders, 1)
+	s.Require().Equal(list.Orders[0].UUID, body.Orders[0].OrderUUID.String())
+	s.Require().Equal(orderV1.NewOptString("next"), body.NextPageToken)
+	s.Require().Equal(int32(11), body.TotalSize)
+}
+
+func (s *APISuite) TestListOrdersDefaultSort() {
+	params := orderV1.ListOrdersParams{PageSize: orderV1.NewOptInt32(50)}
+	listParams := model.ListOrdersParams{
+		PageSize: 50,
+		OrderBy:  model.OrdersOrder{Field: model.OrdersOrderByCreatedAt, Desc: true},
+	}
+
+	s.orderService.On("ListOrders", s.ctx, model.OrdersFilter{}, listParams).Return(model.OrdersList{}, nil)
+
+	res, err := s.api.ListOrders(s.ctx, params)
+
+	s.Require().NoError(err)
+	s.Require().IsType(&orderV1.ListOrdersResponse{}, res)
+	s.Require().NotNil(res.(*orderV1.ListOrdersResponse).Orders)
+	s.Require().False(res.(*orderV1.ListOrdersResponse).NextPageToken.IsSet())
+}
+
+func (s *APISuite) TestListOrdersInvalidPageToken() {
+	params := orderV1.ListOrdersParams{PageToken: orderV1.NewOptString("stale")}
+
+	s.orderService.On("ListOrders", s.ctx, model.OrdersFilter{}, model.ListOrdersParams{
+		PageToken: "stale",
+		OrderBy:   model.OrdersOrder{Field: model.OrdersOrderByCreatedAt, Desc: true},
+	}).Return(model.OrdersList{}, model.ErrInvalidPageToken)
+
+	res, err := s.api.ListOrders(s.ctx, params)
+
+	s.Require().NoError(err)
+	s.Require().IsType(&orderV1.BadRequestError{}, res)
+	s.Require().Equal(http.StatusBadRequest, res.(*orderV1.BadRequestError).Code)
+}
+
+func (s *APISuite) TestListOrdersInvalidOrderBy() {
+	res, err := s.api.ListOrders(s.ctx, orderV1.ListOrdersParams{OrderBy: orderV1.NewOptString("total_price sideways")})
+
+	s.Require().NoError(err)
+	s.Require().IsType(&orderV1.BadRequestError{}, res)
+}
+
+func (s *APISuite) TestListOrdersInternalError() {
+	expectedErr := errors.New("database error")
+
+	s.orderService.On("ListOrders", s.ctx, model.OrdersFilter{}, model.ListOrdersParams{
+		OrderBy: model.OrdersOrder{Field: model.OrdersOrderByCreatedAt, Desc: true},
+	}).Return(model.OrdersList{}, expectedErr)
+
+	res, err := s.api.ListOrders(s.ctx, orderV1.ListOrdersParams{})
+
+	s.Require().ErrorIs(err, expectedErr)
+	s.Require().Nil(res)
+}
@@ -19,6 +19,21 @@ func (a *api) PayOrder(ctx context.Context, req *orderV1.PayOrderRequest, params
 				Code:    http.StatusBadRequest,
 				Message: "Заказ уже оплачен или отменен",
 			}, nil
+		case errors.Is(err, model.ErrReservationExpired):
+			return &orderV1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: "Резерв деталей истёк, оформите заказ заново",
+			}, nil
+		case errors.Is(err, model.ErrPaymentRejected):
+			return &orderV1.ValidationError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: "Платёжный сервис отклонил платёж",
+			}, nil
+		case errors.Is(err, model.ErrPaymentUnavailable):
+			return &orderV1.ServiceUnavailableError{
+				Code:    http.StatusServiceUnavailable,
+				Message: "Платёжный сервис недоступен, повторите оплату позже",
+			}, nil
 		case errors.Is(err, model.ErrPaymentNotFound):
 			return &orderV1.NotFoundError{
 				Code:    http.StatusBadRequest,
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -116,3 +117,65 @@ func (s *APISuite) TestPayOrderInternalError() {
 	s.Require().Equal(expectedResponse.Code, res.(*orderV1.InternalServerError).Code)
 	s.Require().Equal(expectedResponse.Message, res.(*orderV1.InternalServerError).Message)
 }
+
+func (s *APISuite) TestPayOrderReservationExpired() {
+	var (
+		orderUUID = uuid.New()
+		params    = orderV1.PayOrderParams{
+			OrderUUID: orderUUID,
+		}
+		req = &orderV1.PayOrderRequest{
+			PaymentMethod: orderV1.PaymentMethodCARD,
+		}
+	)
+
+	s.orderService.On("PayOrder", s.ctx, orderUUID.String(), string(req.GetPaymentMethod())).Return("", model.ErrReservationExpired)
+
+	res, err := s.api.PayOrder(s.ctx, req, params)
+
+	s.Require().NoError(err)
+	s.Require().IsType(&orderV1.ConflictError{}, res)
+	s.Require().Equal(http.StatusConflict, res.(*orderV1.ConflictError).Code)
+}
+
+func (s *APISuite) TestPayOrderRejected() {
+	var (
+		orderUUID = uuid.New()
+		params    = orderV1.PayOrderParams{
+			OrderUUID: orderUUID,
+		}
+		req = &orderV1.PayOrderRequest{
+			PaymentMethod: orderV1.PaymentMethodCREDITCARD,
+		}
+	)
+
+	s.orderService.On("PayOrder", s.ctx, orderUUID.String(), string(req.GetPaymentMethod())).
+		Return("", fmt.Errorf("%w: amount is out of payment method limits", model.ErrPaymentRejected))
+
+	res, err := s.api.PayOrder(s.ctx, req, params)
+
+	s.Require().NoError(err)
+	s.Require().IsType(&orderV1.ValidationError{}, res)
+	s.Require().Equal(http.StatusUnprocessableEntity, res.(*orderV1.ValidationError).Code)
+}
+
+func (s *APISuite) TestPayOrderPaymentUnavailable() {
+	var (
+		orderUUID = uuid.New()
+		params    = orderV1.PayOrderParams{
+			OrderUUID: orderUUID,
+		}
+		req = &orderV1.PayOrderRequest{
+			PaymentMethod: orderV1.PaymentMethodSBP,
+		}
+	)
+
+	s.orderService.On("PayOrder", s.ctx, orderUUID.String(), string(req.GetPaymentMethod())).
+		Return("", fmt.Errorf("%w: payment provider timeout", model.ErrPaymentUnavailable))
+
+	res, err := s.api.PayOrder(s.ctx, req, params)
+
+	s.Require().NoError(err)
+	s.Require().IsType(&orderV1.ServiceUnavailableError{}, res)
+	s.Require().Equal(http.StatusServiceUnavailable, res.(*orderV1.ServiceUnavailableError).Code)
+}
@@ -0,0 +1,55 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	orderV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+func (a *api) UpdateOrderStatus(ctx context.Context, req *orderV1.UpdateOrderStatusRequest, params orderV1.UpdateOrderStatusParams) (orderV1.UpdateOrderStatusRes, error) {
+	err := a.orderService.UpdateOrderStatus(ctx, params.OrderUUID.String(), model.OrderStatus(req.GetStatus()))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrOrderNotFound):
+			return &orderV1.NotFoundError{
+				Code:    http.StatusNotFound,
+				Message: "Order by this UUID `" + params.OrderUUID.String() + "` not found",
+			}, nil
+		case errors.Is(err, model.ErrOrderConflict):
+			return &orderV1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: "Заказ нельзя перевести в этот статус из текущего",
+			}, nil
+		case errors.Is(err, model.ErrNotFulfillmentStatus):
+			return &orderV1.ValidationError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: "Статус выставляется не сборкой и доставкой заказа",
+			}, nil
+		case errors.Is(err, model.ErrCaptureRejected), errors.Is(err, model.ErrPaymentNotFound):
+			return &orderV1.ValidationError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: "Платёжный сервис отклонил списание оплаты",
+			}, nil
+		case errors.Is(err, model.ErrPaymentUnavailable):
+			return &orderV1.ServiceUnavailableError{
+				Code:    http.StatusServiceUnavailable,
+				Message: "Платёжный сервис недоступен, повторите отгрузку позже",
+			}, nil
+		case errors.Is(err, model.ErrCaptureFailed):
+			return &orderV1.BadGatewayError{
+				Code:    http.StatusBadGateway,
+				Message: "Ошибка платёжного сервиса при списании оплаты",
+			}, nil
+		default:
+			return &orderV1.InternalServerError{
+				Code:    http.StatusInternalServerError,
+				Message: "Внутренняя ошибка сервера",
+			}, nil
+		}
+	}
+
+	return &orderV1.UpdateOrderStatusNoContent{}, nil
+}
@@ -0,0 +1,73 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	orderV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+func (s *APISuite) TestUpdateOrderStatusSuccess() {
+	var (
+		orderUUID = uuid.New()
+		params    = orderV1.UpdateOrderStatusParams{OrderUUID: orderUUID}
+		req       = &orderV1.UpdateOrderStatusRequest{Status: orderV1.OrderStatusSHIPPED}
+	)
+
+	s.orderService.On("UpdateOrderStatus", s.ctx, orderUUID.String(), model.OrderStatusShipped).Return(nil)
+
+	res, err := s.api.UpdateOrderStatus(s.ctx, req, params)
+
+	s.Require().NoError(err)
+	s.Require().IsType(&orderV1.UpdateOrderStatusNoContent{}, res)
+}
+
+func (s *APISuite) TestUpdateOrderStatusErrors() {
+	cases := map[error]int{
+		model.ErrOrderNotFound:            http.StatusNotFound,
+		model.ErrOrderConflict:            http.StatusConflict,
+		model.ErrNotFulfillmentStatus:     http.StatusUnprocessableEntity,
+		model.ErrCaptureRejected:          http.StatusUnprocessableEntity,
+		model.ErrPaymentUnavailable:       http.StatusServiceUnavailable,
+		model.ErrCaptureFailed:            http.StatusBadGateway,
+		errors.New("some internal error"): http.StatusInternalServerError,
+	}
+
+	for serviceErr, code := range cases {
+		var (
+			orderUUID = uuid.New()
+			params    = orderV1.UpdateOrderStatusParams{OrderUUID: orderUUID}
+			req       = &orderV1.UpdateOrderStatusRequest{Status: orderV1.OrderStatusASSEMBLING}
+		)
+
+		s.orderService.On("UpdateOrderStatus", s.ctx, orderUUID.String(), model.OrderStatusAssembling).Return(serviceErr).Once()
+
+		res, err := s.api.UpdateOrderStatus(s.ctx, req, params)
+
+		s.Require().NoError(err)
+		s.Require().Equal(code, errorCode(res), serviceErr.Error())
+	}
+}
+
+// errorCode достаёт HTTP-код из ответа с ошибкой
+func errorCode(res orderV1.UpdateOrderStatusRes) int {
+	switch res := res.(type) {
+	case *orderV1.NotFoundError:
+		return res.Code
+	case *orderV1.ConflictError:
+		return res.Code
+	case *orderV1.ValidationError:
+		return res.Code
+	case *orderV1.ServiceUnavailableError:
+		return res.Code
+	case *orderV1.BadGatewayError:
+		return res.Code
+	case *orderV1.InternalServerError:
+		return res.Code
+	default:
+		return 0
+	}
+}
@@ -38,6 +38,7 @@ func PartToModel(part *inventoryV1.Part) model.Part {
 		Metadata:      MetadataToModel(part.Metadata),
 		CreatedAt:     part.CreatedAt.AsTime(),
 		UpdatedAt:     updatedAt,
+		Archived:      part.Archived,
 	}
 }
 
@@ -62,25 +63,28 @@ func ManufacturerToModel(manufacturer *inventoryV1.Manufacturer) model.Manufactu
 }
 
 func MetadataToModel(metadata map[string]*inventoryV1.Value) model.Metadata {
-	res := model.Metadata{}
+	res := make(model.Metadata, len(metadata))
 
-	for _, value := range metadata {
+	for key, value := range metadata {
 		if value == nil {
 			continue
 		}
 
-		switch v := value.Kind.(type) {
+		var v model.MetadataValue
+		switch kind := value.Kind.(type) {
 		case *inventoryV1.Value_StringValue:
-			res.StringValue = lo.ToPtr(v.StringValue)
+			v.StringValue = lo.ToPtr(kind.StringValue)
 		case *inventoryV1.Value_Int64Value:
-			res.Int64Value = lo.ToPtr(v.Int64Value)
+			v.Int64Value = lo.ToPtr(kind.Int64Value)
 		case *inventoryV1.Value_BoolValue:
-			res.BoolValue = lo.ToPtr(v.BoolValue)
+			v.BoolValue = lo.ToPtr(kind.BoolValue)
 		case *inventoryV1.Value_DoubleValue:
-			res.DoubleValue = lo.ToPtr(v.DoubleValue)
+			v.DoubleValue = lo.ToPtr(kind.DoubleValue)
 		default:
-			log.Printf("unknown metadata metadata type: %T", value)
+			log.Printf("unknown metadata value type for key %q: %T", key, value.Kind)
+			continue
 		}
+		res[key] = v
 	}
 	return res
 }
@@ -0,0 +1,18 @@
+package converter
+
+import (
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func ReservationItemsToProto(items []model.ReservationItem) []*inventoryV1.ReservationItem {
+	result := make([]*inventoryV1.ReservationItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, &inventoryV1.ReservationItem{
+			PartUuid: item.PartUUID,
+			Quantity: item.Quantity,
+		})
+	}
+
+	return result
+}
@@ -8,8 +8,22 @@ import (
 
 type InventoryClient interface {
 	ListParts(ctx context.Context, filter model.PartsFilter) (parts []model.Part, err error)
+	BatchGetParts(ctx context.Context, uuids []string) (model.PartsBatch, error)
+	ReserveParts(ctx context.Context, items []model.ReservationItem) (reservationUUID string, err error)
+	ReleaseReservation(ctx context.Context, reservationUUID string) error
+	CommitReservation(ctx context.Context, reservationUUID string) error
 }
 
 type PaymentClient interface {
-	PayOrder(ctx context.Context, userUUID, orderUUID, paymentMethod string) (transactionUUID string, err error)
+	// AuthorizePayment холдирует amount минимальных единиц валюты currency (ISO 4217) до CapturePayment
+	// или VoidAuthorization
+	AuthorizePayment(ctx context.Context, userUUID, orderUUID, paymentMethod string, amount int64, currency string) (transactionUUID string, err error)
+	// CapturePayment списывает холд
+	CapturePayment(ctx context.Context, transactionUUID, orderUUID string) error
+	// VoidAuthorization снимает холд без списания
+	VoidAuthorization(ctx context.Context, transactionUUID, orderUUID, reason string) error
+	// ListAuthorizations возвращает UUID холдов заказа, которые ещё не списаны и не сняты
+	ListAuthorizations(ctx context.Context, orderUUID string) (transactionUUIDs []string, err error)
+	// RefundPayment возвращает оплату; amount в минимальных единицах валюты, 0 — полный возврат
+	RefundPayment(ctx context.Context, transactionUUID, orderUUID string, amount int64, currency, reason string) (refundTransactionUUID string, err error)
 }
@@ -0,0 +1,28 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/client/converter"
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (c *client) BatchGetParts(ctx context.Context, uuids []string) (model.PartsBatch, error) {
+	res, err := c.generatedClient.BatchGetParts(ctx, &inventoryV1.BatchGetPartsRequest{
+		Uuids: uuids,
+	})
+	if err != nil {
+		return model.PartsBatch{}, err
+	}
+
+	parts := make(map[string]model.Part, len(res.GetParts()))
+	for uuid, part := range res.GetParts() {
+		parts[uuid] = converter.PartToModel(part)
+	}
+
+	return model.PartsBatch{
+		Parts:        parts,
+		MissingUUIDs: res.GetMissingUuids(),
+	}, nil
+}
@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (c *client) CommitReservation(ctx context.Context, reservationUUID string) error {
+	_, err := c.generatedClient.CommitReservation(ctx, &inventoryV1.CommitReservationRequest{
+		ReservationUuid: reservationUUID,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.FailedPrecondition:
+			return model.ErrReservationExpired
+		case codes.NotFound:
+			return model.ErrReservationNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (c *client) ReleaseReservation(ctx context.Context, reservationUUID string) error {
+	_, err := c.generatedClient.ReleaseReservation(ctx, &inventoryV1.ReleaseReservationRequest{
+		ReservationUuid: reservationUUID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return model.ErrReservationNotFound
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/client/converter"
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	inventoryV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (c *client) ReserveParts(ctx context.Context, items []model.ReservationItem) (reservationUUID string, err error) {
+	res, err := c.generatedClient.ReserveParts(ctx, &inventoryV1.ReservePartsRequest{
+		Items: converter.ReservationItemsToProto(items),
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.FailedPrecondition:
+			return "", fmt.Errorf("%w: %s", model.ErrInsufficientStock, status.Convert(err).Message())
+		case codes.NotFound:
+			return "", fmt.Errorf("%w: %s", model.ErrPartsNotFound, status.Convert(err).Message())
+		default:
+			return "", err
+		}
+	}
+
+	return res.GetReservationUuid(), nil
+}
@@ -25,6 +25,110 @@ func (_m *InventoryClient) EXPECT() *InventoryClient_Expecter {
 	return &InventoryClient_Expecter{mock: &_m.Mock}
 }
 
+// BatchGetParts provides a mock function with given fields: ctx, uuids
+func (_m *InventoryClient) BatchGetParts(ctx context.Context, uuids []string) (model.PartsBatch, error) {
+	ret := _m.Called(ctx, uuids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchGetParts")
+	}
+
+	var r0 model.PartsBatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (model.PartsBatch, error)); ok {
+		return rf(ctx, uuids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) model.PartsBatch); ok {
+		r0 = rf(ctx, uuids)
+	} else {
+		r0 = ret.Get(0).(model.PartsBatch)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, uuids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryClient_BatchGetParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchGetParts'
+type InventoryClient_BatchGetParts_Call struct {
+	*mock.Call
+}
+
+// BatchGetParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuids []string
+func (_e *InventoryClient_Expecter) BatchGetParts(ctx interface{}, uuids interface{}) *InventoryClient_BatchGetParts_Call {
+	return &InventoryClient_BatchGetParts_Call{Call: _e.mock.On("BatchGetParts", ctx, uuids)}
+}
+
+func (_c *InventoryClient_BatchGetParts_Call) Run(run func(ctx context.Context, uuids []string)) *InventoryClient_BatchGetParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *InventoryClient_BatchGetParts_Call) Return(_a0 model.PartsBatch, _a1 error) *InventoryClient_BatchGetParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryClient_BatchGetParts_Call) RunAndReturn(run func(context.Context, []string) (model.PartsBatch, error)) *InventoryClient_BatchGetParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CommitReservation provides a mock function with given fields: ctx, reservationUUID
+func (_m *InventoryClient) CommitReservation(ctx context.Context, reservationUUID string) error {
+	ret := _m.Called(ctx, reservationUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, reservationUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryClient_CommitReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitReservation'
+type InventoryClient_CommitReservation_Call struct {
+	*mock.Call
+}
+
+// CommitReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reservationUUID string
+func (_e *InventoryClient_Expecter) CommitReservation(ctx interface{}, reservationUUID interface{}) *InventoryClient_CommitReservation_Call {
+	return &InventoryClient_CommitReservation_Call{Call: _e.mock.On("CommitReservation", ctx, reservationUUID)}
+}
+
+func (_c *InventoryClient_CommitReservation_Call) Run(run func(ctx context.Context, reservationUUID string)) *InventoryClient_CommitReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InventoryClient_CommitReservation_Call) Return(_a0 error) *InventoryClient_CommitReservation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryClient_CommitReservation_Call) RunAndReturn(run func(context.Context, string) error) *InventoryClient_CommitReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListParts provides a mock function with given fields: ctx, filter
 func (_m *InventoryClient) ListParts(ctx context.Context, filter model.PartsFilter) ([]model.Part, error) {
 	ret := _m.Called(ctx, filter)
@@ -84,6 +188,110 @@ func (_c *InventoryClient_ListParts_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ReleaseReservation provides a mock function with given fields: ctx, reservationUUID
+func (_m *InventoryClient) ReleaseReservation(ctx context.Context, reservationUUID string) error {
+	ret := _m.Called(ctx, reservationUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, reservationUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryClient_ReleaseReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseReservation'
+type InventoryClient_ReleaseReservation_Call struct {
+	*mock.Call
+}
+
+// ReleaseReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reservationUUID string
+func (_e *InventoryClient_Expecter) ReleaseReservation(ctx interface{}, reservationUUID interface{}) *InventoryClient_ReleaseReservation_Call {
+	return &InventoryClient_ReleaseReservation_Call{Call: _e.mock.On("ReleaseReservation", ctx, reservationUUID)}
+}
+
+func (_c *InventoryClient_ReleaseReservation_Call) Run(run func(ctx context.Context, reservationUUID string)) *InventoryClient_ReleaseReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InventoryClient_ReleaseReservation_Call) Return(_a0 error) *InventoryClient_ReleaseReservation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryClient_ReleaseReservation_Call) RunAndReturn(run func(context.Context, string) error) *InventoryClient_ReleaseReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveParts provides a mock function with given fields: ctx, items
+func (_m *InventoryClient) ReserveParts(ctx context.Context, items []model.ReservationItem) (string, error) {
+	ret := _m.Called(ctx, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveParts")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []model.ReservationItem) (string, error)); ok {
+		return rf(ctx, items)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []model.ReservationItem) string); ok {
+		r0 = rf(ctx, items)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []model.ReservationItem) error); ok {
+		r1 = rf(ctx, items)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryClient_ReserveParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveParts'
+type InventoryClient_ReserveParts_Call struct {
+	*mock.Call
+}
+
+// ReserveParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - items []model.ReservationItem
+func (_e *InventoryClient_Expecter) ReserveParts(ctx interface{}, items interface{}) *InventoryClient_ReserveParts_Call {
+	return &InventoryClient_ReserveParts_Call{Call: _e.mock.On("ReserveParts", ctx, items)}
+}
+
+func (_c *InventoryClient_ReserveParts_Call) Run(run func(ctx context.Context, items []model.ReservationItem)) *InventoryClient_ReserveParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]model.ReservationItem))
+	})
+	return _c
+}
+
+func (_c *InventoryClient_ReserveParts_Call) Return(reservationUUID string, err error) *InventoryClient_ReserveParts_Call {
+	_c.Call.Return(reservationUUID, err)
+	return _c
+}
+
+func (_c *InventoryClient_ReserveParts_Call) RunAndReturn(run func(context.Context, []model.ReservationItem) (string, error)) *InventoryClient_ReserveParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewInventoryClient creates a new instance of InventoryClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewInventoryClient(t interface {
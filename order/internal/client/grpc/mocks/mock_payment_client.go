@@ -23,27 +23,27 @@ func (_m *PaymentClient) EXPECT() *PaymentClient_Expecter {
 	return &PaymentClient_Expecter{mock: &_m.Mock}
 }
 
-// PayOrder provides a mock function with given fields: ctx, userUUID, orderUUID, paymentMethod
-func (_m *PaymentClient) PayOrder(ctx context.Context, userUUID string, orderUUID string, paymentMethod string) (string, error) {
-	ret := _m.Called(ctx, userUUID, orderUUID, paymentMethod)
+// AuthorizePayment provides a mock function with given fields: ctx, userUUID, orderUUID, paymentMethod, amount, currency
+func (_m *PaymentClient) AuthorizePayment(ctx context.Context, userUUID string, orderUUID string, paymentMethod string, amount int64, currency string) (string, error) {
+	ret := _m.Called(ctx, userUUID, orderUUID, paymentMethod, amount, currency)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PayOrder")
+		panic("no return value specified for AuthorizePayment")
 	}
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (string, error)); ok {
-		return rf(ctx, userUUID, orderUUID, paymentMethod)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, int64, string) (string, error)); ok {
+		return rf(ctx, userUUID, orderUUID, paymentMethod, amount, currency)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) string); ok {
-		r0 = rf(ctx, userUUID, orderUUID, paymentMethod)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, int64, string) string); ok {
+		r0 = rf(ctx, userUUID, orderUUID, paymentMethod, amount, currency)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
-		r1 = rf(ctx, userUUID, orderUUID, paymentMethod)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, int64, string) error); ok {
+		r1 = rf(ctx, userUUID, orderUUID, paymentMethod, amount, currency)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -51,33 +51,252 @@ func (_m *PaymentClient) PayOrder(ctx context.Context, userUUID string, orderUUI
 	return r0, r1
 }
 
-// PaymentClient_PayOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PayOrder'
-type PaymentClient_PayOrder_Call struct {
+// PaymentClient_AuthorizePayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuthorizePayment'
+type PaymentClient_AuthorizePayment_Call struct {
 	*mock.Call
 }
 
-// PayOrder is a helper method to define mock.On call
+// AuthorizePayment is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userUUID string
 //   - orderUUID string
 //   - paymentMethod string
-func (_e *PaymentClient_Expecter) PayOrder(ctx interface{}, userUUID interface{}, orderUUID interface{}, paymentMethod interface{}) *PaymentClient_PayOrder_Call {
-	return &PaymentClient_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, userUUID, orderUUID, paymentMethod)}
+//   - amount int64
+//   - currency string
+func (_e *PaymentClient_Expecter) AuthorizePayment(ctx interface{}, userUUID interface{}, orderUUID interface{}, paymentMethod interface{}, amount interface{}, currency interface{}) *PaymentClient_AuthorizePayment_Call {
+	return &PaymentClient_AuthorizePayment_Call{Call: _e.mock.On("AuthorizePayment", ctx, userUUID, orderUUID, paymentMethod, amount, currency)}
 }
 
-func (_c *PaymentClient_PayOrder_Call) Run(run func(ctx context.Context, userUUID string, orderUUID string, paymentMethod string)) *PaymentClient_PayOrder_Call {
+func (_c *PaymentClient_AuthorizePayment_Call) Run(run func(ctx context.Context, userUUID string, orderUUID string, paymentMethod string, amount int64, currency string)) *PaymentClient_AuthorizePayment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(int64), args[5].(string))
 	})
 	return _c
 }
 
-func (_c *PaymentClient_PayOrder_Call) Return(transactionUUID string, err error) *PaymentClient_PayOrder_Call {
+func (_c *PaymentClient_AuthorizePayment_Call) Return(transactionUUID string, err error) *PaymentClient_AuthorizePayment_Call {
 	_c.Call.Return(transactionUUID, err)
 	return _c
 }
 
-func (_c *PaymentClient_PayOrder_Call) RunAndReturn(run func(context.Context, string, string, string) (string, error)) *PaymentClient_PayOrder_Call {
+func (_c *PaymentClient_AuthorizePayment_Call) RunAndReturn(run func(context.Context, string, string, string, int64, string) (string, error)) *PaymentClient_AuthorizePayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CapturePayment provides a mock function with given fields: ctx, transactionUUID, orderUUID
+func (_m *PaymentClient) CapturePayment(ctx context.Context, transactionUUID string, orderUUID string) error {
+	ret := _m.Called(ctx, transactionUUID, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CapturePayment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, transactionUUID, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PaymentClient_CapturePayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CapturePayment'
+type PaymentClient_CapturePayment_Call struct {
+	*mock.Call
+}
+
+// CapturePayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID string
+//   - orderUUID string
+func (_e *PaymentClient_Expecter) CapturePayment(ctx interface{}, transactionUUID interface{}, orderUUID interface{}) *PaymentClient_CapturePayment_Call {
+	return &PaymentClient_CapturePayment_Call{Call: _e.mock.On("CapturePayment", ctx, transactionUUID, orderUUID)}
+}
+
+func (_c *PaymentClient_CapturePayment_Call) Run(run func(ctx context.Context, transactionUUID string, orderUUID string)) *PaymentClient_CapturePayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *PaymentClient_CapturePayment_Call) Return(_a0 error) *PaymentClient_CapturePayment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentClient_CapturePayment_Call) RunAndReturn(run func(context.Context, string, string) error) *PaymentClient_CapturePayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAuthorizations provides a mock function with given fields: ctx, orderUUID
+func (_m *PaymentClient) ListAuthorizations(ctx context.Context, orderUUID string) ([]string, error) {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAuthorizations")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return rf(ctx, orderUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, orderUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentClient_ListAuthorizations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAuthorizations'
+type PaymentClient_ListAuthorizations_Call struct {
+	*mock.Call
+}
+
+// ListAuthorizations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *PaymentClient_Expecter) ListAuthorizations(ctx interface{}, orderUUID interface{}) *PaymentClient_ListAuthorizations_Call {
+	return &PaymentClient_ListAuthorizations_Call{Call: _e.mock.On("ListAuthorizations", ctx, orderUUID)}
+}
+
+func (_c *PaymentClient_ListAuthorizations_Call) Run(run func(ctx context.Context, orderUUID string)) *PaymentClient_ListAuthorizations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PaymentClient_ListAuthorizations_Call) Return(transactionUUIDs []string, err error) *PaymentClient_ListAuthorizations_Call {
+	_c.Call.Return(transactionUUIDs, err)
+	return _c
+}
+
+func (_c *PaymentClient_ListAuthorizations_Call) RunAndReturn(run func(context.Context, string) ([]string, error)) *PaymentClient_ListAuthorizations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefundPayment provides a mock function with given fields: ctx, transactionUUID, orderUUID, amount, currency, reason
+func (_m *PaymentClient) RefundPayment(ctx context.Context, transactionUUID string, orderUUID string, amount int64, currency string, reason string) (string, error) {
+	ret := _m.Called(ctx, transactionUUID, orderUUID, amount, currency, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefundPayment")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, string, string) (string, error)); ok {
+		return rf(ctx, transactionUUID, orderUUID, amount, currency, reason)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, string, string) string); ok {
+		r0 = rf(ctx, transactionUUID, orderUUID, amount, currency, reason)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64, string, string) error); ok {
+		r1 = rf(ctx, transactionUUID, orderUUID, amount, currency, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentClient_RefundPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefundPayment'
+type PaymentClient_RefundPayment_Call struct {
+	*mock.Call
+}
+
+// RefundPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID string
+//   - orderUUID string
+//   - amount int64
+//   - currency string
+//   - reason string
+func (_e *PaymentClient_Expecter) RefundPayment(ctx interface{}, transactionUUID interface{}, orderUUID interface{}, amount interface{}, currency interface{}, reason interface{}) *PaymentClient_RefundPayment_Call {
+	return &PaymentClient_RefundPayment_Call{Call: _e.mock.On("RefundPayment", ctx, transactionUUID, orderUUID, amount, currency, reason)}
+}
+
+func (_c *PaymentClient_RefundPayment_Call) Run(run func(ctx context.Context, transactionUUID string, orderUUID string, amount int64, currency string, reason string)) *PaymentClient_RefundPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int64), args[4].(string), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *PaymentClient_RefundPayment_Call) Return(refundTransactionUUID string, err error) *PaymentClient_RefundPayment_Call {
+	_c.Call.Return(refundTransactionUUID, err)
+	return _c
+}
+
+func (_c *PaymentClient_RefundPayment_Call) RunAndReturn(run func(context.Context, string, string, int64, string, string) (string, error)) *PaymentClient_RefundPayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VoidAuthorization provides a mock function with given fields: ctx, transactionUUID, orderUUID, reason
+func (_m *PaymentClient) VoidAuthorization(ctx context.Context, transactionUUID string, orderUUID string, reason string) error {
+	ret := _m.Called(ctx, transactionUUID, orderUUID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VoidAuthorization")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, transactionUUID, orderUUID, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PaymentClient_VoidAuthorization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VoidAuthorization'
+type PaymentClient_VoidAuthorization_Call struct {
+	*mock.Call
+}
+
+// VoidAuthorization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID string
+//   - orderUUID string
+//   - reason string
+func (_e *PaymentClient_Expecter) VoidAuthorization(ctx interface{}, transactionUUID interface{}, orderUUID interface{}, reason interface{}) *PaymentClient_VoidAuthorization_Call {
+	return &PaymentClient_VoidAuthorization_Call{Call: _e.mock.On("VoidAuthorization", ctx, transactionUUID, orderUUID, reason)}
+}
+
+func (_c *PaymentClient_VoidAuthorization_Call) Run(run func(ctx context.Context, transactionUUID string, orderUUID string, reason string)) *PaymentClient_VoidAuthorization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *PaymentClient_VoidAuthorization_Call) Return(_a0 error) *PaymentClient_VoidAuthorization_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentClient_VoidAuthorization_Call) RunAndReturn(run func(context.Context, string, string, string) error) *PaymentClient_VoidAuthorization_Call {
 	_c.Call.Return(run)
 	return _c
 }
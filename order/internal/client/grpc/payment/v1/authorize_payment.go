@@ -0,0 +1,53 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	paymentV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/payment/v1"
+)
+
+func (c *client) AuthorizePayment(ctx context.Context, userUUID, orderUUID, paymentMethod string, amount int64, currency string) (transactionUUID string, err error) {
+	res, err := c.generatedClient.AuthorizePayment(ctx, &paymentV1.AuthorizePaymentRequest{
+		OrderUuid:     orderUUID,
+		UserUuid:      userUUID,
+		PaymentMethod: paymentMethodToProto(model.PaymentMethod(paymentMethod)),
+		Amount:        amount,
+		Currency:      currency,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument, codes.FailedPrecondition:
+			// Платёж не прошёл проверку лимитов или отклонён провайдером
+			return "", fmt.Errorf("%w: %s", model.ErrPaymentRejected, status.Convert(err).Message())
+		case codes.AlreadyExists:
+			// Холд по заказу уже есть с другими параметрами
+			return "", fmt.Errorf("%w: %s", model.ErrPaymentConflict, status.Convert(err).Message())
+		case codes.Unavailable, codes.DeadlineExceeded, codes.Aborted:
+			// Провайдер не ответил или списание по заказу ещё идёт: оплату можно повторить
+			return "", fmt.Errorf("%w: %s", model.ErrPaymentUnavailable, status.Convert(err).Message())
+		default:
+			return "", err
+		}
+	}
+	return res.TransactionUuid, nil
+}
+
+func paymentMethodToProto(method model.PaymentMethod) paymentV1.PaymentMethod {
+	switch method {
+	case model.Card:
+		return paymentV1.PaymentMethod_PAYMENT_METHOD_CARD
+	case model.SBP:
+		return paymentV1.PaymentMethod_PAYMENT_METHOD_SBP
+	case model.CreditCard:
+		return paymentV1.PaymentMethod_PAYMENT_METHOD_CREDIT_CARD
+	case model.InvestorMoney:
+		return paymentV1.PaymentMethod_PAYMENT_METHOD_INVESTOR_MONEY
+	default:
+		return paymentV1.PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+	}
+}
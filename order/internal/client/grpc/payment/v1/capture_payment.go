@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	paymentV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/payment/v1"
+)
+
+func (c *client) CapturePayment(ctx context.Context, transactionUUID, orderUUID string) error {
+	_, err := c.generatedClient.CapturePayment(ctx, &paymentV1.CapturePaymentRequest{
+		TransactionUuid: transactionUUID,
+		OrderUuid:       orderUUID,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument, codes.FailedPrecondition:
+			// Холд истёк или уже снят
+			return fmt.Errorf("%w: %s", model.ErrCaptureRejected, status.Convert(err).Message())
+		case codes.NotFound:
+			return model.ErrPaymentNotFound
+		case codes.Unavailable, codes.DeadlineExceeded:
+			return model.ErrPaymentUnavailable
+		default:
+			return fmt.Errorf("%w: %w", model.ErrCaptureFailed, err)
+		}
+	}
+
+	return nil
+}
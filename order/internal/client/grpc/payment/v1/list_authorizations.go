@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	paymentV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/payment/v1"
+)
+
+func (c *client) ListAuthorizations(ctx context.Context, orderUUID string) ([]string, error) {
+	res, err := c.generatedClient.ListTransactions(ctx, &paymentV1.ListTransactionsRequest{
+		Filter: &paymentV1.TransactionsFilter{
+			OrderUuid: orderUUID,
+			Statuses:  []paymentV1.TransactionStatus{paymentV1.TransactionStatus_TRANSACTION_STATUS_AUTHORIZED},
+			Kinds:     []paymentV1.TransactionKind{paymentV1.TransactionKind_TRANSACTION_KIND_PAYMENT},
+		},
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.Unavailable, codes.DeadlineExceeded:
+			return nil, model.ErrPaymentUnavailable
+		default:
+			return nil, fmt.Errorf("%w: %w", model.ErrPaymentInternalError, err)
+		}
+	}
+
+	transactionUUIDs := make([]string, 0, len(res.GetTransactions()))
+	for _, transaction := range res.GetTransactions() {
+		transactionUUIDs = append(transactionUUIDs, transaction.GetTransactionUuid())
+	}
+
+	return transactionUUIDs, nil
+}
@@ -0,0 +1,36 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	paymentV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/payment/v1"
+)
+
+func (c *client) RefundPayment(ctx context.Context, transactionUUID, orderUUID string, amount int64, currency, reason string) (refundTransactionUUID string, err error) {
+	res, err := c.generatedClient.RefundPayment(ctx, &paymentV1.RefundPaymentRequest{
+		TransactionUuid: transactionUUID,
+		OrderUuid:       orderUUID,
+		Amount:          amount,
+		Currency:        currency,
+		Reason:          reason,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument, codes.FailedPrecondition:
+			return "", fmt.Errorf("%w: %s", model.ErrRefundRejected, status.Convert(err).Message())
+		case codes.NotFound:
+			return "", model.ErrPaymentNotFound
+		case codes.Unavailable, codes.DeadlineExceeded:
+			return "", model.ErrPaymentUnavailable
+		default:
+			return "", fmt.Errorf("%w: %w", model.ErrRefundFailed, err)
+		}
+	}
+
+	return res.GetRefundTransactionUuid(), nil
+}
@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	paymentV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/payment/v1"
+)
+
+// VoidAuthorization возвращает те же ошибки, что и RefundPayment: для заказа это возврат оплаты
+func (c *client) VoidAuthorization(ctx context.Context, transactionUUID, orderUUID, reason string) error {
+	_, err := c.generatedClient.VoidAuthorization(ctx, &paymentV1.VoidAuthorizationRequest{
+		TransactionUuid: transactionUUID,
+		OrderUuid:       orderUUID,
+		Reason:          reason,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument, codes.FailedPrecondition:
+			return fmt.Errorf("%w: %s", model.ErrRefundRejected, status.Convert(err).Message())
+		case codes.NotFound:
+			return model.ErrPaymentNotFound
+		case codes.Unavailable, codes.DeadlineExceeded:
+			return model.ErrPaymentUnavailable
+		default:
+			return fmt.Errorf("%w: %w", model.ErrRefundFailed, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package converter
+
+import (
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	orderV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+func OrderHistoryToDTO(orderUUID string, history []model.OrderStatusTransition) *orderV1.GetOrderHistoryResponse {
+	transitions := make([]orderV1.OrderStatusTransition, 0, len(history))
+	for _, entry := range history {
+		var from orderV1.OptOrderStatus
+		if entry.From != nil {
+			from = orderV1.NewOptOrderStatus(orderV1.OrderStatus(*entry.From))
+		}
+		transitions = append(transitions, orderV1.OrderStatusTransition{
+			FromStatus: from,
+			ToStatus:   orderV1.OrderStatus(entry.To),
+			Actor:      orderV1.OrderActor(entry.Actor),
+			Reason:     entry.Reason,
+			CreatedAt:  entry.CreatedAt,
+		})
+	}
+
+	return &orderV1.GetOrderHistoryResponse{
+		OrderUUID:   StringToUUID(orderUUID),
+		Transitions: transitions,
+	}
+}
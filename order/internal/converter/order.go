@@ -32,6 +32,16 @@ func OrderDataToDTO(order model.OrderDto) orderV1.OrderDto {
 		transactionUUID = orderV1.OptUUID{Value: StringToUUID(*order.TransactionUUID)}
 	}
 
+	var refundTransactionUUID orderV1.OptUUID
+	if order.RefundTransactionUUID != nil {
+		refundTransactionUUID = orderV1.NewOptUUID(StringToUUID(*order.RefundTransactionUUID))
+	}
+
+	var paymentCapturedAt orderV1.OptDateTime
+	if order.PaymentCapturedAt != nil {
+		paymentCapturedAt = orderV1.NewOptDateTime(*order.PaymentCapturedAt)
+	}
+
 	var paymentMethod orderV1.OptPaymentMethod
 	if order.PaymentMethod != nil {
 		paymentMethod = orderV1.OptPaymentMethod{Value: paymentMethodToOpt(*order.PaymentMethod)}
@@ -45,23 +55,64 @@ func OrderDataToDTO(order model.OrderDto) orderV1.OrderDto {
 	createdAt := orderV1.OptDateTime{Value: order.CreatedAt}
 
 	return orderV1.OrderDto{
-		OrderUUID:       StringToUUID(order.UUID),
-		UserUUID:        StringToUUID(order.UserUUID),
-		PartUuids:       stringsToUUIDs(order.PartsUUIDs),
-		TotalPrice:      order.TotalPrice,
-		TransactionUUID: transactionUUID,
-		PaymentMethod:   paymentMethod,
-		Status:          orderV1.OrderStatus(order.Status),
-		CreatedAt:       createdAt,
-		UpdatedAt:       updatedAt,
+		OrderUUID:             StringToUUID(order.UUID),
+		UserUUID:              StringToUUID(order.UserUUID),
+		Items:                 orderItemsToDTO(order.Items),
+		PartUuids:             itemsPartUUIDs(order.Items),
+		TotalPrice:            order.TotalPrice,
+		TransactionUUID:       transactionUUID,
+		RefundTransactionUUID: refundTransactionUUID,
+		PaymentCapturedAt:     paymentCapturedAt,
+		PaymentMethod:         paymentMethod,
+		Status:                orderV1.OrderStatus(order.Status),
+		CreatedAt:             createdAt,
+		UpdatedAt:             updatedAt,
+	}
+}
+
+// CreateOrderItemsToModel собирает позиции заказа из запроса. Каждый UUID
+// из устаревшего part_uuids — одна единица детали.
+func CreateOrderItemsToModel(req *orderV1.CreateOrderRequest) []model.CreateOrderItem {
+	items := make([]model.CreateOrderItem, 0, len(req.GetPartUuids())+len(req.GetItems()))
+	for _, partUUID := range req.GetPartUuids() {
+		items = append(items, model.CreateOrderItem{PartUUID: partUUID.String(), Quantity: 1})
+	}
+	for _, item := range req.GetItems() {
+		items = append(items, model.CreateOrderItem{PartUUID: item.GetPartUUID().String(), Quantity: item.GetQuantity()})
+	}
+	return items
+}
+
+func orderItemsToDTO(items []model.OrderItem) []orderV1.OrderItem {
+	res := make([]orderV1.OrderItem, 0, len(items))
+	for _, item := range items {
+		res = append(res, orderV1.OrderItem{
+			PartUUID:   StringToUUID(item.PartUUID),
+			Name:       item.Name,
+			Quantity:   item.Quantity,
+			UnitPrice:  item.UnitPrice,
+			TotalPrice: item.TotalPrice(),
+		})
+	}
+	return res
+}
+
+// itemsPartUUIDs повторяет UUID детали по числу единиц в позиции — так заказ выглядел до появления позиций.
+func itemsPartUUIDs(items []model.OrderItem) []uuid.UUID {
+	res := make([]uuid.UUID, 0, len(items))
+	for _, item := range items {
+		for range item.Quantity {
+			res = append(res, StringToUUID(item.PartUUID))
+		}
 	}
+	return res
 }
 
 func paymentMethodToOpt(paymentMethod model.PaymentMethod) orderV1.PaymentMethod {
 	return orderV1.PaymentMethod(paymentMethod)
 }
 
-func stringsToUUIDs(arr []string) []uuid.UUID {
+func StringsToUUIDs(arr []string) []uuid.UUID {
 	uuids := make([]uuid.UUID, len(arr))
 	for i, s := range arr {
 		uuids[i] = StringToUUID(s)
@@ -0,0 +1,93 @@
+package converter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	orderV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+func OrdersFilterToModel(params orderV1.ListOrdersParams) model.OrdersFilter {
+	var filter model.OrdersFilter
+
+	if userUUID, ok := params.UserUUID.Get(); ok {
+		filter.UserUUID = userUUID.String()
+	}
+	for _, status := range params.Status {
+		filter.Statuses = append(filter.Statuses, model.OrderStatus(status))
+	}
+	if createdFrom, ok := params.CreatedFrom.Get(); ok {
+		filter.CreatedFrom = lo.ToPtr(createdFrom)
+	}
+	if createdTo, ok := params.CreatedTo.Get(); ok {
+		filter.CreatedTo = lo.ToPtr(createdTo)
+	}
+	if partUUID, ok := params.PartUUID.Get(); ok {
+		filter.PartUUID = partUUID.String()
+	}
+
+	return filter
+}
+
+func ListOrdersParamsToModel(params orderV1.ListOrdersParams) (model.ListOrdersParams, error) {
+	order, err := ordersOrderToModel(params.OrderBy.Value)
+	if err != nil {
+		return model.ListOrdersParams{}, err
+	}
+
+	return model.ListOrdersParams{
+		PageSize:  params.PageSize.Value,
+		PageToken: params.PageToken.Value,
+		OrderBy:   order,
+	}, nil
+}
+
+func OrdersListToDTO(list model.OrdersList) *orderV1.ListOrdersResponse {
+	orders := make([]orderV1.OrderDto, 0, len(list.Orders))
+	for _, order := range list.Orders {
+		orders = append(orders, OrderDataToDTO(order))
+	}
+
+	res := &orderV1.ListOrdersResponse{
+		Orders:    orders,
+		TotalSize: list.TotalSize,
+	}
+	if list.NextPageToken != "" {
+		res.NextPageToken = orderV1.NewOptString(list.NextPageToken)
+	}
+
+	return res
+}
+
+// ordersOrderToModel разбирает order_by вида "<поле> [asc|desc]"; пустая строка — сначала новые заказы.
+func ordersOrderToModel(orderBy string) (model.OrdersOrder, error) {
+	fields := strings.Fields(orderBy)
+	if len(fields) == 0 {
+		return model.OrdersOrder{Field: model.OrdersOrderByCreatedAt, Desc: true}, nil
+	}
+	if len(fields) > 2 {
+		return model.OrdersOrder{}, fmt.Errorf("%w: %q", model.ErrInvalidOrderBy, orderBy)
+	}
+
+	order := model.OrdersOrder{Field: model.OrdersOrderField(fields[0])}
+	switch order.Field {
+	case model.OrdersOrderByCreatedAt, model.OrdersOrderByTotalPrice:
+	default:
+		return model.OrdersOrder{}, fmt.Errorf("%w: unknown field %q", model.ErrInvalidOrderBy, fields[0])
+	}
+
+	if len(fields) == 2 {
+		switch fields[1] {
+		case "asc":
+		case "desc":
+			order.Desc = true
+		default:
+			return model.OrdersOrder{}, fmt.Errorf("%w: unknown direction %q", model.ErrInvalidOrderBy, fields[1])
+		}
+	}
+
+	return order, nil
+}
@@ -0,0 +1,216 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	"github.com/baryshnikkov/rocket-factory/order/internal/repository"
+	orderV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+const (
+	// IdempotencyKeyHeader - заголовок с ключом идемпотентности
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader - заголовок, которым помечается повторно отданный ответ
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength   = 255
+	maxIdempotencyRouteLength = 255
+	maxIdempotentBodySize     = 1 << 20
+)
+
+// Idempotency создает middleware, которое выполняет мутирующий запрос с заголовком
+// Idempotency-Key не больше одного раза. Ключ действует в области пользователя (user_uuid из тела
+// или query, если он указан) и маршрута. Повтор с тем же ключом и телом получает сохранённый
+// ответ, с другим телом - 422, а пока первый запрос ещё выполняется - 409.
+// Ответы 5xx не сохраняются: ключ освобождается, и запрос можно повторить.
+func Idempotency(repo repository.IdempotencyRepository) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header := r.Header.Get(IdempotencyKeyHeader)
+			route := r.Method + " " + r.URL.Path
+			// Маршрутов длиннее колонки в API нет: такой запрос всё равно получит 404
+			if header == "" || !isMutating(r.Method) || len(route) > maxIdempotencyRouteLength {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if len(header) > maxIdempotencyKeyLength {
+				writeError(w, http.StatusBadRequest, &orderV1.BadRequestError{
+					Code:    http.StatusBadRequest,
+					Message: "Idempotency-Key длиннее 255 символов",
+				})
+				return
+			}
+
+			body, err := io.ReadAll(io.LimitReader(r.Body, maxIdempotentBodySize+1))
+			if err != nil || len(body) > maxIdempotentBodySize {
+				writeError(w, http.StatusBadRequest, &orderV1.BadRequestError{
+					Code:    http.StatusBadRequest,
+					Message: "Не удалось прочитать тело запроса",
+				})
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			key := model.IdempotencyKey{UserUUID: requestUserUUID(r, body), Route: route, Key: header}
+			fingerprint := requestFingerprint(r, body)
+			record, acquired, err := repo.AcquireIdempotencyKey(r.Context(), key, fingerprint)
+			if err != nil {
+				log.Printf("failed to acquire idempotency key %q: %v\n", header, err)
+				writeError(w, http.StatusInternalServerError, &orderV1.InternalServerError{
+					Code:    http.StatusInternalServerError,
+					Message: "Не удалось проверить Idempotency-Key",
+				})
+				return
+			}
+
+			if !acquired {
+				switch {
+				case record.Fingerprint != fingerprint:
+					writeError(w, http.StatusUnprocessableEntity, &orderV1.ValidationError{
+						Code:    http.StatusUnprocessableEntity,
+						Message: "Idempotency-Key уже использован с другим запросом",
+					})
+				case record.Response == nil:
+					writeError(w, http.StatusConflict, &orderV1.ConflictError{
+						Code:    http.StatusConflict,
+						Message: "Запрос с этим Idempotency-Key ещё выполняется",
+					})
+				default:
+					if record.Response.ContentType != "" {
+						w.Header().Set("Content-Type", record.Response.ContentType)
+					}
+					w.Header().Set(IdempotentReplayedHeader, "true")
+					w.WriteHeader(record.Response.StatusCode)
+					_, _ = w.Write(record.Response.Body)
+				}
+				return
+			}
+
+			recorder := &responseRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+			// Ключ сохраняется и после отмены запроса клиентом, иначе повтор выполнится ещё раз
+			ctx := context.WithoutCancel(r.Context())
+			completed := false
+			defer func() {
+				if completed {
+					return
+				}
+				// Обработчик упал с паникой - освобождаем ключ, чтобы запрос можно было повторить
+				if err := repo.ReleaseIdempotencyKey(ctx, key, record.Token); err != nil {
+					log.Printf("failed to release idempotency key %q: %v\n", header, err)
+				}
+			}()
+
+			next.ServeHTTP(recorder, r)
+
+			if recorder.statusCode >= http.StatusInternalServerError {
+				return
+			}
+			err = repo.SaveIdempotentResponse(ctx, key, record.Token, recorder.response())
+			if err != nil {
+				log.Printf("failed to save response for idempotency key %q: %v\n", header, err)
+				return
+			}
+			completed = true
+		})
+	}
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// requestUserUUID возвращает пользователя запроса: user_uuid из JSON-тела или query.
+// Значение, не являющееся UUID, не учитывается - его всё равно отклонит валидация запроса.
+func requestUserUUID(r *http.Request, body []byte) string {
+	var payload struct {
+		UserUUID string `json:"user_uuid"`
+	}
+	userUUID := r.URL.Query().Get("user_uuid")
+	if err := json.Unmarshal(body, &payload); err == nil && payload.UserUUID != "" {
+		userUUID = payload.UserUUID
+	}
+
+	parsed, err := uuid.Parse(userUUID)
+	if err != nil {
+		return ""
+	}
+	return parsed.String()
+}
+
+// requestFingerprint хеширует метод, путь и тело запроса. JSON-тело приводится к каноническому
+// виду, поэтому порядок полей и пробелы не делают запрос другим.
+func requestFingerprint(r *http.Request, body []byte) string {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err == nil {
+		if canonical, err := json.Marshal(value); err == nil {
+			body = canonical
+		}
+	}
+
+	h := sha256.New()
+	_, _ = io.WriteString(h, r.Method+" "+r.URL.Path+"\n")
+	_, _ = h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func writeError(w http.ResponseWriter, statusCode int, body json.Marshaler) {
+	data, err := body.MarshalJSON()
+	if err != nil {
+		http.Error(w, http.StatusText(statusCode), statusCode)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(data)
+}
+
+// responseRecorder передаёт ответ клиенту и запоминает его для повторов.
+type responseRecorder struct {
+	http.ResponseWriter
+
+	statusCode  int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(statusCode int) {
+	if !r.wroteHeader {
+		r.statusCode = statusCode
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	r.wroteHeader = true
+	r.body.Write(data)
+	return r.ResponseWriter.Write(data)
+}
+
+func (r *responseRecorder) response() model.IdempotentResponse {
+	return model.IdempotentResponse{
+		StatusCode:  r.statusCode,
+		ContentType: r.Header().Get("Content-Type"),
+		Body:        r.body.Bytes(),
+	}
+}
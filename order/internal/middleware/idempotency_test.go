@@ -0,0 +1,172 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/brianvoe/gofakeit/v7"
+)
+
+// countingHandler отвечает 201 с номером вызова в теле.
+func countingHandler(calls *atomic.Int32) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"call":` + strconv.Itoa(int(n)) + `}`))
+	})
+}
+
+func (s *IdempotencySuite) serve(handler http.Handler, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/orders", strings.NewReader(body)).WithContext(s.ctx)
+	if key != "" {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+	rec := httptest.NewRecorder()
+	Idempotency(s.repo)(handler).ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *IdempotencySuite) TestReplayReturnsOriginalResponse() {
+	// Arrange
+	var calls atomic.Int32
+	handler := countingHandler(&calls)
+	key := gofakeit.UUID()
+
+	// Act
+	first := s.serve(handler, key, `{"user_uuid":"u","items":[]}`)
+	// Порядок полей и пробелы не меняют запрос
+	replay := s.serve(handler, key, `{ "items": [], "user_uuid": "u" }`)
+
+	// Assert
+	s.Require().Equal(int32(1), calls.Load())
+	s.Require().Equal(http.StatusCreated, first.Code)
+	s.Require().Equal(http.StatusCreated, replay.Code)
+	s.Require().Equal(first.Body.String(), replay.Body.String())
+	s.Require().Equal("application/json", replay.Header().Get("Content-Type"))
+	s.Require().Equal("true", replay.Header().Get(IdempotentReplayedHeader))
+	s.Require().Empty(first.Header().Get(IdempotentReplayedHeader))
+}
+
+func (s *IdempotencySuite) TestKeyReusedWithDifferentBody() {
+	// Arrange
+	var calls atomic.Int32
+	handler := countingHandler(&calls)
+	key := gofakeit.UUID()
+	s.serve(handler, key, `{"user_uuid":"u"}`)
+
+	// Act
+	res := s.serve(handler, key, `{"user_uuid":"other"}`)
+
+	// Assert
+	s.Require().Equal(http.StatusUnprocessableEntity, res.Code)
+	s.Require().Equal(int32(1), calls.Load())
+}
+
+func (s *IdempotencySuite) TestConcurrentDuplicateInFlight() {
+	// Arrange
+	var (
+		key     = gofakeit.UUID()
+		started = make(chan struct{})
+		release = make(chan struct{})
+		done    = make(chan *httptest.ResponseRecorder)
+	)
+	slow := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		close(started)
+		<-release
+		w.WriteHeader(http.StatusOK)
+	})
+	go func() { done <- s.serve(slow, key, `{}`) }()
+	<-started
+
+	// Act
+	duplicate := s.serve(slow, key, `{}`)
+	close(release)
+	original := <-done
+
+	// Assert
+	s.Require().Equal(http.StatusConflict, duplicate.Code)
+	s.Require().Equal(http.StatusOK, original.Code)
+}
+
+func (s *IdempotencySuite) TestServerErrorReleasesKey() {
+	// Arrange
+	var calls atomic.Int32
+	failing := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	key := gofakeit.UUID()
+
+	// Act
+	first := s.serve(failing, key, `{}`)
+	retry := s.serve(failing, key, `{}`)
+
+	// Assert
+	s.Require().Equal(http.StatusBadGateway, first.Code)
+	s.Require().Equal(http.StatusBadGateway, retry.Code)
+	s.Require().Equal(int32(2), calls.Load())
+}
+
+func (s *IdempotencySuite) TestPanicReleasesKey() {
+	// Arrange
+	key := gofakeit.UUID()
+	panicking := http.HandlerFunc(func(http.ResponseWriter, *http.Request) { panic("boom") })
+	s.Require().Panics(func() { s.serve(panicking, key, `{}`) })
+
+	var calls atomic.Int32
+
+	// Act
+	res := s.serve(countingHandler(&calls), key, `{}`)
+
+	// Assert
+	s.Require().Equal(http.StatusCreated, res.Code)
+	s.Require().Equal(int32(1), calls.Load())
+}
+
+func (s *IdempotencySuite) TestWithoutKeyOrForReads() {
+	// Arrange
+	var calls atomic.Int32
+	handler := countingHandler(&calls)
+
+	// Act
+	s.serve(handler, "", `{}`)
+	s.serve(handler, "", `{}`)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/orders", nil)
+	req.Header.Set(IdempotencyKeyHeader, gofakeit.UUID())
+	Idempotency(s.repo)(handler).ServeHTTP(httptest.NewRecorder(), req)
+	Idempotency(s.repo)(handler).ServeHTTP(httptest.NewRecorder(), req)
+
+	// Assert
+	s.Require().Equal(int32(4), calls.Load())
+}
+
+func (s *IdempotencySuite) TestKeyTooLong() {
+	var calls atomic.Int32
+
+	res := s.serve(countingHandler(&calls), strings.Repeat("k", 256), `{}`)
+
+	s.Require().Equal(http.StatusBadRequest, res.Code)
+	s.Require().Zero(calls.Load())
+}
+
+func (s *IdempotencySuite) TestKeyScopedByUser() {
+	// Arrange
+	var calls atomic.Int32
+	handler := countingHandler(&calls)
+	key := gofakeit.UUID()
+
+	// Act
+	first := s.serve(handler, key, `{"user_uuid":"`+gofakeit.UUID()+`"}`)
+	other := s.serve(handler, key, `{"user_uuid":"`+gofakeit.UUID()+`"}`)
+
+	// Assert
+	s.Require().Equal(http.StatusCreated, first.Code)
+	s.Require().Equal(http.StatusCreated, other.Code)
+	s.Require().Empty(other.Header().Get(IdempotentReplayedHeader))
+	s.Require().Equal(int32(2), calls.Load())
+}
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/repository"
+	orderRepository "github.com/baryshnikkov/rocket-factory/order/internal/repository/order"
+)
+
+type IdempotencySuite struct {
+	suite.Suite
+	ctx context.Context
+
+	repo repository.IdempotencyRepository
+}
+
+func (s *IdempotencySuite) SetupTest() {
+	s.ctx = context.Background()
+	s.repo = orderRepository.NewRepository()
+}
+
+func TestIdempotencyMiddleware(t *testing.T) {
+	suite.Run(t, new(IdempotencySuite))
+}
@@ -1,19 +1,57 @@
 package model
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
 
 // Order errors
 var (
 	ErrOrderNotFound         = errors.New("order not found")
 	ErrOrderInternalError    = errors.New("internal error while get order")
 	ErrOrderConflict         = errors.New("order conflict")
+	ErrOrderStatusConflict   = errors.New("order status changed concurrently")
+	ErrInvalidTransition     = errors.New("order status transition is not allowed")
 	ErrOrderAlreadyPaid      = errors.New("order already paid, cannot be cancelled")
 	ErrOrderAlreadyCancelled = errors.New("order already cancelled, cannot be cancelled again")
+	ErrInvalidPageToken      = errors.New("invalid page token")
+	ErrInvalidOrderBy        = errors.New("invalid order_by")
+	ErrNotFulfillmentStatus  = errors.New("status cannot be set by fulfillment")
+)
+
+// Idempotency errors
+var (
+	ErrIdempotencyKeyNotFound = errors.New("idempotency key not found")
+	// ErrIdempotencyKeyLost - ключ истёк и его занял другой запрос
+	ErrIdempotencyKeyLost = errors.New("idempotency key is held by another request")
 )
 
 // Parts errors
 var (
-	ErrPartsNotFound = errors.New("parts not found")
+	ErrPartsNotFound    = errors.New("parts not found")
+	ErrPartsUnavailable = errors.New("parts unavailable")
+)
+
+// PartsError - ошибка, относящаяся к конкретным деталям заказа
+type PartsError struct {
+	Err   error    // ErrPartsNotFound или ErrPartsUnavailable
+	UUIDs []string // UUID деталей, из-за которых заказ не создан
+}
+
+func (e *PartsError) Error() string {
+	return fmt.Sprintf("%v: %s", e.Err, strings.Join(e.UUIDs, ", "))
+}
+
+func (e *PartsError) Unwrap() error {
+	return e.Err
+}
+
+// Inventory errors
+var (
+	ErrInsufficientStock   = errors.New("insufficient stock")
+	ErrReservationNotFound = errors.New("reservation not found")
+	ErrReservationExpired  = errors.New("reservation expired")
 )
 
 // Payment errors
@@ -21,4 +59,10 @@ var (
 	ErrPaymentInternalError = errors.New("internal error while processing payment")
 	ErrPaymentConflict      = errors.New("payment conflict")
 	ErrPaymentNotFound      = errors.New("payment not found")
+	ErrPaymentUnavailable   = errors.New("payment service unavailable")
+	ErrPaymentRejected      = errors.New("payment rejected by payment service")
+	ErrRefundRejected       = errors.New("refund rejected by payment service")
+	ErrRefundFailed         = errors.New("refund failed")
+	ErrCaptureRejected      = errors.New("capture rejected by payment service")
+	ErrCaptureFailed        = errors.New("capture failed")
 )
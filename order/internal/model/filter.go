@@ -0,0 +1,30 @@
+package model
+
+import "slices"
+
+// Match проверяет заказ на соответствие фильтру так же, как это делает ListOrders.
+func (filter OrdersFilter) Match(order OrderDto) bool {
+	if filter.UserUUID != "" && order.UserUUID != filter.UserUUID {
+		return false
+	}
+
+	if len(filter.Statuses) > 0 && !slices.Contains(filter.Statuses, order.Status) {
+		return false
+	}
+
+	if filter.CreatedFrom != nil && order.CreatedAt.Before(*filter.CreatedFrom) {
+		return false
+	}
+
+	if filter.CreatedTo != nil && order.CreatedAt.After(*filter.CreatedTo) {
+		return false
+	}
+
+	if filter.PartUUID != "" && !slices.ContainsFunc(order.Items, func(item OrderItem) bool {
+		return item.PartUUID == filter.PartUUID
+	}) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,43 @@
+package model
+
+import "time"
+
+const (
+	// IdempotencyKeyTTL - сколько хранится ответ на запрос с ключом идемпотентности
+	IdempotencyKeyTTL = 24 * time.Hour
+	// IdempotencyLockTimeout - через сколько незавершённый запрос считается брошенным
+	// (например, сервис упал посреди обработки), и ключ можно занять снова
+	IdempotencyLockTimeout = time.Minute
+)
+
+// IdempotencyKey - ключ идемпотентности в области пользователя и маршрута: одинаковые заголовки
+// разных пользователей или на разных маршрутах не пересекаются
+type IdempotencyKey struct {
+	UserUUID string // Пустой, если запрос не указывает пользователя
+	Route    string // Метод и путь запроса
+	Key      string // Значение заголовка Idempotency-Key
+}
+
+// IdempotencyRecord - запрос, выполненный с ключом идемпотентности
+type IdempotencyRecord struct {
+	Key         IdempotencyKey
+	Fingerprint string              // Хеш метода, пути и тела запроса
+	Token       string              // Выдаётся запросу, занявшему ключ; сохранить ответ или освободить ключ можно только с ним
+	Response    *IdempotentResponse // nil, пока запрос выполняется
+	CreatedAt   time.Time
+}
+
+// IdempotentResponse - сохранённый ответ, который возвращается на повтор запроса
+type IdempotentResponse struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
+
+// Expired сообщает, что ключ можно занять заново: ответ устарел или запрос брошен.
+func (record IdempotencyRecord) Expired(now time.Time) bool {
+	if record.Response == nil {
+		return now.Sub(record.CreatedAt) > IdempotencyLockTimeout
+	}
+	return now.Sub(record.CreatedAt) > IdempotencyKeyTTL
+}
@@ -1,17 +1,61 @@
 package model
 
-import "time"
+import (
+	"math"
+	"time"
+)
+
+// Currency - валюта цен заказов (ISO 4217); цены хранятся в основных единицах, рублях
+const Currency = "RUB"
 
 type OrderDto struct {
-	UUID            string
-	UserUUID        string
-	PartsUUIDs      []string
-	TotalPrice      float64
-	TransactionUUID *string
-	PaymentMethod   *PaymentMethod
-	Status          OrderStatus
-	CreatedAt       time.Time
-	UpdatedAt       *time.Time
+	UUID                  string
+	UserUUID              string
+	Items                 []OrderItem
+	TotalPrice            float64
+	TransactionUUID       *string
+	PaymentMethod         *PaymentMethod
+	ReservationUUID       *string
+	RefundTransactionUUID *string    // Транзакция возврата оплаты при отмене оплаченного заказа
+	PaymentCapturedAt     *time.Time // Момент списания холда; nil - оплата только авторизована
+	Status                OrderStatus
+	PreviousStatus        *OrderStatus // Статус до последнего перехода; nil у нового заказа
+	Version               int64        // Увеличивается при каждом изменении заказа
+	CreatedAt             time.Time
+	UpdatedAt             *time.Time
+}
+
+// OrderItem - позиция заказа; название и цена фиксируются на момент заказа
+type OrderItem struct {
+	PartUUID  string
+	Name      string
+	Quantity  int64
+	UnitPrice float64
+}
+
+// TotalPrice - стоимость позиции
+func (item OrderItem) TotalPrice() float64 {
+	return item.UnitPrice * float64(item.Quantity)
+}
+
+// OrderTotalPrice - стоимость заказа как сумма стоимостей позиций
+func OrderTotalPrice(items []OrderItem) float64 {
+	var total float64
+	for _, item := range items {
+		total += item.TotalPrice()
+	}
+	return total
+}
+
+// MinorUnits переводит цену в минимальные единицы валюты (копейки), в которых платит payment-сервис
+func MinorUnits(price float64) int64 {
+	return int64(math.Round(price * 100))
+}
+
+// CreateOrderItem - запрошенная позиция заказа
+type CreateOrderItem struct {
+	PartUUID string
+	Quantity int64
 }
 
 type OrderCreationInfo struct {
@@ -20,10 +64,11 @@ type OrderCreationInfo struct {
 }
 
 type OrderUpdateInfo struct {
-	TotalPrice      *float64
-	TransactionUUID *string
-	PaymentMethod   *PaymentMethod
-	Status          *OrderStatus
+	TotalPrice            *float64
+	TransactionUUID       *string
+	PaymentMethod         *PaymentMethod
+	RefundTransactionUUID *string
+	PaymentCapturedAt     *time.Time
 }
 
 type PaymentMethod string
@@ -39,7 +84,16 @@ const (
 type OrderStatus string
 
 const (
-	OrderStatusPendingPayment OrderStatus = "PENDING_PAYMENT"
-	OrderStatusPaid           OrderStatus = "PAID"
-	OrderStatusCancelled      OrderStatus = "CANCELLED"
+	OrderStatusPendingPayment    OrderStatus = "PENDING_PAYMENT"
+	OrderStatusPaymentInProgress OrderStatus = "PAYMENT_IN_PROGRESS" // Платёж отправлен, исход ещё неизвестен
+	OrderStatusPaid              OrderStatus = "PAID"
+	OrderStatusAssembling        OrderStatus = "ASSEMBLING"          // Корабль собирается
+	OrderStatusReady             OrderStatus = "READY"               // Собран и ждёт отгрузки
+	OrderStatusCaptureInProgress OrderStatus = "CAPTURE_IN_PROGRESS" // Холд списывается перед отгрузкой
+	OrderStatusShipped           OrderStatus = "SHIPPED"
+	OrderStatusCompleted         OrderStatus = "COMPLETED"
+	OrderStatusCancelled         OrderStatus = "CANCELLED"
+	OrderStatusRefundInProgress  OrderStatus = "REFUND_IN_PROGRESS" // Возврат отправлен, исход ещё неизвестен
+	OrderStatusVoidInProgress    OrderStatus = "VOID_IN_PROGRESS"   // Снятие холда отправлено, исход ещё неизвестен
+	OrderStatusRefunded          OrderStatus = "REFUNDED"           // Оплата возвращена пользователю
 )
@@ -0,0 +1,68 @@
+package model
+
+import "time"
+
+// OrdersFilter - фильтр списка заказов; пустые поля не ограничивают выборку
+type OrdersFilter struct {
+	UserUUID    string
+	Statuses    []OrderStatus // Подходит любой из статусов
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	PartUUID    string // Заказ содержит позицию с этой деталью
+}
+
+type OrdersOrderField string
+
+const (
+	OrdersOrderByCreatedAt  OrdersOrderField = "created_at"
+	OrdersOrderByTotalPrice OrdersOrderField = "total_price"
+)
+
+// OrdersOrder - порядок выдачи; при равных значениях заказы упорядочиваются по UUID в том же направлении
+type OrdersOrder struct {
+	Field OrdersOrderField
+	Desc  bool
+}
+
+// ListOrdersParams - параметры страницы, которые передаёт клиент
+type ListOrdersParams struct {
+	PageSize  int32 // 0 — вернуть все заказы одной страницей
+	PageToken string
+	OrderBy   OrdersOrder
+}
+
+// OrdersList - страница заказов для клиента
+type OrdersList struct {
+	Orders        []OrderDto
+	NextPageToken string
+	TotalSize     int32
+}
+
+// OrdersPageRequest - запрос страницы к хранилищу
+type OrdersPageRequest struct {
+	PageSize int32
+	OrderBy  OrdersOrder
+	After    *OrdersCursor // Последний заказ предыдущей страницы
+}
+
+// OrdersPage - страница заказов из хранилища
+type OrdersPage struct {
+	Orders    []OrderDto
+	TotalSize int32
+	Next      *OrdersCursor // nil, если страница последняя
+}
+
+// OrdersCursor - значения ключа сортировки последнего выданного заказа
+type OrdersCursor struct {
+	UUID       string
+	CreatedAt  time.Time
+	TotalPrice float64
+}
+
+func NewOrdersCursor(order OrderDto) *OrdersCursor {
+	return &OrdersCursor{
+		UUID:       order.UUID,
+		CreatedAt:  order.CreatedAt,
+		TotalPrice: order.TotalPrice,
+	}
+}
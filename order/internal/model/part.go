@@ -15,6 +15,7 @@ type Part struct {
 	Metadata      Metadata
 	CreatedAt     time.Time
 	UpdatedAt     *time.Time
+	Archived      bool // Деталь снята с продажи
 }
 
 type Category string
@@ -41,13 +42,23 @@ type Manufacturer struct {
 	Website string
 }
 
-type Metadata struct {
+// Metadata - метаданные детали по ключам
+type Metadata map[string]MetadataValue
+
+// MetadataValue - значение метаданных; задано ровно одно из полей
+type MetadataValue struct {
 	StringValue *string
 	Int64Value  *int64
 	DoubleValue *float64
 	BoolValue   *bool
 }
 
+// PartsBatch - детали, найденные по списку UUID
+type PartsBatch struct {
+	Parts        map[string]Part
+	MissingUUIDs []string // UUID, которых нет в каталоге
+}
+
 type PartsFilter struct {
 	Uuids                 []string
 	Names                 []string
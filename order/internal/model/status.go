@@ -0,0 +1,113 @@
+package model
+
+import (
+	"slices"
+	"time"
+)
+
+// orderTransitions - машина состояний заказа: для каждого статуса перечислены статусы,
+// в которые из него можно перейти. Статусы без переходов - конечные.
+var orderTransitions = map[OrderStatus][]OrderStatus{
+	OrderStatusPendingPayment:    {OrderStatusPaymentInProgress, OrderStatusCancelled},
+	OrderStatusPaymentInProgress: {OrderStatusPaid, OrderStatusPendingPayment},
+	OrderStatusPaid:              {OrderStatusAssembling, OrderStatusRefundInProgress, OrderStatusVoidInProgress},
+	OrderStatusAssembling:        {OrderStatusReady, OrderStatusRefundInProgress, OrderStatusVoidInProgress},
+	OrderStatusReady:             {OrderStatusShipped, OrderStatusCaptureInProgress, OrderStatusRefundInProgress, OrderStatusVoidInProgress},
+	// Несписанный холд списывается перед отгрузкой; при неудаче заказ остаётся собранным
+	OrderStatusCaptureInProgress: {OrderStatusShipped, OrderStatusReady},
+	// Неудачный возврат или снятие холда возвращает заказ в статус, из которого их начали;
+	// снятый холд отменяет заказ, ведь деньги так и не были списаны
+	OrderStatusRefundInProgress: {OrderStatusRefunded, OrderStatusPaid, OrderStatusAssembling, OrderStatusReady},
+	OrderStatusVoidInProgress:   {OrderStatusCancelled, OrderStatusPaid, OrderStatusAssembling, OrderStatusReady},
+	OrderStatusShipped:          {OrderStatusCompleted},
+	OrderStatusCompleted:        nil,
+	OrderStatusCancelled:        nil,
+	OrderStatusRefunded:         nil,
+}
+
+// orderRollbacks - откаты промежуточных статусов: при неудаче заказ возвращается только в тот статус,
+// из которого в промежуточный перешёл (OrderDto.PreviousStatus)
+var orderRollbacks = map[OrderStatus][]OrderStatus{
+	OrderStatusPaymentInProgress: {OrderStatusPendingPayment},
+	OrderStatusRefundInProgress:  {OrderStatusPaid, OrderStatusAssembling, OrderStatusReady},
+	OrderStatusCaptureInProgress: {OrderStatusReady},
+	OrderStatusVoidInProgress:    {OrderStatusPaid, OrderStatusAssembling, OrderStatusReady},
+}
+
+// Valid сообщает, известен ли статус машине состояний
+func (s OrderStatus) Valid() bool {
+	_, ok := orderTransitions[s]
+	return ok
+}
+
+// CanTransitionTo сообщает, разрешён ли переход из статуса s в статус to
+func (s OrderStatus) CanTransitionTo(to OrderStatus) bool {
+	return slices.Contains(orderTransitions[s], to)
+}
+
+// CanTransitionTo сообщает, разрешён ли переход заказа в статус to с учётом того, из какого статуса
+// он попал в текущий: откат промежуточного статуса возвращает заказ только туда, откуда его начали
+func (o OrderDto) CanTransitionTo(to OrderStatus) bool {
+	if !o.Status.CanTransitionTo(to) {
+		return false
+	}
+	if slices.Contains(orderRollbacks[o.Status], to) {
+		return o.PreviousStatus != nil && *o.PreviousStatus == to
+	}
+	return true
+}
+
+// fulfillmentStatuses - статусы, которые выставляет сборка и доставка через UpdateOrderStatus
+var fulfillmentStatuses = []OrderStatus{OrderStatusAssembling, OrderStatusReady, OrderStatusShipped, OrderStatusCompleted}
+
+// IsFulfillment сообщает, выставляется ли статус сборкой и доставкой заказа
+func (s OrderStatus) IsFulfillment() bool {
+	return slices.Contains(fulfillmentStatuses, s)
+}
+
+// OrderActor - инициатор смены статуса
+type OrderActor string
+
+const (
+	OrderActorUser   OrderActor = "user"   // Действие пользователя через API
+	OrderActorSystem OrderActor = "system" // Автоматическое действие сервиса
+)
+
+// OrderTransition - запрошенная смена статуса заказа
+type OrderTransition struct {
+	From   OrderStatus
+	To     OrderStatus
+	Actor  OrderActor
+	Reason string
+}
+
+// OrderStatusTransition - запись истории статусов заказа
+type OrderStatusTransition struct {
+	From      *OrderStatus // nil у записи о создании заказа
+	To        OrderStatus
+	Actor     OrderActor
+	Reason    string
+	CreatedAt time.Time
+}
+
+// Причины смены статуса, которые сервис записывает в историю
+const (
+	ReasonOrderCreated    = "order created"
+	ReasonPaymentStarted  = "payment started"
+	ReasonPaymentFailed   = "payment failed"
+	ReasonPaymentDone     = "payment succeeded"
+	ReasonCancelledByUser = "cancelled by user"
+	ReasonExpired         = "expired"
+	ReasonRefundStarted   = "refund started"
+	ReasonRefundFailed    = "refund failed"
+	ReasonRefundDone      = "refund succeeded"
+	ReasonVoidStarted     = "void started"
+	ReasonVoidFailed      = "void failed"
+	ReasonVoidDone        = "authorization voided"
+	ReasonAssemblyStarted = "assembly started"
+	ReasonAssembled       = "assembled"
+	ReasonCaptureStarted  = "capture started"
+	ReasonCaptureFailed   = "capture failed"
+	ReasonShipped         = "shipped"
+	ReasonCompleted       = "completed"
+)
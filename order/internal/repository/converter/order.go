@@ -9,14 +9,53 @@ import (
 
 func OrderDataToModel(order repoModel.OrderDto) model.OrderDto {
 	return model.OrderDto{
-		UUID:            order.UUID,
-		UserUUID:        order.UserUUID,
-		PartsUUIDs:      order.PartsUUIDs,
-		TotalPrice:      order.TotalPrice,
-		TransactionUUID: order.TransactionUUID,
-		PaymentMethod:   lo.ToPtr(model.PaymentMethod(lo.FromPtr(order.PaymentMethod))),
-		Status:          model.OrderStatus(order.Status),
-		CreatedAt:       order.CreatedAt,
-		UpdatedAt:       order.UpdatedAt,
+		UUID:                  order.UUID,
+		UserUUID:              order.UserUUID,
+		Items:                 OrderItemsToModel(order.Items),
+		TotalPrice:            order.TotalPrice,
+		TransactionUUID:       order.TransactionUUID,
+		PaymentMethod:         lo.ToPtr(model.PaymentMethod(lo.FromPtr(order.PaymentMethod))),
+		ReservationUUID:       order.ReservationUUID,
+		RefundTransactionUUID: order.RefundTransactionUUID,
+		PaymentCapturedAt:     order.PaymentCapturedAt,
+		Status:                model.OrderStatus(order.Status),
+		PreviousStatus:        (*model.OrderStatus)(order.PreviousStatus),
+		Version:               order.Version,
+		CreatedAt:             order.CreatedAt,
+		UpdatedAt:             order.UpdatedAt,
 	}
 }
+
+func OrderItemsToModel(items []repoModel.OrderItem) []model.OrderItem {
+	res := make([]model.OrderItem, 0, len(items))
+	for _, item := range items {
+		res = append(res, model.OrderItem(item))
+	}
+	return res
+}
+
+func OrderItemsToRepoModel(items []model.OrderItem) []repoModel.OrderItem {
+	res := make([]repoModel.OrderItem, 0, len(items))
+	for _, item := range items {
+		res = append(res, repoModel.OrderItem(item))
+	}
+	return res
+}
+
+func OrderStatusTransitionsToModel(history []repoModel.OrderStatusTransition) []model.OrderStatusTransition {
+	res := make([]model.OrderStatusTransition, 0, len(history))
+	for _, entry := range history {
+		var from *model.OrderStatus
+		if entry.From != nil {
+			from = lo.ToPtr(model.OrderStatus(*entry.From))
+		}
+		res = append(res, model.OrderStatusTransition{
+			From:      from,
+			To:        model.OrderStatus(entry.To),
+			Actor:     model.OrderActor(entry.Actor),
+			Reason:    entry.Reason,
+			CreatedAt: entry.CreatedAt,
+		})
+	}
+	return res
+}
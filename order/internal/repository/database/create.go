@@ -0,0 +1,65 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	repoModel "github.com/baryshnikkov/rocket-factory/order/internal/repository/model"
+)
+
+func (r *repository) CreateOrder(ctx context.Context, userUUID string, items []model.OrderItem, reservationUUID string) (info model.OrderCreationInfo, err error) {
+	orderUUID := uuid.NewString()
+
+	totalPrice := model.OrderTotalPrice(items)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return model.OrderCreationInfo{}, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	now := time.Now().UTC()
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO orders (uuid, user_uuid, total_price, reservation_uuid, status, created_at) VALUES ($1, $2, $3, $4, $5, $6)`,
+		orderUUID, userUUID, totalPrice, lo.EmptyableToPtr(reservationUUID), string(repoModel.OrderStatusPendingPayment), now,
+	)
+	if err != nil {
+		return model.OrderCreationInfo{}, err
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO order_status_history (order_uuid, seq, from_status, to_status, actor, reason, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		orderUUID, 0, nil, string(repoModel.OrderStatusPendingPayment), string(model.OrderActorUser), model.ReasonOrderCreated, now,
+	)
+	if err != nil {
+		return model.OrderCreationInfo{}, err
+	}
+
+	for i, item := range items {
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO order_items (order_uuid, item_index, part_uuid, name, quantity, unit_price) VALUES ($1, $2, $3, $4, $5, $6)`,
+			orderUUID, i, item.PartUUID, item.Name, item.Quantity, item.UnitPrice,
+		)
+		if err != nil {
+			return model.OrderCreationInfo{}, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return model.OrderCreationInfo{}, err
+	}
+
+	return model.OrderCreationInfo{
+		OrderUUID:  orderUUID,
+		TotalPrice: totalPrice,
+	}, nil
+}
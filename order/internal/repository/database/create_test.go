@@ -0,0 +1,65 @@
+package database
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+)
+
+func (s *RepositorySuite) TestCreateOrderSuccess() {
+	// Arrange
+	userUUID := gofakeit.UUID()
+	items := []model.OrderItem{
+		{
+			PartUUID:  gofakeit.UUID(),
+			Name:      "Engine",
+			Quantity:  4,
+			UnitPrice: 1000.50,
+		},
+		{
+			PartUUID:  gofakeit.UUID(),
+			Name:      "Wing",
+			Quantity:  1,
+			UnitPrice: 500.25,
+		},
+	}
+
+	expectedTotalPrice := 4*1000.50 + 500.25
+	reservationUUID := gofakeit.UUID()
+
+	// Act
+	res, err := s.repo.CreateOrder(s.ctx, userUUID, items, reservationUUID)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().NotEmpty(res.OrderUUID)
+	s.Require().Equal(expectedTotalPrice, res.TotalPrice)
+
+	savedOrder, err := s.repo.GetOrder(s.ctx, res.OrderUUID)
+	s.Require().NoError(err)
+	s.Require().Equal(userUUID, savedOrder.UserUUID)
+	s.Require().Equal(expectedTotalPrice, savedOrder.TotalPrice)
+	s.Require().Equal(model.OrderStatusPendingPayment, savedOrder.Status)
+	s.Require().Equal(items, savedOrder.Items)
+	s.Require().Equal(reservationUUID, *savedOrder.ReservationUUID)
+	s.Require().False(savedOrder.CreatedAt.IsZero())
+	s.Require().Nil(savedOrder.UpdatedAt)
+}
+
+func (s *RepositorySuite) TestCreateOrderEmptyParts() {
+	// Arrange
+	userUUID := gofakeit.UUID()
+
+	// Act
+	res, err := s.repo.CreateOrder(s.ctx, userUUID, nil, "")
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().NotEmpty(res.OrderUUID)
+	s.Require().Equal(0.0, res.TotalPrice)
+
+	savedOrder, err := s.repo.GetOrder(s.ctx, res.OrderUUID)
+	s.Require().NoError(err)
+	s.Require().Empty(savedOrder.Items)
+	s.Require().Nil(savedOrder.ReservationUUID)
+}
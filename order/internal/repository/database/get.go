@@ -0,0 +1,119 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	"github.com/baryshnikkov/rocket-factory/order/internal/repository/converter"
+	repoModel "github.com/baryshnikkov/rocket-factory/order/internal/repository/model"
+)
+
+const orderColumns = `o.uuid, o.user_uuid, o.total_price, o.transaction_uuid, o.payment_method, o.reservation_uuid, o.refund_transaction_uuid, o.payment_captured_at, o.status, o.previous_status, o.version, o.created_at, o.updated_at`
+
+func (r *repository) GetOrder(ctx context.Context, uuid string) (order model.OrderDto, err error) {
+	outOrder, err := scanOrder(r.db.QueryRowContext(ctx,
+		`SELECT `+orderColumns+` FROM orders o WHERE o.uuid = $1`,
+		uuid,
+	))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.OrderDto{}, model.ErrOrderNotFound
+		}
+		return model.OrderDto{}, err
+	}
+
+	outOrder.Items, err = r.getOrderItems(ctx, uuid)
+	if err != nil {
+		return model.OrderDto{}, err
+	}
+
+	return converter.OrderDataToModel(outOrder), nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanOrder читает строку с колонками orderColumns; позиции заказа загружаются отдельно.
+func scanOrder(row rowScanner) (repoModel.OrderDto, error) {
+	var (
+		outOrder        repoModel.OrderDto
+		transactionUUID sql.NullString
+		paymentMethod   sql.NullString
+		reservationUUID sql.NullString
+		refundUUID      sql.NullString
+		capturedAt      sql.NullTime
+		status          string
+		previousStatus  sql.NullString
+		updatedAt       sql.NullTime
+	)
+
+	err := row.Scan(
+		&outOrder.UUID,
+		&outOrder.UserUUID,
+		&outOrder.TotalPrice,
+		&transactionUUID,
+		&paymentMethod,
+		&reservationUUID,
+		&refundUUID,
+		&capturedAt,
+		&status,
+		&previousStatus,
+		&outOrder.Version,
+		&outOrder.CreatedAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return repoModel.OrderDto{}, err
+	}
+
+	outOrder.Status = repoModel.OrderStatus(status)
+	if previousStatus.Valid {
+		outOrder.PreviousStatus = (*repoModel.OrderStatus)(&previousStatus.String)
+	}
+	if transactionUUID.Valid {
+		outOrder.TransactionUUID = &transactionUUID.String
+	}
+	if paymentMethod.Valid {
+		method := repoModel.PaymentMethod(paymentMethod.String)
+		outOrder.PaymentMethod = &method
+	}
+	if reservationUUID.Valid {
+		outOrder.ReservationUUID = &reservationUUID.String
+	}
+	if refundUUID.Valid {
+		outOrder.RefundTransactionUUID = &refundUUID.String
+	}
+	if capturedAt.Valid {
+		outOrder.PaymentCapturedAt = &capturedAt.Time
+	}
+	if updatedAt.Valid {
+		outOrder.UpdatedAt = &updatedAt.Time
+	}
+
+	return outOrder, nil
+}
+
+func (r *repository) getOrderItems(ctx context.Context, orderUUID string) ([]repoModel.OrderItem, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT part_uuid, name, quantity, unit_price FROM order_items WHERE order_uuid = $1 ORDER BY item_index`,
+		orderUUID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []repoModel.OrderItem
+	for rows.Next() {
+		var item repoModel.OrderItem
+		if err = rows.Scan(&item.PartUUID, &item.Name, &item.Quantity, &item.UnitPrice); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, rows.Err()
+}
@@ -0,0 +1,25 @@
+package database
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+)
+
+func (s *RepositorySuite) TestGetOrderNotFound() {
+	// Act
+	res, err := s.repo.GetOrder(s.ctx, gofakeit.UUID())
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrOrderNotFound)
+	s.Require().Equal(model.OrderDto{}, res)
+}
+
+func (s *RepositorySuite) TestGetOrderEmptyUUID() {
+	// Act
+	res, err := s.repo.GetOrder(s.ctx, "")
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrOrderNotFound)
+	s.Require().Equal(model.OrderDto{}, res)
+}
@@ -0,0 +1,58 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	"github.com/baryshnikkov/rocket-factory/order/internal/repository/converter"
+	repoModel "github.com/baryshnikkov/rocket-factory/order/internal/repository/model"
+)
+
+func (r *repository) GetOrderHistory(ctx context.Context, orderUUID string) ([]model.OrderStatusTransition, error) {
+	history, err := r.getOrderHistory(ctx, orderUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// По пустой истории нельзя понять, существует ли заказ
+	if len(history) == 0 {
+		if _, err = r.GetOrder(ctx, orderUUID); err != nil {
+			return nil, err
+		}
+	}
+
+	return converter.OrderStatusTransitionsToModel(history), nil
+}
+
+func (r *repository) getOrderHistory(ctx context.Context, orderUUID string) ([]repoModel.OrderStatusTransition, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT from_status, to_status, actor, reason, created_at FROM order_status_history WHERE order_uuid = $1 ORDER BY seq`,
+		orderUUID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var history []repoModel.OrderStatusTransition
+	for rows.Next() {
+		var (
+			entry repoModel.OrderStatusTransition
+			from  sql.NullString
+			to    string
+		)
+		if err = rows.Scan(&from, &to, &entry.Actor, &entry.Reason, &entry.CreatedAt); err != nil {
+			return nil, err
+		}
+		entry.To = repoModel.OrderStatus(to)
+		if from.Valid {
+			entry.From = lo.ToPtr(repoModel.OrderStatus(from.String))
+		}
+		history = append(history, entry)
+	}
+
+	return history, rows.Err()
+}
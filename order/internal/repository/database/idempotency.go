@@ -0,0 +1,143 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+)
+
+func (r *repository) AcquireIdempotencyKey(ctx context.Context, key model.IdempotencyKey, fingerprint string) (model.IdempotencyRecord, bool, error) {
+	now := time.Now().UTC()
+
+	// Записи старше IdempotencyKeyTTL истекли при любом состоянии
+	_, err := r.db.ExecContext(ctx,
+		`DELETE FROM idempotency_keys WHERE created_at < $1`,
+		now.Add(-model.IdempotencyKeyTTL),
+	)
+	if err != nil {
+		return model.IdempotencyRecord{}, false, err
+	}
+
+	taken := model.IdempotencyRecord{Key: key, Fingerprint: fingerprint, Token: uuid.NewString(), CreatedAt: now}
+
+	// Конкурентные запросы с одним ключом разрешает первичный ключ: вставка проходит только у одного
+	res, err := r.db.ExecContext(ctx,
+		`INSERT INTO idempotency_keys (user_uuid, route, idempotency_key, fingerprint, lock_token, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (user_uuid, route, idempotency_key) DO NOTHING`,
+		key.UserUUID, key.Route, key.Key, fingerprint, taken.Token, now,
+	)
+	if err != nil {
+		return model.IdempotencyRecord{}, false, err
+	}
+	inserted, err := res.RowsAffected()
+	if err != nil {
+		return model.IdempotencyRecord{}, false, err
+	}
+	if inserted == 1 {
+		return taken, true, nil
+	}
+
+	record, err := r.getIdempotencyRecord(ctx, key)
+	if errors.Is(err, model.ErrIdempotencyKeyNotFound) {
+		// Ключ освободили между вставкой и чтением — пробуем занять его снова
+		return r.AcquireIdempotencyKey(ctx, key, fingerprint)
+	}
+	if err != nil {
+		return model.IdempotencyRecord{}, false, err
+	}
+	if !record.Expired(now) {
+		return record, false, nil
+	}
+
+	// Истёкшую запись занимаем, только если её никто не занял раньше нас
+	res, err = r.db.ExecContext(ctx,
+		`UPDATE idempotency_keys
+		SET fingerprint = $1, lock_token = $2, status_code = NULL, content_type = NULL, body = NULL, created_at = $3
+		WHERE user_uuid = $4 AND route = $5 AND idempotency_key = $6 AND lock_token = $7`,
+		fingerprint, taken.Token, now, key.UserUUID, key.Route, key.Key, record.Token,
+	)
+	if err != nil {
+		return model.IdempotencyRecord{}, false, err
+	}
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return model.IdempotencyRecord{}, false, err
+	}
+	if updated == 0 {
+		record, err = r.getIdempotencyRecord(ctx, key)
+		return record, false, err
+	}
+
+	return taken, true, nil
+}
+
+func (r *repository) SaveIdempotentResponse(ctx context.Context, key model.IdempotencyKey, token string, response model.IdempotentResponse) error {
+	res, err := r.db.ExecContext(ctx,
+		`UPDATE idempotency_keys SET status_code = $1, content_type = $2, body = $3
+		WHERE user_uuid = $4 AND route = $5 AND idempotency_key = $6 AND lock_token = $7 AND status_code IS NULL`,
+		response.StatusCode, response.ContentType, string(response.Body), key.UserUUID, key.Route, key.Key, token,
+	)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		if _, err = r.getIdempotencyRecord(ctx, key); err != nil {
+			return err
+		}
+		return model.ErrIdempotencyKeyLost
+	}
+
+	return nil
+}
+
+func (r *repository) ReleaseIdempotencyKey(ctx context.Context, key model.IdempotencyKey, token string) error {
+	// Готовый ответ не удаляем: его должны получать повторы запроса
+	_, err := r.db.ExecContext(ctx,
+		`DELETE FROM idempotency_keys
+		WHERE user_uuid = $1 AND route = $2 AND idempotency_key = $3 AND lock_token = $4 AND status_code IS NULL`,
+		key.UserUUID, key.Route, key.Key, token,
+	)
+	return err
+}
+
+func (r *repository) getIdempotencyRecord(ctx context.Context, key model.IdempotencyKey) (model.IdempotencyRecord, error) {
+	var (
+		record      = model.IdempotencyRecord{Key: key}
+		statusCode  sql.NullInt64
+		contentType sql.NullString
+		body        sql.NullString
+	)
+
+	err := r.db.QueryRowContext(ctx,
+		`SELECT fingerprint, lock_token, status_code, content_type, body, created_at FROM idempotency_keys
+		WHERE user_uuid = $1 AND route = $2 AND idempotency_key = $3`,
+		key.UserUUID, key.Route, key.Key,
+	).Scan(&record.Fingerprint, &record.Token, &statusCode, &contentType, &body, &record.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.IdempotencyRecord{}, model.ErrIdempotencyKeyNotFound
+		}
+		return model.IdempotencyRecord{}, err
+	}
+
+	if statusCode.Valid {
+		record.Response = &model.IdempotentResponse{
+			StatusCode:  int(statusCode.Int64),
+			ContentType: contentType.String,
+			Body:        []byte(body.String),
+		}
+	}
+
+	return record, nil
+}
@@ -0,0 +1,151 @@
+package database
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+)
+
+func newIdempotencyKey() model.IdempotencyKey {
+	return model.IdempotencyKey{UserUUID: gofakeit.UUID(), Route: "POST /api/v1/orders", Key: gofakeit.UUID()}
+}
+
+func (s *RepositorySuite) TestAcquireIdempotencyKey() {
+	// Arrange
+	key := newIdempotencyKey()
+
+	// Act
+	_, acquired, err := s.repo.AcquireIdempotencyKey(s.ctx, key, "fp")
+	s.Require().NoError(err)
+	s.Require().True(acquired)
+
+	record, acquiredAgain, err := s.repo.AcquireIdempotencyKey(s.ctx, key, "other")
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().False(acquiredAgain)
+	s.Require().Equal("fp", record.Fingerprint)
+	s.Require().Nil(record.Response)
+}
+
+func (s *RepositorySuite) TestSaveIdempotentResponse() {
+	// Arrange
+	key := newIdempotencyKey()
+	taken, _, err := s.repo.AcquireIdempotencyKey(s.ctx, key, "fp")
+	s.Require().NoError(err)
+	response := model.IdempotentResponse{StatusCode: 201, ContentType: "application/json", Body: []byte(`{"uuid":"x"}`)}
+
+	// Act
+	err = s.repo.SaveIdempotentResponse(s.ctx, key, taken.Token, response)
+	s.Require().NoError(err)
+	s.Require().NoError(s.repo.ReleaseIdempotencyKey(s.ctx, key, taken.Token))
+
+	record, acquired, err := s.repo.AcquireIdempotencyKey(s.ctx, key, "fp")
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().False(acquired)
+	s.Require().NotNil(record.Response)
+	s.Require().Equal(response, *record.Response)
+}
+
+func (s *RepositorySuite) TestSaveIdempotentResponseNotFound() {
+	// Act
+	err := s.repo.SaveIdempotentResponse(s.ctx, newIdempotencyKey(), gofakeit.UUID(), model.IdempotentResponse{StatusCode: 200})
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrIdempotencyKeyNotFound)
+}
+
+func (s *RepositorySuite) TestReleaseIdempotencyKey() {
+	// Arrange
+	key := newIdempotencyKey()
+	taken, _, err := s.repo.AcquireIdempotencyKey(s.ctx, key, "fp")
+	s.Require().NoError(err)
+
+	// Act
+	s.Require().NoError(s.repo.ReleaseIdempotencyKey(s.ctx, key, taken.Token))
+	_, acquired, err := s.repo.AcquireIdempotencyKey(s.ctx, key, "other")
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().True(acquired)
+}
+
+func (s *RepositorySuite) TestAcquireExpiredIdempotencyKey() {
+	// Arrange
+	key := newIdempotencyKey()
+	stale, _, err := s.repo.AcquireIdempotencyKey(s.ctx, key, "fp")
+	s.Require().NoError(err)
+
+	_, err = s.db.ExecContext(s.ctx,
+		`UPDATE idempotency_keys SET created_at = $1 WHERE idempotency_key = $2`,
+		time.Now().UTC().Add(-model.IdempotencyLockTimeout-time.Second), key.Key,
+	)
+	s.Require().NoError(err)
+
+	// Act
+	record, acquired, err := s.repo.AcquireIdempotencyKey(s.ctx, key, "other")
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().True(acquired)
+	s.Require().Equal("other", record.Fingerprint)
+	s.Require().NotEqual(stale.Token, record.Token)
+
+	// Брошенный запрос не может ни сохранить ответ, ни освободить ключ нового запроса
+	err = s.repo.SaveIdempotentResponse(s.ctx, key, stale.Token, model.IdempotentResponse{StatusCode: 201})
+	s.Require().ErrorIs(err, model.ErrIdempotencyKeyLost)
+	s.Require().NoError(s.repo.ReleaseIdempotencyKey(s.ctx, key, stale.Token))
+
+	_, acquired, err = s.repo.AcquireIdempotencyKey(s.ctx, key, "third")
+	s.Require().NoError(err)
+	s.Require().False(acquired)
+}
+
+func (s *RepositorySuite) TestIdempotencyKeyScopedByUserAndRoute() {
+	// Arrange
+	key := newIdempotencyKey()
+	_, _, err := s.repo.AcquireIdempotencyKey(s.ctx, key, "fp")
+	s.Require().NoError(err)
+
+	otherUser := key
+	otherUser.UserUUID = gofakeit.UUID()
+	otherRoute := key
+	otherRoute.Route = "POST /api/v1/orders/" + gofakeit.UUID() + "/pay"
+
+	// Act
+	_, acquiredByUser, err := s.repo.AcquireIdempotencyKey(s.ctx, otherUser, "fp")
+	s.Require().NoError(err)
+	_, acquiredOnRoute, err := s.repo.AcquireIdempotencyKey(s.ctx, otherRoute, "fp")
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().True(acquiredByUser)
+	s.Require().True(acquiredOnRoute)
+}
+
+func (s *RepositorySuite) TestAcquireIdempotencyKeyPrunesOutdated() {
+	// Arrange - ответ старше IdempotencyKeyTTL
+	key := newIdempotencyKey()
+	taken, _, err := s.repo.AcquireIdempotencyKey(s.ctx, key, "fp")
+	s.Require().NoError(err)
+	s.Require().NoError(s.repo.SaveIdempotentResponse(s.ctx, key, taken.Token, model.IdempotentResponse{StatusCode: 201}))
+
+	_, err = s.db.ExecContext(s.ctx,
+		`UPDATE idempotency_keys SET created_at = $1 WHERE idempotency_key = $2`,
+		time.Now().UTC().Add(-model.IdempotencyKeyTTL-time.Second), key.Key,
+	)
+	s.Require().NoError(err)
+
+	// Act
+	_, _, err = s.repo.AcquireIdempotencyKey(s.ctx, newIdempotencyKey(), "fp")
+
+	// Assert
+	s.Require().NoError(err)
+	var count int
+	s.Require().NoError(s.db.QueryRowContext(s.ctx, `SELECT COUNT(*) FROM idempotency_keys`).Scan(&count))
+	s.Require().Equal(1, count)
+}
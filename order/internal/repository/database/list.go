@@ -0,0 +1,163 @@
+package database
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	"github.com/baryshnikkov/rocket-factory/order/internal/repository/converter"
+)
+
+func (r *repository) ListOrders(ctx context.Context, filter model.OrdersFilter, page model.OrdersPageRequest) (model.OrdersPage, error) {
+	where, args := buildFilter(filter)
+
+	countQuery := `SELECT COUNT(*) FROM orders o`
+	if where != "" {
+		countQuery += ` WHERE ` + where
+	}
+
+	var total int32
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		return model.OrdersPage{}, err
+	}
+	if total == 0 {
+		return model.OrdersPage{}, nil
+	}
+
+	column, direction := orderColumn(page.OrderBy), "ASC"
+	if page.OrderBy.Desc {
+		direction = "DESC"
+	}
+
+	var conds []string
+	if where != "" {
+		conds = append(conds, where)
+	}
+	if page.After != nil {
+		conds = append(conds, afterCondition(&args, column, page.OrderBy, *page.After))
+	}
+
+	query := `SELECT ` + orderColumns + ` FROM orders o`
+	if len(conds) > 0 {
+		query += ` WHERE ` + strings.Join(conds, " AND ")
+	}
+	query += ` ORDER BY ` + column + ` ` + direction + `, o.uuid ` + direction
+
+	// Запрашиваем на один заказ больше, чтобы понять, есть ли следующая страница
+	if page.PageSize > 0 {
+		args = append(args, page.PageSize+1)
+		query += ` LIMIT ` + placeholder(len(args))
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return model.OrdersPage{}, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	result := model.OrdersPage{TotalSize: total}
+	for rows.Next() {
+		order, scanErr := scanOrder(rows)
+		if scanErr != nil {
+			return model.OrdersPage{}, scanErr
+		}
+		result.Orders = append(result.Orders, converter.OrderDataToModel(order))
+	}
+	if err = rows.Err(); err != nil {
+		return model.OrdersPage{}, err
+	}
+	_ = rows.Close()
+
+	if page.PageSize > 0 && len(result.Orders) > int(page.PageSize) {
+		result.Orders = result.Orders[:page.PageSize]
+		result.Next = model.NewOrdersCursor(result.Orders[len(result.Orders)-1])
+	}
+
+	for i := range result.Orders {
+		items, itemsErr := r.getOrderItems(ctx, result.Orders[i].UUID)
+		if itemsErr != nil {
+			return model.OrdersPage{}, itemsErr
+		}
+		result.Orders[i].Items = converter.OrderItemsToModel(items)
+	}
+
+	return result, nil
+}
+
+func orderColumn(order model.OrdersOrder) string {
+	switch order.Field {
+	case model.OrdersOrderByTotalPrice:
+		return "o.total_price"
+	default:
+		return "o.created_at"
+	}
+}
+
+// afterCondition отбирает заказы, идущие в порядке сортировки строго после курсора.
+func afterCondition(args *[]any, column string, order model.OrdersOrder, after model.OrdersCursor) string {
+	var value any
+	switch order.Field {
+	case model.OrdersOrderByTotalPrice:
+		value = after.TotalPrice
+	default:
+		value = after.CreatedAt.UTC()
+	}
+
+	op := ">"
+	if order.Desc {
+		op = "<"
+	}
+
+	*args = append(*args, value)
+	cond := `(` + column + ` ` + op + ` ` + placeholder(len(*args))
+	*args = append(*args, value)
+	cond += ` OR (` + column + ` = ` + placeholder(len(*args))
+	*args = append(*args, after.UUID)
+	cond += ` AND o.uuid ` + op + ` ` + placeholder(len(*args)) + `))`
+
+	return cond
+}
+
+// buildFilter собирает условие WHERE по фильтру; пустые поля фильтра не ограничивают выборку.
+func buildFilter(filter model.OrdersFilter) (string, []any) {
+	var (
+		conds []string
+		args  []any
+	)
+
+	if filter.UserUUID != "" {
+		args = append(args, filter.UserUUID)
+		conds = append(conds, `o.user_uuid = `+placeholder(len(args)))
+	}
+
+	if len(filter.Statuses) > 0 {
+		items := make([]string, 0, len(filter.Statuses))
+		for _, status := range filter.Statuses {
+			args = append(args, string(status))
+			items = append(items, placeholder(len(args)))
+		}
+		conds = append(conds, `o.status IN (`+strings.Join(items, ", ")+`)`)
+	}
+
+	if filter.CreatedFrom != nil {
+		args = append(args, filter.CreatedFrom.UTC())
+		conds = append(conds, `o.created_at >= `+placeholder(len(args)))
+	}
+
+	if filter.CreatedTo != nil {
+		args = append(args, filter.CreatedTo.UTC())
+		conds = append(conds, `o.created_at <= `+placeholder(len(args)))
+	}
+
+	if filter.PartUUID != "" {
+		args = append(args, filter.PartUUID)
+		conds = append(conds, `EXISTS (SELECT 1 FROM order_items i WHERE i.order_uuid = o.uuid AND i.part_uuid = `+placeholder(len(args))+`)`)
+	}
+
+	return strings.Join(conds, " AND "), args
+}
+
+func placeholder(n int) string {
+	return "$" + strconv.Itoa(n)
+}
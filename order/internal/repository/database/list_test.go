@@ -0,0 +1,115 @@
+package database
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+)
+
+// createOrder создаёт заказ и переносит его создание на createdAt.
+func (s *RepositorySuite) createOrder(userUUID string, createdAt time.Time, items ...model.OrderItem) string {
+	info, err := s.repo.CreateOrder(s.ctx, userUUID, items, "")
+	s.Require().NoError(err)
+
+	_, err = s.db.ExecContext(s.ctx, `UPDATE orders SET created_at = $1 WHERE uuid = $2`, createdAt.UTC(), info.OrderUUID)
+	s.Require().NoError(err)
+
+	return info.OrderUUID
+}
+
+// setStatus выставляет статус заказа в обход машины состояний
+func (s *RepositorySuite) setStatus(orderUUID string, status model.OrderStatus) {
+	_, err := s.db.ExecContext(s.ctx, `UPDATE orders SET status = $1 WHERE uuid = $2`, string(status), orderUUID)
+	s.Require().NoError(err)
+}
+
+func orderUUIDs(orders []model.OrderDto) []string {
+	uuids := make([]string, 0, len(orders))
+	for _, order := range orders {
+		uuids = append(uuids, order.UUID)
+	}
+	return uuids
+}
+
+func (s *RepositorySuite) TestListOrdersFilter() {
+	// Arrange
+	var (
+		userUUID = gofakeit.UUID()
+		part     = model.OrderItem{PartUUID: gofakeit.UUID(), Name: "Engine", Quantity: 2, UnitPrice: 100}
+		now      = time.Now()
+	)
+	match := s.createOrder(userUUID, now, part)
+	s.createOrder(gofakeit.UUID(), now, part)             // другой пользователь
+	s.createOrder(userUUID, now.Add(-48*time.Hour), part) // раньше диапазона
+	s.createOrder(userUUID, now, model.OrderItem{PartUUID: gofakeit.UUID(), Quantity: 1})
+	cancelled := s.createOrder(userUUID, now, part)
+	s.setStatus(cancelled, model.OrderStatusCancelled)
+
+	filter := model.OrdersFilter{
+		UserUUID:    userUUID,
+		Statuses:    []model.OrderStatus{model.OrderStatusPendingPayment, model.OrderStatusPaid},
+		CreatedFrom: lo.ToPtr(now.Add(-time.Hour)),
+		CreatedTo:   lo.ToPtr(now.Add(time.Hour)),
+		PartUUID:    part.PartUUID,
+	}
+
+	// Act
+	res, err := s.repo.ListOrders(s.ctx, filter, model.OrdersPageRequest{})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal([]string{match}, orderUUIDs(res.Orders))
+	s.Require().Equal(int32(1), res.TotalSize)
+	s.Require().Equal([]model.OrderItem{part}, res.Orders[0].Items)
+	s.Require().Nil(res.Next)
+}
+
+func (s *RepositorySuite) TestListOrdersPagination() {
+	// Arrange
+	userUUID := gofakeit.UUID()
+	now := time.Now()
+	newest := s.createOrder(userUUID, now)
+	middle := s.createOrder(userUUID, now.Add(-time.Hour))
+	oldest := s.createOrder(userUUID, now.Add(-2*time.Hour))
+	order := model.OrdersOrder{Field: model.OrdersOrderByCreatedAt, Desc: true}
+
+	// Act
+	first, err := s.repo.ListOrders(s.ctx, model.OrdersFilter{}, model.OrdersPageRequest{PageSize: 2, OrderBy: order})
+	s.Require().NoError(err)
+	second, err := s.repo.ListOrders(s.ctx, model.OrdersFilter{}, model.OrdersPageRequest{PageSize: 2, OrderBy: order, After: first.Next})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal([]string{newest, middle}, orderUUIDs(first.Orders))
+	s.Require().Equal(int32(3), first.TotalSize)
+	s.Require().Equal([]string{oldest}, orderUUIDs(second.Orders))
+	s.Require().Nil(second.Next)
+}
+
+func (s *RepositorySuite) TestListOrdersSortByTotalPrice() {
+	// Arrange
+	userUUID := gofakeit.UUID()
+	now := time.Now()
+	expensive := s.createOrder(userUUID, now, model.OrderItem{PartUUID: gofakeit.UUID(), Quantity: 3, UnitPrice: 100})
+	cheap := s.createOrder(userUUID, now, model.OrderItem{PartUUID: gofakeit.UUID(), Quantity: 1, UnitPrice: 100})
+
+	// Act
+	res, err := s.repo.ListOrders(s.ctx, model.OrdersFilter{}, model.OrdersPageRequest{
+		OrderBy: model.OrdersOrder{Field: model.OrdersOrderByTotalPrice},
+	})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal([]string{cheap, expensive}, orderUUIDs(res.Orders))
+}
+
+func (s *RepositorySuite) TestListOrdersEmpty() {
+	res, err := s.repo.ListOrders(s.ctx, model.OrdersFilter{UserUUID: gofakeit.UUID()}, model.OrdersPageRequest{})
+
+	s.Require().NoError(err)
+	s.Require().Empty(res.Orders)
+	s.Require().Zero(res.TotalSize)
+}
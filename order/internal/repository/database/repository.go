@@ -0,0 +1,24 @@
+package database
+
+import (
+	"database/sql"
+
+	def "github.com/baryshnikkov/rocket-factory/order/internal/repository"
+)
+
+var (
+	_ def.OrderRepository       = (*repository)(nil)
+	_ def.IdempotencyRepository = (*repository)(nil)
+)
+
+// repository — реализация OrderRepository поверх SQL-базы (PostgreSQL или SQLite).
+// Схема создаётся миграциями из пакета migrations, см. Open.
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) *repository {
+	return &repository{
+		db: db,
+	}
+}
@@ -0,0 +1,106 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+)
+
+func (r *repository) TransitionStatus(ctx context.Context, orderUUID string, transition model.OrderTransition, patch model.OrderUpdateInfo) (model.OrderDto, error) {
+	transitioned, err := r.transitionStatus(ctx, orderUUID, transition, patch)
+	if err != nil {
+		return model.OrderDto{}, err
+	}
+	if !transitioned {
+		// Отличаем отсутствующий заказ от заказа в другом статусе
+		if _, err = r.GetOrder(ctx, orderUUID); err != nil {
+			return model.OrderDto{}, err
+		}
+		return model.OrderDto{}, model.ErrOrderStatusConflict
+	}
+
+	return r.GetOrder(ctx, orderUUID)
+}
+
+// transitionStatus меняет статус и пишет историю в одной транзакции; false - заказ не в статусе transition.From
+func (r *repository) transitionStatus(ctx context.Context, orderUUID string, transition model.OrderTransition, patch model.OrderUpdateInfo) (transitioned bool, err error) {
+	var paymentMethod *string
+	if patch.PaymentMethod != nil {
+		paymentMethod = (*string)(patch.PaymentMethod)
+	}
+	var capturedAt *time.Time
+	if patch.PaymentCapturedAt != nil {
+		capturedAt = lo.ToPtr(patch.PaymentCapturedAt.UTC())
+	}
+
+	now := time.Now().UTC()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err != nil || !transitioned {
+			_ = tx.Rollback()
+		}
+	}()
+
+	// Условие на статус делает переход compare-and-set: из двух конкурентных переходов проходит один
+	res, err := tx.ExecContext(ctx,
+		`UPDATE orders SET
+			total_price = COALESCE($1, total_price),
+			transaction_uuid = COALESCE($2, transaction_uuid),
+			payment_method = COALESCE($3, payment_method),
+			status = $4,
+			previous_status = $5,
+			refund_transaction_uuid = COALESCE($6, refund_transaction_uuid),
+			payment_captured_at = COALESCE($7, payment_captured_at),
+			version = version + 1,
+			updated_at = $8
+		WHERE uuid = $9 AND status = $10`,
+		patch.TotalPrice,
+		patch.TransactionUUID,
+		paymentMethod,
+		string(transition.To),
+		string(transition.From),
+		patch.RefundTransactionUUID,
+		capturedAt,
+		now,
+		orderUUID,
+		string(transition.From),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if affected == 0 {
+		return false, nil
+	}
+
+	var version int64
+	err = tx.QueryRowContext(ctx, `SELECT version FROM orders WHERE uuid = $1`, orderUUID).Scan(&version)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO order_status_history (order_uuid, seq, from_status, to_status, actor, reason, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		orderUUID, version, string(transition.From), string(transition.To), string(transition.Actor), transition.Reason, now,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
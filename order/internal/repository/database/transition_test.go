@@ -0,0 +1,133 @@
+package database
+
+import (
+	"sync"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+)
+
+func (s *RepositorySuite) TestTransitionStatusSuccess() {
+	// Arrange
+	info, err := s.repo.CreateOrder(s.ctx, gofakeit.UUID(), []model.OrderItem{{PartUUID: gofakeit.UUID(), Quantity: 1, UnitPrice: 100.0}}, "")
+	s.Require().NoError(err)
+	transactionUUID := gofakeit.UUID()
+
+	// Act
+	order, err := s.repo.TransitionStatus(s.ctx, info.OrderUUID, model.OrderTransition{From: model.OrderStatusPendingPayment, To: model.OrderStatusPaid}, model.OrderUpdateInfo{
+		TransactionUUID: &transactionUUID,
+		PaymentMethod:   lo.ToPtr(model.SBP),
+	})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(model.OrderStatusPaid, order.Status)
+	s.Require().Equal(model.OrderStatusPendingPayment, *order.PreviousStatus)
+	s.Require().Equal(int64(1), order.Version)
+	s.Require().Equal(transactionUUID, *order.TransactionUUID)
+	s.Require().Equal(model.SBP, *order.PaymentMethod)
+	s.Require().Len(order.Items, 1)
+}
+
+func (s *RepositorySuite) TestTransitionStatusConflict() {
+	// Arrange
+	info, err := s.repo.CreateOrder(s.ctx, gofakeit.UUID(), []model.OrderItem{{PartUUID: gofakeit.UUID(), Quantity: 1, UnitPrice: 100.0}}, "")
+	s.Require().NoError(err)
+
+	// Act
+	_, err = s.repo.TransitionStatus(s.ctx, info.OrderUUID, model.OrderTransition{From: model.OrderStatusPaymentInProgress, To: model.OrderStatusPaid}, model.OrderUpdateInfo{})
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrOrderStatusConflict)
+
+	order, err := s.repo.GetOrder(s.ctx, info.OrderUUID)
+	s.Require().NoError(err)
+	s.Require().Equal(model.OrderStatusPendingPayment, order.Status)
+	s.Require().Zero(order.Version)
+}
+
+func (s *RepositorySuite) TestTransitionStatusNotFound() {
+	// Act
+	_, err := s.repo.TransitionStatus(s.ctx, gofakeit.UUID(), model.OrderTransition{From: model.OrderStatusPendingPayment, To: model.OrderStatusPaid}, model.OrderUpdateInfo{})
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrOrderNotFound)
+}
+
+func (s *RepositorySuite) TestTransitionStatusConcurrent() {
+	// Arrange
+	info, err := s.repo.CreateOrder(s.ctx, gofakeit.UUID(), []model.OrderItem{{PartUUID: gofakeit.UUID(), Quantity: 1, UnitPrice: 100.0}}, "")
+	s.Require().NoError(err)
+
+	const attempts = 10
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		wins int
+	)
+
+	// Act
+	for range attempts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, transitionErr := s.repo.TransitionStatus(s.ctx, info.OrderUUID, model.OrderTransition{From: model.OrderStatusPendingPayment, To: model.OrderStatusPaymentInProgress}, model.OrderUpdateInfo{})
+			if transitionErr == nil {
+				mu.Lock()
+				wins++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Assert
+	s.Require().Equal(1, wins)
+
+	order, err := s.repo.GetOrder(s.ctx, info.OrderUUID)
+	s.Require().NoError(err)
+	s.Require().Equal(model.OrderStatusPaymentInProgress, order.Status)
+	s.Require().Equal(int64(1), order.Version)
+}
+
+func (s *RepositorySuite) TestTransitionStatusStoresRefundTransaction() {
+	// Arrange
+	info, err := s.repo.CreateOrder(s.ctx, gofakeit.UUID(), []model.OrderItem{{PartUUID: gofakeit.UUID(), Quantity: 1, UnitPrice: 100.0}}, "")
+	s.Require().NoError(err)
+	s.setStatus(info.OrderUUID, model.OrderStatusRefundInProgress)
+	refundUUID := gofakeit.UUID()
+
+	// Act
+	order, err := s.repo.TransitionStatus(s.ctx, info.OrderUUID, model.OrderTransition{
+		From: model.OrderStatusRefundInProgress,
+		To:   model.OrderStatusRefunded,
+	}, model.OrderUpdateInfo{RefundTransactionUUID: &refundUUID})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(model.OrderStatusRefunded, order.Status)
+	s.Require().Equal(refundUUID, *order.RefundTransactionUUID)
+}
+
+func (s *RepositorySuite) TestTransitionStatusStoresPaymentCapturedAt() {
+	// Arrange
+	info, err := s.repo.CreateOrder(s.ctx, gofakeit.UUID(), []model.OrderItem{{PartUUID: gofakeit.UUID(), Quantity: 1, UnitPrice: 100.0}}, "")
+	s.Require().NoError(err)
+	s.setStatus(info.OrderUUID, model.OrderStatusReady)
+	capturedAt := time.Now().Truncate(time.Second)
+
+	// Act
+	order, err := s.repo.TransitionStatus(s.ctx, info.OrderUUID, model.OrderTransition{
+		From: model.OrderStatusReady,
+		To:   model.OrderStatusShipped,
+	}, model.OrderUpdateInfo{PaymentCapturedAt: &capturedAt})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(model.OrderStatusShipped, order.Status)
+	s.Require().NotNil(order.PaymentCapturedAt)
+	s.Require().True(capturedAt.Equal(*order.PaymentCapturedAt))
+}
@@ -0,0 +1,201 @@
+// Code generated for baryshnikkov service
+// © baryshnikkov 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/baryshnikkov/rocket-factory/order/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IdempotencyRepository is an autogenerated mock type for the IdempotencyRepository type
+type IdempotencyRepository struct {
+	mock.Mock
+}
+
+type IdempotencyRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IdempotencyRepository) EXPECT() *IdempotencyRepository_Expecter {
+	return &IdempotencyRepository_Expecter{mock: &_m.Mock}
+}
+
+// AcquireIdempotencyKey provides a mock function with given fields: ctx, key, fingerprint
+func (_m *IdempotencyRepository) AcquireIdempotencyKey(ctx context.Context, key model.IdempotencyKey, fingerprint string) (model.IdempotencyRecord, bool, error) {
+	ret := _m.Called(ctx, key, fingerprint)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcquireIdempotencyKey")
+	}
+
+	var r0 model.IdempotencyRecord
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.IdempotencyKey, string) (model.IdempotencyRecord, bool, error)); ok {
+		return rf(ctx, key, fingerprint)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.IdempotencyKey, string) model.IdempotencyRecord); ok {
+		r0 = rf(ctx, key, fingerprint)
+	} else {
+		r0 = ret.Get(0).(model.IdempotencyRecord)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.IdempotencyKey, string) bool); ok {
+		r1 = rf(ctx, key, fingerprint)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, model.IdempotencyKey, string) error); ok {
+		r2 = rf(ctx, key, fingerprint)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// IdempotencyRepository_AcquireIdempotencyKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcquireIdempotencyKey'
+type IdempotencyRepository_AcquireIdempotencyKey_Call struct {
+	*mock.Call
+}
+
+// AcquireIdempotencyKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key model.IdempotencyKey
+//   - fingerprint string
+func (_e *IdempotencyRepository_Expecter) AcquireIdempotencyKey(ctx interface{}, key interface{}, fingerprint interface{}) *IdempotencyRepository_AcquireIdempotencyKey_Call {
+	return &IdempotencyRepository_AcquireIdempotencyKey_Call{Call: _e.mock.On("AcquireIdempotencyKey", ctx, key, fingerprint)}
+}
+
+func (_c *IdempotencyRepository_AcquireIdempotencyKey_Call) Run(run func(ctx context.Context, key model.IdempotencyKey, fingerprint string)) *IdempotencyRepository_AcquireIdempotencyKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.IdempotencyKey), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *IdempotencyRepository_AcquireIdempotencyKey_Call) Return(record model.IdempotencyRecord, acquired bool, err error) *IdempotencyRepository_AcquireIdempotencyKey_Call {
+	_c.Call.Return(record, acquired, err)
+	return _c
+}
+
+func (_c *IdempotencyRepository_AcquireIdempotencyKey_Call) RunAndReturn(run func(context.Context, model.IdempotencyKey, string) (model.IdempotencyRecord, bool, error)) *IdempotencyRepository_AcquireIdempotencyKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseIdempotencyKey provides a mock function with given fields: ctx, key, token
+func (_m *IdempotencyRepository) ReleaseIdempotencyKey(ctx context.Context, key model.IdempotencyKey, token string) error {
+	ret := _m.Called(ctx, key, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseIdempotencyKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.IdempotencyKey, string) error); ok {
+		r0 = rf(ctx, key, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IdempotencyRepository_ReleaseIdempotencyKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseIdempotencyKey'
+type IdempotencyRepository_ReleaseIdempotencyKey_Call struct {
+	*mock.Call
+}
+
+// ReleaseIdempotencyKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key model.IdempotencyKey
+//   - token string
+func (_e *IdempotencyRepository_Expecter) ReleaseIdempotencyKey(ctx interface{}, key interface{}, token interface{}) *IdempotencyRepository_ReleaseIdempotencyKey_Call {
+	return &IdempotencyRepository_ReleaseIdempotencyKey_Call{Call: _e.mock.On("ReleaseIdempotencyKey", ctx, key, token)}
+}
+
+func (_c *IdempotencyRepository_ReleaseIdempotencyKey_Call) Run(run func(ctx context.Context, key model.IdempotencyKey, token string)) *IdempotencyRepository_ReleaseIdempotencyKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.IdempotencyKey), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *IdempotencyRepository_ReleaseIdempotencyKey_Call) Return(_a0 error) *IdempotencyRepository_ReleaseIdempotencyKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdempotencyRepository_ReleaseIdempotencyKey_Call) RunAndReturn(run func(context.Context, model.IdempotencyKey, string) error) *IdempotencyRepository_ReleaseIdempotencyKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveIdempotentResponse provides a mock function with given fields: ctx, key, token, response
+func (_m *IdempotencyRepository) SaveIdempotentResponse(ctx context.Context, key model.IdempotencyKey, token string, response model.IdempotentResponse) error {
+	ret := _m.Called(ctx, key, token, response)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveIdempotentResponse")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.IdempotencyKey, string, model.IdempotentResponse) error); ok {
+		r0 = rf(ctx, key, token, response)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IdempotencyRepository_SaveIdempotentResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveIdempotentResponse'
+type IdempotencyRepository_SaveIdempotentResponse_Call struct {
+	*mock.Call
+}
+
+// SaveIdempotentResponse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key model.IdempotencyKey
+//   - token string
+//   - response model.IdempotentResponse
+func (_e *IdempotencyRepository_Expecter) SaveIdempotentResponse(ctx interface{}, key interface{}, token interface{}, response interface{}) *IdempotencyRepository_SaveIdempotentResponse_Call {
+	return &IdempotencyRepository_SaveIdempotentResponse_Call{Call: _e.mock.On("SaveIdempotentResponse", ctx, key, token, response)}
+}
+
+func (_c *IdempotencyRepository_SaveIdempotentResponse_Call) Run(run func(ctx context.Context, key model.IdempotencyKey, token string, response model.IdempotentResponse)) *IdempotencyRepository_SaveIdempotentResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.IdempotencyKey), args[2].(string), args[3].(model.IdempotentResponse))
+	})
+	return _c
+}
+
+func (_c *IdempotencyRepository_SaveIdempotentResponse_Call) Return(_a0 error) *IdempotencyRepository_SaveIdempotentResponse_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdempotencyRepository_SaveIdempotentResponse_Call) RunAndReturn(run func(context.Context, model.IdempotencyKey, string, model.IdempotentResponse) error) *IdempotencyRepository_SaveIdempotentResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIdempotencyRepository creates a new instance of IdempotencyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIdempotencyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IdempotencyRepository {
+	mock := &IdempotencyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -24,9 +24,9 @@ func (_m *OrderRepository) EXPECT() *OrderRepository_Expecter {
 	return &OrderRepository_Expecter{mock: &_m.Mock}
 }
 
-// CreateOrder provides a mock function with given fields: ctx, userUUID, parts
-func (_m *OrderRepository) CreateOrder(ctx context.Context, userUUID string, parts []model.Part) (model.OrderCreationInfo, error) {
-	ret := _m.Called(ctx, userUUID, parts)
+// CreateOrder provides a mock function with given fields: ctx, userUUID, items, reservationUUID
+func (_m *OrderRepository) CreateOrder(ctx context.Context, userUUID string, items []model.OrderItem, reservationUUID string) (model.OrderCreationInfo, error) {
+	ret := _m.Called(ctx, userUUID, items, reservationUUID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateOrder")
@@ -34,17 +34,17 @@ func (_m *OrderRepository) CreateOrder(ctx context.Context, userUUID string, par
 
 	var r0 model.OrderCreationInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, []model.Part) (model.OrderCreationInfo, error)); ok {
-		return rf(ctx, userUUID, parts)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []model.OrderItem, string) (model.OrderCreationInfo, error)); ok {
+		return rf(ctx, userUUID, items, reservationUUID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, []model.Part) model.OrderCreationInfo); ok {
-		r0 = rf(ctx, userUUID, parts)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []model.OrderItem, string) model.OrderCreationInfo); ok {
+		r0 = rf(ctx, userUUID, items, reservationUUID)
 	} else {
 		r0 = ret.Get(0).(model.OrderCreationInfo)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, []model.Part) error); ok {
-		r1 = rf(ctx, userUUID, parts)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []model.OrderItem, string) error); ok {
+		r1 = rf(ctx, userUUID, items, reservationUUID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -60,14 +60,15 @@ type OrderRepository_CreateOrder_Call struct {
 // CreateOrder is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userUUID string
-//   - parts []model.Part
-func (_e *OrderRepository_Expecter) CreateOrder(ctx interface{}, userUUID interface{}, parts interface{}) *OrderRepository_CreateOrder_Call {
-	return &OrderRepository_CreateOrder_Call{Call: _e.mock.On("CreateOrder", ctx, userUUID, parts)}
+//   - items []model.OrderItem
+//   - reservationUUID string
+func (_e *OrderRepository_Expecter) CreateOrder(ctx interface{}, userUUID interface{}, items interface{}, reservationUUID interface{}) *OrderRepository_CreateOrder_Call {
+	return &OrderRepository_CreateOrder_Call{Call: _e.mock.On("CreateOrder", ctx, userUUID, items, reservationUUID)}
 }
 
-func (_c *OrderRepository_CreateOrder_Call) Run(run func(ctx context.Context, userUUID string, parts []model.Part)) *OrderRepository_CreateOrder_Call {
+func (_c *OrderRepository_CreateOrder_Call) Run(run func(ctx context.Context, userUUID string, items []model.OrderItem, reservationUUID string)) *OrderRepository_CreateOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].([]model.Part))
+		run(args[0].(context.Context), args[1].(string), args[2].([]model.OrderItem), args[3].(string))
 	})
 	return _c
 }
@@ -77,7 +78,7 @@ func (_c *OrderRepository_CreateOrder_Call) Return(info model.OrderCreationInfo,
 	return _c
 }
 
-func (_c *OrderRepository_CreateOrder_Call) RunAndReturn(run func(context.Context, string, []model.Part) (model.OrderCreationInfo, error)) *OrderRepository_CreateOrder_Call {
+func (_c *OrderRepository_CreateOrder_Call) RunAndReturn(run func(context.Context, string, []model.OrderItem, string) (model.OrderCreationInfo, error)) *OrderRepository_CreateOrder_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -139,50 +140,178 @@ func (_c *OrderRepository_GetOrder_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
-// UpdateOrder provides a mock function with given fields: ctx, orderUUID, orderUpdateInfo
-func (_m *OrderRepository) UpdateOrder(ctx context.Context, orderUUID string, orderUpdateInfo model.OrderUpdateInfo) error {
-	ret := _m.Called(ctx, orderUUID, orderUpdateInfo)
+// GetOrderHistory provides a mock function with given fields: ctx, orderUUID
+func (_m *OrderRepository) GetOrderHistory(ctx context.Context, orderUUID string) ([]model.OrderStatusTransition, error) {
+	ret := _m.Called(ctx, orderUUID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateOrder")
+		panic("no return value specified for GetOrderHistory")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, model.OrderUpdateInfo) error); ok {
-		r0 = rf(ctx, orderUUID, orderUpdateInfo)
+	var r0 []model.OrderStatusTransition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.OrderStatusTransition, error)); ok {
+		return rf(ctx, orderUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.OrderStatusTransition); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.OrderStatusTransition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, orderUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderRepository_GetOrderHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderHistory'
+type OrderRepository_GetOrderHistory_Call struct {
+	*mock.Call
+}
+
+// GetOrderHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *OrderRepository_Expecter) GetOrderHistory(ctx interface{}, orderUUID interface{}) *OrderRepository_GetOrderHistory_Call {
+	return &OrderRepository_GetOrderHistory_Call{Call: _e.mock.On("GetOrderHistory", ctx, orderUUID)}
+}
+
+func (_c *OrderRepository_GetOrderHistory_Call) Run(run func(ctx context.Context, orderUUID string)) *OrderRepository_GetOrderHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_GetOrderHistory_Call) Return(_a0 []model.OrderStatusTransition, _a1 error) *OrderRepository_GetOrderHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderRepository_GetOrderHistory_Call) RunAndReturn(run func(context.Context, string) ([]model.OrderStatusTransition, error)) *OrderRepository_GetOrderHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrders provides a mock function with given fields: ctx, filter, page
+func (_m *OrderRepository) ListOrders(ctx context.Context, filter model.OrdersFilter, page model.OrdersPageRequest) (model.OrdersPage, error) {
+	ret := _m.Called(ctx, filter, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrders")
+	}
+
+	var r0 model.OrdersPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrdersFilter, model.OrdersPageRequest) (model.OrdersPage, error)); ok {
+		return rf(ctx, filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrdersFilter, model.OrdersPageRequest) model.OrdersPage); ok {
+		r0 = rf(ctx, filter, page)
+	} else {
+		r0 = ret.Get(0).(model.OrdersPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.OrdersFilter, model.OrdersPageRequest) error); ok {
+		r1 = rf(ctx, filter, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderRepository_ListOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrders'
+type OrderRepository_ListOrders_Call struct {
+	*mock.Call
+}
+
+// ListOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.OrdersFilter
+//   - page model.OrdersPageRequest
+func (_e *OrderRepository_Expecter) ListOrders(ctx interface{}, filter interface{}, page interface{}) *OrderRepository_ListOrders_Call {
+	return &OrderRepository_ListOrders_Call{Call: _e.mock.On("ListOrders", ctx, filter, page)}
+}
+
+func (_c *OrderRepository_ListOrders_Call) Run(run func(ctx context.Context, filter model.OrdersFilter, page model.OrdersPageRequest)) *OrderRepository_ListOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.OrdersFilter), args[2].(model.OrdersPageRequest))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_ListOrders_Call) Return(_a0 model.OrdersPage, _a1 error) *OrderRepository_ListOrders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderRepository_ListOrders_Call) RunAndReturn(run func(context.Context, model.OrdersFilter, model.OrdersPageRequest) (model.OrdersPage, error)) *OrderRepository_ListOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransitionStatus provides a mock function with given fields: ctx, orderUUID, transition, patch
+func (_m *OrderRepository) TransitionStatus(ctx context.Context, orderUUID string, transition model.OrderTransition, patch model.OrderUpdateInfo) (model.OrderDto, error) {
+	ret := _m.Called(ctx, orderUUID, transition, patch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransitionStatus")
+	}
+
+	var r0 model.OrderDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.OrderTransition, model.OrderUpdateInfo) (model.OrderDto, error)); ok {
+		return rf(ctx, orderUUID, transition, patch)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.OrderTransition, model.OrderUpdateInfo) model.OrderDto); ok {
+		r0 = rf(ctx, orderUUID, transition, patch)
+	} else {
+		r0 = ret.Get(0).(model.OrderDto)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.OrderTransition, model.OrderUpdateInfo) error); ok {
+		r1 = rf(ctx, orderUUID, transition, patch)
 	} else {
-		r0 = ret.Error(0)
+		r1 = ret.Error(1)
 	}
 
-	return r0
+	return r0, r1
 }
 
-// OrderRepository_UpdateOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrder'
-type OrderRepository_UpdateOrder_Call struct {
+// OrderRepository_TransitionStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransitionStatus'
+type OrderRepository_TransitionStatus_Call struct {
 	*mock.Call
 }
 
-// UpdateOrder is a helper method to define mock.On call
+// TransitionStatus is a helper method to define mock.On call
 //   - ctx context.Context
 //   - orderUUID string
-//   - orderUpdateInfo model.OrderUpdateInfo
-func (_e *OrderRepository_Expecter) UpdateOrder(ctx interface{}, orderUUID interface{}, orderUpdateInfo interface{}) *OrderRepository_UpdateOrder_Call {
-	return &OrderRepository_UpdateOrder_Call{Call: _e.mock.On("UpdateOrder", ctx, orderUUID, orderUpdateInfo)}
+//   - transition model.OrderTransition
+//   - patch model.OrderUpdateInfo
+func (_e *OrderRepository_Expecter) TransitionStatus(ctx interface{}, orderUUID interface{}, transition interface{}, patch interface{}) *OrderRepository_TransitionStatus_Call {
+	return &OrderRepository_TransitionStatus_Call{Call: _e.mock.On("TransitionStatus", ctx, orderUUID, transition, patch)}
 }
 
-func (_c *OrderRepository_UpdateOrder_Call) Run(run func(ctx context.Context, orderUUID string, orderUpdateInfo model.OrderUpdateInfo)) *OrderRepository_UpdateOrder_Call {
+func (_c *OrderRepository_TransitionStatus_Call) Run(run func(ctx context.Context, orderUUID string, transition model.OrderTransition, patch model.OrderUpdateInfo)) *OrderRepository_TransitionStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(model.OrderUpdateInfo))
+		run(args[0].(context.Context), args[1].(string), args[2].(model.OrderTransition), args[3].(model.OrderUpdateInfo))
 	})
 	return _c
 }
 
-func (_c *OrderRepository_UpdateOrder_Call) Return(_a0 error) *OrderRepository_UpdateOrder_Call {
-	_c.Call.Return(_a0)
+func (_c *OrderRepository_TransitionStatus_Call) Return(_a0 model.OrderDto, _a1 error) *OrderRepository_TransitionStatus_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *OrderRepository_UpdateOrder_Call) RunAndReturn(run func(context.Context, string, model.OrderUpdateInfo) error) *OrderRepository_UpdateOrder_Call {
+func (_c *OrderRepository_TransitionStatus_Call) RunAndReturn(run func(context.Context, string, model.OrderTransition, model.OrderUpdateInfo) (model.OrderDto, error)) *OrderRepository_TransitionStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3,15 +3,27 @@ package model
 import "time"
 
 type OrderDto struct {
-	UUID            string
-	UserUUID        string
-	PartsUUIDs      []string
-	TotalPrice      float64
-	TransactionUUID *string
-	PaymentMethod   *PaymentMethod
-	Status          OrderStatus
-	CreatedAt       time.Time
-	UpdatedAt       *time.Time
+	UUID                  string
+	UserUUID              string
+	Items                 []OrderItem
+	TotalPrice            float64
+	TransactionUUID       *string
+	PaymentMethod         *PaymentMethod
+	ReservationUUID       *string
+	RefundTransactionUUID *string
+	PaymentCapturedAt     *time.Time
+	Status                OrderStatus
+	PreviousStatus        *OrderStatus
+	Version               int64
+	CreatedAt             time.Time
+	UpdatedAt             *time.Time
+}
+
+type OrderItem struct {
+	PartUUID  string
+	Name      string
+	Quantity  int64
+	UnitPrice float64
 }
 
 type PaymentMethod string
@@ -27,7 +39,24 @@ const (
 type OrderStatus string
 
 const (
-	OrderStatusPendingPayment OrderStatus = "PENDING_PAYMENT"
-	OrderStatusPaid           OrderStatus = "PAID"
-	OrderStatusCancelled      OrderStatus = "CANCELLED"
+	OrderStatusPendingPayment    OrderStatus = "PENDING_PAYMENT"
+	OrderStatusPaymentInProgress OrderStatus = "PAYMENT_IN_PROGRESS"
+	OrderStatusPaid              OrderStatus = "PAID"
+	OrderStatusAssembling        OrderStatus = "ASSEMBLING"
+	OrderStatusReady             OrderStatus = "READY"
+	OrderStatusCaptureInProgress OrderStatus = "CAPTURE_IN_PROGRESS"
+	OrderStatusShipped           OrderStatus = "SHIPPED"
+	OrderStatusCompleted         OrderStatus = "COMPLETED"
+	OrderStatusCancelled         OrderStatus = "CANCELLED"
+	OrderStatusRefundInProgress  OrderStatus = "REFUND_IN_PROGRESS"
+	OrderStatusVoidInProgress    OrderStatus = "VOID_IN_PROGRESS"
+	OrderStatusRefunded          OrderStatus = "REFUNDED"
 )
+
+type OrderStatusTransition struct {
+	From      *OrderStatus
+	To        OrderStatus
+	Actor     string
+	Reason    string
+	CreatedAt time.Time
+}
@@ -6,44 +6,48 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 
 	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	"github.com/baryshnikkov/rocket-factory/order/internal/repository/converter"
 	repoModel "github.com/baryshnikkov/rocket-factory/order/internal/repository/model"
 )
 
-func (r *repository) CreateOrder(ctx context.Context, userUUID string, parts []model.Part) (info model.OrderCreationInfo, err error) {
+func (r *repository) CreateOrder(ctx context.Context, userUUID string, items []model.OrderItem, reservationUUID string) (info model.OrderCreationInfo, err error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	orderUUID := uuid.NewString()
 
-	var partUUIDs []string
-	var totalPrice float64
-	for _, part := range parts {
-		partUUIDs = append(partUUIDs, part.UUID)
-		totalPrice += part.Price
-	}
+	totalPrice := model.OrderTotalPrice(items)
 
 	order := repoModel.OrderDto{
-		UUID:       orderUUID,
-		UserUUID:   userUUID,
-		PartsUUIDs: partUUIDs,
-		TotalPrice: totalPrice,
-		Status:     repoModel.OrderStatusPendingPayment,
-		CreatedAt:  time.Now(),
+		UUID:            orderUUID,
+		UserUUID:        userUUID,
+		Items:           converter.OrderItemsToRepoModel(items),
+		TotalPrice:      totalPrice,
+		ReservationUUID: lo.EmptyableToPtr(reservationUUID),
+		Status:          repoModel.OrderStatusPendingPayment,
+		CreatedAt:       time.Now(),
 	}
 
 	r.data[orderUUID] = order
+	r.history[orderUUID] = []repoModel.OrderStatusTransition{{
+		To:        order.Status,
+		Actor:     string(model.OrderActorUser),
+		Reason:    model.ReasonOrderCreated,
+		CreatedAt: order.CreatedAt,
+	}}
 
 	log.Printf(`
 💳 [Order Created]
 • 🆔 Order UUID: %s
 • 👤 User UUID: %s
-• 💰 Part UUIDs: %v
+• 💰 Items: %v
 • 💰 Total Price: %f
 • 💰 Status: %s
 • 💰 CreatedAt: %v
-`, order.UUID, order.UserUUID, order.PartsUUIDs, order.TotalPrice, order.Status, order.CreatedAt,
+`, order.UUID, order.UserUUID, order.Items, order.TotalPrice, order.Status, order.CreatedAt,
 	)
 
 	return model.OrderCreationInfo{
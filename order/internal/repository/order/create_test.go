@@ -6,29 +6,33 @@ import (
 	"github.com/brianvoe/gofakeit/v7"
 
 	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	"github.com/baryshnikkov/rocket-factory/order/internal/repository/converter"
 	repoModel "github.com/baryshnikkov/rocket-factory/order/internal/repository/model"
 )
 
 func (s *RepositorySuite) TestCreateOrderSuccess() {
 	// Arrange
 	userUUID := gofakeit.UUID()
-	parts := []model.Part{
+	items := []model.OrderItem{
 		{
-			UUID:  gofakeit.UUID(),
-			Name:  "Engine",
-			Price: 1000.50,
+			PartUUID:  gofakeit.UUID(),
+			Name:      "Engine",
+			Quantity:  4,
+			UnitPrice: 1000.50,
 		},
 		{
-			UUID:  gofakeit.UUID(),
-			Name:  "Wing",
-			Price: 500.25,
+			PartUUID:  gofakeit.UUID(),
+			Name:      "Wing",
+			Quantity:  1,
+			UnitPrice: 500.25,
 		},
 	}
 
-	expectedTotalPrice := 1000.50 + 500.25
+	expectedTotalPrice := 4*1000.50 + 500.25
+	reservationUUID := gofakeit.UUID()
 
 	// Act
-	res, err := s.repo.CreateOrder(s.ctx, userUUID, parts)
+	res, err := s.repo.CreateOrder(s.ctx, userUUID, items, reservationUUID)
 
 	// Assert
 	s.Require().NoError(err)
@@ -42,18 +46,17 @@ func (s *RepositorySuite) TestCreateOrderSuccess() {
 	s.Require().Equal(userUUID, savedOrder.UserUUID)
 	s.Require().Equal(expectedTotalPrice, savedOrder.TotalPrice)
 	s.Require().Equal(repoModel.OrderStatusPendingPayment, savedOrder.Status)
-	s.Require().Len(savedOrder.PartsUUIDs, 2)
-	s.Require().Contains(savedOrder.PartsUUIDs, parts[0].UUID)
-	s.Require().Contains(savedOrder.PartsUUIDs, parts[1].UUID)
+	s.Require().Equal(converter.OrderItemsToRepoModel(items), savedOrder.Items)
+	s.Require().Equal(reservationUUID, *savedOrder.ReservationUUID)
 }
 
 func (s *RepositorySuite) TestCreateOrderEmptyParts() {
 	// Arrange
 	userUUID := gofakeit.UUID()
-	var parts []model.Part
+	var items []model.OrderItem
 
 	// Act
-	res, err := s.repo.CreateOrder(s.ctx, userUUID, parts)
+	res, err := s.repo.CreateOrder(s.ctx, userUUID, items, "")
 
 	// Assert
 	s.Require().NoError(err)
@@ -65,22 +68,24 @@ func (s *RepositorySuite) TestCreateOrderEmptyParts() {
 	s.Require().Equal(res.OrderUUID, savedOrder.UUID)
 	s.Require().Equal(userUUID, savedOrder.UserUUID)
 	s.Require().Equal(0.0, savedOrder.TotalPrice)
-	s.Require().Empty(savedOrder.PartsUUIDs)
+	s.Require().Nil(savedOrder.ReservationUUID)
+	s.Require().Empty(savedOrder.Items)
 }
 
 func (s *RepositorySuite) TestCreateOrderNilContext() {
 	// Arrange
 	userUUID := gofakeit.UUID()
-	parts := []model.Part{
+	items := []model.OrderItem{
 		{
-			UUID:  gofakeit.UUID(),
-			Name:  "Engine",
-			Price: 1000.0,
+			PartUUID:  gofakeit.UUID(),
+			Name:      "Engine",
+			Quantity:  1,
+			UnitPrice: 1000.0,
 		},
 	}
 
 	// Act - передаем nil контекст
-	res, err := s.repo.CreateOrder(nil, userUUID, parts)
+	res, err := s.repo.CreateOrder(nil, userUUID, items, "")
 
 	// Assert - должен работать даже с nil контекстом
 	s.Require().NoError(err)
@@ -95,16 +100,17 @@ func (s *RepositorySuite) TestCreateOrderNilContext() {
 func (s *RepositorySuite) TestCreateOrderEmptyUserUUID() {
 	// Arrange
 	userUUID := ""
-	parts := []model.Part{
+	items := []model.OrderItem{
 		{
-			UUID:  gofakeit.UUID(),
-			Name:  "Engine",
-			Price: 1000.0,
+			PartUUID:  gofakeit.UUID(),
+			Name:      "Engine",
+			Quantity:  1,
+			UnitPrice: 1000.0,
 		},
 	}
 
 	// Act
-	res, err := s.repo.CreateOrder(s.ctx, userUUID, parts)
+	res, err := s.repo.CreateOrder(s.ctx, userUUID, items, "")
 
 	// Assert
 	s.Require().NoError(err)
@@ -119,11 +125,12 @@ func (s *RepositorySuite) TestCreateOrderEmptyUserUUID() {
 func (s *RepositorySuite) TestCreateOrderConcurrent() {
 	// Arrange
 	userUUID := gofakeit.UUID()
-	parts := []model.Part{
+	items := []model.OrderItem{
 		{
-			UUID:  gofakeit.UUID(),
-			Name:  "Engine",
-			Price: 1000.0,
+			PartUUID:  gofakeit.UUID(),
+			Name:      "Engine",
+			Quantity:  1,
+			UnitPrice: 1000.0,
 		},
 	}
 
@@ -135,7 +142,7 @@ func (s *RepositorySuite) TestCreateOrderConcurrent() {
 		wg.Add(1)
 		go func(index int) {
 			defer wg.Done()
-			res, err := s.repo.CreateOrder(s.ctx, userUUID, parts)
+			res, err := s.repo.CreateOrder(s.ctx, userUUID, items, "")
 			s.Require().NoError(err)
 			orderUUIDs[index] = res.OrderUUID
 		}(i)
@@ -6,15 +6,19 @@ import (
 	"github.com/brianvoe/gofakeit/v7"
 
 	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	"github.com/baryshnikkov/rocket-factory/order/internal/repository/converter"
 	repoModel "github.com/baryshnikkov/rocket-factory/order/internal/repository/model"
 )
 
 func (s *RepositorySuite) TestGetOrderSuccess() {
 	// Arrange
 	repoOrder := repoModel.OrderDto{
-		UUID:       gofakeit.UUID(),
-		UserUUID:   gofakeit.UUID(),
-		PartsUUIDs: []string{gofakeit.UUID(), gofakeit.UUID()},
+		UUID:     gofakeit.UUID(),
+		UserUUID: gofakeit.UUID(),
+		Items: []repoModel.OrderItem{
+			{PartUUID: gofakeit.UUID(), Name: "Engine", Quantity: 2, UnitPrice: 500.25},
+			{PartUUID: gofakeit.UUID(), Name: "Wing", Quantity: 1, UnitPrice: 500.25},
+		},
 		TotalPrice: 1500.75,
 		Status:     repoModel.OrderStatusPendingPayment,
 		CreatedAt:  time.Now(),
@@ -28,7 +32,7 @@ func (s *RepositorySuite) TestGetOrderSuccess() {
 	s.Require().NoError(err)
 	s.Require().Equal(repoOrder.UUID, res.UUID)
 	s.Require().Equal(repoOrder.UserUUID, res.UserUUID)
-	s.Require().Equal(repoOrder.PartsUUIDs, res.PartsUUIDs)
+	s.Require().Equal(converter.OrderItemsToModel(repoOrder.Items), res.Items)
 	s.Require().Equal(repoOrder.TotalPrice, res.TotalPrice)
 	s.Require().Equal(model.OrderStatusPendingPayment, res.Status)
 }
@@ -61,7 +65,7 @@ func (s *RepositorySuite) TestGetOrderNilContext() {
 	repoOrder := repoModel.OrderDto{
 		UUID:       gofakeit.UUID(),
 		UserUUID:   gofakeit.UUID(),
-		PartsUUIDs: []string{gofakeit.UUID()},
+		Items:      []repoModel.OrderItem{{PartUUID: gofakeit.UUID(), Name: "Engine", Quantity: 1, UnitPrice: 1000.0}},
 		TotalPrice: 1000.0,
 		Status:     repoModel.OrderStatusPaid,
 		CreatedAt:  time.Now(),
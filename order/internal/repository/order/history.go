@@ -0,0 +1,19 @@
+package order
+
+import (
+	"context"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	"github.com/baryshnikkov/rocket-factory/order/internal/repository/converter"
+)
+
+func (r *repository) GetOrderHistory(_ context.Context, orderUUID string) ([]model.OrderStatusTransition, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if _, ok := r.data[orderUUID]; !ok {
+		return nil, model.ErrOrderNotFound
+	}
+
+	return converter.OrderStatusTransitionsToModel(r.history[orderUUID]), nil
+}
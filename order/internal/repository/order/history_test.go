@@ -0,0 +1,65 @@
+package order
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+)
+
+func (s *RepositorySuite) TestGetOrderHistory() {
+	// Arrange
+	info, err := s.repo.CreateOrder(s.ctx, gofakeit.UUID(), []model.OrderItem{{PartUUID: gofakeit.UUID(), Quantity: 1, UnitPrice: 10.0}}, "")
+	s.Require().NoError(err)
+
+	_, err = s.repo.TransitionStatus(s.ctx, info.OrderUUID, model.OrderTransition{
+		From:   model.OrderStatusPendingPayment,
+		To:     model.OrderStatusCancelled,
+		Actor:  model.OrderActorUser,
+		Reason: model.ReasonCancelledByUser,
+	}, model.OrderUpdateInfo{})
+	s.Require().NoError(err)
+
+	// Act
+	history, err := s.repo.GetOrderHistory(s.ctx, info.OrderUUID)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+
+	s.Require().Nil(history[0].From)
+	s.Require().Equal(model.OrderStatusPendingPayment, history[0].To)
+	s.Require().Equal(model.OrderActorUser, history[0].Actor)
+	s.Require().Equal(model.ReasonOrderCreated, history[0].Reason)
+
+	s.Require().Equal(model.OrderStatusPendingPayment, *history[1].From)
+	s.Require().Equal(model.OrderStatusCancelled, history[1].To)
+	s.Require().Equal(model.ReasonCancelledByUser, history[1].Reason)
+	s.Require().False(history[1].CreatedAt.Before(history[0].CreatedAt))
+}
+
+func (s *RepositorySuite) TestGetOrderHistoryConflictNotRecorded() {
+	// Arrange
+	info, err := s.repo.CreateOrder(s.ctx, gofakeit.UUID(), []model.OrderItem{{PartUUID: gofakeit.UUID(), Quantity: 1, UnitPrice: 10.0}}, "")
+	s.Require().NoError(err)
+
+	_, err = s.repo.TransitionStatus(s.ctx, info.OrderUUID, model.OrderTransition{
+		From: model.OrderStatusPaid,
+		To:   model.OrderStatusAssembling,
+	}, model.OrderUpdateInfo{})
+	s.Require().ErrorIs(err, model.ErrOrderStatusConflict)
+
+	// Act
+	history, err := s.repo.GetOrderHistory(s.ctx, info.OrderUUID)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(history, 1)
+}
+
+func (s *RepositorySuite) TestGetOrderHistoryNotFound() {
+	// Act
+	_, err := s.repo.GetOrderHistory(s.ctx, gofakeit.UUID())
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrOrderNotFound)
+}
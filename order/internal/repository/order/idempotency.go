@@ -0,0 +1,85 @@
+package order
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+)
+
+// idempotencyEntry - ключ и время, когда его заняли
+type idempotencyEntry struct {
+	key       model.IdempotencyKey
+	createdAt time.Time
+}
+
+func (r *repository) AcquireIdempotencyKey(_ context.Context, key model.IdempotencyKey, fingerprint string) (model.IdempotencyRecord, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	r.pruneIdempotency(now)
+
+	if record, ok := r.idempotency[key]; ok && !record.Expired(now) {
+		return record, false, nil
+	}
+
+	record := model.IdempotencyRecord{
+		Key:         key,
+		Fingerprint: fingerprint,
+		Token:       uuid.NewString(),
+		CreatedAt:   now,
+	}
+	r.idempotency[key] = record
+	r.idempotencyQueue = append(r.idempotencyQueue, idempotencyEntry{key: key, createdAt: now})
+
+	return record, true, nil
+}
+
+func (r *repository) SaveIdempotentResponse(_ context.Context, key model.IdempotencyKey, token string, response model.IdempotentResponse) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	record, ok := r.idempotency[key]
+	if !ok {
+		return model.ErrIdempotencyKeyNotFound
+	}
+	if record.Token != token || record.Response != nil {
+		return model.ErrIdempotencyKeyLost
+	}
+
+	record.Response = &response
+	r.idempotency[key] = record
+
+	return nil
+}
+
+func (r *repository) ReleaseIdempotencyKey(_ context.Context, key model.IdempotencyKey, token string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Готовый ответ не удаляем: его должны получать повторы запроса
+	if record, ok := r.idempotency[key]; ok && record.Token == token && record.Response == nil {
+		delete(r.idempotency, key)
+	}
+
+	return nil
+}
+
+// pruneIdempotency удаляет записи старше IdempotencyKeyTTL: такие ключи истекли при любом состоянии.
+// Запись удаляется, только если ключ с тех пор не заняли заново. Вызывается под блокировкой.
+func (r *repository) pruneIdempotency(now time.Time) {
+	pruned := 0
+	for _, entry := range r.idempotencyQueue {
+		if now.Sub(entry.createdAt) <= model.IdempotencyKeyTTL {
+			break
+		}
+		if record, ok := r.idempotency[entry.key]; ok && record.CreatedAt.Equal(entry.createdAt) {
+			delete(r.idempotency, entry.key)
+		}
+		pruned++
+	}
+	r.idempotencyQueue = r.idempotencyQueue[pruned:]
+}
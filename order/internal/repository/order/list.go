@@ -0,0 +1,69 @@
+package order
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	"github.com/baryshnikkov/rocket-factory/order/internal/repository/converter"
+)
+
+func (r *repository) ListOrders(_ context.Context, filter model.OrdersFilter, page model.OrdersPageRequest) (model.OrdersPage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var matched []model.OrderDto
+	for _, order := range r.data {
+		modelOrder := converter.OrderDataToModel(order)
+		if filter.Match(modelOrder) {
+			matched = append(matched, modelOrder)
+		}
+	}
+
+	// Порядок map случаен, поэтому сортируем всегда — иначе страницы не будут стабильными
+	slices.SortFunc(matched, func(a, b model.OrderDto) int {
+		return compareOrderKeys(*model.NewOrdersCursor(a), *model.NewOrdersCursor(b), page.OrderBy)
+	})
+
+	start := 0
+	if page.After != nil {
+		start, _ = slices.BinarySearchFunc(matched, *page.After, func(order model.OrderDto, after model.OrdersCursor) int {
+			if compareOrderKeys(*model.NewOrdersCursor(order), after, page.OrderBy) <= 0 {
+				return -1
+			}
+			return 1
+		})
+	}
+
+	result := model.OrdersPage{
+		Orders:    matched[start:],
+		TotalSize: int32(len(matched)),
+	}
+
+	if page.PageSize > 0 && len(result.Orders) > int(page.PageSize) {
+		result.Orders = result.Orders[:page.PageSize]
+		result.Next = model.NewOrdersCursor(result.Orders[len(result.Orders)-1])
+	}
+
+	return result, nil
+}
+
+// compareOrderKeys сравнивает заказы по ключу сортировки, а при равенстве — по UUID.
+func compareOrderKeys(a, b model.OrdersCursor, order model.OrdersOrder) int {
+	var c int
+	switch order.Field {
+	case model.OrdersOrderByTotalPrice:
+		c = cmp.Compare(a.TotalPrice, b.TotalPrice)
+	default:
+		c = a.CreatedAt.Compare(b.CreatedAt)
+	}
+	if c == 0 {
+		c = cmp.Compare(a.UUID, b.UUID)
+	}
+
+	if order.Desc {
+		return -c
+	}
+	return c
+}
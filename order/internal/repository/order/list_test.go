@@ -0,0 +1,95 @@
+package order
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	repoModel "github.com/baryshnikkov/rocket-factory/order/internal/repository/model"
+)
+
+func (s *RepositorySuite) insertOrder(userUUID string, status repoModel.OrderStatus, totalPrice float64, createdAt time.Time, partUUIDs ...string) repoModel.OrderDto {
+	order := repoModel.OrderDto{
+		UUID:       gofakeit.UUID(),
+		UserUUID:   userUUID,
+		TotalPrice: totalPrice,
+		Status:     status,
+		CreatedAt:  createdAt,
+	}
+	for _, partUUID := range partUUIDs {
+		order.Items = append(order.Items, repoModel.OrderItem{PartUUID: partUUID, Quantity: 1})
+	}
+	s.repo.data[order.UUID] = order
+	return order
+}
+
+func orderUUIDs(orders []model.OrderDto) []string {
+	uuids := make([]string, 0, len(orders))
+	for _, order := range orders {
+		uuids = append(uuids, order.UUID)
+	}
+	return uuids
+}
+
+func (s *RepositorySuite) TestListOrdersFilter() {
+	// Arrange
+	var (
+		userUUID = gofakeit.UUID()
+		partUUID = gofakeit.UUID()
+		now      = time.Now()
+	)
+	match := s.insertOrder(userUUID, repoModel.OrderStatusPaid, 100, now, partUUID)
+	s.insertOrder(gofakeit.UUID(), repoModel.OrderStatusPaid, 100, now, partUUID)             // другой пользователь
+	s.insertOrder(userUUID, repoModel.OrderStatusCancelled, 100, now, partUUID)               // другой статус
+	s.insertOrder(userUUID, repoModel.OrderStatusPaid, 100, now.Add(-48*time.Hour), partUUID) // раньше диапазона
+	s.insertOrder(userUUID, repoModel.OrderStatusPaid, 100, now, gofakeit.UUID())             // без детали
+
+	filter := model.OrdersFilter{
+		UserUUID:    userUUID,
+		Statuses:    []model.OrderStatus{model.OrderStatusPendingPayment, model.OrderStatusPaid},
+		CreatedFrom: lo.ToPtr(now.Add(-time.Hour)),
+		CreatedTo:   lo.ToPtr(now.Add(time.Hour)),
+		PartUUID:    partUUID,
+	}
+
+	// Act
+	res, err := s.repo.ListOrders(s.ctx, filter, model.OrdersPageRequest{})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal([]string{match.UUID}, orderUUIDs(res.Orders))
+	s.Require().Equal(int32(1), res.TotalSize)
+	s.Require().Nil(res.Next)
+}
+
+func (s *RepositorySuite) TestListOrdersPagination() {
+	// Arrange
+	userUUID := gofakeit.UUID()
+	now := time.Now()
+	cheap := s.insertOrder(userUUID, repoModel.OrderStatusPaid, 100, now)
+	middle := s.insertOrder(userUUID, repoModel.OrderStatusPaid, 200, now.Add(-time.Hour))
+	expensive := s.insertOrder(userUUID, repoModel.OrderStatusPaid, 300, now.Add(-2*time.Hour))
+	order := model.OrdersOrder{Field: model.OrdersOrderByTotalPrice, Desc: true}
+
+	// Act
+	first, err := s.repo.ListOrders(s.ctx, model.OrdersFilter{}, model.OrdersPageRequest{PageSize: 2, OrderBy: order})
+	s.Require().NoError(err)
+	second, err := s.repo.ListOrders(s.ctx, model.OrdersFilter{}, model.OrdersPageRequest{PageSize: 2, OrderBy: order, After: first.Next})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal([]string{expensive.UUID, middle.UUID}, orderUUIDs(first.Orders))
+	s.Require().Equal(int32(3), first.TotalSize)
+	s.Require().Equal([]string{cheap.UUID}, orderUUIDs(second.Orders))
+	s.Require().Nil(second.Next)
+}
+
+func (s *RepositorySuite) TestListOrdersEmpty() {
+	res, err := s.repo.ListOrders(s.ctx, model.OrdersFilter{UserUUID: gofakeit.UUID()}, model.OrdersPageRequest{})
+
+	s.Require().NoError(err)
+	s.Require().Empty(res.Orders)
+	s.Require().Zero(res.TotalSize)
+}
@@ -3,19 +3,31 @@ package order
 import (
 	"sync"
 
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
 	def "github.com/baryshnikkov/rocket-factory/order/internal/repository"
 	repoModel "github.com/baryshnikkov/rocket-factory/order/internal/repository/model"
 )
 
-var _ def.OrderRepository = (*repository)(nil)
+var (
+	_ def.OrderRepository       = (*repository)(nil)
+	_ def.IdempotencyRepository = (*repository)(nil)
+)
 
 type repository struct {
 	mu   sync.RWMutex
 	data map[string]repoModel.OrderDto
+	// История статусов по UUID заказа
+	history map[string][]repoModel.OrderStatusTransition
+
+	idempotency map[model.IdempotencyKey]model.IdempotencyRecord
+	// Занятые ключи в порядке занятия: по ним удаляются записи старше IdempotencyKeyTTL
+	idempotencyQueue []idempotencyEntry
 }
 
 func NewRepository() *repository {
 	return &repository{
-		data: make(map[string]repoModel.OrderDto),
+		data:        make(map[string]repoModel.OrderDto),
+		history:     make(map[string][]repoModel.OrderStatusTransition),
+		idempotency: make(map[model.IdempotencyKey]model.IdempotencyRecord),
 	}
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
 	repoModel "github.com/baryshnikkov/rocket-factory/order/internal/repository/model"
 )
 
@@ -20,8 +21,10 @@ type RepositorySuite struct {
 func (s *RepositorySuite) SetupTest() {
 	s.ctx = context.Background()
 	s.repo = &repository{
-		data: make(map[string]repoModel.OrderDto), // ← реальные данные
-		mu:   sync.RWMutex{},
+		data:        make(map[string]repoModel.OrderDto), // ← реальные данные
+		history:     make(map[string][]repoModel.OrderStatusTransition),
+		idempotency: make(map[model.IdempotencyKey]model.IdempotencyRecord),
+		mu:          sync.RWMutex{},
 	}
 }
 
@@ -0,0 +1,67 @@
+package order
+
+import (
+	"context"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	"github.com/baryshnikkov/rocket-factory/order/internal/repository/converter"
+	repoModel "github.com/baryshnikkov/rocket-factory/order/internal/repository/model"
+)
+
+func (r *repository) TransitionStatus(_ context.Context, orderUUID string, transition model.OrderTransition, patch model.OrderUpdateInfo) (model.OrderDto, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	order, ok := r.data[orderUUID]
+	if !ok {
+		return model.OrderDto{}, model.ErrOrderNotFound
+	}
+
+	if model.OrderStatus(order.Status) != transition.From {
+		return model.OrderDto{}, model.ErrOrderStatusConflict
+	}
+
+	order.PreviousStatus = lo.ToPtr(order.Status)
+	order.Status = repoModel.OrderStatus(transition.To)
+	applyOrderUpdate(&order, patch)
+
+	r.data[orderUUID] = order
+	r.history[orderUUID] = append(r.history[orderUUID], repoModel.OrderStatusTransition{
+		From:      lo.ToPtr(repoModel.OrderStatus(transition.From)),
+		To:        order.Status,
+		Actor:     string(transition.Actor),
+		Reason:    transition.Reason,
+		CreatedAt: *order.UpdatedAt,
+	})
+
+	return converter.OrderDataToModel(order), nil
+}
+
+// applyOrderUpdate применяет заданные поля, увеличивает версию и обновляет updated_at
+func applyOrderUpdate(order *repoModel.OrderDto, orderUpdateInfo model.OrderUpdateInfo) {
+	if orderUpdateInfo.TotalPrice != nil {
+		order.TotalPrice = *orderUpdateInfo.TotalPrice
+	}
+
+	if orderUpdateInfo.TransactionUUID != nil {
+		order.TransactionUUID = orderUpdateInfo.TransactionUUID
+	}
+
+	if orderUpdateInfo.RefundTransactionUUID != nil {
+		order.RefundTransactionUUID = orderUpdateInfo.RefundTransactionUUID
+	}
+
+	if orderUpdateInfo.PaymentCapturedAt != nil {
+		order.PaymentCapturedAt = orderUpdateInfo.PaymentCapturedAt
+	}
+
+	if orderUpdateInfo.PaymentMethod != nil {
+		order.PaymentMethod = lo.ToPtr(repoModel.PaymentMethod(lo.FromPtr(orderUpdateInfo.PaymentMethod)))
+	}
+
+	order.Version++
+	order.UpdatedAt = lo.ToPtr(time.Now())
+}
@@ -0,0 +1,131 @@
+package order
+
+import (
+	"sync"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	repoModel "github.com/baryshnikkov/rocket-factory/order/internal/repository/model"
+)
+
+func (s *RepositorySuite) insertPendingOrder() string {
+	order := repoModel.OrderDto{
+		UUID:      gofakeit.UUID(),
+		UserUUID:  gofakeit.UUID(),
+		Status:    repoModel.OrderStatusPendingPayment,
+		CreatedAt: time.Now(),
+	}
+	s.repo.data[order.UUID] = order
+	return order.UUID
+}
+
+func (s *RepositorySuite) TestTransitionStatusSuccess() {
+	// Arrange
+	orderUUID := s.insertPendingOrder()
+	transactionUUID := gofakeit.UUID()
+
+	// Act
+	order, err := s.repo.TransitionStatus(s.ctx, orderUUID, model.OrderTransition{From: model.OrderStatusPendingPayment, To: model.OrderStatusPaid}, model.OrderUpdateInfo{
+		TransactionUUID: &transactionUUID,
+		PaymentMethod:   lo.ToPtr(model.Card),
+	})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(model.OrderStatusPaid, order.Status)
+	s.Require().Equal(model.OrderStatusPendingPayment, *order.PreviousStatus)
+	s.Require().Equal(int64(1), order.Version)
+	s.Require().Equal(transactionUUID, *order.TransactionUUID)
+	s.Require().Equal(model.Card, *order.PaymentMethod)
+	s.Require().NotNil(order.UpdatedAt)
+	s.Require().Equal(repoModel.OrderStatusPaid, s.repo.data[orderUUID].Status)
+}
+
+func (s *RepositorySuite) TestTransitionStatusConflict() {
+	// Arrange
+	orderUUID := s.insertPendingOrder()
+
+	// Act
+	_, err := s.repo.TransitionStatus(s.ctx, orderUUID, model.OrderTransition{From: model.OrderStatusPaymentInProgress, To: model.OrderStatusPaid}, model.OrderUpdateInfo{})
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrOrderStatusConflict)
+	s.Require().Equal(repoModel.OrderStatusPendingPayment, s.repo.data[orderUUID].Status)
+	s.Require().Zero(s.repo.data[orderUUID].Version)
+}
+
+func (s *RepositorySuite) TestTransitionStatusNotFound() {
+	// Act
+	_, err := s.repo.TransitionStatus(s.ctx, gofakeit.UUID(), model.OrderTransition{From: model.OrderStatusPendingPayment, To: model.OrderStatusPaid}, model.OrderUpdateInfo{})
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrOrderNotFound)
+}
+
+func (s *RepositorySuite) TestTransitionStatusConcurrent() {
+	// Arrange
+	orderUUID := s.insertPendingOrder()
+
+	const attempts = 20
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		wins int
+	)
+
+	// Act
+	for range attempts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.repo.TransitionStatus(s.ctx, orderUUID, model.OrderTransition{From: model.OrderStatusPendingPayment, To: model.OrderStatusPaymentInProgress}, model.OrderUpdateInfo{})
+			if err == nil {
+				mu.Lock()
+				wins++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Assert
+	s.Require().Equal(1, wins)
+	s.Require().Equal(int64(1), s.repo.data[orderUUID].Version)
+}
+
+func (s *RepositorySuite) TestTransitionStatusStoresRefundTransaction() {
+	// Arrange
+	orderUUID := s.insertPendingOrder()
+	refundUUID := gofakeit.UUID()
+
+	// Act
+	order, err := s.repo.TransitionStatus(s.ctx, orderUUID, model.OrderTransition{
+		From: model.OrderStatusPendingPayment,
+		To:   model.OrderStatusRefunded,
+	}, model.OrderUpdateInfo{RefundTransactionUUID: &refundUUID})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(refundUUID, *order.RefundTransactionUUID)
+	s.Require().Equal(refundUUID, *s.repo.data[orderUUID].RefundTransactionUUID)
+}
+
+func (s *RepositorySuite) TestTransitionStatusStoresPaymentCapturedAt() {
+	// Arrange
+	orderUUID := s.insertPendingOrder()
+	capturedAt := time.Now()
+
+	// Act
+	order, err := s.repo.TransitionStatus(s.ctx, orderUUID, model.OrderTransition{
+		From: model.OrderStatusPendingPayment,
+		To:   model.OrderStatusShipped,
+	}, model.OrderUpdateInfo{PaymentCapturedAt: &capturedAt})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(capturedAt, *order.PaymentCapturedAt)
+	s.Require().Equal(capturedAt, *s.repo.data[orderUUID].PaymentCapturedAt)
+}
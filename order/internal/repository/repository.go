@@ -8,6 +8,25 @@ import (
 
 type OrderRepository interface {
 	GetOrder(ctx context.Context, UUID string) (order model.OrderDto, err error)
-	CreateOrder(ctx context.Context, userUUID string, parts []model.Part) (info model.OrderCreationInfo, err error)
-	UpdateOrder(ctx context.Context, orderUUID string, orderUpdateInfo model.OrderUpdateInfo) error
+	CreateOrder(ctx context.Context, userUUID string, items []model.OrderItem, reservationUUID string) (info model.OrderCreationInfo, err error)
+	ListOrders(ctx context.Context, filter model.OrdersFilter, page model.OrdersPageRequest) (model.OrdersPage, error)
+	// TransitionStatus атомарно переводит заказ из статуса transition.From в transition.To, применяя patch
+	// (поле Status в patch игнорируется), увеличивает версию заказа и записывает переход в историю.
+	// Если заказ уже не в статусе transition.From, возвращает ErrOrderStatusConflict.
+	TransitionStatus(ctx context.Context, orderUUID string, transition model.OrderTransition, patch model.OrderUpdateInfo) (model.OrderDto, error)
+	// GetOrderHistory возвращает историю статусов заказа от старых записей к новым, начиная с создания.
+	GetOrderHistory(ctx context.Context, orderUUID string) ([]model.OrderStatusTransition, error)
+}
+
+// IdempotencyRepository хранит запросы с ключом идемпотентности и ответы на них.
+type IdempotencyRepository interface {
+	// AcquireIdempotencyKey занимает ключ под новый запрос и выдаёт в записи Token. Если ключ уже
+	// занят и не истёк, возвращает существующую запись и acquired = false.
+	AcquireIdempotencyKey(ctx context.Context, key model.IdempotencyKey, fingerprint string) (record model.IdempotencyRecord, acquired bool, err error)
+	// SaveIdempotentResponse сохраняет ответ, если ключ всё ещё занят запросом с token,
+	// иначе возвращает ErrIdempotencyKeyLost.
+	SaveIdempotentResponse(ctx context.Context, key model.IdempotencyKey, token string, response model.IdempotentResponse) error
+	// ReleaseIdempotencyKey освобождает ключ незавершённого запроса с token, чтобы его можно было повторить.
+	// Ключ, который уже занял другой запрос, не трогает.
+	ReleaseIdempotencyKey(ctx context.Context, key model.IdempotencyKey, token string) error
 }
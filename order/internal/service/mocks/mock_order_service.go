@@ -9,6 +9,8 @@ import (
 
 	model "github.com/baryshnikkov/rocket-factory/order/internal/model"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // OrderService is an autogenerated mock type for the OrderService type
@@ -71,9 +73,9 @@ func (_c *OrderService_CancelOrder_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
-// CreateOrder provides a mock function with given fields: ctx, userUUID, partsUUIDs
-func (_m *OrderService) CreateOrder(ctx context.Context, userUUID string, partsUUIDs []string) (model.OrderCreationInfo, error) {
-	ret := _m.Called(ctx, userUUID, partsUUIDs)
+// CreateOrder provides a mock function with given fields: ctx, userUUID, items
+func (_m *OrderService) CreateOrder(ctx context.Context, userUUID string, items []model.CreateOrderItem) (model.OrderCreationInfo, error) {
+	ret := _m.Called(ctx, userUUID, items)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateOrder")
@@ -81,17 +83,17 @@ func (_m *OrderService) CreateOrder(ctx context.Context, userUUID string, partsU
 
 	var r0 model.OrderCreationInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, []string) (model.OrderCreationInfo, error)); ok {
-		return rf(ctx, userUUID, partsUUIDs)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []model.CreateOrderItem) (model.OrderCreationInfo, error)); ok {
+		return rf(ctx, userUUID, items)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, []string) model.OrderCreationInfo); ok {
-		r0 = rf(ctx, userUUID, partsUUIDs)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []model.CreateOrderItem) model.OrderCreationInfo); ok {
+		r0 = rf(ctx, userUUID, items)
 	} else {
 		r0 = ret.Get(0).(model.OrderCreationInfo)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
-		r1 = rf(ctx, userUUID, partsUUIDs)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []model.CreateOrderItem) error); ok {
+		r1 = rf(ctx, userUUID, items)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -107,14 +109,14 @@ type OrderService_CreateOrder_Call struct {
 // CreateOrder is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userUUID string
-//   - partsUUIDs []string
-func (_e *OrderService_Expecter) CreateOrder(ctx interface{}, userUUID interface{}, partsUUIDs interface{}) *OrderService_CreateOrder_Call {
-	return &OrderService_CreateOrder_Call{Call: _e.mock.On("CreateOrder", ctx, userUUID, partsUUIDs)}
+//   - items []model.CreateOrderItem
+func (_e *OrderService_Expecter) CreateOrder(ctx interface{}, userUUID interface{}, items interface{}) *OrderService_CreateOrder_Call {
+	return &OrderService_CreateOrder_Call{Call: _e.mock.On("CreateOrder", ctx, userUUID, items)}
 }
 
-func (_c *OrderService_CreateOrder_Call) Run(run func(ctx context.Context, userUUID string, partsUUIDs []string)) *OrderService_CreateOrder_Call {
+func (_c *OrderService_CreateOrder_Call) Run(run func(ctx context.Context, userUUID string, items []model.CreateOrderItem)) *OrderService_CreateOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+		run(args[0].(context.Context), args[1].(string), args[2].([]model.CreateOrderItem))
 	})
 	return _c
 }
@@ -124,7 +126,64 @@ func (_c *OrderService_CreateOrder_Call) Return(info model.OrderCreationInfo, er
 	return _c
 }
 
-func (_c *OrderService_CreateOrder_Call) RunAndReturn(run func(context.Context, string, []string) (model.OrderCreationInfo, error)) *OrderService_CreateOrder_Call {
+func (_c *OrderService_CreateOrder_Call) RunAndReturn(run func(context.Context, string, []model.CreateOrderItem) (model.OrderCreationInfo, error)) *OrderService_CreateOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpireOrders provides a mock function with given fields: ctx, createdBefore
+func (_m *OrderService) ExpireOrders(ctx context.Context, createdBefore time.Time) (int, error) {
+	ret := _m.Called(ctx, createdBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireOrders")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, createdBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, createdBefore)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, createdBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderService_ExpireOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpireOrders'
+type OrderService_ExpireOrders_Call struct {
+	*mock.Call
+}
+
+// ExpireOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - createdBefore time.Time
+func (_e *OrderService_Expecter) ExpireOrders(ctx interface{}, createdBefore interface{}) *OrderService_ExpireOrders_Call {
+	return &OrderService_ExpireOrders_Call{Call: _e.mock.On("ExpireOrders", ctx, createdBefore)}
+}
+
+func (_c *OrderService_ExpireOrders_Call) Run(run func(ctx context.Context, createdBefore time.Time)) *OrderService_ExpireOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *OrderService_ExpireOrders_Call) Return(expired int, err error) *OrderService_ExpireOrders_Call {
+	_c.Call.Return(expired, err)
+	return _c
+}
+
+func (_c *OrderService_ExpireOrders_Call) RunAndReturn(run func(context.Context, time.Time) (int, error)) *OrderService_ExpireOrders_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -186,6 +245,123 @@ func (_c *OrderService_GetOrder_Call) RunAndReturn(run func(context.Context, str
 	return _c
 }
 
+// GetOrderHistory provides a mock function with given fields: ctx, orderUUID
+func (_m *OrderService) GetOrderHistory(ctx context.Context, orderUUID string) ([]model.OrderStatusTransition, error) {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderHistory")
+	}
+
+	var r0 []model.OrderStatusTransition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.OrderStatusTransition, error)); ok {
+		return rf(ctx, orderUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.OrderStatusTransition); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.OrderStatusTransition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, orderUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderService_GetOrderHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderHistory'
+type OrderService_GetOrderHistory_Call struct {
+	*mock.Call
+}
+
+// GetOrderHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *OrderService_Expecter) GetOrderHistory(ctx interface{}, orderUUID interface{}) *OrderService_GetOrderHistory_Call {
+	return &OrderService_GetOrderHistory_Call{Call: _e.mock.On("GetOrderHistory", ctx, orderUUID)}
+}
+
+func (_c *OrderService_GetOrderHistory_Call) Run(run func(ctx context.Context, orderUUID string)) *OrderService_GetOrderHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OrderService_GetOrderHistory_Call) Return(_a0 []model.OrderStatusTransition, _a1 error) *OrderService_GetOrderHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderService_GetOrderHistory_Call) RunAndReturn(run func(context.Context, string) ([]model.OrderStatusTransition, error)) *OrderService_GetOrderHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrders provides a mock function with given fields: ctx, filter, params
+func (_m *OrderService) ListOrders(ctx context.Context, filter model.OrdersFilter, params model.ListOrdersParams) (model.OrdersList, error) {
+	ret := _m.Called(ctx, filter, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrders")
+	}
+
+	var r0 model.OrdersList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrdersFilter, model.ListOrdersParams) (model.OrdersList, error)); ok {
+		return rf(ctx, filter, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrdersFilter, model.ListOrdersParams) model.OrdersList); ok {
+		r0 = rf(ctx, filter, params)
+	} else {
+		r0 = ret.Get(0).(model.OrdersList)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.OrdersFilter, model.ListOrdersParams) error); ok {
+		r1 = rf(ctx, filter, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderService_ListOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrders'
+type OrderService_ListOrders_Call struct {
+	*mock.Call
+}
+
+// ListOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.OrdersFilter
+//   - params model.ListOrdersParams
+func (_e *OrderService_Expecter) ListOrders(ctx interface{}, filter interface{}, params interface{}) *OrderService_ListOrders_Call {
+	return &OrderService_ListOrders_Call{Call: _e.mock.On("ListOrders", ctx, filter, params)}
+}
+
+func (_c *OrderService_ListOrders_Call) Run(run func(ctx context.Context, filter model.OrdersFilter, params model.ListOrdersParams)) *OrderService_ListOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.OrdersFilter), args[2].(model.ListOrdersParams))
+	})
+	return _c
+}
+
+func (_c *OrderService_ListOrders_Call) Return(_a0 model.OrdersList, _a1 error) *OrderService_ListOrders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderService_ListOrders_Call) RunAndReturn(run func(context.Context, model.OrdersFilter, model.ListOrdersParams) (model.OrdersList, error)) *OrderService_ListOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PayOrder provides a mock function with given fields: ctx, orderUUID, paymentMethod
 func (_m *OrderService) PayOrder(ctx context.Context, orderUUID string, paymentMethod string) (string, error) {
 	ret := _m.Called(ctx, orderUUID, paymentMethod)
@@ -244,6 +420,54 @@ func (_c *OrderService_PayOrder_Call) RunAndReturn(run func(context.Context, str
 	return _c
 }
 
+// UpdateOrderStatus provides a mock function with given fields: ctx, orderUUID, status
+func (_m *OrderService) UpdateOrderStatus(ctx context.Context, orderUUID string, status model.OrderStatus) error {
+	ret := _m.Called(ctx, orderUUID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrderStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.OrderStatus) error); ok {
+		r0 = rf(ctx, orderUUID, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderService_UpdateOrderStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrderStatus'
+type OrderService_UpdateOrderStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateOrderStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+//   - status model.OrderStatus
+func (_e *OrderService_Expecter) UpdateOrderStatus(ctx interface{}, orderUUID interface{}, status interface{}) *OrderService_UpdateOrderStatus_Call {
+	return &OrderService_UpdateOrderStatus_Call{Call: _e.mock.On("UpdateOrderStatus", ctx, orderUUID, status)}
+}
+
+func (_c *OrderService_UpdateOrderStatus_Call) Run(run func(ctx context.Context, orderUUID string, status model.OrderStatus)) *OrderService_UpdateOrderStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(model.OrderStatus))
+	})
+	return _c
+}
+
+func (_c *OrderService_UpdateOrderStatus_Call) Return(_a0 error) *OrderService_UpdateOrderStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderService_UpdateOrderStatus_Call) RunAndReturn(run func(context.Context, string, model.OrderStatus) error) *OrderService_UpdateOrderStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewOrderService creates a new instance of OrderService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOrderService(t interface {
@@ -2,31 +2,131 @@ package order
 
 import (
 	"context"
+	"errors"
+	"log"
+
+	"github.com/samber/lo"
 
 	"github.com/baryshnikkov/rocket-factory/order/internal/model"
 )
 
+// CancelOrder отменяет неоплаченный заказ, а оплаченный, но ещё не отгруженный - отменяет со снятием холда
+// или, если оплата уже списана, с полным возвратом
 func (s *service) CancelOrder(ctx context.Context, userUUID string) error {
 	order, err := s.orderRepository.GetOrder(ctx, userUUID)
 	if err != nil {
 		return err
 	}
 
-	switch order.Status {
-	case model.OrderStatusPaid:
-		return model.ErrOrderConflict
-	case model.OrderStatusCancelled:
+	switch {
+	case !order.Status.Valid():
+		return model.ErrOrderInternalError
+	case order.Status.CanTransitionTo(model.OrderStatusCancelled):
+		return s.cancelUnpaid(ctx, order, model.OrderActorUser, model.ReasonCancelledByUser)
+	case order.Status.CanTransitionTo(model.OrderStatusVoidInProgress) && order.PaymentCapturedAt == nil:
+		return s.void(ctx, order)
+	case order.Status.CanTransitionTo(model.OrderStatusRefundInProgress):
+		return s.refund(ctx, order)
+	default:
 		return model.ErrOrderConflict
-	case model.OrderStatusPendingPayment:
-		status := model.OrderStatusCancelled
-		err = s.orderRepository.UpdateOrder(ctx, order.UUID, model.OrderUpdateInfo{
-			Status: &status,
-		})
-		if err != nil {
-			return err
+	}
+}
+
+func (s *service) cancelUnpaid(ctx context.Context, order model.OrderDto, actor model.OrderActor, reason string) error {
+	// Сначала отменяем заказ: если его успели взять в оплату, резерв трогать нельзя
+	_, err := s.transition(ctx, order, model.OrderStatusCancelled, actor, reason, model.OrderUpdateInfo{})
+	if err != nil {
+		if errors.Is(err, model.ErrOrderStatusConflict) {
+			return model.ErrOrderConflict
 		}
-		return nil
-	default:
+		return err
+	}
+
+	s.releaseReservation(ctx, order)
+
+	return nil
+}
+
+// releaseReservation возвращает детали отменённого заказа на склад. Резерв мог уже истечь
+// или пропасть из inventory; если снять его не удалось, неподтверждённый резерв истечёт сам
+func (s *service) releaseReservation(ctx context.Context, order model.OrderDto) {
+	if order.ReservationUUID == nil {
+		return
+	}
+
+	err := s.inventoryClient.ReleaseReservation(ctx, *order.ReservationUUID)
+	if err != nil && !errors.Is(err, model.ErrReservationNotFound) {
+		log.Printf("failed to release reservation %s: %v\n", *order.ReservationUUID, err)
+	}
+}
+
+func (s *service) refund(ctx context.Context, order model.OrderDto) error {
+	if order.TransactionUUID == nil {
+		return model.ErrOrderInternalError
+	}
+
+	// Как и оплата, возврат сначала занимает заказ, чтобы конкурентные отмены не вернули деньги дважды
+	_, err := s.transition(ctx, order, model.OrderStatusRefundInProgress, model.OrderActorUser, model.ReasonRefundStarted, model.OrderUpdateInfo{})
+	if err != nil {
+		if errors.Is(err, model.ErrOrderStatusConflict) {
+			return model.ErrOrderConflict
+		}
+		return err
+	}
+	inProgress := moved(order, model.OrderStatusRefundInProgress)
+
+	refundUUID, err := s.paymentClient.RefundPayment(ctx, *order.TransactionUUID, order.UUID, 0, model.Currency, model.ReasonCancelledByUser)
+	if err != nil {
+		_, rollbackErr := s.transition(context.WithoutCancel(ctx), inProgress, order.Status, model.OrderActorSystem, model.ReasonRefundFailed, model.OrderUpdateInfo{})
+		return errors.Join(err, rollbackErr)
+	}
+
+	// Деньги уже возвращены: результат записываем, даже если клиент не дождался ответа
+	ctx = context.WithoutCancel(ctx)
+	_, err = s.transition(ctx, inProgress, model.OrderStatusRefunded, model.OrderActorSystem, model.ReasonRefundDone, model.OrderUpdateInfo{
+		RefundTransactionUUID: lo.ToPtr(refundUUID),
+	})
+	if err != nil {
+		return err
+	}
+
+	// Оплата подтвердила резерв, и детали вернутся на склад только явным снятием
+	s.releaseReservation(ctx, order)
+
+	return nil
+}
+
+// void снимает холд с авторизованной, но не списанной оплаты; деньги не списывались, поэтому заказ просто отменяется
+func (s *service) void(ctx context.Context, order model.OrderDto) error {
+	if order.TransactionUUID == nil {
 		return model.ErrOrderInternalError
 	}
+
+	// Снятие холда занимает заказ так же, как возврат: конкурентное списание при отгрузке получит конфликт
+	_, err := s.transition(ctx, order, model.OrderStatusVoidInProgress, model.OrderActorUser, model.ReasonVoidStarted, model.OrderUpdateInfo{})
+	if err != nil {
+		if errors.Is(err, model.ErrOrderStatusConflict) {
+			return model.ErrOrderConflict
+		}
+		return err
+	}
+	inProgress := moved(order, model.OrderStatusVoidInProgress)
+
+	err = s.paymentClient.VoidAuthorization(ctx, *order.TransactionUUID, order.UUID, model.ReasonCancelledByUser)
+	if err != nil {
+		_, rollbackErr := s.transition(context.WithoutCancel(ctx), inProgress, order.Status, model.OrderActorSystem, model.ReasonVoidFailed, model.OrderUpdateInfo{})
+		return errors.Join(err, rollbackErr)
+	}
+
+	// Холд уже снят: результат записываем, даже если клиент не дождался ответа
+	ctx = context.WithoutCancel(ctx)
+	_, err = s.transition(ctx, inProgress, model.OrderStatusCancelled, model.OrderActorSystem, model.ReasonVoidDone, model.OrderUpdateInfo{})
+	if err != nil {
+		return err
+	}
+
+	// Холд подтвердил резерв так же, как списание
+	s.releaseReservation(ctx, order)
+
+	return nil
 }
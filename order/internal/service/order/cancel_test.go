@@ -1,8 +1,11 @@
 package order
 
 import (
+	"time"
+
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/baryshnikkov/rocket-factory/order/internal/model"
 )
@@ -14,19 +17,20 @@ func (s *ServiceSuite) TestCancelOrderSuccess() {
 	}
 
 	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
-	s.orderRepository.On("UpdateOrder", s.ctx, order.UUID, model.OrderUpdateInfo{
-		Status: lo.ToPtr(model.OrderStatusCancelled),
-	}).Return(nil)
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, model.OrderTransition{
+		From: model.OrderStatusPendingPayment, To: model.OrderStatusCancelled, Actor: model.OrderActorUser, Reason: model.ReasonCancelledByUser,
+	}, model.OrderUpdateInfo{}).
+		Return(model.OrderDto{}, nil)
 
 	err := s.service.CancelOrder(s.ctx, order.UUID)
 
 	s.NoError(err)
 }
 
-func (s *ServiceSuite) TestCancelOrderAlreadyPaid() {
+func (s *ServiceSuite) TestCancelOrderShipped() {
 	order := model.OrderDto{
 		UUID:   gofakeit.UUID(),
-		Status: model.OrderStatusPaid,
+		Status: model.OrderStatusShipped,
 	}
 
 	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
@@ -71,9 +75,10 @@ func (s *ServiceSuite) TestCancelOrderUpdateOrderError() {
 	expectedErr := model.ErrOrderInternalError
 
 	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
-	s.orderRepository.On("UpdateOrder", s.ctx, order.UUID, model.OrderUpdateInfo{
-		Status: lo.ToPtr(model.OrderStatusCancelled),
-	}).Return(expectedErr)
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, model.OrderTransition{
+		From: model.OrderStatusPendingPayment, To: model.OrderStatusCancelled, Actor: model.OrderActorUser, Reason: model.ReasonCancelledByUser,
+	}, model.OrderUpdateInfo{}).
+		Return(model.OrderDto{}, expectedErr)
 
 	err := s.service.CancelOrder(s.ctx, order.UUID)
 
@@ -94,3 +99,243 @@ func (s *ServiceSuite) TestCancelOrderUnknownStatus() {
 	s.Error(err)
 	s.ErrorIs(err, model.ErrOrderInternalError)
 }
+
+func (s *ServiceSuite) TestCancelOrderReleasesReservation() {
+	order := model.OrderDto{
+		UUID:            gofakeit.UUID(),
+		ReservationUUID: lo.ToPtr(gofakeit.UUID()),
+		Status:          model.OrderStatusPendingPayment,
+	}
+
+	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
+	s.inventoryClient.On("ReleaseReservation", s.ctx, *order.ReservationUUID).Return(nil)
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, model.OrderTransition{
+		From: model.OrderStatusPendingPayment, To: model.OrderStatusCancelled, Actor: model.OrderActorUser, Reason: model.ReasonCancelledByUser,
+	}, model.OrderUpdateInfo{}).
+		Return(model.OrderDto{}, nil)
+
+	err := s.service.CancelOrder(s.ctx, order.UUID)
+
+	s.NoError(err)
+}
+
+func (s *ServiceSuite) TestCancelOrderReservationNotFound() {
+	order := model.OrderDto{
+		UUID:            gofakeit.UUID(),
+		ReservationUUID: lo.ToPtr(gofakeit.UUID()),
+		Status:          model.OrderStatusPendingPayment,
+	}
+
+	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
+	s.inventoryClient.On("ReleaseReservation", s.ctx, *order.ReservationUUID).Return(model.ErrReservationNotFound)
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, model.OrderTransition{
+		From: model.OrderStatusPendingPayment, To: model.OrderStatusCancelled, Actor: model.OrderActorUser, Reason: model.ReasonCancelledByUser,
+	}, model.OrderUpdateInfo{}).
+		Return(model.OrderDto{}, nil)
+
+	err := s.service.CancelOrder(s.ctx, order.UUID)
+
+	s.NoError(err)
+}
+
+func (s *ServiceSuite) TestCancelOrderReleaseError() {
+	order := model.OrderDto{
+		UUID:            gofakeit.UUID(),
+		ReservationUUID: lo.ToPtr(gofakeit.UUID()),
+		Status:          model.OrderStatusPendingPayment,
+	}
+	expectedErr := gofakeit.Error()
+
+	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, model.OrderTransition{
+		From: model.OrderStatusPendingPayment, To: model.OrderStatusCancelled, Actor: model.OrderActorUser, Reason: model.ReasonCancelledByUser,
+	}, model.OrderUpdateInfo{}).
+		Return(model.OrderDto{}, nil)
+	s.inventoryClient.On("ReleaseReservation", s.ctx, *order.ReservationUUID).Return(expectedErr)
+
+	err := s.service.CancelOrder(s.ctx, order.UUID)
+
+	// Заказ уже отменён, а резерв истечёт сам
+	s.NoError(err)
+}
+
+func (s *ServiceSuite) TestCancelOrderPaymentInProgress() {
+	order := model.OrderDto{
+		UUID:   gofakeit.UUID(),
+		Status: model.OrderStatusPaymentInProgress,
+	}
+
+	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
+
+	err := s.service.CancelOrder(s.ctx, order.UUID)
+
+	s.ErrorIs(err, model.ErrOrderConflict)
+}
+
+func (s *ServiceSuite) TestCancelOrderLostRace() {
+	order := model.OrderDto{
+		UUID:            gofakeit.UUID(),
+		ReservationUUID: lo.ToPtr(gofakeit.UUID()),
+		Status:          model.OrderStatusPendingPayment,
+	}
+
+	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, model.OrderTransition{
+		From: model.OrderStatusPendingPayment, To: model.OrderStatusCancelled, Actor: model.OrderActorUser, Reason: model.ReasonCancelledByUser,
+	}, model.OrderUpdateInfo{}).
+		Return(model.OrderDto{}, model.ErrOrderStatusConflict)
+
+	err := s.service.CancelOrder(s.ctx, order.UUID)
+
+	// Резерв принадлежит победившей оплате и не снимается
+	s.ErrorIs(err, model.ErrOrderConflict)
+}
+
+// paidOrder - оплаченный заказ, оплата которого уже списана и при отмене возвращается
+func (s *ServiceSuite) paidOrder() model.OrderDto {
+	return model.OrderDto{
+		UUID:              gofakeit.UUID(),
+		TransactionUUID:   lo.ToPtr(gofakeit.UUID()),
+		PaymentCapturedAt: lo.ToPtr(time.Now()),
+		Status:            model.OrderStatusPaid,
+	}
+}
+
+// authorizedOrder - оплаченный заказ, оплата которого только авторизована и при отмене снимается
+func (s *ServiceSuite) authorizedOrder() model.OrderDto {
+	order := s.paidOrder()
+	order.PaymentCapturedAt = nil
+	return order
+}
+
+func (s *ServiceSuite) TestCancelOrderPaidRefunds() {
+	order := s.paidOrder()
+	refundUUID := gofakeit.UUID()
+
+	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, model.OrderTransition{
+		From: model.OrderStatusPaid, To: model.OrderStatusRefundInProgress, Actor: model.OrderActorUser, Reason: model.ReasonRefundStarted,
+	}, model.OrderUpdateInfo{}).Return(model.OrderDto{}, nil)
+	s.paymentClient.On("RefundPayment", s.ctx, *order.TransactionUUID, order.UUID, int64(0), model.Currency, model.ReasonCancelledByUser).Return(refundUUID, nil)
+	s.orderRepository.On("TransitionStatus", mock.Anything, order.UUID, model.OrderTransition{
+		From: model.OrderStatusRefundInProgress, To: model.OrderStatusRefunded, Actor: model.OrderActorSystem, Reason: model.ReasonRefundDone,
+	}, model.OrderUpdateInfo{RefundTransactionUUID: lo.ToPtr(refundUUID)}).Return(model.OrderDto{}, nil)
+
+	err := s.service.CancelOrder(s.ctx, order.UUID)
+
+	s.NoError(err)
+}
+
+func (s *ServiceSuite) TestCancelOrderRefundReleasesReservation() {
+	order := s.paidOrder()
+	order.ReservationUUID = lo.ToPtr(gofakeit.UUID())
+	refundUUID := gofakeit.UUID()
+
+	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, model.OrderTransition{
+		From: model.OrderStatusPaid, To: model.OrderStatusRefundInProgress, Actor: model.OrderActorUser, Reason: model.ReasonRefundStarted,
+	}, model.OrderUpdateInfo{}).Return(model.OrderDto{}, nil)
+	s.paymentClient.On("RefundPayment", s.ctx, *order.TransactionUUID, order.UUID, int64(0), model.Currency, model.ReasonCancelledByUser).Return(refundUUID, nil)
+	s.orderRepository.On("TransitionStatus", mock.Anything, order.UUID, model.OrderTransition{
+		From: model.OrderStatusRefundInProgress, To: model.OrderStatusRefunded, Actor: model.OrderActorSystem, Reason: model.ReasonRefundDone,
+	}, model.OrderUpdateInfo{RefundTransactionUUID: lo.ToPtr(refundUUID)}).Return(model.OrderDto{}, nil)
+	// Подтверждённый при оплате резерв снимается, и детали возвращаются на склад
+	s.inventoryClient.On("ReleaseReservation", mock.Anything, *order.ReservationUUID).Return(nil)
+
+	err := s.service.CancelOrder(s.ctx, order.UUID)
+
+	s.NoError(err)
+}
+
+func (s *ServiceSuite) TestCancelOrderRefundFailedRestoresStatus() {
+	order := s.paidOrder()
+	order.Status = model.OrderStatusAssembling
+
+	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, model.OrderTransition{
+		From: model.OrderStatusAssembling, To: model.OrderStatusRefundInProgress, Actor: model.OrderActorUser, Reason: model.ReasonRefundStarted,
+	}, model.OrderUpdateInfo{}).Return(model.OrderDto{}, nil)
+	s.paymentClient.On("RefundPayment", s.ctx, *order.TransactionUUID, order.UUID, int64(0), model.Currency, model.ReasonCancelledByUser).Return("", model.ErrPaymentUnavailable)
+	s.orderRepository.On("TransitionStatus", mock.Anything, order.UUID, model.OrderTransition{
+		From: model.OrderStatusRefundInProgress, To: model.OrderStatusAssembling, Actor: model.OrderActorSystem, Reason: model.ReasonRefundFailed,
+	}, model.OrderUpdateInfo{}).Return(model.OrderDto{}, nil)
+
+	err := s.service.CancelOrder(s.ctx, order.UUID)
+
+	s.ErrorIs(err, model.ErrPaymentUnavailable)
+}
+
+func (s *ServiceSuite) TestCancelOrderRefundLostRace() {
+	order := s.paidOrder()
+
+	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, model.OrderTransition{
+		From: model.OrderStatusPaid, To: model.OrderStatusRefundInProgress, Actor: model.OrderActorUser, Reason: model.ReasonRefundStarted,
+	}, model.OrderUpdateInfo{}).Return(model.OrderDto{}, model.ErrOrderStatusConflict)
+
+	err := s.service.CancelOrder(s.ctx, order.UUID)
+
+	s.ErrorIs(err, model.ErrOrderConflict)
+}
+
+func (s *ServiceSuite) TestCancelOrderPaidWithoutTransaction() {
+	order := s.paidOrder()
+	order.TransactionUUID = nil
+
+	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
+
+	err := s.service.CancelOrder(s.ctx, order.UUID)
+
+	s.ErrorIs(err, model.ErrOrderInternalError)
+}
+
+func (s *ServiceSuite) TestCancelOrderAuthorizedVoids() {
+	order := s.authorizedOrder()
+	order.ReservationUUID = lo.ToPtr(gofakeit.UUID())
+
+	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, model.OrderTransition{
+		From: model.OrderStatusPaid, To: model.OrderStatusVoidInProgress, Actor: model.OrderActorUser, Reason: model.ReasonVoidStarted,
+	}, model.OrderUpdateInfo{}).Return(model.OrderDto{}, nil)
+	s.paymentClient.On("VoidAuthorization", s.ctx, *order.TransactionUUID, order.UUID, model.ReasonCancelledByUser).Return(nil)
+	s.orderRepository.On("TransitionStatus", mock.Anything, order.UUID, model.OrderTransition{
+		From: model.OrderStatusVoidInProgress, To: model.OrderStatusCancelled, Actor: model.OrderActorSystem, Reason: model.ReasonVoidDone,
+	}, model.OrderUpdateInfo{}).Return(model.OrderDto{}, nil)
+	// Холд снят, и резерв деталей больше не нужен
+	s.inventoryClient.On("ReleaseReservation", mock.Anything, *order.ReservationUUID).Return(nil)
+
+	err := s.service.CancelOrder(s.ctx, order.UUID)
+
+	s.NoError(err)
+}
+
+func (s *ServiceSuite) TestCancelOrderVoidFailedRestoresStatus() {
+	order := s.authorizedOrder()
+	order.Status = model.OrderStatusReady
+
+	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, model.OrderTransition{
+		From: model.OrderStatusReady, To: model.OrderStatusVoidInProgress, Actor: model.OrderActorUser, Reason: model.ReasonVoidStarted,
+	}, model.OrderUpdateInfo{}).Return(model.OrderDto{}, nil)
+	s.paymentClient.On("VoidAuthorization", s.ctx, *order.TransactionUUID, order.UUID, model.ReasonCancelledByUser).Return(model.ErrRefundRejected)
+	s.orderRepository.On("TransitionStatus", mock.Anything, order.UUID, model.OrderTransition{
+		From: model.OrderStatusVoidInProgress, To: model.OrderStatusReady, Actor: model.OrderActorSystem, Reason: model.ReasonVoidFailed,
+	}, model.OrderUpdateInfo{}).Return(model.OrderDto{}, nil)
+
+	err := s.service.CancelOrder(s.ctx, order.UUID)
+
+	s.ErrorIs(err, model.ErrRefundRejected)
+}
+
+func (s *ServiceSuite) TestCancelOrderVoidLostRace() {
+	order := s.authorizedOrder()
+
+	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, model.OrderTransition{
+		From: model.OrderStatusPaid, To: model.OrderStatusVoidInProgress, Actor: model.OrderActorUser, Reason: model.ReasonVoidStarted,
+	}, model.OrderUpdateInfo{}).Return(model.OrderDto{}, model.ErrOrderStatusConflict)
+
+	err := s.service.CancelOrder(s.ctx, order.UUID)
+
+	s.ErrorIs(err, model.ErrOrderConflict)
+}
@@ -2,26 +2,35 @@ package order
 
 import (
 	"context"
+	"log"
 
 	"github.com/baryshnikkov/rocket-factory/order/internal/model"
 )
 
-func (s *service) CreateOrder(ctx context.Context, userUUID string, partsUUIDs []string) (info model.OrderCreationInfo, error error) {
-	filter := model.PartsFilter{
-		Uuids: partsUUIDs,
-	}
+func (s *service) CreateOrder(ctx context.Context, userUUID string, items []model.CreateOrderItem) (info model.OrderCreationInfo, error error) {
+	items = mergeOrderItems(items)
 
-	partsList, err := s.inventoryClient.ListParts(ctx, filter)
+	orderItems, err := s.orderItems(ctx, items)
 	if err != nil {
 		return model.OrderCreationInfo{}, err
 	}
 
-	if len(partsList) != len(partsUUIDs) {
-		return model.OrderCreationInfo{}, model.ErrOrderConflict
+	var reservationUUID string
+	if len(items) > 0 {
+		reservationUUID, err = s.inventoryClient.ReserveParts(ctx, reservationItems(items))
+		if err != nil {
+			return model.OrderCreationInfo{}, err
+		}
 	}
 
-	orderInfo, createOrderErr := s.orderRepository.CreateOrder(ctx, userUUID, partsList)
+	orderInfo, createOrderErr := s.orderRepository.CreateOrder(ctx, userUUID, orderItems, reservationUUID)
 	if createOrderErr != nil {
+		// Заказ не сохранён — возвращаем детали на склад, не дожидаясь истечения резерва
+		if reservationUUID != "" {
+			if releaseErr := s.inventoryClient.ReleaseReservation(ctx, reservationUUID); releaseErr != nil {
+				log.Printf("failed to release reservation %s: %v\n", reservationUUID, releaseErr)
+			}
+		}
 		return model.OrderCreationInfo{}, createOrderErr
 	}
 
@@ -30,3 +39,72 @@ func (s *service) CreateOrder(ctx context.Context, userUUID string, partsUUIDs [
 		TotalPrice: orderInfo.TotalPrice,
 	}, nil
 }
+
+// orderItems фиксирует название и цену деталей на момент заказа.
+// Отсутствующие и архивные детали перечисляются в ошибке.
+func (s *service) orderItems(ctx context.Context, items []model.CreateOrderItem) ([]model.OrderItem, error) {
+	orderItems := make([]model.OrderItem, 0, len(items))
+	if len(items) == 0 {
+		return orderItems, nil
+	}
+
+	partsUUIDs := make([]string, 0, len(items))
+	for _, item := range items {
+		partsUUIDs = append(partsUUIDs, item.PartUUID)
+	}
+
+	batch, err := s.inventoryClient.BatchGetParts(ctx, partsUUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(batch.MissingUUIDs) > 0 {
+		return nil, &model.PartsError{Err: model.ErrPartsNotFound, UUIDs: batch.MissingUUIDs}
+	}
+
+	var archived []string
+	for _, item := range items {
+		part := batch.Parts[item.PartUUID]
+		if part.Archived {
+			archived = append(archived, item.PartUUID)
+			continue
+		}
+		orderItems = append(orderItems, model.OrderItem{
+			PartUUID:  part.UUID,
+			Name:      part.Name,
+			Quantity:  item.Quantity,
+			UnitPrice: part.Price,
+		})
+	}
+
+	if len(archived) > 0 {
+		return nil, &model.PartsError{Err: model.ErrPartsUnavailable, UUIDs: archived}
+	}
+
+	return orderItems, nil
+}
+
+// mergeOrderItems объединяет позиции с одной и той же деталью, сохраняя порядок первых вхождений.
+func mergeOrderItems(items []model.CreateOrderItem) []model.CreateOrderItem {
+	merged := make([]model.CreateOrderItem, 0, len(items))
+	index := make(map[string]int, len(items))
+	for _, item := range items {
+		if i, ok := index[item.PartUUID]; ok {
+			merged[i].Quantity += item.Quantity
+			continue
+		}
+		index[item.PartUUID] = len(merged)
+		merged = append(merged, item)
+	}
+
+	return merged
+}
+
+func reservationItems(items []model.CreateOrderItem) []model.ReservationItem {
+	res := make([]model.ReservationItem, 0, len(items))
+	for _, item := range items {
+		res = append(res, model.ReservationItem{PartUUID: item.PartUUID, Quantity: item.Quantity})
+	}
+
+	return res
+}
@@ -8,34 +8,77 @@ import (
 
 func (s *ServiceSuite) TestCreateOrderSuccess() {
 	userUUID := gofakeit.UUID()
-	partsUUIDs := []string{gofakeit.UUID(), gofakeit.UUID()}
-	partsList := []model.Part{
-		{UUID: partsUUIDs[0], Price: 1000.0},
-		{UUID: partsUUIDs[1], Price: 500.0},
+	parts := []model.Part{
+		{UUID: gofakeit.UUID(), Name: "Engine", Price: 1000.0},
+		{UUID: gofakeit.UUID(), Name: "Wing", Price: 500.0},
+	}
+	items := []model.CreateOrderItem{
+		{PartUUID: parts[0].UUID, Quantity: 4},
+		{PartUUID: parts[1].UUID, Quantity: 1},
+	}
+	orderItems := []model.OrderItem{
+		{PartUUID: parts[0].UUID, Name: "Engine", Quantity: 4, UnitPrice: 1000.0},
+		{PartUUID: parts[1].UUID, Name: "Wing", Quantity: 1, UnitPrice: 500.0},
 	}
 	orderInfo := model.OrderCreationInfo{
 		OrderUUID:  gofakeit.UUID(),
-		TotalPrice: 1500.0,
+		TotalPrice: 4500.0,
 	}
+	reservationUUID := gofakeit.UUID()
 
-	s.inventoryClient.On("ListParts", s.ctx, model.PartsFilter{Uuids: partsUUIDs}).Return(partsList, nil)
-	s.orderRepository.On("CreateOrder", s.ctx, userUUID, partsList).Return(orderInfo, nil)
+	s.inventoryClient.On("BatchGetParts", s.ctx, []string{parts[0].UUID, parts[1].UUID}).Return(partsBatch(parts...), nil)
+	s.inventoryClient.On("ReserveParts", s.ctx, []model.ReservationItem{
+		{PartUUID: parts[0].UUID, Quantity: 4},
+		{PartUUID: parts[1].UUID, Quantity: 1},
+	}).Return(reservationUUID, nil)
+	s.orderRepository.On("CreateOrder", s.ctx, userUUID, orderItems, reservationUUID).Return(orderInfo, nil)
 
-	res, err := s.service.CreateOrder(s.ctx, userUUID, partsUUIDs)
+	res, err := s.service.CreateOrder(s.ctx, userUUID, items)
 
 	s.NoError(err)
 	s.Equal(orderInfo.OrderUUID, res.OrderUUID)
 	s.Equal(orderInfo.TotalPrice, res.TotalPrice)
 }
 
+func (s *ServiceSuite) TestCreateOrderMergesRepeatedParts() {
+	userUUID := gofakeit.UUID()
+	first := model.Part{UUID: gofakeit.UUID(), Name: "Engine", Price: 1000.0}
+	second := model.Part{UUID: gofakeit.UUID(), Name: "Wing", Price: 500.0}
+	items := []model.CreateOrderItem{
+		{PartUUID: first.UUID, Quantity: 1},
+		{PartUUID: second.UUID, Quantity: 1},
+		{PartUUID: first.UUID, Quantity: 2},
+	}
+	orderInfo := model.OrderCreationInfo{
+		OrderUUID:  gofakeit.UUID(),
+		TotalPrice: 3500.0,
+	}
+	reservationUUID := gofakeit.UUID()
+
+	s.inventoryClient.On("BatchGetParts", s.ctx, []string{first.UUID, second.UUID}).Return(partsBatch(first, second), nil)
+	s.inventoryClient.On("ReserveParts", s.ctx, []model.ReservationItem{
+		{PartUUID: first.UUID, Quantity: 3},
+		{PartUUID: second.UUID, Quantity: 1},
+	}).Return(reservationUUID, nil)
+	s.orderRepository.On("CreateOrder", s.ctx, userUUID, []model.OrderItem{
+		{PartUUID: first.UUID, Name: "Engine", Quantity: 3, UnitPrice: 1000.0},
+		{PartUUID: second.UUID, Name: "Wing", Quantity: 1, UnitPrice: 500.0},
+	}, reservationUUID).Return(orderInfo, nil)
+
+	res, err := s.service.CreateOrder(s.ctx, userUUID, items)
+
+	s.NoError(err)
+	s.Equal(orderInfo, res)
+}
+
 func (s *ServiceSuite) TestCreateOrderInventoryClientError() {
 	userUUID := gofakeit.UUID()
-	partsUUIDs := []string{gofakeit.UUID()}
-	expectedErr := model.ErrPartsNotFound
+	partUUID := gofakeit.UUID()
+	expectedErr := gofakeit.Error()
 
-	s.inventoryClient.On("ListParts", s.ctx, model.PartsFilter{Uuids: partsUUIDs}).Return([]model.Part{}, expectedErr)
+	s.inventoryClient.On("BatchGetParts", s.ctx, []string{partUUID}).Return(model.PartsBatch{}, expectedErr)
 
-	res, err := s.service.CreateOrder(s.ctx, userUUID, partsUUIDs)
+	res, err := s.service.CreateOrder(s.ctx, userUUID, []model.CreateOrderItem{{PartUUID: partUUID, Quantity: 1}})
 
 	s.Error(err)
 	s.ErrorIs(err, expectedErr)
@@ -44,33 +87,56 @@ func (s *ServiceSuite) TestCreateOrderInventoryClientError() {
 
 func (s *ServiceSuite) TestCreateOrderPartsNotFound() {
 	userUUID := gofakeit.UUID()
-	partsUUIDs := []string{gofakeit.UUID(), gofakeit.UUID()}
-	partsList := []model.Part{
-		{UUID: partsUUIDs[0], Price: 1000.0},
-		// Второй части нет в ответе
-	}
+	partsUUIDs := []string{gofakeit.UUID(), gofakeit.UUID(), gofakeit.UUID()}
+	batch := partsBatch(model.Part{UUID: partsUUIDs[0], Price: 1000.0})
+	batch.MissingUUIDs = partsUUIDs[1:]
 
-	s.inventoryClient.On("ListParts", s.ctx, model.PartsFilter{Uuids: partsUUIDs}).Return(partsList, nil)
+	s.inventoryClient.On("BatchGetParts", s.ctx, partsUUIDs).Return(batch, nil)
 
-	res, err := s.service.CreateOrder(s.ctx, userUUID, partsUUIDs)
+	res, err := s.service.CreateOrder(s.ctx, userUUID, createItems(partsUUIDs...))
 
-	s.Error(err)
-	s.ErrorIs(err, model.ErrOrderConflict)
+	s.ErrorIs(err, model.ErrPartsNotFound)
+	var partsErr *model.PartsError
+	s.Require().ErrorAs(err, &partsErr)
+	s.Equal(partsUUIDs[1:], partsErr.UUIDs)
+	s.Equal(model.OrderCreationInfo{}, res)
+}
+
+func (s *ServiceSuite) TestCreateOrderPartsArchived() {
+	userUUID := gofakeit.UUID()
+	archivedUUID := gofakeit.UUID()
+	activeUUID := gofakeit.UUID()
+
+	s.inventoryClient.On("BatchGetParts", s.ctx, []string{archivedUUID, activeUUID}).Return(partsBatch(
+		model.Part{UUID: archivedUUID, Archived: true},
+		model.Part{UUID: activeUUID},
+	), nil)
+
+	res, err := s.service.CreateOrder(s.ctx, userUUID, createItems(archivedUUID, activeUUID, archivedUUID))
+
+	s.ErrorIs(err, model.ErrPartsUnavailable)
+	var partsErr *model.PartsError
+	s.Require().ErrorAs(err, &partsErr)
+	s.Equal([]string{archivedUUID}, partsErr.UUIDs)
 	s.Equal(model.OrderCreationInfo{}, res)
 }
 
 func (s *ServiceSuite) TestCreateOrderRepositoryError() {
 	userUUID := gofakeit.UUID()
-	partsUUIDs := []string{gofakeit.UUID()}
-	partsList := []model.Part{
-		{UUID: partsUUIDs[0], Price: 1000.0},
-	}
+	part := model.Part{UUID: gofakeit.UUID(), Name: "Engine", Price: 1000.0}
 	expectedErr := model.ErrOrderInternalError
+	reservationUUID := gofakeit.UUID()
 
-	s.inventoryClient.On("ListParts", s.ctx, model.PartsFilter{Uuids: partsUUIDs}).Return(partsList, nil)
-	s.orderRepository.On("CreateOrder", s.ctx, userUUID, partsList).Return(model.OrderCreationInfo{}, expectedErr)
+	s.inventoryClient.On("BatchGetParts", s.ctx, []string{part.UUID}).Return(partsBatch(part), nil)
+	s.inventoryClient.On("ReserveParts", s.ctx, []model.ReservationItem{
+		{PartUUID: part.UUID, Quantity: 1},
+	}).Return(reservationUUID, nil)
+	s.orderRepository.On("CreateOrder", s.ctx, userUUID, []model.OrderItem{
+		{PartUUID: part.UUID, Name: "Engine", Quantity: 1, UnitPrice: 1000.0},
+	}, reservationUUID).Return(model.OrderCreationInfo{}, expectedErr)
+	s.inventoryClient.On("ReleaseReservation", s.ctx, reservationUUID).Return(nil)
 
-	res, err := s.service.CreateOrder(s.ctx, userUUID, partsUUIDs)
+	res, err := s.service.CreateOrder(s.ctx, userUUID, createItems(part.UUID))
 
 	s.Error(err)
 	s.ErrorIs(err, expectedErr)
@@ -79,18 +145,62 @@ func (s *ServiceSuite) TestCreateOrderRepositoryError() {
 
 func (s *ServiceSuite) TestCreateOrderEmptyParts() {
 	userUUID := gofakeit.UUID()
-	var partsUUIDs []string
 	orderInfo := model.OrderCreationInfo{
 		OrderUUID:  gofakeit.UUID(),
 		TotalPrice: 0.0,
 	}
 
-	s.inventoryClient.On("ListParts", s.ctx, model.PartsFilter{Uuids: partsUUIDs}).Return([]model.Part{}, nil)
-	s.orderRepository.On("CreateOrder", s.ctx, userUUID, []model.Part{}).Return(orderInfo, nil)
+	s.orderRepository.On("CreateOrder", s.ctx, userUUID, []model.OrderItem{}, "").Return(orderInfo, nil)
 
-	res, err := s.service.CreateOrder(s.ctx, userUUID, partsUUIDs)
+	res, err := s.service.CreateOrder(s.ctx, userUUID, nil)
 
 	s.NoError(err)
 	s.Equal(orderInfo.OrderUUID, res.OrderUUID)
 	s.Equal(0.0, res.TotalPrice)
 }
+
+func (s *ServiceSuite) TestCreateOrderInsufficientStock() {
+	userUUID := gofakeit.UUID()
+	part := model.Part{UUID: gofakeit.UUID(), Price: 1000.0}
+
+	s.inventoryClient.On("BatchGetParts", s.ctx, []string{part.UUID}).Return(partsBatch(part), nil)
+	s.inventoryClient.On("ReserveParts", s.ctx, []model.ReservationItem{
+		{PartUUID: part.UUID, Quantity: 1},
+	}).Return("", model.ErrInsufficientStock)
+
+	res, err := s.service.CreateOrder(s.ctx, userUUID, createItems(part.UUID))
+
+	s.ErrorIs(err, model.ErrInsufficientStock)
+	s.Equal(model.OrderCreationInfo{}, res)
+}
+
+func (s *ServiceSuite) TestMergeOrderItems() {
+	first, second := gofakeit.UUID(), gofakeit.UUID()
+
+	items := mergeOrderItems([]model.CreateOrderItem{
+		{PartUUID: first, Quantity: 1},
+		{PartUUID: second, Quantity: 5},
+		{PartUUID: first, Quantity: 2},
+	})
+
+	s.Equal([]model.CreateOrderItem{
+		{PartUUID: first, Quantity: 3},
+		{PartUUID: second, Quantity: 5},
+	}, items)
+}
+
+func createItems(partsUUIDs ...string) []model.CreateOrderItem {
+	items := make([]model.CreateOrderItem, 0, len(partsUUIDs))
+	for _, partUUID := range partsUUIDs {
+		items = append(items, model.CreateOrderItem{PartUUID: partUUID, Quantity: 1})
+	}
+	return items
+}
+
+func partsBatch(parts ...model.Part) model.PartsBatch {
+	batch := model.PartsBatch{Parts: make(map[string]model.Part, len(parts))}
+	for _, part := range parts {
+		batch.Parts[part.UUID] = part
+	}
+	return batch
+}
@@ -0,0 +1,67 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+)
+
+// expireBatchSize - сколько просроченных заказов читается из хранилища за раз
+const expireBatchSize = 100
+
+func (s *service) ExpireOrders(ctx context.Context, createdBefore time.Time) (expired int, err error) {
+	filter := model.OrdersFilter{
+		Statuses:  []model.OrderStatus{model.OrderStatusPendingPayment},
+		CreatedTo: &createdBefore,
+	}
+	page := model.OrdersPageRequest{
+		PageSize: expireBatchSize,
+		OrderBy:  model.OrdersOrder{Field: model.OrdersOrderByCreatedAt},
+	}
+
+	for {
+		result, err := s.orderRepository.ListOrders(ctx, filter, page)
+		if err != nil {
+			return expired, err
+		}
+
+		for _, order := range result.Orders {
+			err = s.cancelUnpaid(ctx, order, model.OrderActorSystem, model.ReasonExpired)
+			// Заказ успели оплатить или отменить - он уже не просрочен
+			if errors.Is(err, model.ErrOrderConflict) {
+				continue
+			}
+			if err != nil {
+				return expired, err
+			}
+			s.voidAuthorizations(ctx, order)
+			expired++
+		}
+
+		if result.Next == nil {
+			return expired, nil
+		}
+		page.After = result.Next
+	}
+}
+
+// voidAuthorizations снимает холды, которые могли остаться у просроченного заказа: оплата с неизвестным
+// исходом или без подтверждённого резерва откатывает заказ в ожидание оплаты, не снимая холд.
+// Операции, так и не получившие ответа провайдера, payment-сервис отменяет сам
+func (s *service) voidAuthorizations(ctx context.Context, order model.OrderDto) {
+	transactionUUIDs, err := s.paymentClient.ListAuthorizations(ctx, order.UUID)
+	if err != nil {
+		log.Printf("failed to list authorizations of order %s: %v\n", order.UUID, err)
+		return
+	}
+
+	for _, transactionUUID := range transactionUUIDs {
+		err = s.paymentClient.VoidAuthorization(ctx, transactionUUID, order.UUID, model.ReasonExpired)
+		if err != nil {
+			log.Printf("failed to void authorization %s of order %s: %v\n", transactionUUID, order.UUID, err)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package order
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+)
+
+func (s *ServiceSuite) expireFilter(createdBefore time.Time) model.OrdersFilter {
+	return model.OrdersFilter{
+		Statuses:  []model.OrderStatus{model.OrderStatusPendingPayment},
+		CreatedTo: &createdBefore,
+	}
+}
+
+func (s *ServiceSuite) expireTransition() model.OrderTransition {
+	return model.OrderTransition{
+		From:   model.OrderStatusPendingPayment,
+		To:     model.OrderStatusCancelled,
+		Actor:  model.OrderActorSystem,
+		Reason: model.ReasonExpired,
+	}
+}
+
+func (s *ServiceSuite) TestExpireOrdersPages() {
+	createdBefore := time.Now().Add(-time.Hour)
+	first := model.OrderDto{UUID: gofakeit.UUID(), Status: model.OrderStatusPendingPayment, ReservationUUID: lo.ToPtr(gofakeit.UUID())}
+	second := model.OrderDto{UUID: gofakeit.UUID(), Status: model.OrderStatusPendingPayment}
+	firstPage := model.OrdersPageRequest{PageSize: expireBatchSize, OrderBy: model.OrdersOrder{Field: model.OrdersOrderByCreatedAt}}
+	secondPage := firstPage
+	secondPage.After = model.NewOrdersCursor(first)
+
+	s.orderRepository.On("ListOrders", s.ctx, s.expireFilter(createdBefore), firstPage).
+		Return(model.OrdersPage{Orders: []model.OrderDto{first}, TotalSize: 2, Next: model.NewOrdersCursor(first)}, nil)
+	s.orderRepository.On("ListOrders", s.ctx, s.expireFilter(createdBefore), secondPage).
+		Return(model.OrdersPage{Orders: []model.OrderDto{second}, TotalSize: 1}, nil)
+	s.orderRepository.On("TransitionStatus", s.ctx, first.UUID, s.expireTransition(), model.OrderUpdateInfo{}).Return(model.OrderDto{}, nil)
+	s.orderRepository.On("TransitionStatus", s.ctx, second.UUID, s.expireTransition(), model.OrderUpdateInfo{}).Return(model.OrderDto{}, nil)
+	s.inventoryClient.On("ReleaseReservation", s.ctx, *first.ReservationUUID).Return(nil)
+	s.paymentClient.On("ListAuthorizations", s.ctx, first.UUID).Return([]string{}, nil)
+	s.paymentClient.On("ListAuthorizations", s.ctx, second.UUID).Return([]string{}, nil)
+
+	expired, err := s.service.ExpireOrders(s.ctx, createdBefore)
+
+	s.NoError(err)
+	s.Equal(2, expired)
+}
+
+func (s *ServiceSuite) TestExpireOrdersSkipsPaidMeanwhile() {
+	createdBefore := time.Now()
+	order := model.OrderDto{UUID: gofakeit.UUID(), Status: model.OrderStatusPendingPayment}
+
+	s.orderRepository.On("ListOrders", s.ctx, s.expireFilter(createdBefore), model.OrdersPageRequest{
+		PageSize: expireBatchSize,
+		OrderBy:  model.OrdersOrder{Field: model.OrdersOrderByCreatedAt},
+	}).Return(model.OrdersPage{Orders: []model.OrderDto{order}, TotalSize: 1}, nil)
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, s.expireTransition(), model.OrderUpdateInfo{}).
+		Return(model.OrderDto{}, model.ErrOrderStatusConflict)
+
+	expired, err := s.service.ExpireOrders(s.ctx, createdBefore)
+
+	s.NoError(err)
+	s.Zero(expired)
+}
+
+func (s *ServiceSuite) TestExpireOrdersListError() {
+	createdBefore := time.Now()
+	expectedErr := gofakeit.Error()
+
+	s.orderRepository.On("ListOrders", s.ctx, s.expireFilter(createdBefore), model.OrdersPageRequest{
+		PageSize: expireBatchSize,
+		OrderBy:  model.OrdersOrder{Field: model.OrdersOrderByCreatedAt},
+	}).Return(model.OrdersPage{}, expectedErr)
+
+	expired, err := s.service.ExpireOrders(s.ctx, createdBefore)
+
+	s.ErrorIs(err, expectedErr)
+	s.Zero(expired)
+}
+
+func (s *ServiceSuite) TestExpireOrdersVoidsAuthorizations() {
+	createdBefore := time.Now()
+	order := model.OrderDto{UUID: gofakeit.UUID(), Status: model.OrderStatusPendingPayment}
+	voided, failed := gofakeit.UUID(), gofakeit.UUID()
+
+	s.orderRepository.On("ListOrders", s.ctx, s.expireFilter(createdBefore), model.OrdersPageRequest{
+		PageSize: expireBatchSize,
+		OrderBy:  model.OrdersOrder{Field: model.OrdersOrderByCreatedAt},
+	}).Return(model.OrdersPage{Orders: []model.OrderDto{order}, TotalSize: 1}, nil)
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, s.expireTransition(), model.OrderUpdateInfo{}).Return(model.OrderDto{}, nil)
+	// Холд остался после оплаты с неизвестным исходом: заказ отменён, и холд снимается
+	s.paymentClient.On("ListAuthorizations", s.ctx, order.UUID).Return([]string{voided, failed}, nil)
+	s.paymentClient.On("VoidAuthorization", s.ctx, voided, order.UUID, model.ReasonExpired).Return(nil).Once()
+	s.paymentClient.On("VoidAuthorization", s.ctx, failed, order.UUID, model.ReasonExpired).Return(model.ErrPaymentUnavailable).Once()
+
+	expired, err := s.service.ExpireOrders(s.ctx, createdBefore)
+
+	s.NoError(err)
+	s.Equal(1, expired)
+}
+
+func (s *ServiceSuite) TestExpireOrdersListAuthorizationsError() {
+	createdBefore := time.Now()
+	order := model.OrderDto{UUID: gofakeit.UUID(), Status: model.OrderStatusPendingPayment}
+
+	s.orderRepository.On("ListOrders", s.ctx, s.expireFilter(createdBefore), model.OrdersPageRequest{
+		PageSize: expireBatchSize,
+		OrderBy:  model.OrdersOrder{Field: model.OrdersOrderByCreatedAt},
+	}).Return(model.OrdersPage{Orders: []model.OrderDto{order}, TotalSize: 1}, nil)
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, s.expireTransition(), model.OrderUpdateInfo{}).Return(model.OrderDto{}, nil)
+	s.paymentClient.On("ListAuthorizations", s.ctx, order.UUID).Return(nil, model.ErrPaymentUnavailable)
+
+	expired, err := s.service.ExpireOrders(s.ctx, createdBefore)
+
+	// Заказ уже отменён; холд истечёт по сроку
+	s.NoError(err)
+	s.Equal(1, expired)
+}
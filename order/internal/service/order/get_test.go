@@ -10,9 +10,12 @@ import (
 
 func (s *ServiceSuite) TestGetOrderSuccess() {
 	order := model.OrderDto{
-		UUID:       gofakeit.UUID(),
-		UserUUID:   gofakeit.UUID(),
-		PartsUUIDs: []string{gofakeit.UUID(), gofakeit.UUID()},
+		UUID:     gofakeit.UUID(),
+		UserUUID: gofakeit.UUID(),
+		Items: []model.OrderItem{
+			{PartUUID: gofakeit.UUID(), Name: "Engine", Quantity: 2, UnitPrice: 500.25},
+			{PartUUID: gofakeit.UUID(), Name: "Wing", Quantity: 1, UnitPrice: 500.25},
+		},
 		TotalPrice: 1500.75,
 		Status:     model.OrderStatusPaid,
 		CreatedAt:  time.Now(),
@@ -0,0 +1,11 @@
+package order
+
+import (
+	"context"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+)
+
+func (s *service) GetOrderHistory(ctx context.Context, orderUUID string) ([]model.OrderStatusTransition, error) {
+	return s.orderRepository.GetOrderHistory(ctx, orderUUID)
+}
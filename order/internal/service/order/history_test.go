@@ -0,0 +1,35 @@
+package order
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+)
+
+func (s *ServiceSuite) TestGetOrderHistorySuccess() {
+	orderUUID := gofakeit.UUID()
+	history := []model.OrderStatusTransition{
+		{To: model.OrderStatusPendingPayment, Actor: model.OrderActorUser, Reason: model.ReasonOrderCreated, CreatedAt: time.Now()},
+		{From: lo.ToPtr(model.OrderStatusPendingPayment), To: model.OrderStatusCancelled, Actor: model.OrderActorUser, Reason: model.ReasonCancelledByUser, CreatedAt: time.Now()},
+	}
+
+	s.orderRepository.On("GetOrderHistory", s.ctx, orderUUID).Return(history, nil)
+
+	res, err := s.service.GetOrderHistory(s.ctx, orderUUID)
+
+	s.NoError(err)
+	s.Equal(history, res)
+}
+
+func (s *ServiceSuite) TestGetOrderHistoryNotFound() {
+	orderUUID := gofakeit.UUID()
+
+	s.orderRepository.On("GetOrderHistory", s.ctx, orderUUID).Return(nil, model.ErrOrderNotFound)
+
+	_, err := s.service.GetOrderHistory(s.ctx, orderUUID)
+
+	s.ErrorIs(err, model.ErrOrderNotFound)
+}
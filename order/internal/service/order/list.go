@@ -0,0 +1,41 @@
+package order
+
+import (
+	"context"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	"github.com/baryshnikkov/rocket-factory/shared/pkg/pagetoken"
+)
+
+func (s *service) ListOrders(ctx context.Context, filter model.OrdersFilter, params model.ListOrdersParams) (model.OrdersList, error) {
+	page := model.OrdersPageRequest{
+		PageSize: params.PageSize,
+		OrderBy:  params.OrderBy,
+	}
+
+	if params.PageToken != "" {
+		cursor, err := pagetoken.Decode[model.OrdersCursor](params.PageToken, filter, params.OrderBy)
+		if err != nil {
+			return model.OrdersList{}, model.ErrInvalidPageToken
+		}
+		page.After = &cursor
+	}
+
+	result, err := s.orderRepository.ListOrders(ctx, filter, page)
+	if err != nil {
+		return model.OrdersList{}, err
+	}
+
+	list := model.OrdersList{
+		Orders:    result.Orders,
+		TotalSize: result.TotalSize,
+	}
+	if result.Next != nil {
+		list.NextPageToken, err = pagetoken.Encode(*result.Next, filter, params.OrderBy)
+		if err != nil {
+			return model.OrdersList{}, err
+		}
+	}
+
+	return list, nil
+}
@@ -0,0 +1,76 @@
+package order
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+	"github.com/baryshnikkov/rocket-factory/shared/pkg/pagetoken"
+)
+
+func (s *ServiceSuite) TestListOrdersSuccess() {
+	filter := model.OrdersFilter{UserUUID: gofakeit.UUID()}
+	orders := []model.OrderDto{{UUID: gofakeit.UUID(), UserUUID: filter.UserUUID}}
+
+	s.orderRepository.On("ListOrders", s.ctx, filter, model.OrdersPageRequest{}).
+		Return(model.OrdersPage{Orders: orders, TotalSize: 1}, nil)
+
+	res, err := s.service.ListOrders(s.ctx, filter, model.ListOrdersParams{})
+
+	s.NoError(err)
+	s.Equal(orders, res.Orders)
+	s.Equal(int32(1), res.TotalSize)
+	s.Empty(res.NextPageToken)
+}
+
+func (s *ServiceSuite) TestListOrdersNextPage() {
+	filter := model.OrdersFilter{UserUUID: gofakeit.UUID()}
+	order := model.OrdersOrder{Field: model.OrdersOrderByTotalPrice, Desc: true}
+
+	first := model.OrderDto{UUID: gofakeit.UUID(), TotalPrice: 300, CreatedAt: time.Now().UTC()}
+	second := model.OrderDto{UUID: gofakeit.UUID(), TotalPrice: 100, CreatedAt: time.Now().UTC()}
+	cursor := model.NewOrdersCursor(first)
+
+	s.orderRepository.On("ListOrders", s.ctx, filter, model.OrdersPageRequest{PageSize: 1, OrderBy: order}).
+		Return(model.OrdersPage{Orders: []model.OrderDto{first}, TotalSize: 2, Next: cursor}, nil)
+	s.orderRepository.On("ListOrders", s.ctx, filter, model.OrdersPageRequest{PageSize: 1, OrderBy: order, After: cursor}).
+		Return(model.OrdersPage{Orders: []model.OrderDto{second}, TotalSize: 2}, nil)
+
+	page, err := s.service.ListOrders(s.ctx, filter, model.ListOrdersParams{PageSize: 1, OrderBy: order})
+	s.Require().NoError(err)
+	s.Require().NotEmpty(page.NextPageToken)
+
+	next, err := s.service.ListOrders(s.ctx, filter, model.ListOrdersParams{PageSize: 1, OrderBy: order, PageToken: page.NextPageToken})
+	s.Require().NoError(err)
+	s.Equal([]model.OrderDto{second}, next.Orders)
+	s.Empty(next.NextPageToken)
+}
+
+func (s *ServiceSuite) TestListOrdersTokenFromOtherFilter() {
+	token, err := pagetoken.Encode(model.OrdersCursor{UUID: gofakeit.UUID()}, model.OrdersFilter{UserUUID: gofakeit.UUID()}, model.OrdersOrder{})
+	s.Require().NoError(err)
+
+	res, err := s.service.ListOrders(s.ctx, model.OrdersFilter{UserUUID: gofakeit.UUID()}, model.ListOrdersParams{PageToken: token})
+
+	s.ErrorIs(err, model.ErrInvalidPageToken)
+	s.Empty(res)
+}
+
+func (s *ServiceSuite) TestListOrdersMalformedToken() {
+	res, err := s.service.ListOrders(s.ctx, model.OrdersFilter{}, model.ListOrdersParams{PageToken: "not a token"})
+
+	s.ErrorIs(err, model.ErrInvalidPageToken)
+	s.Empty(res)
+}
+
+func (s *ServiceSuite) TestListOrdersFail() {
+	repoErr := gofakeit.Error()
+
+	s.orderRepository.On("ListOrders", s.ctx, model.OrdersFilter{}, model.OrdersPageRequest{}).Return(model.OrdersPage{}, repoErr)
+
+	res, err := s.service.ListOrders(s.ctx, model.OrdersFilter{}, model.ListOrdersParams{})
+
+	s.ErrorIs(err, repoErr)
+	s.Empty(res)
+}
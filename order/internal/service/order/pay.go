@@ -2,6 +2,7 @@ package order
 
 import (
 	"context"
+	"errors"
 
 	"github.com/samber/lo"
 
@@ -14,38 +15,87 @@ func (s *service) PayOrder(ctx context.Context, orderUUID, paymentMethod string)
 		return "", err
 	}
 
-	if resp, ok := canPayOrder(order); ok {
-		return "", resp
+	// Заказ, застрявший в оплате (сервис упал посреди неё или откат не записался), оплачивается
+	// заново: холд по заказу идемпотентен, поэтому повтор вернёт уже созданную транзакцию
+	resumed := order.Status == model.OrderStatusPaymentInProgress
+	if !resumed {
+		if resp, ok := canPayOrder(order); ok {
+			return "", resp
+		}
+
+		// Занимаем заказ под оплату: из конкурентных оплат и отмен проходит только одна
+		_, err = s.transition(ctx, order, model.OrderStatusPaymentInProgress, model.OrderActorUser, model.ReasonPaymentStarted, model.OrderUpdateInfo{})
+		if err != nil {
+			if errors.Is(err, model.ErrOrderStatusConflict) {
+				return "", model.ErrPaymentConflict
+			}
+			return "", err
+		}
+		order = moved(order, model.OrderStatusPaymentInProgress)
 	}
 
-	transUUID, err := s.paymentClient.PayOrder(ctx, order.UserUUID, orderUUID, paymentMethod)
+	transUUID, err := s.pay(ctx, order, paymentMethod)
 	if err != nil {
-		return "", err
+		// Исход неизвестен, а оплату, возможно, ещё ведёт запрос, занявший заказ: заказ остаётся
+		// в оплате, и PayOrder можно повторить
+		if resumed && errors.Is(err, model.ErrPaymentUnavailable) {
+			return "", err
+		}
+		// Оплата не прошла — возвращаем заказ в ожидание оплаты, даже если запрос уже отменён
+		_, rollbackErr := s.transition(context.WithoutCancel(ctx), order, model.OrderStatusPendingPayment, model.OrderActorSystem, model.ReasonPaymentFailed, model.OrderUpdateInfo{})
+		return "", errors.Join(err, rollbackErr)
 	}
 
-	orderStatus := model.OrderStatusPaid
-	updateErr := s.orderRepository.UpdateOrder(ctx, order.UUID, model.OrderUpdateInfo{
-		Status:          &orderStatus,
+	// Деньги уже заморожены: результат записываем, даже если клиент не дождался ответа
+	_, err = s.transition(context.WithoutCancel(ctx), order, model.OrderStatusPaid, model.OrderActorSystem, model.ReasonPaymentDone, model.OrderUpdateInfo{
 		PaymentMethod:   lo.ToPtr(model.PaymentMethod(paymentMethod)),
 		TransactionUUID: lo.ToPtr(transUUID),
 	})
-
-	if updateErr != nil {
-		return "", updateErr
+	if errors.Is(err, model.ErrOrderStatusConflict) && resumed {
+		// Ту же оплату успел записать конкурентный запрос
+		current, getErr := s.orderRepository.GetOrder(context.WithoutCancel(ctx), order.UUID)
+		if getErr == nil && current.TransactionUUID != nil && *current.TransactionUUID == transUUID {
+			return transUUID, nil
+		}
+	}
+	if err != nil {
+		return "", err
 	}
 
 	return transUUID, nil
 }
 
+func (s *service) pay(ctx context.Context, order model.OrderDto, paymentMethod string) (transactionUUID string, err error) {
+	// Деньги только замораживаются: списание происходит при отгрузке заказа. Резерв подтверждается
+	// после холда, поэтому отклонённая оплата не списывает детали со склада
+	transactionUUID, err = s.paymentClient.AuthorizePayment(ctx, order.UserUUID, order.UUID, paymentMethod, model.MinorUnits(order.TotalPrice), model.Currency)
+	if err != nil {
+		return "", err
+	}
+
+	if order.ReservationUUID != nil {
+		err = s.inventoryClient.CommitReservation(ctx, *order.ReservationUUID)
+		// Резерва больше нет - деталей не будет, холд снимаем. При сбое склада холд остаётся:
+		// повтор оплаты получит ту же транзакцию и подтвердит резерв
+		if errors.Is(err, model.ErrReservationExpired) || errors.Is(err, model.ErrReservationNotFound) {
+			voidErr := s.paymentClient.VoidAuthorization(context.WithoutCancel(ctx), transactionUUID, order.UUID, model.ReasonPaymentFailed)
+			return "", errors.Join(err, voidErr)
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return transactionUUID, nil
+}
+
 func canPayOrder(order model.OrderDto) (error, bool) {
-	switch order.Status {
-	case model.OrderStatusPaid:
-		return model.ErrPaymentConflict, true
-	case model.OrderStatusCancelled:
+	switch {
+	case !order.Status.Valid():
+		return model.ErrPaymentInternalError, true
+	case !order.Status.CanTransitionTo(model.OrderStatusPaymentInProgress):
 		return model.ErrPaymentConflict, true
-	case model.OrderStatusPendingPayment:
-		return nil, false
 	default:
-		return model.ErrPaymentInternalError, true
+		return nil, false
 	}
 }
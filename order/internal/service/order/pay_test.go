@@ -1,28 +1,36 @@
 package order
 
 import (
+	"context"
+
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/baryshnikkov/rocket-factory/order/internal/model"
 )
 
 func (s *ServiceSuite) TestPayOrderSuccess() {
 	order := model.OrderDto{
-		UUID:     gofakeit.UUID(),
-		UserUUID: gofakeit.UUID(),
-		Status:   model.OrderStatusPendingPayment,
+		UUID:       gofakeit.UUID(),
+		UserUUID:   gofakeit.UUID(),
+		TotalPrice: 1500.75,
+		Status:     model.OrderStatusPendingPayment,
 	}
 	paymentMethod := "CARD"
 	transUUID := gofakeit.UUID()
 
 	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
-	s.paymentClient.On("PayOrder", s.ctx, order.UserUUID, order.UUID, paymentMethod).Return(transUUID, nil)
-	s.orderRepository.On("UpdateOrder", s.ctx, order.UUID, model.OrderUpdateInfo{
-		Status:          lo.ToPtr(model.OrderStatusPaid),
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, model.OrderTransition{
+		From: model.OrderStatusPendingPayment, To: model.OrderStatusPaymentInProgress, Actor: model.OrderActorUser, Reason: model.ReasonPaymentStarted,
+	}, model.OrderUpdateInfo{}).Return(model.OrderDto{}, nil)
+	s.paymentClient.On("AuthorizePayment", s.ctx, order.UserUUID, order.UUID, paymentMethod, model.MinorUnits(order.TotalPrice), model.Currency).Return(transUUID, nil)
+	s.orderRepository.On("TransitionStatus", mock.Anything, order.UUID, model.OrderTransition{
+		From: model.OrderStatusPaymentInProgress, To: model.OrderStatusPaid, Actor: model.OrderActorSystem, Reason: model.ReasonPaymentDone,
+	}, model.OrderUpdateInfo{
 		PaymentMethod:   lo.ToPtr(model.PaymentMethod(paymentMethod)),
 		TransactionUUID: lo.ToPtr(transUUID),
-	}).Return(nil)
+	}).Return(model.OrderDto{}, nil)
 
 	result, err := s.service.PayOrder(s.ctx, order.UUID, paymentMethod)
 
@@ -86,7 +94,13 @@ func (s *ServiceSuite) TestPayOrderPaymentClientError() {
 	expectedErr := model.ErrPaymentInternalError
 
 	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
-	s.paymentClient.On("PayOrder", s.ctx, order.UserUUID, order.UUID, paymentMethod).Return("", expectedErr)
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, model.OrderTransition{
+		From: model.OrderStatusPendingPayment, To: model.OrderStatusPaymentInProgress, Actor: model.OrderActorUser, Reason: model.ReasonPaymentStarted,
+	}, model.OrderUpdateInfo{}).Return(model.OrderDto{}, nil)
+	s.paymentClient.On("AuthorizePayment", s.ctx, order.UserUUID, order.UUID, paymentMethod, model.MinorUnits(order.TotalPrice), model.Currency).Return("", expectedErr)
+	s.orderRepository.On("TransitionStatus", mock.Anything, order.UUID, model.OrderTransition{
+		From: model.OrderStatusPaymentInProgress, To: model.OrderStatusPendingPayment, Actor: model.OrderActorSystem, Reason: model.ReasonPaymentFailed,
+	}, model.OrderUpdateInfo{}).Return(model.OrderDto{}, nil)
 
 	result, err := s.service.PayOrder(s.ctx, order.UUID, paymentMethod)
 
@@ -106,12 +120,16 @@ func (s *ServiceSuite) TestPayOrderUpdateOrderError() {
 	expectedErr := model.ErrOrderInternalError
 
 	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
-	s.paymentClient.On("PayOrder", s.ctx, order.UserUUID, order.UUID, paymentMethod).Return(transUUID, nil)
-	s.orderRepository.On("UpdateOrder", s.ctx, order.UUID, model.OrderUpdateInfo{
-		Status:          lo.ToPtr(model.OrderStatusPaid),
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, model.OrderTransition{
+		From: model.OrderStatusPendingPayment, To: model.OrderStatusPaymentInProgress, Actor: model.OrderActorUser, Reason: model.ReasonPaymentStarted,
+	}, model.OrderUpdateInfo{}).Return(model.OrderDto{}, nil)
+	s.paymentClient.On("AuthorizePayment", s.ctx, order.UserUUID, order.UUID, paymentMethod, model.MinorUnits(order.TotalPrice), model.Currency).Return(transUUID, nil)
+	s.orderRepository.On("TransitionStatus", mock.Anything, order.UUID, model.OrderTransition{
+		From: model.OrderStatusPaymentInProgress, To: model.OrderStatusPaid, Actor: model.OrderActorSystem, Reason: model.ReasonPaymentDone,
+	}, model.OrderUpdateInfo{
 		PaymentMethod:   lo.ToPtr(model.PaymentMethod(paymentMethod)),
 		TransactionUUID: lo.ToPtr(transUUID),
-	}).Return(expectedErr)
+	}).Return(model.OrderDto{}, expectedErr)
 
 	result, err := s.service.PayOrder(s.ctx, order.UUID, paymentMethod)
 
@@ -182,3 +200,186 @@ func (s *ServiceSuite) TestCanPayOrderUnknownStatus() {
 	s.ErrorIs(err, model.ErrPaymentInternalError)
 	s.True(shouldReturn)
 }
+
+func (s *ServiceSuite) TestPayOrderCommitsReservation() {
+	order := model.OrderDto{
+		UUID:            gofakeit.UUID(),
+		UserUUID:        gofakeit.UUID(),
+		ReservationUUID: lo.ToPtr(gofakeit.UUID()),
+		Status:          model.OrderStatusPendingPayment,
+	}
+	paymentMethod := "CARD"
+	transUUID := gofakeit.UUID()
+
+	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, model.OrderTransition{
+		From: model.OrderStatusPendingPayment, To: model.OrderStatusPaymentInProgress, Actor: model.OrderActorUser, Reason: model.ReasonPaymentStarted,
+	}, model.OrderUpdateInfo{}).Return(model.OrderDto{}, nil)
+	s.inventoryClient.On("CommitReservation", s.ctx, *order.ReservationUUID).Return(nil)
+	s.paymentClient.On("AuthorizePayment", s.ctx, order.UserUUID, order.UUID, paymentMethod, model.MinorUnits(order.TotalPrice), model.Currency).Return(transUUID, nil)
+	s.orderRepository.On("TransitionStatus", mock.Anything, order.UUID, model.OrderTransition{
+		From: model.OrderStatusPaymentInProgress, To: model.OrderStatusPaid, Actor: model.OrderActorSystem, Reason: model.ReasonPaymentDone,
+	}, model.OrderUpdateInfo{
+		PaymentMethod:   lo.ToPtr(model.PaymentMethod(paymentMethod)),
+		TransactionUUID: lo.ToPtr(transUUID),
+	}).Return(model.OrderDto{}, nil)
+
+	result, err := s.service.PayOrder(s.ctx, order.UUID, paymentMethod)
+
+	s.NoError(err)
+	s.Equal(transUUID, result)
+}
+
+func (s *ServiceSuite) TestPayOrderReservationExpired() {
+	order := model.OrderDto{
+		UUID:            gofakeit.UUID(),
+		UserUUID:        gofakeit.UUID(),
+		ReservationUUID: lo.ToPtr(gofakeit.UUID()),
+		Status:          model.OrderStatusPendingPayment,
+	}
+
+	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, model.OrderTransition{
+		From: model.OrderStatusPendingPayment, To: model.OrderStatusPaymentInProgress, Actor: model.OrderActorUser, Reason: model.ReasonPaymentStarted,
+	}, model.OrderUpdateInfo{}).Return(model.OrderDto{}, nil)
+	transUUID := gofakeit.UUID()
+	s.paymentClient.On("AuthorizePayment", s.ctx, order.UserUUID, order.UUID, "CARD", model.MinorUnits(order.TotalPrice), model.Currency).Return(transUUID, nil)
+	s.inventoryClient.On("CommitReservation", s.ctx, *order.ReservationUUID).Return(model.ErrReservationExpired)
+	// Деталей не будет - холд снимается
+	s.paymentClient.On("VoidAuthorization", mock.Anything, transUUID, order.UUID, model.ReasonPaymentFailed).Return(nil)
+	s.orderRepository.On("TransitionStatus", mock.Anything, order.UUID, model.OrderTransition{
+		From: model.OrderStatusPaymentInProgress, To: model.OrderStatusPendingPayment, Actor: model.OrderActorSystem, Reason: model.ReasonPaymentFailed,
+	}, model.OrderUpdateInfo{}).Return(model.OrderDto{}, nil)
+
+	result, err := s.service.PayOrder(s.ctx, order.UUID, "CARD")
+
+	s.ErrorIs(err, model.ErrReservationExpired)
+	s.Equal("", result)
+}
+
+func (s *ServiceSuite) TestPayOrderDeclinedKeepsReservation() {
+	order := model.OrderDto{
+		UUID:            gofakeit.UUID(),
+		UserUUID:        gofakeit.UUID(),
+		ReservationUUID: lo.ToPtr(gofakeit.UUID()),
+		Status:          model.OrderStatusPendingPayment,
+	}
+
+	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, model.OrderTransition{
+		From: model.OrderStatusPendingPayment, To: model.OrderStatusPaymentInProgress, Actor: model.OrderActorUser, Reason: model.ReasonPaymentStarted,
+	}, model.OrderUpdateInfo{}).Return(model.OrderDto{}, nil)
+	s.paymentClient.On("AuthorizePayment", s.ctx, order.UserUUID, order.UUID, "CARD", model.MinorUnits(order.TotalPrice), model.Currency).Return("", model.ErrPaymentRejected)
+	s.orderRepository.On("TransitionStatus", mock.Anything, order.UUID, model.OrderTransition{
+		From: model.OrderStatusPaymentInProgress, To: model.OrderStatusPendingPayment, Actor: model.OrderActorSystem, Reason: model.ReasonPaymentFailed,
+	}, model.OrderUpdateInfo{}).Return(model.OrderDto{}, nil)
+
+	result, err := s.service.PayOrder(s.ctx, order.UUID, "CARD")
+
+	s.ErrorIs(err, model.ErrPaymentRejected)
+	s.Equal("", result)
+	// Резерв не подтверждался: детали остаются зарезервированными под повтор оплаты
+	s.inventoryClient.AssertNotCalled(s.T(), "CommitReservation", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestPayOrderResumesInProgress() {
+	order := model.OrderDto{
+		UUID:           gofakeit.UUID(),
+		UserUUID:       gofakeit.UUID(),
+		Status:         model.OrderStatusPaymentInProgress,
+		PreviousStatus: lo.ToPtr(model.OrderStatusPendingPayment),
+	}
+	transUUID := gofakeit.UUID()
+
+	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
+	s.paymentClient.On("AuthorizePayment", s.ctx, order.UserUUID, order.UUID, "CARD", model.MinorUnits(order.TotalPrice), model.Currency).Return(transUUID, nil)
+	s.orderRepository.On("TransitionStatus", mock.Anything, order.UUID, model.OrderTransition{
+		From: model.OrderStatusPaymentInProgress, To: model.OrderStatusPaid, Actor: model.OrderActorSystem, Reason: model.ReasonPaymentDone,
+	}, model.OrderUpdateInfo{
+		PaymentMethod:   lo.ToPtr(model.Card),
+		TransactionUUID: lo.ToPtr(transUUID),
+	}).Return(model.OrderDto{}, nil)
+
+	result, err := s.service.PayOrder(s.ctx, order.UUID, "CARD")
+
+	s.NoError(err)
+	s.Equal(transUUID, result)
+}
+
+func (s *ServiceSuite) TestPayOrderResumeKeepsInProgressWhenOutcomeUnknown() {
+	order := model.OrderDto{
+		UUID:           gofakeit.UUID(),
+		UserUUID:       gofakeit.UUID(),
+		Status:         model.OrderStatusPaymentInProgress,
+		PreviousStatus: lo.ToPtr(model.OrderStatusPendingPayment),
+	}
+
+	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
+	s.paymentClient.On("AuthorizePayment", s.ctx, order.UserUUID, order.UUID, "CARD", model.MinorUnits(order.TotalPrice), model.Currency).Return("", model.ErrPaymentUnavailable)
+
+	result, err := s.service.PayOrder(s.ctx, order.UUID, "CARD")
+
+	s.ErrorIs(err, model.ErrPaymentUnavailable)
+	s.Equal("", result)
+	s.orderRepository.AssertNotCalled(s.T(), "TransitionStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestPayOrderLostRace() {
+	order := model.OrderDto{
+		UUID:     gofakeit.UUID(),
+		UserUUID: gofakeit.UUID(),
+		Status:   model.OrderStatusPendingPayment,
+	}
+
+	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, model.OrderTransition{
+		From: model.OrderStatusPendingPayment, To: model.OrderStatusPaymentInProgress, Actor: model.OrderActorUser, Reason: model.ReasonPaymentStarted,
+	}, model.OrderUpdateInfo{}).
+		Return(model.OrderDto{}, model.ErrOrderStatusConflict)
+
+	result, err := s.service.PayOrder(s.ctx, order.UUID, "CARD")
+
+	s.ErrorIs(err, model.ErrPaymentConflict)
+	s.Equal("", result)
+}
+
+func (s *ServiceSuite) TestCanPayOrderInProgress() {
+	order := model.OrderDto{
+		Status: model.OrderStatusPaymentInProgress,
+	}
+
+	err, shouldReturn := canPayOrder(order)
+
+	s.ErrorIs(err, model.ErrPaymentConflict)
+	s.True(shouldReturn)
+}
+
+func (s *ServiceSuite) TestPayOrderRecordsPaymentAfterClientGone() {
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+	order := model.OrderDto{
+		UUID:     gofakeit.UUID(),
+		UserUUID: gofakeit.UUID(),
+		Status:   model.OrderStatusPendingPayment,
+	}
+	transUUID := gofakeit.UUID()
+
+	s.orderRepository.On("GetOrder", ctx, order.UUID).Return(order, nil)
+	s.orderRepository.On("TransitionStatus", ctx, order.UUID, model.OrderTransition{
+		From: model.OrderStatusPendingPayment, To: model.OrderStatusPaymentInProgress, Actor: model.OrderActorUser, Reason: model.ReasonPaymentStarted,
+	}, model.OrderUpdateInfo{}).Return(model.OrderDto{}, nil)
+	// Клиент отключается, пока payment-сервис замораживает деньги
+	s.paymentClient.On("AuthorizePayment", ctx, order.UserUUID, order.UUID, "CARD", model.MinorUnits(order.TotalPrice), model.Currency).
+		Run(func(mock.Arguments) { cancel() }).
+		Return(transUUID, nil)
+	s.orderRepository.On("TransitionStatus", mock.MatchedBy(func(ctx context.Context) bool {
+		return ctx.Err() == nil
+	}), order.UUID, model.OrderTransition{
+		From: model.OrderStatusPaymentInProgress, To: model.OrderStatusPaid, Actor: model.OrderActorSystem, Reason: model.ReasonPaymentDone,
+	}, mock.Anything).Return(model.OrderDto{}, nil)
+
+	result, err := s.service.PayOrder(ctx, order.UUID, "CARD")
+
+	s.NoError(err)
+	s.Equal(transUUID, result)
+}
@@ -0,0 +1,74 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+)
+
+// fulfillmentReasons - причины, с которыми этапы сборки и доставки записываются в историю
+var fulfillmentReasons = map[model.OrderStatus]string{
+	model.OrderStatusAssembling: model.ReasonAssemblyStarted,
+	model.OrderStatusReady:      model.ReasonAssembled,
+	model.OrderStatusShipped:    model.ReasonShipped,
+	model.OrderStatusCompleted:  model.ReasonCompleted,
+}
+
+func (s *service) UpdateOrderStatus(ctx context.Context, orderUUID string, status model.OrderStatus) error {
+	if !status.IsFulfillment() {
+		return model.ErrNotFulfillmentStatus
+	}
+
+	order, err := s.orderRepository.GetOrder(ctx, orderUUID)
+	if err != nil {
+		return err
+	}
+
+	if !order.Status.CanTransitionTo(status) {
+		return model.ErrOrderConflict
+	}
+
+	// Отгруженный заказ уже нельзя отменить со снятием холда, поэтому перед отгрузкой оплата списывается
+	if status == model.OrderStatusShipped && order.PaymentCapturedAt == nil {
+		return s.captureAndShip(ctx, order)
+	}
+
+	_, err = s.transition(ctx, order, status, model.OrderActorSystem, fulfillmentReasons[status], model.OrderUpdateInfo{})
+	if errors.Is(err, model.ErrOrderStatusConflict) {
+		return model.ErrOrderConflict
+	}
+	return err
+}
+
+// captureAndShip списывает холд и отгружает заказ
+func (s *service) captureAndShip(ctx context.Context, order model.OrderDto) error {
+	if order.TransactionUUID == nil {
+		return model.ErrOrderInternalError
+	}
+
+	// Как и оплата, списание сначала занимает заказ, чтобы конкурентная отмена не сняла списываемый холд
+	_, err := s.transition(ctx, order, model.OrderStatusCaptureInProgress, model.OrderActorSystem, model.ReasonCaptureStarted, model.OrderUpdateInfo{})
+	if err != nil {
+		if errors.Is(err, model.ErrOrderStatusConflict) {
+			return model.ErrOrderConflict
+		}
+		return err
+	}
+	inProgress := moved(order, model.OrderStatusCaptureInProgress)
+
+	err = s.paymentClient.CapturePayment(ctx, *order.TransactionUUID, order.UUID)
+	if err != nil {
+		_, rollbackErr := s.transition(context.WithoutCancel(ctx), inProgress, order.Status, model.OrderActorSystem, model.ReasonCaptureFailed, model.OrderUpdateInfo{})
+		return errors.Join(err, rollbackErr)
+	}
+
+	// Деньги уже списаны: результат записываем, даже если клиент не дождался ответа
+	_, err = s.transition(context.WithoutCancel(ctx), inProgress, model.OrderStatusShipped, model.OrderActorSystem, model.ReasonShipped, model.OrderUpdateInfo{
+		PaymentCapturedAt: lo.ToPtr(time.Now()),
+	})
+	return err
+}
@@ -0,0 +1,154 @@
+package order
+
+import (
+	"context"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+)
+
+func (s *ServiceSuite) TestUpdateOrderStatusAssembling() {
+	order := s.authorizedOrder()
+
+	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, model.OrderTransition{
+		From: model.OrderStatusPaid, To: model.OrderStatusAssembling, Actor: model.OrderActorSystem, Reason: model.ReasonAssemblyStarted,
+	}, model.OrderUpdateInfo{}).Return(model.OrderDto{}, nil)
+
+	err := s.service.UpdateOrderStatus(s.ctx, order.UUID, model.OrderStatusAssembling)
+
+	s.NoError(err)
+}
+
+func (s *ServiceSuite) TestUpdateOrderStatusShippedCaptures() {
+	order := s.authorizedOrder()
+	order.Status = model.OrderStatusReady
+
+	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, model.OrderTransition{
+		From: model.OrderStatusReady, To: model.OrderStatusCaptureInProgress, Actor: model.OrderActorSystem, Reason: model.ReasonCaptureStarted,
+	}, model.OrderUpdateInfo{}).Return(model.OrderDto{}, nil)
+	s.paymentClient.On("CapturePayment", s.ctx, *order.TransactionUUID, order.UUID).Return(nil)
+	s.orderRepository.On("TransitionStatus", mock.Anything, order.UUID, model.OrderTransition{
+		From: model.OrderStatusCaptureInProgress, To: model.OrderStatusShipped, Actor: model.OrderActorSystem, Reason: model.ReasonShipped,
+	}, mock.MatchedBy(func(patch model.OrderUpdateInfo) bool {
+		return patch.PaymentCapturedAt != nil
+	})).Return(model.OrderDto{}, nil)
+
+	err := s.service.UpdateOrderStatus(s.ctx, order.UUID, model.OrderStatusShipped)
+
+	s.NoError(err)
+}
+
+func (s *ServiceSuite) TestUpdateOrderStatusShippedWhileCancelling() {
+	order := s.authorizedOrder()
+	order.Status = model.OrderStatusReady
+
+	// Отмена успела занять заказ: холд не списывается
+	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, model.OrderTransition{
+		From: model.OrderStatusReady, To: model.OrderStatusCaptureInProgress, Actor: model.OrderActorSystem, Reason: model.ReasonCaptureStarted,
+	}, model.OrderUpdateInfo{}).Return(model.OrderDto{}, model.ErrOrderStatusConflict)
+
+	err := s.service.UpdateOrderStatus(s.ctx, order.UUID, model.OrderStatusShipped)
+
+	s.ErrorIs(err, model.ErrOrderConflict)
+	s.paymentClient.AssertNotCalled(s.T(), "CapturePayment")
+}
+
+func (s *ServiceSuite) TestUpdateOrderStatusRecordsCaptureAfterClientGone() {
+	ctx, cancel := context.WithCancel(s.ctx)
+	order := s.authorizedOrder()
+	order.Status = model.OrderStatusReady
+
+	s.orderRepository.On("GetOrder", ctx, order.UUID).Return(order, nil)
+	s.orderRepository.On("TransitionStatus", ctx, order.UUID, model.OrderTransition{
+		From: model.OrderStatusReady, To: model.OrderStatusCaptureInProgress, Actor: model.OrderActorSystem, Reason: model.ReasonCaptureStarted,
+	}, model.OrderUpdateInfo{}).Return(model.OrderDto{}, nil)
+	s.paymentClient.On("CapturePayment", ctx, *order.TransactionUUID, order.UUID).
+		Run(func(mock.Arguments) { cancel() }).
+		Return(nil)
+	s.orderRepository.On("TransitionStatus", mock.MatchedBy(func(ctx context.Context) bool {
+		return ctx.Err() == nil
+	}), order.UUID, model.OrderTransition{
+		From: model.OrderStatusCaptureInProgress, To: model.OrderStatusShipped, Actor: model.OrderActorSystem, Reason: model.ReasonShipped,
+	}, mock.Anything).Return(model.OrderDto{}, nil)
+
+	err := s.service.UpdateOrderStatus(ctx, order.UUID, model.OrderStatusShipped)
+
+	s.NoError(err)
+}
+
+func (s *ServiceSuite) TestUpdateOrderStatusShippedAlreadyCaptured() {
+	order := s.paidOrder()
+	order.Status = model.OrderStatusReady
+
+	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, model.OrderTransition{
+		From: model.OrderStatusReady, To: model.OrderStatusShipped, Actor: model.OrderActorSystem, Reason: model.ReasonShipped,
+	}, model.OrderUpdateInfo{}).Return(model.OrderDto{}, nil)
+
+	err := s.service.UpdateOrderStatus(s.ctx, order.UUID, model.OrderStatusShipped)
+
+	s.NoError(err)
+}
+
+func (s *ServiceSuite) TestUpdateOrderStatusCaptureFailed() {
+	order := s.authorizedOrder()
+	order.Status = model.OrderStatusReady
+
+	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, model.OrderTransition{
+		From: model.OrderStatusReady, To: model.OrderStatusCaptureInProgress, Actor: model.OrderActorSystem, Reason: model.ReasonCaptureStarted,
+	}, model.OrderUpdateInfo{}).Return(model.OrderDto{}, nil)
+	s.paymentClient.On("CapturePayment", s.ctx, *order.TransactionUUID, order.UUID).Return(model.ErrCaptureRejected)
+	s.orderRepository.On("TransitionStatus", mock.Anything, order.UUID, model.OrderTransition{
+		From: model.OrderStatusCaptureInProgress, To: model.OrderStatusReady, Actor: model.OrderActorSystem, Reason: model.ReasonCaptureFailed,
+	}, model.OrderUpdateInfo{}).Return(model.OrderDto{}, nil)
+
+	err := s.service.UpdateOrderStatus(s.ctx, order.UUID, model.OrderStatusShipped)
+
+	// Заказ без списанной оплаты не отгружается
+	s.ErrorIs(err, model.ErrCaptureRejected)
+}
+
+func (s *ServiceSuite) TestUpdateOrderStatusNotFulfillment() {
+	err := s.service.UpdateOrderStatus(s.ctx, gofakeit.UUID(), model.OrderStatusCancelled)
+
+	s.ErrorIs(err, model.ErrNotFulfillmentStatus)
+}
+
+func (s *ServiceSuite) TestUpdateOrderStatusInvalidTransition() {
+	order := model.OrderDto{
+		UUID:   gofakeit.UUID(),
+		Status: model.OrderStatusPendingPayment,
+	}
+
+	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
+
+	err := s.service.UpdateOrderStatus(s.ctx, order.UUID, model.OrderStatusShipped)
+
+	s.ErrorIs(err, model.ErrOrderConflict)
+}
+
+func (s *ServiceSuite) TestUpdateOrderStatusLostRace() {
+	order := model.OrderDto{
+		UUID:              gofakeit.UUID(),
+		TransactionUUID:   lo.ToPtr(gofakeit.UUID()),
+		PaymentCapturedAt: lo.ToPtr(time.Now()),
+		Status:            model.OrderStatusShipped,
+	}
+
+	s.orderRepository.On("GetOrder", s.ctx, order.UUID).Return(order, nil)
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, model.OrderTransition{
+		From: model.OrderStatusShipped, To: model.OrderStatusCompleted, Actor: model.OrderActorSystem, Reason: model.ReasonCompleted,
+	}, model.OrderUpdateInfo{}).Return(model.OrderDto{}, model.ErrOrderStatusConflict)
+
+	err := s.service.UpdateOrderStatus(s.ctx, order.UUID, model.OrderStatusCompleted)
+
+	s.ErrorIs(err, model.ErrOrderConflict)
+}
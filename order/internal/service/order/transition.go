@@ -0,0 +1,30 @@
+package order
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+)
+
+// transition переводит заказ в статус to, если это разрешено машиной состояний, и записывает переход в историю
+func (s *service) transition(ctx context.Context, order model.OrderDto, to model.OrderStatus, actor model.OrderActor, reason string, patch model.OrderUpdateInfo) (model.OrderDto, error) {
+	if !order.CanTransitionTo(to) {
+		return model.OrderDto{}, model.ErrInvalidTransition
+	}
+
+	return s.orderRepository.TransitionStatus(ctx, order.UUID, model.OrderTransition{
+		From:   order.Status,
+		To:     to,
+		Actor:  actor,
+		Reason: reason,
+	}, patch)
+}
+
+// moved - заказ после перехода в статус to, без повторного чтения из хранилища
+func moved(order model.OrderDto, to model.OrderStatus) model.OrderDto {
+	order.PreviousStatus = lo.ToPtr(order.Status)
+	order.Status = to
+	return order
+}
@@ -0,0 +1,122 @@
+package order
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/model"
+)
+
+func (s *ServiceSuite) TestTransitionSuccess() {
+	order := model.OrderDto{
+		UUID:   gofakeit.UUID(),
+		Status: model.OrderStatusPaid,
+	}
+	transition := model.OrderTransition{
+		From:   model.OrderStatusPaid,
+		To:     model.OrderStatusAssembling,
+		Actor:  model.OrderActorSystem,
+		Reason: "assembly started",
+	}
+	updated := model.OrderDto{UUID: order.UUID, Status: model.OrderStatusAssembling, Version: 1}
+
+	s.orderRepository.On("TransitionStatus", s.ctx, order.UUID, transition, model.OrderUpdateInfo{}).Return(updated, nil)
+
+	res, err := s.service.transition(s.ctx, order, model.OrderStatusAssembling, model.OrderActorSystem, "assembly started", model.OrderUpdateInfo{})
+
+	s.NoError(err)
+	s.Equal(updated, res)
+}
+
+func (s *ServiceSuite) TestTransitionNotAllowed() {
+	order := model.OrderDto{
+		UUID:   gofakeit.UUID(),
+		Status: model.OrderStatusShipped,
+	}
+
+	_, err := s.service.transition(s.ctx, order, model.OrderStatusRefundInProgress, model.OrderActorUser, "refund", model.OrderUpdateInfo{})
+
+	s.ErrorIs(err, model.ErrInvalidTransition)
+}
+
+func (s *ServiceSuite) TestOrderStateMachine() {
+	allowed := []struct{ from, to model.OrderStatus }{
+		{model.OrderStatusPendingPayment, model.OrderStatusPaymentInProgress},
+		{model.OrderStatusPendingPayment, model.OrderStatusCancelled},
+		{model.OrderStatusPaymentInProgress, model.OrderStatusPaid},
+		{model.OrderStatusPaymentInProgress, model.OrderStatusPendingPayment},
+		{model.OrderStatusPaid, model.OrderStatusAssembling},
+		{model.OrderStatusAssembling, model.OrderStatusReady},
+		{model.OrderStatusReady, model.OrderStatusShipped},
+		{model.OrderStatusShipped, model.OrderStatusCompleted},
+		{model.OrderStatusPaid, model.OrderStatusRefundInProgress},
+		{model.OrderStatusReady, model.OrderStatusRefundInProgress},
+		{model.OrderStatusRefundInProgress, model.OrderStatusRefunded},
+		{model.OrderStatusRefundInProgress, model.OrderStatusAssembling},
+		{model.OrderStatusAssembling, model.OrderStatusVoidInProgress},
+		{model.OrderStatusVoidInProgress, model.OrderStatusCancelled},
+		{model.OrderStatusVoidInProgress, model.OrderStatusReady},
+	}
+	for _, tt := range allowed {
+		s.True(tt.from.CanTransitionTo(tt.to), "%s -> %s", tt.from, tt.to)
+	}
+
+	forbidden := []struct{ from, to model.OrderStatus }{
+		{model.OrderStatusPendingPayment, model.OrderStatusPaid},
+		{model.OrderStatusPaid, model.OrderStatusCancelled},
+		{model.OrderStatusCancelled, model.OrderStatusPendingPayment},
+		{model.OrderStatusCompleted, model.OrderStatusRefunded},
+		{model.OrderStatusRefunded, model.OrderStatusPaid},
+		{model.OrderStatusPaid, model.OrderStatusRefunded},
+		{model.OrderStatusShipped, model.OrderStatusRefundInProgress},
+		{model.OrderStatusShipped, model.OrderStatusVoidInProgress},
+		{model.OrderStatusRefundInProgress, model.OrderStatusCancelled},
+		{model.OrderStatusVoidInProgress, model.OrderStatusRefunded},
+	}
+	for _, tt := range forbidden {
+		s.False(tt.from.CanTransitionTo(tt.to), "%s -> %s", tt.from, tt.to)
+	}
+
+	s.False(model.OrderStatus("UNKNOWN").Valid())
+	s.True(model.OrderStatusReady.Valid())
+}
+
+func (s *ServiceSuite) TestOrderRollbackReturnsToPreviousStatus() {
+	order := model.OrderDto{
+		Status:         model.OrderStatusRefundInProgress,
+		PreviousStatus: lo.ToPtr(model.OrderStatusReady),
+	}
+
+	s.True(order.CanTransitionTo(model.OrderStatusReady))
+	s.True(order.CanTransitionTo(model.OrderStatusRefunded))
+	s.False(order.CanTransitionTo(model.OrderStatusPaid))
+	s.False(order.CanTransitionTo(model.OrderStatusAssembling))
+
+	// Без записанного исходного статуса откатывать некуда
+	order.PreviousStatus = nil
+	s.False(order.CanTransitionTo(model.OrderStatusReady))
+}
+
+func (s *ServiceSuite) TestOrderVoidRollbackReturnsToPreviousStatus() {
+	order := model.OrderDto{
+		Status:         model.OrderStatusVoidInProgress,
+		PreviousStatus: lo.ToPtr(model.OrderStatusAssembling),
+	}
+
+	s.True(order.CanTransitionTo(model.OrderStatusAssembling))
+	s.True(order.CanTransitionTo(model.OrderStatusCancelled))
+	s.False(order.CanTransitionTo(model.OrderStatusPaid))
+	s.False(order.CanTransitionTo(model.OrderStatusReady))
+}
+
+func (s *ServiceSuite) TestTransitionRollbackToOtherStatus() {
+	order := model.OrderDto{
+		UUID:           gofakeit.UUID(),
+		Status:         model.OrderStatusRefundInProgress,
+		PreviousStatus: lo.ToPtr(model.OrderStatusPaid),
+	}
+
+	_, err := s.service.transition(s.ctx, order, model.OrderStatusReady, model.OrderActorSystem, model.ReasonRefundFailed, model.OrderUpdateInfo{})
+
+	s.ErrorIs(err, model.ErrInvalidTransition)
+}
@@ -2,13 +2,21 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/baryshnikkov/rocket-factory/order/internal/model"
 )
 
 type OrderService interface {
-	CreateOrder(ctx context.Context, userUUID string, partsUUIDs []string) (info model.OrderCreationInfo, err error)
+	CreateOrder(ctx context.Context, userUUID string, items []model.CreateOrderItem) (info model.OrderCreationInfo, err error)
 	GetOrder(ctx context.Context, orderUUID string) (order model.OrderDto, err error)
+	ListOrders(ctx context.Context, filter model.OrdersFilter, params model.ListOrdersParams) (model.OrdersList, error)
+	GetOrderHistory(ctx context.Context, orderUUID string) ([]model.OrderStatusTransition, error)
 	CancelOrder(ctx context.Context, orderUUID string) error
+	// PayOrder авторизует оплату заказа: деньги замораживаются и списываются при отгрузке
 	PayOrder(ctx context.Context, orderUUID, paymentMethod string) (transactionUUID string, err error)
+	// UpdateOrderStatus переводит оплаченный заказ по этапам сборки и доставки; при отгрузке списывает оплату
+	UpdateOrderStatus(ctx context.Context, orderUUID string, status model.OrderStatus) error
+	// ExpireOrders отменяет неоплаченные заказы, созданные не позже createdBefore, и возвращает их количество
+	ExpireOrders(ctx context.Context, createdBefore time.Time) (expired int, err error)
 }
@@ -0,0 +1,19 @@
+package expiration
+
+import "time"
+
+// Clock - источник текущего времени; в тестах подменяется, чтобы срок жизни заказа не зависел от часов
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// SystemClock возвращает системное время
+func SystemClock() Clock {
+	return systemClock{}
+}
@@ -0,0 +1,101 @@
+package expiration
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/baryshnikkov/rocket-factory/order/internal/service"
+)
+
+// worker периодически отменяет заказы, не оплаченные за ttl
+type worker struct {
+	orderService service.OrderService
+	clock        Clock
+	ttl          time.Duration
+	interval     time.Duration
+
+	stop   chan struct{}
+	done   chan struct{}
+	cancel context.CancelFunc
+}
+
+func NewWorker(orderService service.OrderService, clock Clock, ttl, interval time.Duration) *worker {
+	return &worker{
+		orderService: orderService,
+		clock:        clock,
+		ttl:          ttl,
+		interval:     interval,
+
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+}
+
+// Start запускает проверку раз в interval в отдельной горутине
+func (w *worker) Start(ctx context.Context) {
+	ctx, w.cancel = context.WithCancel(ctx)
+
+	go w.run(ctx)
+}
+
+// Stop останавливает воркер и ждёт завершения текущей проверки. Если ctx истёк раньше,
+// проверка прерывается отменой её контекста.
+func (w *worker) Stop(ctx context.Context) error {
+	close(w.stop)
+
+	select {
+	case <-w.done:
+		w.cancel()
+		return nil
+	case <-ctx.Done():
+		w.cancel()
+		<-w.done
+		return ctx.Err()
+	}
+}
+
+// RunOnce отменяет заказы, созданные раньше, чем ttl назад по часам воркера
+func (w *worker) RunOnce(ctx context.Context) (int, error) {
+	return w.orderService.ExpireOrders(ctx, w.clock.Now().Add(-w.ttl))
+}
+
+func (w *worker) run(ctx context.Context) {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stop:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// Тик мог совпасть с остановкой: select выбирает случайно
+			if w.stopped(ctx) {
+				return
+			}
+
+			expired, err := w.RunOnce(ctx)
+			if err != nil {
+				log.Printf("failed to expire orders: %v\n", err)
+			}
+			if expired > 0 {
+				log.Printf("⌛ Отменено просроченных заказов: %d\n", expired)
+			}
+		}
+	}
+}
+
+func (w *worker) stopped(ctx context.Context) bool {
+	select {
+	case <-w.stop:
+		return true
+	case <-ctx.Done():
+		return true
+	default:
+		return false
+	}
+}
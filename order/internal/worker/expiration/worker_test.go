@@ -0,0 +1,71 @@
+package expiration
+
+import (
+	"context"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+)
+
+func (s *WorkerSuite) TestRunOnceUsesClock() {
+	w := NewWorker(s.orderService, s.clock, 30*time.Minute, time.Hour)
+
+	s.orderService.On("ExpireOrders", s.ctx, time.Date(2025, 1, 1, 11, 30, 0, 0, time.UTC)).Return(3, nil)
+
+	expired, err := w.RunOnce(s.ctx)
+
+	s.NoError(err)
+	s.Equal(3, expired)
+}
+
+func (s *WorkerSuite) TestRunOnceError() {
+	w := NewWorker(s.orderService, s.clock, time.Minute, time.Hour)
+	expectedErr := gofakeit.Error()
+
+	s.orderService.On("ExpireOrders", s.ctx, s.clock.now.Add(-time.Minute)).Return(0, expectedErr)
+
+	_, err := w.RunOnce(s.ctx)
+
+	s.ErrorIs(err, expectedErr)
+}
+
+func (s *WorkerSuite) TestStartRunsOnTickAndStops() {
+	w := NewWorker(s.orderService, s.clock, time.Minute, time.Millisecond)
+	called := make(chan struct{}, 1)
+
+	s.orderService.On("ExpireOrders", mock.Anything, s.clock.now.Add(-time.Minute)).
+		Run(func(mock.Arguments) {
+			select {
+			case called <- struct{}{}:
+			default:
+			}
+		}).
+		Return(0, nil)
+
+	w.Start(s.ctx)
+	<-called
+
+	s.NoError(w.Stop(s.ctx))
+}
+
+func (s *WorkerSuite) TestStopInterruptsSlowRun() {
+	w := NewWorker(s.orderService, s.clock, time.Minute, time.Millisecond)
+	started := make(chan struct{})
+
+	// Проверка висит, пока её контекст не отменят
+	s.orderService.On("ExpireOrders", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			close(started)
+			<-args.Get(0).(context.Context).Done()
+		}).
+		Return(0, context.Canceled).Once()
+
+	w.Start(s.ctx)
+	<-started
+
+	ctx, cancel := context.WithTimeout(s.ctx, 10*time.Millisecond)
+	defer cancel()
+
+	s.ErrorIs(w.Stop(ctx), context.DeadlineExceeded)
+}
@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS orders (
+    uuid             VARCHAR(36)      PRIMARY KEY,
+    user_uuid        VARCHAR(36)      NOT NULL,
+    total_price      DOUBLE PRECISION NOT NULL,
+    transaction_uuid VARCHAR(36),
+    payment_method   VARCHAR(32),
+    status           VARCHAR(32)      NOT NULL,
+    created_at       TIMESTAMP        NOT NULL,
+    updated_at       TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS order_parts (
+    order_uuid VARCHAR(36) NOT NULL REFERENCES orders (uuid) ON DELETE CASCADE,
+    item_index INTEGER     NOT NULL,
+    part_uuid  VARCHAR(36) NOT NULL,
+    PRIMARY KEY (order_uuid, item_index)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS order_parts;
+DROP TABLE IF EXISTS orders;
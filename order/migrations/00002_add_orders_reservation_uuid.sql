@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE orders ADD COLUMN reservation_uuid VARCHAR(36);
+
+-- +goose Down
+ALTER TABLE orders DROP COLUMN reservation_uuid;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- Позиция заказа хранит количество и снимок названия и цены детали на момент заказа.
+-- У заказов, созданных до миграции, каждая строка order_parts — одна единица детали,
+-- а цена и название не сохранялись.
+ALTER TABLE order_parts RENAME TO order_items;
+ALTER TABLE order_items ADD COLUMN name VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE order_items ADD COLUMN quantity BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE order_items ADD COLUMN unit_price DOUBLE PRECISION NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE order_items DROP COLUMN unit_price;
+ALTER TABLE order_items DROP COLUMN quantity;
+ALTER TABLE order_items DROP COLUMN name;
+ALTER TABLE order_items RENAME TO order_parts;
@@ -0,0 +1,13 @@
+-- +goose Up
+-- Ключи идемпотентности мутирующих запросов. Пока запрос выполняется, status_code и body пусты.
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    idempotency_key VARCHAR(255) PRIMARY KEY,
+    fingerprint     VARCHAR(64)  NOT NULL,
+    status_code     INTEGER,
+    content_type    VARCHAR(255),
+    body            TEXT,
+    created_at      TIMESTAMP    NOT NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS idempotency_keys;
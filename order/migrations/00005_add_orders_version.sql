@@ -0,0 +1,6 @@
+-- +goose Up
+-- Версия заказа увеличивается при каждом изменении и позволяет переводить статус через compare-and-set.
+ALTER TABLE orders ADD COLUMN version BIGINT NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE orders DROP COLUMN version;
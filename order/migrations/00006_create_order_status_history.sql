@@ -0,0 +1,19 @@
+-- +goose Up
+-- История статусов заказа. seq совпадает с версией заказа после перехода; запись о создании имеет seq = 0
+-- и пустой from_status. Для существующих заказов сохраняется только их текущий статус.
+CREATE TABLE IF NOT EXISTS order_status_history (
+    order_uuid  VARCHAR(36)  NOT NULL REFERENCES orders (uuid) ON DELETE CASCADE,
+    seq         BIGINT       NOT NULL,
+    from_status VARCHAR(32),
+    to_status   VARCHAR(32)  NOT NULL,
+    actor       VARCHAR(32)  NOT NULL,
+    reason      VARCHAR(255) NOT NULL,
+    created_at  TIMESTAMP    NOT NULL,
+    PRIMARY KEY (order_uuid, seq)
+);
+
+INSERT INTO order_status_history (order_uuid, seq, from_status, to_status, actor, reason, created_at)
+SELECT uuid, version, NULL, status, 'system', 'history started', COALESCE(updated_at, created_at) FROM orders;
+
+-- +goose Down
+DROP TABLE IF EXISTS order_status_history;
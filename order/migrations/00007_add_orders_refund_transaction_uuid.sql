@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE orders ADD COLUMN refund_transaction_uuid VARCHAR(36);
+
+-- +goose Down
+ALTER TABLE orders DROP COLUMN refund_transaction_uuid;
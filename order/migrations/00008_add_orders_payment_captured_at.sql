@@ -0,0 +1,8 @@
+-- +goose Up
+ALTER TABLE orders ADD COLUMN payment_captured_at TIMESTAMP;
+
+-- Заказы, оплаченные до двухфазных платежей, списаны сразу при оплате
+UPDATE orders SET payment_captured_at = COALESCE(updated_at, created_at) WHERE transaction_uuid IS NOT NULL;
+
+-- +goose Down
+ALTER TABLE orders DROP COLUMN payment_captured_at;
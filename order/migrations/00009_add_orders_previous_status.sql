@@ -0,0 +1,13 @@
+-- +goose Up
+-- Статус до последнего перехода: откат промежуточного статуса возвращает заказ только в него.
+ALTER TABLE orders ADD COLUMN previous_status VARCHAR(32);
+
+UPDATE orders SET previous_status = (
+    SELECT h.from_status FROM order_status_history h
+    WHERE h.order_uuid = orders.uuid
+    ORDER BY h.seq DESC
+    LIMIT 1
+);
+
+-- +goose Down
+ALTER TABLE orders DROP COLUMN previous_status;
@@ -0,0 +1,32 @@
+-- +goose Up
+-- Ключ идемпотентности действует в области пользователя и маршрута, а запрос, занявший ключ,
+-- получает lock_token: ответ сохраняется и ключ освобождается только с ним.
+-- Старые записи не переносятся: у них нет области, и новые запросы их всё равно не найдут.
+DROP TABLE IF EXISTS idempotency_keys;
+
+CREATE TABLE idempotency_keys (
+    user_uuid       VARCHAR(36)  NOT NULL DEFAULT '',
+    route           VARCHAR(255) NOT NULL,
+    idempotency_key VARCHAR(255) NOT NULL,
+    fingerprint     VARCHAR(64)  NOT NULL,
+    lock_token      VARCHAR(36)  NOT NULL,
+    status_code     INTEGER,
+    content_type    VARCHAR(255),
+    body            TEXT,
+    created_at      TIMESTAMP    NOT NULL,
+    PRIMARY KEY (user_uuid, route, idempotency_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_created_at ON idempotency_keys (created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS idempotency_keys;
+
+CREATE TABLE idempotency_keys (
+    idempotency_key VARCHAR(255) PRIMARY KEY,
+    fingerprint     VARCHAR(64)  NOT NULL,
+    status_code     INTEGER,
+    content_type    VARCHAR(255),
+    body            TEXT,
+    created_at      TIMESTAMP    NOT NULL
+);
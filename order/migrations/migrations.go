@@ -0,0 +1,8 @@
+package migrations
+
+import "embed"
+
+// FS содержит версионированные SQL-миграции (формат goose) схемы заказов.
+//
+//go:embed *.sql
+var FS embed.FS
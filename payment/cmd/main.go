@@ -1,25 +1,102 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
 	paymentV1API "github.com/baryshnikkov/rocket-factory/payment/internal/api/payment/v1"
 	"github.com/baryshnikkov/rocket-factory/payment/internal/interceptor"
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	"github.com/baryshnikkov/rocket-factory/payment/internal/provider"
+	"github.com/baryshnikkov/rocket-factory/payment/internal/provider/investor"
+	"github.com/baryshnikkov/rocket-factory/payment/internal/provider/router"
+	"github.com/baryshnikkov/rocket-factory/payment/internal/provider/simulator"
+	"github.com/baryshnikkov/rocket-factory/payment/internal/repository"
+	transactionDatabaseRepository "github.com/baryshnikkov/rocket-factory/payment/internal/repository/database"
+	transactionRepository "github.com/baryshnikkov/rocket-factory/payment/internal/repository/transaction"
 	paymentService "github.com/baryshnikkov/rocket-factory/payment/internal/service/payment"
+	"github.com/baryshnikkov/rocket-factory/payment/internal/worker/expiration"
+	"github.com/baryshnikkov/rocket-factory/payment/migrations"
+	sharedDatabase "github.com/baryshnikkov/rocket-factory/shared/pkg/database"
 	paymentV1Proto "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/payment/v1"
 )
 
-const grpcPort = 50052
+const (
+	grpcPort = 50052
+
+	// Хранилище журнала оплат: memory (по умолчанию), postgres или sqlite
+	storageEnv = "PAYMENT_STORAGE"
+	// Строка подключения к базе для postgres/sqlite
+	databaseDSNEnv = "PAYMENT_DATABASE_DSN"
+
+	// Сценарии симулятора шлюзов по способам оплаты, например CARD=decline,SBP=timeout
+	simulatorScenariosEnv = "PAYMENT_SIMULATOR_SCENARIOS"
+	// Задержка ответа симулятора для timeout и delayed_success
+	simulatorDelayEnv = "PAYMENT_SIMULATOR_DELAY"
+
+	defaultSimulatorDelay = 2 * time.Second
+
+	// Срок холда AuthorizePayment
+	authorizationTTLEnv     = "PAYMENT_AUTHORIZATION_TTL"
+	defaultAuthorizationTTL = 7 * 24 * time.Hour
+
+	// Срок, после которого операция без ответа провайдера отменяется, например 10m
+	pendingTTLEnv     = "PAYMENT_PENDING_TTL"
+	defaultPendingTTL = 10 * time.Minute
+	// Как часто искать зависшие транзакции
+	expiryIntervalEnv     = "PAYMENT_EXPIRY_INTERVAL"
+	defaultExpiryInterval = time.Minute
+
+	shutdownTimeout = 10 * time.Second
+)
 
 func main() {
+	repository, closeRepository, err := newRepository(context.Background())
+	if err != nil {
+		log.Printf("failed to init transaction repository: %v\n", err)
+		return
+	}
+	defer func() {
+		if err := closeRepository(); err != nil {
+			log.Printf("failed to close transaction repository: %v\n", err)
+		}
+	}()
+
+	paymentProvider, err := newProvider()
+	if err != nil {
+		log.Printf("failed to init payment provider: %v\n", err)
+		return
+	}
+
+	authorizationTTL := defaultAuthorizationTTL
+	if value := os.Getenv(authorizationTTLEnv); value != "" {
+		authorizationTTL, err = time.ParseDuration(value)
+		if err != nil || authorizationTTL <= 0 {
+			log.Printf("invalid %s: %q\n", authorizationTTLEnv, value)
+			return
+		}
+	}
+
+	pendingTTL, err := durationFromEnv(pendingTTLEnv, defaultPendingTTL)
+	if err != nil {
+		log.Printf("invalid %s: %v\n", pendingTTLEnv, err)
+		return
+	}
+	expiryInterval, err := durationFromEnv(expiryIntervalEnv, defaultExpiryInterval)
+	if err != nil {
+		log.Printf("invalid %s: %v\n", expiryIntervalEnv, err)
+		return
+	}
+
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", grpcPort))
 	if err != nil {
 		log.Printf("failed to listen: %v", err)
@@ -38,11 +115,15 @@ func main() {
 		),
 	)
 
-	service := paymentService.NewService()
+	service := paymentService.NewService(repository, paymentProvider, authorizationTTL)
 	api := paymentV1API.NewAPI(service)
 
 	paymentV1Proto.RegisterPaymentServiceServer(grpcServer, api)
 
+	// Воркер закрывает операции, оставшиеся без ответа провайдера дольше pendingTTL
+	expirationWorker := expiration.NewWorker(service, expiration.SystemClock(), pendingTTL, expiryInterval)
+	expirationWorker.Start(context.Background())
+
 	reflection.Register(grpcServer)
 
 	go func() {
@@ -58,5 +139,83 @@ func main() {
 	<-quit
 	log.Println("🛑Shutting down payment gRPC server...")
 	grpcServer.GracefulStop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := expirationWorker.Stop(ctx); err != nil {
+		log.Printf("❌ Ошибка при остановке воркера зависших транзакций: %v\n", err)
+	}
 	log.Println("✅ Server stopped")
 }
+
+// newRepository выбирает реализацию TransactionRepository по переменной окружения PAYMENT_STORAGE.
+func newRepository(ctx context.Context) (repository.TransactionRepository, func() error, error) {
+	db, err := sharedDatabase.OpenFromEnv(ctx, storageEnv, databaseDSNEnv, migrations.FS)
+	if err != nil {
+		return nil, nil, err
+	}
+	if db == nil {
+		return transactionRepository.NewRepository(), func() error { return nil }, nil
+	}
+
+	log.Printf("📦 Хранилище журнала оплат: %s\n", os.Getenv(storageEnv))
+
+	return transactionDatabaseRepository.NewRepository(db), db.Close, nil
+}
+
+// newProvider собирает провайдеров способов оплаты. Карты, СБП и кредитные карты обслуживает
+// симулятор шлюза со сценариями из PAYMENT_SIMULATOR_SCENARIOS, деньги инвестора списываются
+// без внешнего шлюза.
+func newProvider() (provider.Provider, error) {
+	scenarios, err := simulator.ParseScenarios(os.Getenv(simulatorScenariosEnv))
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := scenarios[model.InvestorMoney]; ok {
+		return nil, fmt.Errorf("%s: %s has no simulated gateway", simulatorScenariosEnv, model.InvestorMoney)
+	}
+
+	delay := defaultSimulatorDelay
+	if value := os.Getenv(simulatorDelayEnv); value != "" {
+		delay, err = time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", simulatorDelayEnv, err)
+		}
+	}
+
+	gateway := func(method model.PaymentMethod) provider.Provider {
+		scenario, ok := scenarios[method]
+		if !ok {
+			scenario = simulator.ScenarioSuccess
+		}
+		log.Printf("🧪 Симулятор шлюза %s: %s\n", method, scenario)
+
+		return simulator.NewSimulator(simulator.Config{Scenario: scenario, Delay: delay})
+	}
+
+	return router.NewRouter(map[model.PaymentMethod]provider.Provider{
+		model.Card:          gateway(model.Card),
+		model.SBP:           gateway(model.SBP),
+		model.CreditCard:    gateway(model.CreditCard),
+		model.InvestorMoney: investor.NewProvider(),
+	}), nil
+}
+
+// durationFromEnv читает длительность из переменной окружения; пустое значение означает def
+func durationFromEnv(env string, def time.Duration) (time.Duration, error) {
+	value := os.Getenv(env)
+	if value == "" {
+		return def, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("must be positive, got %s", value)
+	}
+
+	return d, nil
+}
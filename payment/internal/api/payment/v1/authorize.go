@@ -0,0 +1,17 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/converter"
+	paymentV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/payment/v1"
+)
+
+func (a *api) AuthorizePayment(ctx context.Context, req *paymentV1.AuthorizePaymentRequest) (*paymentV1.AuthorizePaymentResponse, error) {
+	res, err := a.paymentService.AuthorizePayment(ctx, converter.AuthorizePaymentRequestToModel(req))
+	if err != nil {
+		return nil, paymentError(err)
+	}
+
+	return converter.AuthorizePaymentResponseToProto(res), nil
+}
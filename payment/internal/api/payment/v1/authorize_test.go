@@ -0,0 +1,70 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	paymentV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/payment/v1"
+)
+
+func (s *APISuite) TestAuthorizePaymentSuccess() {
+	var (
+		req = &paymentV1.AuthorizePaymentRequest{
+			OrderUuid:     gofakeit.UUID(),
+			UserUuid:      gofakeit.UUID(),
+			PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_CARD,
+			Amount:        150_050,
+			Currency:      "RUB",
+		}
+
+		resModel = model.AuthorizePaymentResponse{
+			TransactionUUID: gofakeit.UUID(),
+			Amount:          150_050,
+			Currency:        model.RUB,
+			ExpiresAt:       time.Now().Add(time.Hour).UTC(),
+		}
+	)
+
+	s.paymentService.On("AuthorizePayment", s.ctx, model.AuthorizePaymentRequest{
+		OrderUUID:     req.OrderUuid,
+		UserUUID:      req.UserUuid,
+		PaymentMethod: model.Card,
+		Amount:        150_050,
+		Currency:      model.RUB,
+	}).Return(resModel, nil)
+
+	res, err := s.api.AuthorizePayment(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().Equal(resModel.TransactionUUID, res.GetTransactionUuid())
+	s.Require().Equal(resModel.Amount, res.GetAmount())
+	s.Require().True(resModel.ExpiresAt.Equal(res.GetExpiresAt().AsTime()))
+}
+
+func (s *APISuite) TestAuthorizePaymentErrors() {
+	cases := map[error]codes.Code{
+		model.ErrAmountOutOfLimits:    codes.InvalidArgument,
+		model.ErrIdempotencyConflict:  codes.AlreadyExists,
+		model.ErrPaymentDeclined:      codes.FailedPrecondition,
+		model.ErrProviderTimeout:      codes.Unavailable,
+		model.ErrPaymentInternalError: codes.Internal,
+	}
+
+	for serviceErr, code := range cases {
+		req := &paymentV1.AuthorizePaymentRequest{OrderUuid: gofakeit.UUID()}
+
+		s.paymentService.On("AuthorizePayment", s.ctx, mock.MatchedBy(func(reqModel model.AuthorizePaymentRequest) bool {
+			return reqModel.OrderUUID == req.OrderUuid
+		})).Return(model.AuthorizePaymentResponse{}, serviceErr).Once()
+
+		res, err := s.api.AuthorizePayment(s.ctx, req)
+
+		s.Require().Equal(code, status.Code(err), serviceErr.Error())
+		s.Require().Nil(res)
+	}
+}
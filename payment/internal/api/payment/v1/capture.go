@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/converter"
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	paymentV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/payment/v1"
+)
+
+func (a *api) CapturePayment(ctx context.Context, req *paymentV1.CapturePaymentRequest) (*paymentV1.CapturePaymentResponse, error) {
+	res, err := a.paymentService.CapturePayment(ctx, converter.CapturePaymentRequestToModel(req))
+	if err != nil {
+		return nil, authorizationError(err, req.GetTransactionUuid())
+	}
+
+	return converter.CapturePaymentResponseToProto(res), nil
+}
+
+// authorizationError переводит ошибки списания и снятия холда в статусы gRPC
+func authorizationError(err error, transactionUUID string) error {
+	switch {
+	case errors.Is(err, model.ErrInvalidTransaction):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, model.ErrTransactionNotFound):
+		return status.Errorf(codes.NotFound, "transaction %s not found", transactionUUID)
+	case errors.Is(err, model.ErrTransactionNotAuthorized), errors.Is(err, model.ErrAuthorizationExpired):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	case errors.Is(err, model.ErrProviderTimeout):
+		return status.Errorf(codes.Unavailable, "%v", err)
+	case errors.Is(err, model.ErrPaymentInternalError):
+		return status.Errorf(codes.Internal, "Payment service error: %v", err)
+	case errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled):
+		return status.Errorf(codes.Unavailable, "Payment service timeout")
+	default:
+		return err
+	}
+}
@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	paymentV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/payment/v1"
+)
+
+func (s *APISuite) TestCapturePaymentSuccess() {
+	req := &paymentV1.CapturePaymentRequest{
+		TransactionUuid: gofakeit.UUID(),
+		OrderUuid:       gofakeit.UUID(),
+	}
+
+	s.paymentService.On("CapturePayment", s.ctx, model.CapturePaymentRequest{
+		TransactionUUID: req.TransactionUuid,
+		OrderUUID:       req.OrderUuid,
+	}).Return(model.CapturePaymentResponse{TransactionUUID: req.TransactionUuid}, nil)
+
+	res, err := s.api.CapturePayment(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().Equal(req.TransactionUuid, res.GetTransactionUuid())
+}
+
+func (s *APISuite) TestCapturePaymentErrors() {
+	cases := map[error]codes.Code{
+		model.ErrInvalidTransaction:       codes.InvalidArgument,
+		model.ErrTransactionNotFound:      codes.NotFound,
+		model.ErrTransactionNotAuthorized: codes.FailedPrecondition,
+		model.ErrAuthorizationExpired:     codes.FailedPrecondition,
+		model.ErrProviderTimeout:          codes.Unavailable,
+		model.ErrPaymentInternalError:     codes.Internal,
+	}
+
+	for serviceErr, code := range cases {
+		req := &paymentV1.CapturePaymentRequest{TransactionUuid: gofakeit.UUID()}
+
+		s.paymentService.On("CapturePayment", s.ctx, model.CapturePaymentRequest{TransactionUUID: req.TransactionUuid}).
+			Return(model.CapturePaymentResponse{}, serviceErr).Once()
+
+		res, err := s.api.CapturePayment(s.ctx, req)
+
+		s.Require().Equal(code, status.Code(err), serviceErr.Error())
+		s.Require().Nil(res)
+	}
+}
@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/converter"
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	paymentV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/payment/v1"
+)
+
+func (a *api) GetTransaction(ctx context.Context, req *paymentV1.GetTransactionRequest) (*paymentV1.GetTransactionResponse, error) {
+	transaction, err := a.paymentService.GetTransaction(ctx, req.GetTransactionUuid())
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidTransaction):
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		case errors.Is(err, model.ErrTransactionNotFound):
+			return nil, status.Errorf(codes.NotFound, "transaction %s not found", req.GetTransactionUuid())
+		default:
+			return nil, status.Errorf(codes.Internal, "Payment service error: %v", err)
+		}
+	}
+
+	return &paymentV1.GetTransactionResponse{
+		Transaction: converter.TransactionToProto(transaction),
+	}, nil
+}
@@ -0,0 +1,63 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	paymentV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/payment/v1"
+)
+
+func (s *APISuite) TestGetTransactionSuccess() {
+	updatedAt := time.Now().UTC()
+	transaction := model.Transaction{
+		UUID:           gofakeit.UUID(),
+		OrderUUID:      gofakeit.UUID(),
+		UserUUID:       gofakeit.UUID(),
+		PaymentMethod:  model.CreditCard,
+		Amount:         500,
+		RefundedAmount: 200,
+		Status:         model.TransactionStatusPartiallyRefunded,
+		CreatedAt:      updatedAt.Add(-time.Hour),
+		UpdatedAt:      &updatedAt,
+	}
+
+	s.paymentService.On("GetTransaction", s.ctx, transaction.UUID).Return(transaction, nil)
+
+	res, err := s.api.GetTransaction(s.ctx, &paymentV1.GetTransactionRequest{TransactionUuid: transaction.UUID})
+
+	s.Require().NoError(err)
+	got := res.GetTransaction()
+	s.Require().Equal(transaction.UUID, got.GetTransactionUuid())
+	s.Require().Equal(transaction.OrderUUID, got.GetOrderUuid())
+	s.Require().Equal(transaction.UserUUID, got.GetUserUuid())
+	s.Require().Equal(paymentV1.PaymentMethod_PAYMENT_METHOD_CREDIT_CARD, got.GetPaymentMethod())
+	s.Require().Equal(transaction.Amount, got.GetAmount())
+	s.Require().Equal(transaction.RefundedAmount, got.GetRefundedAmount())
+	s.Require().Equal(paymentV1.TransactionStatus_TRANSACTION_STATUS_PARTIALLY_REFUNDED, got.GetStatus())
+	s.Require().True(transaction.CreatedAt.Equal(got.GetCreatedAt().AsTime()))
+	s.Require().True(updatedAt.Equal(got.GetUpdatedAt().AsTime()))
+}
+
+func (s *APISuite) TestGetTransactionNotFound() {
+	transactionUUID := gofakeit.UUID()
+
+	s.paymentService.On("GetTransaction", s.ctx, transactionUUID).Return(model.Transaction{}, model.ErrTransactionNotFound)
+
+	res, err := s.api.GetTransaction(s.ctx, &paymentV1.GetTransactionRequest{TransactionUuid: transactionUUID})
+
+	s.Require().Equal(codes.NotFound, status.Code(err))
+	s.Require().Nil(res)
+}
+
+func (s *APISuite) TestGetTransactionInvalidUUID() {
+	s.paymentService.On("GetTransaction", s.ctx, "bad").Return(model.Transaction{}, model.ErrInvalidTransaction)
+
+	res, err := s.api.GetTransaction(s.ctx, &paymentV1.GetTransactionRequest{TransactionUuid: "bad"})
+
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	s.Require().Nil(res)
+}
@@ -0,0 +1,36 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/converter"
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	paymentV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/payment/v1"
+)
+
+func (a *api) ListTransactions(ctx context.Context, req *paymentV1.ListTransactionsRequest) (*paymentV1.ListTransactionsResponse, error) {
+	if req.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	list, err := a.paymentService.ListTransactions(ctx,
+		converter.TransactionsFilterToModel(req.GetFilter()),
+		converter.ListTransactionsParamsToModel(req),
+	)
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidPageToken) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "Payment service error: %v", err)
+	}
+
+	return &paymentV1.ListTransactionsResponse{
+		Transactions:  converter.TransactionsToProto(list.Transactions),
+		NextPageToken: list.NextPageToken,
+		TotalSize:     list.TotalSize,
+	}, nil
+}
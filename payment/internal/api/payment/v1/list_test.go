@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	paymentV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/payment/v1"
+)
+
+func (s *APISuite) TestListTransactionsSuccess() {
+	var (
+		userUUID = gofakeit.UUID()
+		from     = time.Now().Add(-time.Hour).UTC()
+		to       = time.Now().UTC()
+
+		req = &paymentV1.ListTransactionsRequest{
+			Filter: &paymentV1.TransactionsFilter{
+				UserUuid:    userUUID,
+				Statuses:    []paymentV1.TransactionStatus{paymentV1.TransactionStatus_TRANSACTION_STATUS_REFUNDED},
+				CreatedFrom: timestamppb.New(from),
+				CreatedTo:   timestamppb.New(to),
+			},
+			PageSize:  10,
+			PageToken: "token",
+		}
+
+		filter = model.TransactionsFilter{
+			UserUUID:    userUUID,
+			Statuses:    []model.TransactionStatus{model.TransactionStatusRefunded},
+			CreatedFrom: &from,
+			CreatedTo:   &to,
+		}
+
+		transaction = model.Transaction{UUID: gofakeit.UUID(), UserUUID: userUUID, Status: model.TransactionStatusRefunded}
+	)
+
+	s.paymentService.On("ListTransactions", s.ctx, filter, model.ListTransactionsParams{PageSize: 10, PageToken: "token"}).
+		Return(model.TransactionsList{Transactions: []model.Transaction{transaction}, NextPageToken: "next", TotalSize: 11}, nil)
+
+	res, err := s.api.ListTransactions(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().Len(res.GetTransactions(), 1)
+	s.Require().Equal(transaction.UUID, res.GetTransactions()[0].GetTransactionUuid())
+	s.Require().Equal("next", res.GetNextPageToken())
+	s.Require().Equal(int32(11), res.GetTotalSize())
+}
+
+func (s *APISuite) TestListTransactionsInvalidPageToken() {
+	s.paymentService.On("ListTransactions", s.ctx, model.TransactionsFilter{}, model.ListTransactionsParams{PageToken: "bad"}).
+		Return(model.TransactionsList{}, model.ErrInvalidPageToken)
+
+	res, err := s.api.ListTransactions(s.ctx, &paymentV1.ListTransactionsRequest{PageToken: "bad"})
+
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	s.Require().Nil(res)
+}
+
+func (s *APISuite) TestListTransactionsNegativePageSize() {
+	res, err := s.api.ListTransactions(s.ctx, &paymentV1.ListTransactionsRequest{PageSize: -1})
+
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	s.Require().Nil(res)
+}
@@ -17,16 +17,37 @@ func (a *api) PayOrder(ctx context.Context, req *paymentV1.PayOrderRequest) (*pa
 
 	resModel, err := a.paymentService.PayOrder(ctx, reqModel)
 	if err != nil {
-		if errors.Is(err, model.ErrPaymentInternalError) {
-			return nil, status.Errorf(codes.Internal, "Payment service error: %v", err)
-		}
-		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
-			return nil, status.Errorf(codes.Unavailable, "Paymnent service timeout")
-		}
-		return nil, err
+		return nil, paymentError(err)
 	}
 
 	reqProto := converter.PayOrderResponseToProto(resModel)
 
 	return reqProto, nil
 }
+
+// paymentError переводит ошибки оплаты и холдирования в статусы gRPC
+func paymentError(err error) error {
+	if errors.Is(err, model.ErrUnsupportedCurrency) || errors.Is(err, model.ErrUnsupportedPaymentMethod) ||
+		errors.Is(err, model.ErrAmountOutOfLimits) {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if errors.Is(err, model.ErrIdempotencyConflict) {
+		return status.Errorf(codes.AlreadyExists, "%v", err)
+	}
+	if errors.Is(err, model.ErrPaymentInProgress) {
+		return status.Errorf(codes.Aborted, "%v", err)
+	}
+	if errors.Is(err, model.ErrPaymentDeclined) || errors.Is(err, model.ErrInsufficientFunds) {
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	if errors.Is(err, model.ErrProviderTimeout) {
+		return status.Errorf(codes.Unavailable, "%v", err)
+	}
+	if errors.Is(err, model.ErrPaymentInternalError) {
+		return status.Errorf(codes.Internal, "Payment service error: %v", err)
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return status.Errorf(codes.Unavailable, "Paymnent service timeout")
+	}
+	return err
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -22,20 +23,28 @@ func (s *APISuite) TestPayOrderSuccess() {
 			OrderUuid:     orderUUID,
 			UserUuid:      userUUID,
 			PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_CARD,
+			Amount:        150_050,
+			Currency:      "RUB",
 		}
 
 		reqModel = model.PayOrderRequest{
 			OrderUUID:     orderUUID,
 			UserUUID:      userUUID,
 			PaymentMethod: model.Card,
+			Amount:        150_050,
+			Currency:      model.RUB,
 		}
 
 		resModel = model.PayOrderResponse{
 			TransactionUUID: transactionUUID,
+			Amount:          150_050,
+			Currency:        model.RUB,
 		}
 
 		expectedResponse = &paymentV1.PayOrderResponse{
 			TransactionUuid: transactionUUID,
+			Amount:          150_050,
+			Currency:        "RUB",
 		}
 	)
 
@@ -46,6 +55,25 @@ func (s *APISuite) TestPayOrderSuccess() {
 	s.Require().NoError(err)
 	s.Require().NotNil(res)
 	s.Require().Equal(expectedResponse.TransactionUuid, res.TransactionUuid)
+	s.Require().Equal(expectedResponse.Amount, res.Amount)
+	s.Require().Equal(expectedResponse.Currency, res.Currency)
+}
+
+func (s *APISuite) TestPayOrderAmountOutOfLimits() {
+	req := &paymentV1.PayOrderRequest{
+		OrderUuid:     gofakeit.UUID(),
+		UserUuid:      gofakeit.UUID(),
+		PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_CREDIT_CARD,
+		Amount:        100_000_000,
+		Currency:      "RUB",
+	}
+
+	s.paymentService.On("PayOrder", s.ctx, mock.Anything).Return(model.PayOrderResponse{}, model.ErrAmountOutOfLimits)
+
+	res, err := s.api.PayOrder(s.ctx, req)
+
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	s.Require().Nil(res)
 }
 
 func (s *APISuite) TestPayOrderPaymentInternalError() {
@@ -166,3 +194,51 @@ func (s *APISuite) TestPayOrderUnknownError() {
 	s.Require().Equal(expectedErr, err)
 	s.Require().Nil(res)
 }
+
+func (s *APISuite) TestPayOrderIdempotencyConflict() {
+	req := &paymentV1.PayOrderRequest{
+		OrderUuid:      gofakeit.UUID(),
+		UserUuid:       gofakeit.UUID(),
+		PaymentMethod:  paymentV1.PaymentMethod_PAYMENT_METHOD_CARD,
+		Amount:         10_000,
+		Currency:       "RUB",
+		IdempotencyKey: gofakeit.UUID(),
+	}
+
+	s.paymentService.On("PayOrder", s.ctx, mock.MatchedBy(func(reqModel model.PayOrderRequest) bool {
+		return reqModel.IdempotencyKey == req.IdempotencyKey
+	})).Return(model.PayOrderResponse{}, model.ErrIdempotencyConflict)
+
+	res, err := s.api.PayOrder(s.ctx, req)
+
+	s.Require().Equal(codes.AlreadyExists, status.Code(err))
+	s.Require().Nil(res)
+}
+
+func (s *APISuite) TestPayOrderProviderErrors() {
+	cases := map[error]codes.Code{
+		model.ErrPaymentDeclined:   codes.FailedPrecondition,
+		model.ErrInsufficientFunds: codes.FailedPrecondition,
+		model.ErrProviderTimeout:   codes.Unavailable,
+		model.ErrPaymentInProgress: codes.Aborted,
+	}
+
+	for serviceErr, code := range cases {
+		req := &paymentV1.PayOrderRequest{
+			OrderUuid:     gofakeit.UUID(),
+			UserUuid:      gofakeit.UUID(),
+			PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_SBP,
+			Amount:        10_000,
+			Currency:      "RUB",
+		}
+
+		s.paymentService.On("PayOrder", s.ctx, mock.MatchedBy(func(reqModel model.PayOrderRequest) bool {
+			return reqModel.OrderUUID == req.OrderUuid
+		})).Return(model.PayOrderResponse{}, serviceErr).Once()
+
+		res, err := s.api.PayOrder(s.ctx, req)
+
+		s.Require().Equal(code, status.Code(err), serviceErr.Error())
+		s.Require().Nil(res)
+	}
+}
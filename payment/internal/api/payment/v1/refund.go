@@ -0,0 +1,40 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/converter"
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	paymentV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/payment/v1"
+)
+
+func (a *api) RefundPayment(ctx context.Context, req *paymentV1.RefundPaymentRequest) (*paymentV1.RefundPaymentResponse, error) {
+	resModel, err := a.paymentService.RefundPayment(ctx, converter.RefundPaymentRequestToModel(req))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidTransaction), errors.Is(err, model.ErrInvalidRefundAmount),
+			errors.Is(err, model.ErrUnsupportedCurrency), errors.Is(err, model.ErrRefundCurrencyMismatch):
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		case errors.Is(err, model.ErrTransactionNotFound):
+			return nil, status.Errorf(codes.NotFound, "transaction %s not found", req.GetTransactionUuid())
+		case errors.Is(err, model.ErrTransactionAlreadyRefunded), errors.Is(err, model.ErrRefundExceedsAmount),
+			errors.Is(err, model.ErrTransactionNotPaid),
+			errors.Is(err, model.ErrPaymentDeclined), errors.Is(err, model.ErrInsufficientFunds):
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		case errors.Is(err, model.ErrProviderTimeout):
+			return nil, status.Errorf(codes.Unavailable, "%v", err)
+		case errors.Is(err, model.ErrPaymentInternalError):
+			return nil, status.Errorf(codes.Internal, "Payment service error: %v", err)
+		case errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled):
+			return nil, status.Errorf(codes.Unavailable, "Payment service timeout")
+		default:
+			return nil, err
+		}
+	}
+
+	return converter.RefundPaymentResponseToProto(resModel), nil
+}
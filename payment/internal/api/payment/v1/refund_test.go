@@ -0,0 +1,147 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	paymentV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/payment/v1"
+)
+
+func (s *APISuite) refundRequest() (*paymentV1.RefundPaymentRequest, model.RefundPaymentRequest) {
+	req := &paymentV1.RefundPaymentRequest{
+		TransactionUuid: gofakeit.UUID(),
+		OrderUuid:       gofakeit.UUID(),
+		Amount:          9990,
+		Currency:        "RUB",
+		Reason:          "damaged part",
+	}
+
+	return req, model.RefundPaymentRequest{
+		TransactionUUID: req.TransactionUuid,
+		OrderUUID:       req.OrderUuid,
+		Amount:          req.Amount,
+		Currency:        model.RUB,
+		Reason:          req.Reason,
+	}
+}
+
+func (s *APISuite) TestRefundPaymentSuccess() {
+	req, reqModel := s.refundRequest()
+	refundUUID := gofakeit.UUID()
+
+	s.paymentService.On("RefundPayment", s.ctx, reqModel).Return(model.RefundPaymentResponse{RefundTransactionUUID: refundUUID}, nil)
+
+	res, err := s.api.RefundPayment(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().Equal(refundUUID, res.GetRefundTransactionUuid())
+}
+
+func (s *APISuite) TestRefundPaymentInvalidArgument() {
+	req, reqModel := s.refundRequest()
+
+	s.paymentService.On("RefundPayment", s.ctx, reqModel).Return(model.RefundPaymentResponse{}, model.ErrInvalidRefundAmount)
+
+	res, err := s.api.RefundPayment(s.ctx, req)
+
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	s.Require().Nil(res)
+}
+
+func (s *APISuite) TestRefundPaymentCurrencyMismatch() {
+	req, reqModel := s.refundRequest()
+
+	s.paymentService.On("RefundPayment", s.ctx, reqModel).Return(model.RefundPaymentResponse{}, model.ErrRefundCurrencyMismatch)
+
+	res, err := s.api.RefundPayment(s.ctx, req)
+
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	s.Require().Nil(res)
+}
+
+func (s *APISuite) TestRefundPaymentInternalError() {
+	req, reqModel := s.refundRequest()
+
+	s.paymentService.On("RefundPayment", s.ctx, reqModel).Return(model.RefundPaymentResponse{}, model.ErrPaymentInternalError)
+
+	res, err := s.api.RefundPayment(s.ctx, req)
+
+	s.Require().Equal(codes.Internal, status.Code(err))
+	s.Require().Nil(res)
+}
+
+func (s *APISuite) TestRefundPaymentTimeout() {
+	req, reqModel := s.refundRequest()
+
+	s.paymentService.On("RefundPayment", s.ctx, reqModel).Return(model.RefundPaymentResponse{}, context.DeadlineExceeded)
+
+	res, err := s.api.RefundPayment(s.ctx, req)
+
+	s.Require().Equal(codes.Unavailable, status.Code(err))
+	s.Require().Nil(res)
+}
+
+func (s *APISuite) TestRefundPaymentUnknownError() {
+	req, reqModel := s.refundRequest()
+	expectedErr := errors.New("unknown error")
+
+	s.paymentService.On("RefundPayment", s.ctx, reqModel).Return(model.RefundPaymentResponse{}, expectedErr)
+
+	res, err := s.api.RefundPayment(s.ctx, req)
+
+	s.Require().Equal(expectedErr, err)
+	s.Require().Nil(res)
+}
+
+func (s *APISuite) TestRefundPaymentTransactionNotFound() {
+	req, reqModel := s.refundRequest()
+
+	s.paymentService.On("RefundPayment", s.ctx, reqModel).Return(model.RefundPaymentResponse{}, model.ErrTransactionNotFound)
+
+	res, err := s.api.RefundPayment(s.ctx, req)
+
+	s.Require().Equal(codes.NotFound, status.Code(err))
+	s.Require().Nil(res)
+}
+
+func (s *APISuite) TestRefundPaymentExceedsAmount() {
+	req, reqModel := s.refundRequest()
+
+	s.paymentService.On("RefundPayment", s.ctx, reqModel).Return(model.RefundPaymentResponse{}, model.ErrRefundExceedsAmount)
+
+	res, err := s.api.RefundPayment(s.ctx, req)
+
+	s.Require().Equal(codes.FailedPrecondition, status.Code(err))
+	s.Require().Nil(res)
+}
+
+func (s *APISuite) TestRefundPaymentNotPaid() {
+	req, reqModel := s.refundRequest()
+
+	s.paymentService.On("RefundPayment", s.ctx, reqModel).Return(model.RefundPaymentResponse{}, model.ErrTransactionNotPaid)
+
+	res, err := s.api.RefundPayment(s.ctx, req)
+
+	s.Require().Equal(codes.FailedPrecondition, status.Code(err))
+	s.Require().Nil(res)
+}
+
+func (s *APISuite) TestRefundPaymentProviderOutcome() {
+	for err, code := range map[error]codes.Code{
+		model.ErrPaymentDeclined: codes.FailedPrecondition,
+		model.ErrProviderTimeout: codes.Unavailable,
+	} {
+		req, reqModel := s.refundRequest()
+
+		s.paymentService.On("RefundPayment", s.ctx, reqModel).Return(model.RefundPaymentResponse{}, err).Once()
+
+		_, resErr := s.api.RefundPayment(s.ctx, req)
+
+		s.Require().Equal(code, status.Code(resErr), err)
+	}
+}
@@ -0,0 +1,17 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/converter"
+	paymentV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/payment/v1"
+)
+
+func (a *api) VoidAuthorization(ctx context.Context, req *paymentV1.VoidAuthorizationRequest) (*paymentV1.VoidAuthorizationResponse, error) {
+	res, err := a.paymentService.VoidAuthorization(ctx, converter.VoidAuthorizationRequestToModel(req))
+	if err != nil {
+		return nil, authorizationError(err, req.GetTransactionUuid())
+	}
+
+	return converter.VoidAuthorizationResponseToProto(res), nil
+}
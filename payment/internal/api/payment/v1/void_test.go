@@ -0,0 +1,45 @@
+package v1
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	paymentV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/payment/v1"
+)
+
+func (s *APISuite) TestVoidAuthorizationSuccess() {
+	req := &paymentV1.VoidAuthorizationRequest{
+		TransactionUuid: gofakeit.UUID(),
+		OrderUuid:       gofakeit.UUID(),
+		Reason:          "cancelled by user",
+	}
+
+	s.paymentService.On("VoidAuthorization", s.ctx, model.VoidAuthorizationRequest{
+		TransactionUUID: req.TransactionUuid,
+		OrderUUID:       req.OrderUuid,
+		Reason:          req.Reason,
+	}).Return(model.VoidAuthorizationResponse{
+		TransactionUUID: req.TransactionUuid,
+		Status:          model.TransactionStatusExpired,
+	}, nil)
+
+	res, err := s.api.VoidAuthorization(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().Equal(req.TransactionUuid, res.GetTransactionUuid())
+	s.Require().Equal(paymentV1.TransactionStatus_TRANSACTION_STATUS_EXPIRED, res.GetStatus())
+}
+
+func (s *APISuite) TestVoidAuthorizationCaptured() {
+	req := &paymentV1.VoidAuthorizationRequest{TransactionUuid: gofakeit.UUID()}
+
+	s.paymentService.On("VoidAuthorization", s.ctx, model.VoidAuthorizationRequest{TransactionUUID: req.TransactionUuid}).
+		Return(model.VoidAuthorizationResponse{}, model.ErrTransactionNotAuthorized)
+
+	res, err := s.api.VoidAuthorization(s.ctx, req)
+
+	s.Require().Equal(codes.FailedPrecondition, status.Code(err))
+	s.Require().Nil(res)
+}
@@ -0,0 +1,56 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	paymentV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/payment/v1"
+)
+
+func AuthorizePaymentRequestToModel(req *paymentV1.AuthorizePaymentRequest) model.AuthorizePaymentRequest {
+	return model.AuthorizePaymentRequest{
+		OrderUUID:      req.GetOrderUuid(),
+		UserUUID:       req.GetUserUuid(),
+		PaymentMethod:  paymentMethodToModel(req.GetPaymentMethod()),
+		Amount:         req.GetAmount(),
+		Currency:       model.Currency(req.GetCurrency()),
+		IdempotencyKey: req.GetIdempotencyKey(),
+	}
+}
+
+func AuthorizePaymentResponseToProto(res model.AuthorizePaymentResponse) *paymentV1.AuthorizePaymentResponse {
+	return &paymentV1.AuthorizePaymentResponse{
+		TransactionUuid: res.TransactionUUID,
+		Amount:          res.Amount,
+		Currency:        string(res.Currency),
+		ExpiresAt:       timestamppb.New(res.ExpiresAt),
+	}
+}
+
+func CapturePaymentRequestToModel(req *paymentV1.CapturePaymentRequest) model.CapturePaymentRequest {
+	return model.CapturePaymentRequest{
+		TransactionUUID: req.GetTransactionUuid(),
+		OrderUUID:       req.GetOrderUuid(),
+	}
+}
+
+func CapturePaymentResponseToProto(res model.CapturePaymentResponse) *paymentV1.CapturePaymentResponse {
+	return &paymentV1.CapturePaymentResponse{
+		TransactionUuid: res.TransactionUUID,
+	}
+}
+
+func VoidAuthorizationRequestToModel(req *paymentV1.VoidAuthorizationRequest) model.VoidAuthorizationRequest {
+	return model.VoidAuthorizationRequest{
+		TransactionUUID: req.GetTransactionUuid(),
+		OrderUUID:       req.GetOrderUuid(),
+		Reason:          req.GetReason(),
+	}
+}
+
+func VoidAuthorizationResponseToProto(res model.VoidAuthorizationResponse) *paymentV1.VoidAuthorizationResponse {
+	return &paymentV1.VoidAuthorizationResponse{
+		TransactionUuid: res.TransactionUUID,
+		Status:          transactionStatusToProto(res.Status),
+	}
+}
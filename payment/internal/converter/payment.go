@@ -7,15 +7,20 @@ import (
 
 func PayOrderRequestToModel(payOrder *paymentV1.PayOrderRequest) model.PayOrderRequest {
 	return model.PayOrderRequest{
-		OrderUUID:     payOrder.GetOrderUuid(),
-		UserUUID:      payOrder.GetUserUuid(),
-		PaymentMethod: paymentMethodToModel(payOrder.GetPaymentMethod()),
+		OrderUUID:      payOrder.GetOrderUuid(),
+		UserUUID:       payOrder.GetUserUuid(),
+		PaymentMethod:  paymentMethodToModel(payOrder.GetPaymentMethod()),
+		Amount:         payOrder.GetAmount(),
+		Currency:       model.Currency(payOrder.GetCurrency()),
+		IdempotencyKey: payOrder.GetIdempotencyKey(),
 	}
 }
 
 func PayOrderResponseToProto(payOrder model.PayOrderResponse) *paymentV1.PayOrderResponse {
 	return &paymentV1.PayOrderResponse{
 		TransactionUuid: payOrder.TransactionUUID,
+		Amount:          payOrder.Amount,
+		Currency:        string(payOrder.Currency),
 	}
 }
 
@@ -33,3 +38,19 @@ func paymentMethodToModel(payMethod paymentV1.PaymentMethod) model.PaymentMethod
 		return model.Unspecified
 	}
 }
+
+func RefundPaymentRequestToModel(req *paymentV1.RefundPaymentRequest) model.RefundPaymentRequest {
+	return model.RefundPaymentRequest{
+		TransactionUUID: req.GetTransactionUuid(),
+		OrderUUID:       req.GetOrderUuid(),
+		Amount:          req.GetAmount(),
+		Currency:        model.Currency(req.GetCurrency()),
+		Reason:          req.GetReason(),
+	}
+}
+
+func RefundPaymentResponseToProto(res model.RefundPaymentResponse) *paymentV1.RefundPaymentResponse {
+	return &paymentV1.RefundPaymentResponse{
+		RefundTransactionUuid: res.RefundTransactionUUID,
+	}
+}
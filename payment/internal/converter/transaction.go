@@ -0,0 +1,169 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	paymentV1 "github.com/baryshnikkov/rocket-factory/shared/pkg/proto/payment/v1"
+)
+
+func TransactionToProto(transaction model.Transaction) *paymentV1.Transaction {
+	var updatedAt *timestamppb.Timestamp
+	if transaction.UpdatedAt != nil {
+		updatedAt = timestamppb.New(*transaction.UpdatedAt)
+	}
+
+	var authorizationExpiresAt *timestamppb.Timestamp
+	if transaction.AuthorizationExpiresAt != nil {
+		authorizationExpiresAt = timestamppb.New(*transaction.AuthorizationExpiresAt)
+	}
+
+	return &paymentV1.Transaction{
+		TransactionUuid: transaction.UUID,
+		OrderUuid:       transaction.OrderUUID,
+		UserUuid:        transaction.UserUUID,
+		PaymentMethod:   paymentMethodToProto(transaction.PaymentMethod),
+		Amount:          transaction.Amount,
+		RefundedAmount:  transaction.RefundedAmount,
+		Currency:        string(transaction.Currency),
+		Status:          transactionStatusToProto(transaction.Status),
+		CreatedAt:       timestamppb.New(transaction.CreatedAt),
+		UpdatedAt:       updatedAt,
+
+		AuthorizationExpiresAt: authorizationExpiresAt,
+		Kind:                   transactionKindToProto(transaction.Kind),
+		ParentTransactionUuid:  transaction.ParentTransactionUUID,
+	}
+}
+
+func TransactionsToProto(transactions []model.Transaction) []*paymentV1.Transaction {
+	res := make([]*paymentV1.Transaction, 0, len(transactions))
+	for _, transaction := range transactions {
+		res = append(res, TransactionToProto(transaction))
+	}
+	return res
+}
+
+func TransactionsFilterToModel(filter *paymentV1.TransactionsFilter) model.TransactionsFilter {
+	res := model.TransactionsFilter{
+		UserUUID:  filter.GetUserUuid(),
+		OrderUUID: filter.GetOrderUuid(),
+	}
+
+	for _, status := range filter.GetStatuses() {
+		res.Statuses = append(res.Statuses, transactionStatusToModel(status))
+	}
+
+	for _, kind := range filter.GetKinds() {
+		res.Kinds = append(res.Kinds, transactionKindToModel(kind))
+	}
+
+	if filter.GetCreatedFrom() != nil {
+		from := filter.GetCreatedFrom().AsTime()
+		res.CreatedFrom = &from
+	}
+	if filter.GetCreatedTo() != nil {
+		to := filter.GetCreatedTo().AsTime()
+		res.CreatedTo = &to
+	}
+
+	return res
+}
+
+func ListTransactionsParamsToModel(req *paymentV1.ListTransactionsRequest) model.ListTransactionsParams {
+	return model.ListTransactionsParams{
+		PageSize:  req.GetPageSize(),
+		PageToken: req.GetPageToken(),
+	}
+}
+
+func paymentMethodToProto(method model.PaymentMethod) paymentV1.PaymentMethod {
+	switch method {
+	case model.Card:
+		return paymentV1.PaymentMethod_PAYMENT_METHOD_CARD
+	case model.SBP:
+		return paymentV1.PaymentMethod_PAYMENT_METHOD_SBP
+	case model.CreditCard:
+		return paymentV1.PaymentMethod_PAYMENT_METHOD_CREDIT_CARD
+	case model.InvestorMoney:
+		return paymentV1.PaymentMethod_PAYMENT_METHOD_INVESTOR_MONEY
+	default:
+		return paymentV1.PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+	}
+}
+
+func transactionStatusToProto(status model.TransactionStatus) paymentV1.TransactionStatus {
+	switch status {
+	case model.TransactionStatusPending:
+		return paymentV1.TransactionStatus_TRANSACTION_STATUS_PENDING
+	case model.TransactionStatusFailed:
+		return paymentV1.TransactionStatus_TRANSACTION_STATUS_FAILED
+	case model.TransactionStatusSucceeded:
+		return paymentV1.TransactionStatus_TRANSACTION_STATUS_SUCCEEDED
+	case model.TransactionStatusPartiallyRefunded:
+		return paymentV1.TransactionStatus_TRANSACTION_STATUS_PARTIALLY_REFUNDED
+	case model.TransactionStatusRefunded:
+		return paymentV1.TransactionStatus_TRANSACTION_STATUS_REFUNDED
+	case model.TransactionStatusAuthorized:
+		return paymentV1.TransactionStatus_TRANSACTION_STATUS_AUTHORIZED
+	case model.TransactionStatusVoided:
+		return paymentV1.TransactionStatus_TRANSACTION_STATUS_VOIDED
+	case model.TransactionStatusExpired:
+		return paymentV1.TransactionStatus_TRANSACTION_STATUS_EXPIRED
+	case model.TransactionStatusCapturing:
+		return paymentV1.TransactionStatus_TRANSACTION_STATUS_CAPTURING
+	case model.TransactionStatusVoiding:
+		return paymentV1.TransactionStatus_TRANSACTION_STATUS_VOIDING
+	default:
+		return paymentV1.TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED
+	}
+}
+
+func transactionStatusToModel(status paymentV1.TransactionStatus) model.TransactionStatus {
+	switch status {
+	case paymentV1.TransactionStatus_TRANSACTION_STATUS_PENDING:
+		return model.TransactionStatusPending
+	case paymentV1.TransactionStatus_TRANSACTION_STATUS_FAILED:
+		return model.TransactionStatusFailed
+	case paymentV1.TransactionStatus_TRANSACTION_STATUS_SUCCEEDED:
+		return model.TransactionStatusSucceeded
+	case paymentV1.TransactionStatus_TRANSACTION_STATUS_PARTIALLY_REFUNDED:
+		return model.TransactionStatusPartiallyRefunded
+	case paymentV1.TransactionStatus_TRANSACTION_STATUS_REFUNDED:
+		return model.TransactionStatusRefunded
+	case paymentV1.TransactionStatus_TRANSACTION_STATUS_AUTHORIZED:
+		return model.TransactionStatusAuthorized
+	case paymentV1.TransactionStatus_TRANSACTION_STATUS_VOIDED:
+		return model.TransactionStatusVoided
+	case paymentV1.TransactionStatus_TRANSACTION_STATUS_EXPIRED:
+		return model.TransactionStatusExpired
+	case paymentV1.TransactionStatus_TRANSACTION_STATUS_CAPTURING:
+		return model.TransactionStatusCapturing
+	case paymentV1.TransactionStatus_TRANSACTION_STATUS_VOIDING:
+		return model.TransactionStatusVoiding
+	default:
+		return ""
+	}
+}
+
+func transactionKindToProto(kind model.TransactionKind) paymentV1.TransactionKind {
+	switch kind {
+	case model.TransactionKindPayment:
+		return paymentV1.TransactionKind_TRANSACTION_KIND_PAYMENT
+	case model.TransactionKindRefund:
+		return paymentV1.TransactionKind_TRANSACTION_KIND_REFUND
+	default:
+		return paymentV1.TransactionKind_TRANSACTION_KIND_UNSPECIFIED
+	}
+}
+
+func transactionKindToModel(kind paymentV1.TransactionKind) model.TransactionKind {
+	switch kind {
+	case paymentV1.TransactionKind_TRANSACTION_KIND_PAYMENT:
+		return model.TransactionKindPayment
+	case paymentV1.TransactionKind_TRANSACTION_KIND_REFUND:
+		return model.TransactionKindRefund
+	default:
+		return ""
+	}
+}
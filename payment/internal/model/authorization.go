@@ -0,0 +1,33 @@
+package model
+
+import "time"
+
+// AuthorizePaymentRequest - запрос холдирования; параметры и идемпотентность те же, что у оплаты
+type AuthorizePaymentRequest = PayOrderRequest
+
+type AuthorizePaymentResponse struct {
+	TransactionUUID string
+	Amount          int64 // Холдированная сумма в минимальных единицах валюты
+	Currency        Currency
+	ExpiresAt       time.Time
+}
+
+type CapturePaymentRequest struct {
+	TransactionUUID string
+	OrderUUID       string
+}
+
+type CapturePaymentResponse struct {
+	TransactionUUID string
+}
+
+type VoidAuthorizationRequest struct {
+	TransactionUUID string
+	OrderUUID       string
+	Reason          string
+}
+
+type VoidAuthorizationResponse struct {
+	TransactionUUID string
+	Status          TransactionStatus // VOIDED или EXPIRED
+}
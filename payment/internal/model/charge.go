@@ -0,0 +1,32 @@
+package model
+
+// Charge - списание, которое сервис отправляет провайдеру способа оплаты
+type Charge struct {
+	TransactionUUID string // Транзакция журнала, за которую списываем
+	OrderUUID       string
+	UserUUID        string
+	PaymentMethod   PaymentMethod
+	Amount          int64 // В минимальных единицах валюты
+	Currency        Currency
+	// ParentTransactionUUID - у возврата оплата, по которой он проведён
+	ParentTransactionUUID string
+}
+
+// ChargeResult - ответ провайдера об успешном списании
+type ChargeResult struct {
+	ProviderTransactionID string // Идентификатор операции у провайдера
+}
+
+// ChargeOf - операция у провайдера на всю сумму транзакции
+func ChargeOf(transaction Transaction) Charge {
+	return Charge{
+		TransactionUUID: transaction.UUID,
+		OrderUUID:       transaction.OrderUUID,
+		UserUUID:        transaction.UserUUID,
+		PaymentMethod:   transaction.PaymentMethod,
+		Amount:          transaction.Amount,
+		Currency:        transaction.Currency,
+
+		ParentTransactionUUID: transaction.ParentTransactionUUID,
+	}
+}
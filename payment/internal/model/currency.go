@@ -0,0 +1,33 @@
+package model
+
+import "math"
+
+// Currency - код валюты ISO 4217
+type Currency string
+
+const (
+	RUB Currency = "RUB"
+	USD Currency = "USD"
+	EUR Currency = "EUR"
+	CNY Currency = "CNY"
+	JPY Currency = "JPY"
+)
+
+// currencyExponents - число знаков после запятой у поддерживаемых валют (ISO 4217)
+var currencyExponents = map[Currency]int{
+	RUB: 2,
+	USD: 2,
+	EUR: 2,
+	CNY: 2,
+	JPY: 0,
+}
+
+func (c Currency) Supported() bool {
+	_, ok := currencyExponents[c]
+	return ok
+}
+
+// MajorUnits переводит сумму из минимальных единиц валюты в основные, например копейки в рубли
+func (c Currency) MajorUnits(minor int64) float64 {
+	return float64(minor) / math.Pow10(currencyExponents[c])
+}
@@ -2,4 +2,25 @@ package model
 
 import "errors"
 
-var ErrPaymentInternalError = errors.New("internal error while processing payment")
+var (
+	ErrPaymentInternalError         = errors.New("internal error while processing payment")
+	ErrInvalidTransaction           = errors.New("invalid transaction uuid")
+	ErrInvalidRefundAmount          = errors.New("refund amount must not be negative")
+	ErrTransactionNotFound          = errors.New("transaction not found")
+	ErrTransactionAlreadyRefunded   = errors.New("transaction already refunded")
+	ErrRefundExceedsAmount          = errors.New("refund amount exceeds paid amount")
+	ErrInvalidPageToken             = errors.New("invalid page token")
+	ErrUnsupportedCurrency          = errors.New("unsupported currency")
+	ErrRefundCurrencyMismatch       = errors.New("refund currency differs from payment currency")
+	ErrUnsupportedPaymentMethod     = errors.New("unsupported payment method")
+	ErrAmountOutOfLimits            = errors.New("amount is out of payment method limits")
+	ErrIdempotencyConflict          = errors.New("payment with this idempotency key already exists with other parameters")
+	ErrPaymentInProgress            = errors.New("payment with this idempotency key is still in progress")
+	ErrInvalidTransactionTransition = errors.New("invalid transaction status transition")
+	ErrTransactionNotAuthorized     = errors.New("transaction is not an active authorization")
+	ErrAuthorizationExpired         = errors.New("authorization expired")
+	ErrTransactionNotPaid           = errors.New("transaction is not paid")
+	ErrPaymentDeclined              = errors.New("payment declined by provider")
+	ErrInsufficientFunds            = errors.New("insufficient funds")
+	ErrProviderTimeout              = errors.New("payment provider timeout")
+)
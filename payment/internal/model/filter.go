@@ -0,0 +1,32 @@
+package model
+
+import "slices"
+
+// Match проверяет транзакцию на соответствие фильтру так же, как это делает ListTransactions.
+func (filter TransactionsFilter) Match(transaction Transaction) bool {
+	if filter.UserUUID != "" && transaction.UserUUID != filter.UserUUID {
+		return false
+	}
+
+	if filter.OrderUUID != "" && transaction.OrderUUID != filter.OrderUUID {
+		return false
+	}
+
+	if len(filter.Statuses) > 0 && !slices.Contains(filter.Statuses, transaction.Status) {
+		return false
+	}
+
+	if len(filter.Kinds) > 0 && !slices.Contains(filter.Kinds, transaction.Kind) {
+		return false
+	}
+
+	if filter.CreatedFrom != nil && transaction.CreatedAt.Before(*filter.CreatedFrom) {
+		return false
+	}
+
+	if filter.CreatedTo != nil && transaction.CreatedAt.After(*filter.CreatedTo) {
+		return false
+	}
+
+	return true
+}
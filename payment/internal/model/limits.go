@@ -0,0 +1,25 @@
+package model
+
+// AmountLimit - допустимая сумма одного платежа в основных единицах валюты; Max = 0 — без ограничения сверху
+type AmountLimit struct {
+	Min float64
+	Max float64
+}
+
+// paymentMethodLimits - лимиты способов оплаты; способ без лимитов не принимается
+var paymentMethodLimits = map[PaymentMethod]AmountLimit{
+	Card:          {Min: 1, Max: 1_000_000},
+	SBP:           {Min: 1, Max: 1_000_000},
+	CreditCard:    {Min: 1, Max: 500_000},
+	InvestorMoney: {Min: 1},
+}
+
+// AmountLimit возвращает лимиты способа оплаты; false — способ не поддерживается
+func (m PaymentMethod) AmountLimit() (AmountLimit, bool) {
+	limit, ok := paymentMethodLimits[m]
+	return limit, ok
+}
+
+func (l AmountLimit) Allows(amount float64) bool {
+	return amount >= l.Min && (l.Max == 0 || amount <= l.Max)
+}
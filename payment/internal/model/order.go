@@ -14,8 +14,22 @@ type PayOrderRequest struct {
 	OrderUUID     string
 	UserUUID      string
 	PaymentMethod PaymentMethod
+	Amount        int64 // В минимальных единицах валюты
+	Currency      Currency
+	// IdempotencyKey - ключ идемпотентности; пустой — повторы определяются по OrderUUID
+	IdempotencyKey string
+}
+
+// DeduplicationKey - ключ, по которому повтор оплаты находит исходную транзакцию
+func (r PayOrderRequest) DeduplicationKey() string {
+	if r.IdempotencyKey != "" {
+		return "key:" + r.IdempotencyKey
+	}
+	return "order:" + r.OrderUUID
 }
 
 type PayOrderResponse struct {
 	TransactionUUID string
+	Amount          int64 // Списанная сумма в минимальных единицах валюты
+	Currency        Currency
 }
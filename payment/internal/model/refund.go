@@ -0,0 +1,18 @@
+package model
+
+type RefundPaymentRequest struct {
+	TransactionUUID string
+	OrderUUID       string
+	Amount          int64 // В минимальных единицах валюты; 0 — полный возврат
+	Currency        Currency
+	Reason          string
+}
+
+// Full сообщает, что возвращается вся сумма оплаты
+func (r RefundPaymentRequest) Full() bool {
+	return r.Amount == 0
+}
+
+type RefundPaymentResponse struct {
+	RefundTransactionUUID string
+}
@@ -0,0 +1,227 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+type TransactionStatus string
+
+const (
+	TransactionStatusPending           TransactionStatus = "PENDING"            // Списание отправлено провайдеру
+	TransactionStatusFailed            TransactionStatus = "FAILED"             // Провайдер не провёл списание
+	TransactionStatusSucceeded         TransactionStatus = "SUCCEEDED"          // Оплата прошла
+	TransactionStatusPartiallyRefunded TransactionStatus = "PARTIALLY_REFUNDED" // Часть суммы возвращена
+	TransactionStatusRefunded          TransactionStatus = "REFUNDED"           // Сумма возвращена полностью
+	TransactionStatusAuthorized        TransactionStatus = "AUTHORIZED"         // Сумма холдирована и ждёт списания
+	TransactionStatusVoided            TransactionStatus = "VOIDED"             // Холд снят без списания
+	TransactionStatusExpired           TransactionStatus = "EXPIRED"            // Холд истёк без списания
+	TransactionStatusCapturing         TransactionStatus = "CAPTURING"          // Списание холда отправлено провайдеру
+	TransactionStatusVoiding           TransactionStatus = "VOIDING"            // Снятие холда отправлено провайдеру
+)
+
+// TransactionKind - вид записи журнала оплат
+type TransactionKind string
+
+const (
+	TransactionKindPayment TransactionKind = "PAYMENT" // Оплата или холд
+	TransactionKindRefund  TransactionKind = "REFUND"  // Возврат по оплате ParentTransactionUUID
+)
+
+// transactionTransitions - переходы статусов по ответам провайдера. Возвраты меняют статус
+// через ApplyRefund. Списание и снятие холда сначала занимают транзакцию (CAPTURING, VOIDING),
+// чтобы провайдер не получил обе операции; отказ провайдера возвращает холд в AUTHORIZED.
+var transactionTransitions = map[TransactionStatus][]TransactionStatus{
+	TransactionStatusPending:    {TransactionStatusSucceeded, TransactionStatusAuthorized, TransactionStatusFailed},
+	TransactionStatusAuthorized: {TransactionStatusCapturing, TransactionStatusVoiding, TransactionStatusExpired},
+	TransactionStatusCapturing:  {TransactionStatusSucceeded, TransactionStatusAuthorized},
+	TransactionStatusVoiding:    {TransactionStatusVoided, TransactionStatusAuthorized},
+}
+
+// CanTransitionTo сообщает, разрешён ли переход из статуса s в статус to
+func (s TransactionStatus) CanTransitionTo(to TransactionStatus) bool {
+	return slices.Contains(transactionTransitions[s], to)
+}
+
+// Transaction - запись журнала оплат
+type Transaction struct {
+	UUID           string
+	OrderUUID      string
+	UserUUID       string
+	PaymentMethod  PaymentMethod
+	Amount         int64 // В минимальных единицах валюты
+	RefundedAmount int64
+	Currency       Currency
+	Status         TransactionStatus
+	CreatedAt      time.Time
+	UpdatedAt      *time.Time
+	// AuthorizationExpiresAt - срок холда; nil у оплат без холда
+	AuthorizationExpiresAt *time.Time
+	Kind                   TransactionKind
+	// ParentTransactionUUID - у возврата UUID оплаты, по которой он проведён; у оплаты пустой
+	ParentTransactionUUID string
+}
+
+// ApplyRefund возвращает транзакцию после возврата amount (0 — весь остаток) и запись журнала
+// о самом возврате в PENDING; UUID возврату назначает хранилище. Сумма возврата сразу учитывается
+// в RefundedAmount, чтобы параллельные возвраты не превысили сумму оплаты.
+func (t Transaction) ApplyRefund(amount int64, at time.Time) (paid, refund Transaction, err error) {
+	if t.Kind == TransactionKindRefund {
+		return Transaction{}, Transaction{}, ErrInvalidTransaction
+	}
+
+	// Вернуть можно только списанные деньги: холд снимается через VoidAuthorization
+	switch t.Status {
+	case TransactionStatusSucceeded, TransactionStatusPartiallyRefunded:
+	case TransactionStatusRefunded:
+		return Transaction{}, Transaction{}, ErrTransactionAlreadyRefunded
+	default:
+		return Transaction{}, Transaction{}, ErrTransactionNotPaid
+	}
+
+	remaining := t.Amount - t.RefundedAmount
+	if amount == 0 {
+		amount = remaining
+	}
+	if amount > remaining {
+		return Transaction{}, Transaction{}, ErrRefundExceedsAmount
+	}
+
+	refund = Transaction{
+		OrderUUID:             t.OrderUUID,
+		UserUUID:              t.UserUUID,
+		PaymentMethod:         t.PaymentMethod,
+		Amount:                amount,
+		Currency:              t.Currency,
+		Status:                TransactionStatusPending,
+		CreatedAt:             at,
+		Kind:                  TransactionKindRefund,
+		ParentTransactionUUID: t.UUID,
+	}
+
+	t.RefundedAmount += amount
+	t.Status = TransactionStatusPartiallyRefunded
+	if t.RefundedAmount >= t.Amount {
+		t.Status = TransactionStatusRefunded
+	}
+	t.UpdatedAt = &at
+
+	return t, refund, nil
+}
+
+// ReleaseRefund возвращает транзакцию, в остаток которой вернулась сумма отклонённого провайдером
+// возврата refund, и сам возврат в FAILED
+func (t Transaction) ReleaseRefund(refund Transaction, at time.Time) (paid, failed Transaction, err error) {
+	if refund.Kind != TransactionKindRefund || refund.ParentTransactionUUID != t.UUID {
+		return Transaction{}, Transaction{}, ErrInvalidTransaction
+	}
+
+	failed, err = refund.Transition(TransactionStatusFailed, at)
+	if err != nil {
+		return Transaction{}, Transaction{}, err
+	}
+
+	t.RefundedAmount -= refund.Amount
+	t.Status = TransactionStatusPartiallyRefunded
+	if t.RefundedAmount == 0 {
+		t.Status = TransactionStatusSucceeded
+	}
+	t.UpdatedAt = &at
+
+	return t, failed, nil
+}
+
+// TransactionsFilter - фильтр журнала оплат; пустые поля не ограничивают выборку
+type TransactionsFilter struct {
+	UserUUID    string
+	OrderUUID   string
+	Statuses    []TransactionStatus // Подходит любой из статусов
+	Kinds       []TransactionKind   // Подходит любой из видов
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+}
+
+// ListTransactionsParams - параметры страницы, которые передаёт клиент
+type ListTransactionsParams struct {
+	PageSize  int32 // 0 — вернуть все транзакции одной страницей
+	PageToken string
+}
+
+// TransactionsList - страница транзакций для клиента
+type TransactionsList struct {
+	Transactions  []Transaction
+	NextPageToken string
+	TotalSize     int32
+}
+
+// TransactionsPageRequest - запрос страницы к хранилищу. Транзакции выдаются от новых к старым,
+// при равном времени создания — по убыванию UUID.
+type TransactionsPageRequest struct {
+	PageSize int32
+	After    *TransactionsCursor // Последняя транзакция предыдущей страницы
+}
+
+// TransactionsPage - страница транзакций из хранилища
+type TransactionsPage struct {
+	Transactions []Transaction
+	TotalSize    int32
+	Next         *TransactionsCursor // nil, если страница последняя
+}
+
+// TransactionsCursor - ключ сортировки последней выданной транзакции
+type TransactionsCursor struct {
+	UUID      string
+	CreatedAt time.Time
+}
+
+func NewTransactionsCursor(transaction Transaction) *TransactionsCursor {
+	return &TransactionsCursor{
+		UUID:      transaction.UUID,
+		CreatedAt: transaction.CreatedAt,
+	}
+}
+
+// TransactionInfo - данные новой транзакции; UUID и время создания назначает хранилище
+type TransactionInfo struct {
+	OrderUUID     string
+	UserUUID      string
+	PaymentMethod PaymentMethod
+	Amount        int64 // В минимальных единицах валюты
+	Currency      Currency
+	// IdempotencyKey - у одного ключа может быть только одна транзакция; пустой ключ не проверяется
+	IdempotencyKey string
+	// AuthorizationExpiresAt - срок холда; задаётся только для AuthorizePayment
+	AuthorizationExpiresAt *time.Time
+}
+
+// Transition возвращает транзакцию в статусе status, если переход разрешён transactionTransitions
+func (t Transaction) Transition(status TransactionStatus, at time.Time) (Transaction, error) {
+	if !t.Status.CanTransitionTo(status) {
+		return Transaction{}, fmt.Errorf("%w: %s -> %s", ErrInvalidTransactionTransition, t.Status, status)
+	}
+
+	t.Status = status
+	t.UpdatedAt = &at
+
+	return t, nil
+}
+
+// Authorization сообщает, что транзакция создана холдом AuthorizePayment
+func (t Transaction) Authorization() bool {
+	return t.AuthorizationExpiresAt != nil
+}
+
+// AuthorizationExpired сообщает, что холд не списан и его срок к now истёк
+func (t Transaction) AuthorizationExpired(now time.Time) bool {
+	return t.Status == TransactionStatusAuthorized && t.AuthorizationExpiresAt != nil && !now.Before(*t.AuthorizationExpiresAt)
+}
+
+// Matches сообщает, что транзакция записана с теми же параметрами оплаты, что и info
+func (t Transaction) Matches(info TransactionInfo) bool {
+	return t.OrderUUID == info.OrderUUID &&
+		t.UserUUID == info.UserUUID &&
+		t.PaymentMethod == info.PaymentMethod &&
+		t.Amount == info.Amount &&
+		t.Currency == info.Currency
+}
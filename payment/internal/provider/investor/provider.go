@@ -0,0 +1,42 @@
+package investor
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	def "github.com/baryshnikkov/rocket-factory/payment/internal/provider"
+)
+
+var _ def.Provider = (*provider)(nil)
+
+// provider списывает деньги инвестора: это внутренний счёт без внешнего шлюза, поэтому все
+// операции проходят сразу
+type provider struct{}
+
+func NewProvider() *provider {
+	return &provider{}
+}
+
+func (p *provider) Charge(_ context.Context, _ model.Charge) (model.ChargeResult, error) {
+	return model.ChargeResult{
+		ProviderTransactionID: "investor-" + uuid.NewString(),
+	}, nil
+}
+
+func (p *provider) Authorize(ctx context.Context, charge model.Charge) (model.ChargeResult, error) {
+	return p.Charge(ctx, charge)
+}
+
+func (p *provider) Capture(_ context.Context, _ model.Charge) error {
+	return nil
+}
+
+func (p *provider) Void(_ context.Context, _ model.Charge) error {
+	return nil
+}
+
+func (p *provider) Refund(_ context.Context, _ model.Charge) error {
+	return nil
+}
@@ -0,0 +1,31 @@
+package investor
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+)
+
+func (s *ProviderSuite) TestChargeSucceedsImmediately() {
+	res, err := s.provider.Charge(s.ctx, model.Charge{
+		TransactionUUID: gofakeit.UUID(),
+		PaymentMethod:   model.InvestorMoney,
+		Amount:          100_000_000_000,
+		Currency:        model.RUB,
+	})
+
+	s.Require().NoError(err)
+	s.Require().NotEmpty(res.ProviderTransactionID)
+}
+
+func (s *ProviderSuite) TestAuthorizeCaptureVoidRefund() {
+	charge := model.Charge{TransactionUUID: gofakeit.UUID(), PaymentMethod: model.InvestorMoney}
+
+	res, err := s.provider.Authorize(s.ctx, charge)
+
+	s.Require().NoError(err)
+	s.Require().NotEmpty(res.ProviderTransactionID)
+	s.Require().NoError(s.provider.Capture(s.ctx, charge))
+	s.Require().NoError(s.provider.Void(s.ctx, charge))
+	s.Require().NoError(s.provider.Refund(s.ctx, charge))
+}
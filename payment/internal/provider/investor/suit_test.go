@@ -0,0 +1,24 @@
+package investor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ProviderSuite struct {
+	suite.Suite
+	ctx context.Context
+
+	provider *provider
+}
+
+func (s *ProviderSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.provider = NewProvider()
+}
+
+func TestProviderInvestorIntegration(t *testing.T) {
+	suite.Run(t, new(ProviderSuite))
+}
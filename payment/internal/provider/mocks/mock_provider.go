@@ -0,0 +1,294 @@
+// Code generated for baryshnikkov service
+// © baryshnikkov 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Provider is an autogenerated mock type for the Provider type
+type Provider struct {
+	mock.Mock
+}
+
+type Provider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Provider) EXPECT() *Provider_Expecter {
+	return &Provider_Expecter{mock: &_m.Mock}
+}
+
+// Authorize provides a mock function with given fields: ctx, charge
+func (_m *Provider) Authorize(ctx context.Context, charge model.Charge) (model.ChargeResult, error) {
+	ret := _m.Called(ctx, charge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Authorize")
+	}
+
+	var r0 model.ChargeResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Charge) (model.ChargeResult, error)); ok {
+		return rf(ctx, charge)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Charge) model.ChargeResult); ok {
+		r0 = rf(ctx, charge)
+	} else {
+		r0 = ret.Get(0).(model.ChargeResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Charge) error); ok {
+		r1 = rf(ctx, charge)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Provider_Authorize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authorize'
+type Provider_Authorize_Call struct {
+	*mock.Call
+}
+
+// Authorize is a helper method to define mock.On call
+//   - ctx context.Context
+//   - charge model.Charge
+func (_e *Provider_Expecter) Authorize(ctx interface{}, charge interface{}) *Provider_Authorize_Call {
+	return &Provider_Authorize_Call{Call: _e.mock.On("Authorize", ctx, charge)}
+}
+
+func (_c *Provider_Authorize_Call) Run(run func(ctx context.Context, charge model.Charge)) *Provider_Authorize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Charge))
+	})
+	return _c
+}
+
+func (_c *Provider_Authorize_Call) Return(_a0 model.ChargeResult, _a1 error) *Provider_Authorize_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Provider_Authorize_Call) RunAndReturn(run func(context.Context, model.Charge) (model.ChargeResult, error)) *Provider_Authorize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Capture provides a mock function with given fields: ctx, charge
+func (_m *Provider) Capture(ctx context.Context, charge model.Charge) error {
+	ret := _m.Called(ctx, charge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Capture")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Charge) error); ok {
+		r0 = rf(ctx, charge)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Provider_Capture_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Capture'
+type Provider_Capture_Call struct {
+	*mock.Call
+}
+
+// Capture is a helper method to define mock.On call
+//   - ctx context.Context
+//   - charge model.Charge
+func (_e *Provider_Expecter) Capture(ctx interface{}, charge interface{}) *Provider_Capture_Call {
+	return &Provider_Capture_Call{Call: _e.mock.On("Capture", ctx, charge)}
+}
+
+func (_c *Provider_Capture_Call) Run(run func(ctx context.Context, charge model.Charge)) *Provider_Capture_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Charge))
+	})
+	return _c
+}
+
+func (_c *Provider_Capture_Call) Return(_a0 error) *Provider_Capture_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Provider_Capture_Call) RunAndReturn(run func(context.Context, model.Charge) error) *Provider_Capture_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Charge provides a mock function with given fields: ctx, charge
+func (_m *Provider) Charge(ctx context.Context, charge model.Charge) (model.ChargeResult, error) {
+	ret := _m.Called(ctx, charge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Charge")
+	}
+
+	var r0 model.ChargeResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Charge) (model.ChargeResult, error)); ok {
+		return rf(ctx, charge)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Charge) model.ChargeResult); ok {
+		r0 = rf(ctx, charge)
+	} else {
+		r0 = ret.Get(0).(model.ChargeResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Charge) error); ok {
+		r1 = rf(ctx, charge)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Provider_Charge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Charge'
+type Provider_Charge_Call struct {
+	*mock.Call
+}
+
+// Charge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - charge model.Charge
+func (_e *Provider_Expecter) Charge(ctx interface{}, charge interface{}) *Provider_Charge_Call {
+	return &Provider_Charge_Call{Call: _e.mock.On("Charge", ctx, charge)}
+}
+
+func (_c *Provider_Charge_Call) Run(run func(ctx context.Context, charge model.Charge)) *Provider_Charge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Charge))
+	})
+	return _c
+}
+
+func (_c *Provider_Charge_Call) Return(_a0 model.ChargeResult, _a1 error) *Provider_Charge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Provider_Charge_Call) RunAndReturn(run func(context.Context, model.Charge) (model.ChargeResult, error)) *Provider_Charge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refund provides a mock function with given fields: ctx, charge
+func (_m *Provider) Refund(ctx context.Context, charge model.Charge) error {
+	ret := _m.Called(ctx, charge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refund")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Charge) error); ok {
+		r0 = rf(ctx, charge)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Provider_Refund_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refund'
+type Provider_Refund_Call struct {
+	*mock.Call
+}
+
+// Refund is a helper method to define mock.On call
+//   - ctx context.Context
+//   - charge model.Charge
+func (_e *Provider_Expecter) Refund(ctx interface{}, charge interface{}) *Provider_Refund_Call {
+	return &Provider_Refund_Call{Call: _e.mock.On("Refund", ctx, charge)}
+}
+
+func (_c *Provider_Refund_Call) Run(run func(ctx context.Context, charge model.Charge)) *Provider_Refund_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Charge))
+	})
+	return _c
+}
+
+func (_c *Provider_Refund_Call) Return(_a0 error) *Provider_Refund_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Provider_Refund_Call) RunAndReturn(run func(context.Context, model.Charge) error) *Provider_Refund_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Void provides a mock function with given fields: ctx, charge
+func (_m *Provider) Void(ctx context.Context, charge model.Charge) error {
+	ret := _m.Called(ctx, charge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Void")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Charge) error); ok {
+		r0 = rf(ctx, charge)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Provider_Void_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Void'
+type Provider_Void_Call struct {
+	*mock.Call
+}
+
+// Void is a helper method to define mock.On call
+//   - ctx context.Context
+//   - charge model.Charge
+func (_e *Provider_Expecter) Void(ctx interface{}, charge interface{}) *Provider_Void_Call {
+	return &Provider_Void_Call{Call: _e.mock.On("Void", ctx, charge)}
+}
+
+func (_c *Provider_Void_Call) Run(run func(ctx context.Context, charge model.Charge)) *Provider_Void_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Charge))
+	})
+	return _c
+}
+
+func (_c *Provider_Void_Call) Return(_a0 error) *Provider_Void_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Provider_Void_Call) RunAndReturn(run func(context.Context, model.Charge) error) *Provider_Void_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewProvider creates a new instance of Provider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Provider {
+	mock := &Provider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
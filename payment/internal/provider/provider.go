@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+)
+
+// Provider - платёжный шлюз способа оплаты. Операции над одной транзакцией идемпотентны
+// по charge.TransactionUUID.
+type Provider interface {
+	// Charge списывает charge.Amount. Отказ шлюза — ErrPaymentDeclined или ErrInsufficientFunds,
+	// отсутствие ответа — ErrProviderTimeout.
+	Charge(ctx context.Context, charge model.Charge) (model.ChargeResult, error)
+	// Authorize холдирует charge.Amount; ошибки те же, что у Charge
+	Authorize(ctx context.Context, charge model.Charge) (model.ChargeResult, error)
+	// Capture списывает холд, сделанный Authorize
+	Capture(ctx context.Context, charge model.Charge) error
+	// Void снимает холд без списания. Операцию Charge или Authorize с неизвестным исходом Void
+	// отменяет, если шлюз успел её провести
+	Void(ctx context.Context, charge model.Charge) error
+	// Refund возвращает charge.Amount по оплате charge.ParentTransactionUUID; charge.TransactionUUID -
+	// запись журнала о возврате. Ошибки те же, что у Charge
+	Refund(ctx context.Context, charge model.Charge) error
+}
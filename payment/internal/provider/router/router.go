@@ -0,0 +1,76 @@
+package router
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	def "github.com/baryshnikkov/rocket-factory/payment/internal/provider"
+)
+
+var _ def.Provider = (*router)(nil)
+
+// router передаёт списание провайдеру его способа оплаты
+type router struct {
+	providers map[model.PaymentMethod]def.Provider
+}
+
+func NewRouter(providers map[model.PaymentMethod]def.Provider) *router {
+	return &router{
+		providers: providers,
+	}
+}
+
+func (r *router) Charge(ctx context.Context, charge model.Charge) (model.ChargeResult, error) {
+	provider, err := r.route(charge)
+	if err != nil {
+		return model.ChargeResult{}, err
+	}
+
+	return provider.Charge(ctx, charge)
+}
+
+func (r *router) Authorize(ctx context.Context, charge model.Charge) (model.ChargeResult, error) {
+	provider, err := r.route(charge)
+	if err != nil {
+		return model.ChargeResult{}, err
+	}
+
+	return provider.Authorize(ctx, charge)
+}
+
+func (r *router) Capture(ctx context.Context, charge model.Charge) error {
+	provider, err := r.route(charge)
+	if err != nil {
+		return err
+	}
+
+	return provider.Capture(ctx, charge)
+}
+
+func (r *router) Void(ctx context.Context, charge model.Charge) error {
+	provider, err := r.route(charge)
+	if err != nil {
+		return err
+	}
+
+	return provider.Void(ctx, charge)
+}
+
+func (r *router) Refund(ctx context.Context, charge model.Charge) error {
+	provider, err := r.route(charge)
+	if err != nil {
+		return err
+	}
+
+	return provider.Refund(ctx, charge)
+}
+
+func (r *router) route(charge model.Charge) (def.Provider, error) {
+	provider, ok := r.providers[charge.PaymentMethod]
+	if !ok {
+		return nil, fmt.Errorf("%w: no provider for %s", model.ErrUnsupportedPaymentMethod, charge.PaymentMethod)
+	}
+
+	return provider, nil
+}
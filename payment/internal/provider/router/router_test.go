@@ -0,0 +1,61 @@
+package router
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+)
+
+func (s *RouterSuite) TestChargeRoutesByPaymentMethod() {
+	charge := model.Charge{
+		TransactionUUID: gofakeit.UUID(),
+		PaymentMethod:   model.SBP,
+		Amount:          10_000,
+		Currency:        model.RUB,
+	}
+	expected := model.ChargeResult{ProviderTransactionID: gofakeit.UUID()}
+
+	s.sbp.On("Charge", s.ctx, charge).Return(expected, nil).Once()
+
+	res, err := s.router.Charge(s.ctx, charge)
+
+	s.Require().NoError(err)
+	s.Require().Equal(expected, res)
+}
+
+func (s *RouterSuite) TestChargeReturnsProviderError() {
+	charge := model.Charge{PaymentMethod: model.Card}
+
+	s.card.On("Charge", s.ctx, charge).Return(model.ChargeResult{}, model.ErrPaymentDeclined).Once()
+
+	_, err := s.router.Charge(s.ctx, charge)
+
+	s.Require().ErrorIs(err, model.ErrPaymentDeclined)
+}
+
+func (s *RouterSuite) TestChargeWithoutProvider() {
+	_, err := s.router.Charge(s.ctx, model.Charge{PaymentMethod: model.CreditCard})
+
+	s.Require().ErrorIs(err, model.ErrUnsupportedPaymentMethod)
+}
+
+func (s *RouterSuite) TestAuthorizeCaptureVoidRefundRouteByPaymentMethod() {
+	charge := model.Charge{TransactionUUID: gofakeit.UUID(), PaymentMethod: model.Card}
+
+	s.card.On("Authorize", s.ctx, charge).Return(model.ChargeResult{}, nil).Once()
+	s.card.On("Capture", s.ctx, charge).Return(nil).Once()
+	s.card.On("Void", s.ctx, charge).Return(model.ErrProviderTimeout).Once()
+	s.card.On("Refund", s.ctx, charge).Return(model.ErrPaymentDeclined).Once()
+
+	_, err := s.router.Authorize(s.ctx, charge)
+	s.Require().NoError(err)
+	s.Require().NoError(s.router.Capture(s.ctx, charge))
+	s.Require().ErrorIs(s.router.Void(s.ctx, charge), model.ErrProviderTimeout)
+	s.Require().ErrorIs(s.router.Refund(s.ctx, charge), model.ErrPaymentDeclined)
+}
+
+func (s *RouterSuite) TestCaptureWithoutProvider() {
+	err := s.router.Capture(s.ctx, model.Charge{PaymentMethod: model.InvestorMoney})
+
+	s.Require().ErrorIs(err, model.ErrUnsupportedPaymentMethod)
+}
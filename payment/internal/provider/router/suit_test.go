@@ -0,0 +1,38 @@
+package router
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	def "github.com/baryshnikkov/rocket-factory/payment/internal/provider"
+	"github.com/baryshnikkov/rocket-factory/payment/internal/provider/mocks"
+)
+
+type RouterSuite struct {
+	suite.Suite
+	ctx context.Context
+
+	card *mocks.Provider
+	sbp  *mocks.Provider
+
+	router *router
+}
+
+func (s *RouterSuite) SetupTest() {
+	s.ctx = context.Background()
+
+	s.card = mocks.NewProvider(s.T())
+	s.sbp = mocks.NewProvider(s.T())
+
+	s.router = NewRouter(map[model.PaymentMethod]def.Provider{
+		model.Card: s.card,
+		model.SBP:  s.sbp,
+	})
+}
+
+func TestRouterIntegration(t *testing.T) {
+	suite.Run(t, new(RouterSuite))
+}
@@ -0,0 +1,58 @@
+package simulator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+)
+
+// Scenario - поведение симулятора шлюза
+type Scenario string
+
+const (
+	ScenarioSuccess           Scenario = "success"            // Списание проходит сразу
+	ScenarioDecline           Scenario = "decline"            // Шлюз отклоняет списание
+	ScenarioInsufficientFunds Scenario = "insufficient_funds" // Недостаточно средств
+	ScenarioTimeout           Scenario = "timeout"            // Шлюз не отвечает за Delay
+	ScenarioDelayedSuccess    Scenario = "delayed_success"    // Списание проходит через Delay
+)
+
+func (s Scenario) valid() bool {
+	switch s {
+	case ScenarioSuccess, ScenarioDecline, ScenarioInsufficientFunds, ScenarioTimeout, ScenarioDelayedSuccess:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseScenarios разбирает сценарии по способам оплаты из строки вида "CARD=decline,SBP=timeout".
+// Способы, которых нет в строке, получают ScenarioSuccess.
+func ParseScenarios(spec string) (map[model.PaymentMethod]Scenario, error) {
+	scenarios := make(map[model.PaymentMethod]Scenario)
+	if strings.TrimSpace(spec) == "" {
+		return scenarios, nil
+	}
+
+	for _, pair := range strings.Split(spec, ",") {
+		method, scenario, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid simulator scenario %q: expected METHOD=scenario", pair)
+		}
+
+		paymentMethod := model.PaymentMethod(strings.ToUpper(strings.TrimSpace(method)))
+		if _, ok := paymentMethod.AmountLimit(); !ok {
+			return nil, fmt.Errorf("%w: %q", model.ErrUnsupportedPaymentMethod, method)
+		}
+
+		parsed := Scenario(strings.ToLower(strings.TrimSpace(scenario)))
+		if !parsed.valid() {
+			return nil, fmt.Errorf("unknown simulator scenario %q", scenario)
+		}
+
+		scenarios[paymentMethod] = parsed
+	}
+
+	return scenarios, nil
+}
@@ -0,0 +1,31 @@
+package simulator
+
+import (
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+)
+
+func (s *SimulatorSuite) TestParseScenarios() {
+	scenarios, err := ParseScenarios(" card=decline, SBP=delayed_success,CREDIT_CARD=TIMEOUT ")
+
+	s.Require().NoError(err)
+	s.Require().Equal(map[model.PaymentMethod]Scenario{
+		model.Card:       ScenarioDecline,
+		model.SBP:        ScenarioDelayedSuccess,
+		model.CreditCard: ScenarioTimeout,
+	}, scenarios)
+}
+
+func (s *SimulatorSuite) TestParseScenariosEmpty() {
+	scenarios, err := ParseScenarios("")
+
+	s.Require().NoError(err)
+	s.Require().Empty(scenarios)
+}
+
+func (s *SimulatorSuite) TestParseScenariosInvalid() {
+	for _, spec := range []string{"CARD", "CARD=explode", "CASH=decline", "UNSPECIFIED=decline"} {
+		_, err := ParseScenarios(spec)
+
+		s.Require().Error(err, spec)
+	}
+}
@@ -0,0 +1,123 @@
+package simulator
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	def "github.com/baryshnikkov/rocket-factory/payment/internal/provider"
+)
+
+var _ def.Provider = (*simulator)(nil)
+
+// Config - настройки симулятора
+type Config struct {
+	Scenario Scenario
+	Delay    time.Duration // Задержка ответа для ScenarioTimeout и ScenarioDelayedSuccess
+}
+
+// simulator имитирует внешний шлюз (карты, СБП) без сетевых вызовов
+type simulator struct {
+	mu     sync.RWMutex
+	config Config
+}
+
+func NewSimulator(config Config) *simulator {
+	return &simulator{
+		config: config,
+	}
+}
+
+// SetConfig меняет сценарий на ходу, например между тестами
+func (s *simulator) SetConfig(config Config) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.config = config
+}
+
+func (s *simulator) Charge(ctx context.Context, _ model.Charge) (model.ChargeResult, error) {
+	return s.respond(ctx)
+}
+
+// Authorize ведёт себя как Charge: холд отклоняется по тем же сценариям
+func (s *simulator) Authorize(ctx context.Context, _ model.Charge) (model.ChargeResult, error) {
+	return s.respond(ctx)
+}
+
+// Capture, Void и Refund не отклоняются шлюзом: операция над уже одобренной оплатой. Сценарии
+// timeout и delayed_success действуют и на них.
+func (s *simulator) Capture(ctx context.Context, _ model.Charge) error {
+	return s.settle(ctx)
+}
+
+func (s *simulator) Void(ctx context.Context, _ model.Charge) error {
+	return s.settle(ctx)
+}
+
+func (s *simulator) Refund(ctx context.Context, _ model.Charge) error {
+	return s.settle(ctx)
+}
+
+func (s *simulator) settle(ctx context.Context) error {
+	config := s.currentConfig()
+
+	switch config.Scenario {
+	case ScenarioTimeout:
+		if err := wait(ctx, config.Delay); err != nil {
+			return err
+		}
+		return model.ErrProviderTimeout
+	case ScenarioDelayedSuccess:
+		return wait(ctx, config.Delay)
+	default:
+		return nil
+	}
+}
+
+func (s *simulator) respond(ctx context.Context) (model.ChargeResult, error) {
+	config := s.currentConfig()
+
+	switch config.Scenario {
+	case ScenarioDecline:
+		return model.ChargeResult{}, model.ErrPaymentDeclined
+	case ScenarioInsufficientFunds:
+		return model.ChargeResult{}, model.ErrInsufficientFunds
+	case ScenarioTimeout:
+		if err := wait(ctx, config.Delay); err != nil {
+			return model.ChargeResult{}, err
+		}
+		return model.ChargeResult{}, model.ErrProviderTimeout
+	case ScenarioDelayedSuccess:
+		if err := wait(ctx, config.Delay); err != nil {
+			return model.ChargeResult{}, err
+		}
+	}
+
+	return model.ChargeResult{
+		ProviderTransactionID: "sim-" + uuid.NewString(),
+	}, nil
+}
+
+func (s *simulator) currentConfig() Config {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.config
+}
+
+// wait ждёт delay или отмены ctx
+func wait(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
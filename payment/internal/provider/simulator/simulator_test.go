@@ -0,0 +1,112 @@
+package simulator
+
+import (
+	"context"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+)
+
+func charge() model.Charge {
+	return model.Charge{
+		TransactionUUID: gofakeit.UUID(),
+		OrderUUID:       gofakeit.UUID(),
+		UserUUID:        gofakeit.UUID(),
+		PaymentMethod:   model.Card,
+		Amount:          10_000,
+		Currency:        model.RUB,
+	}
+}
+
+func (s *SimulatorSuite) TestChargeSuccess() {
+	res, err := NewSimulator(Config{Scenario: ScenarioSuccess}).Charge(s.ctx, charge())
+
+	s.Require().NoError(err)
+	s.Require().NotEmpty(res.ProviderTransactionID)
+}
+
+func (s *SimulatorSuite) TestChargeDefaultsToSuccess() {
+	res, err := NewSimulator(Config{}).Charge(s.ctx, charge())
+
+	s.Require().NoError(err)
+	s.Require().NotEmpty(res.ProviderTransactionID)
+}
+
+func (s *SimulatorSuite) TestChargeDecline() {
+	_, err := NewSimulator(Config{Scenario: ScenarioDecline}).Charge(s.ctx, charge())
+
+	s.Require().ErrorIs(err, model.ErrPaymentDeclined)
+}
+
+func (s *SimulatorSuite) TestChargeInsufficientFunds() {
+	_, err := NewSimulator(Config{Scenario: ScenarioInsufficientFunds}).Charge(s.ctx, charge())
+
+	s.Require().ErrorIs(err, model.ErrInsufficientFunds)
+}
+
+func (s *SimulatorSuite) TestChargeTimeout() {
+	const delay = 20 * time.Millisecond
+	start := time.Now()
+
+	_, err := NewSimulator(Config{Scenario: ScenarioTimeout, Delay: delay}).Charge(s.ctx, charge())
+
+	s.Require().ErrorIs(err, model.ErrProviderTimeout)
+	s.Require().GreaterOrEqual(time.Since(start), delay)
+}
+
+func (s *SimulatorSuite) TestChargeDelayedSuccess() {
+	const delay = 20 * time.Millisecond
+	start := time.Now()
+
+	res, err := NewSimulator(Config{Scenario: ScenarioDelayedSuccess, Delay: delay}).Charge(s.ctx, charge())
+
+	s.Require().NoError(err)
+	s.Require().NotEmpty(res.ProviderTransactionID)
+	s.Require().GreaterOrEqual(time.Since(start), delay)
+}
+
+func (s *SimulatorSuite) TestChargeDelayRespectsContext() {
+	ctx, cancel := context.WithTimeout(s.ctx, 10*time.Millisecond)
+	defer cancel()
+
+	_, err := NewSimulator(Config{Scenario: ScenarioDelayedSuccess, Delay: time.Minute}).Charge(ctx, charge())
+
+	s.Require().ErrorIs(err, context.DeadlineExceeded)
+}
+
+func (s *SimulatorSuite) TestSetConfig() {
+	simulator := NewSimulator(Config{Scenario: ScenarioSuccess})
+
+	simulator.SetConfig(Config{Scenario: ScenarioDecline})
+	_, err := simulator.Charge(s.ctx, charge())
+
+	s.Require().ErrorIs(err, model.ErrPaymentDeclined)
+}
+
+func (s *SimulatorSuite) TestAuthorizeFollowsScenario() {
+	_, err := NewSimulator(Config{Scenario: ScenarioInsufficientFunds}).Authorize(s.ctx, charge())
+
+	s.Require().ErrorIs(err, model.ErrInsufficientFunds)
+}
+
+func (s *SimulatorSuite) TestCaptureVoidAndRefundAreNotDeclined() {
+	simulator := NewSimulator(Config{Scenario: ScenarioDecline})
+
+	s.Require().NoError(simulator.Capture(s.ctx, charge()))
+	s.Require().NoError(simulator.Void(s.ctx, charge()))
+	s.Require().NoError(simulator.Refund(s.ctx, charge()))
+}
+
+func (s *SimulatorSuite) TestCaptureTimeout() {
+	err := NewSimulator(Config{Scenario: ScenarioTimeout, Delay: time.Millisecond}).Capture(s.ctx, charge())
+
+	s.Require().ErrorIs(err, model.ErrProviderTimeout)
+}
+
+func (s *SimulatorSuite) TestRefundTimeout() {
+	err := NewSimulator(Config{Scenario: ScenarioTimeout, Delay: time.Millisecond}).Refund(s.ctx, charge())
+
+	s.Require().ErrorIs(err, model.ErrProviderTimeout)
+}
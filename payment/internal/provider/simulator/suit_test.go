@@ -0,0 +1,21 @@
+package simulator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SimulatorSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func (s *SimulatorSuite) SetupTest() {
+	s.ctx = context.Background()
+}
+
+func TestSimulatorIntegration(t *testing.T) {
+	suite.Run(t, new(SimulatorSuite))
+}
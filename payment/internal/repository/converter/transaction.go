@@ -0,0 +1,54 @@
+package converter
+
+import (
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	repoModel "github.com/baryshnikkov/rocket-factory/payment/internal/repository/model"
+)
+
+func TransactionToModel(transaction repoModel.Transaction) model.Transaction {
+	var parentUUID string
+	if transaction.ParentTransactionUUID != nil {
+		parentUUID = *transaction.ParentTransactionUUID
+	}
+
+	return model.Transaction{
+		UUID:           transaction.UUID,
+		OrderUUID:      transaction.OrderUUID,
+		UserUUID:       transaction.UserUUID,
+		PaymentMethod:  model.PaymentMethod(transaction.PaymentMethod),
+		Amount:         transaction.Amount,
+		RefundedAmount: transaction.RefundedAmount,
+		Currency:       model.Currency(transaction.Currency),
+		Status:         model.TransactionStatus(transaction.Status),
+		CreatedAt:      transaction.CreatedAt,
+		UpdatedAt:      transaction.UpdatedAt,
+
+		AuthorizationExpiresAt: transaction.AuthorizationExpiresAt,
+		Kind:                   model.TransactionKind(transaction.Kind),
+		ParentTransactionUUID:  parentUUID,
+	}
+}
+
+func TransactionToRepoModel(transaction model.Transaction) repoModel.Transaction {
+	var parentUUID *string
+	if transaction.ParentTransactionUUID != "" {
+		parentUUID = &transaction.ParentTransactionUUID
+	}
+
+	return repoModel.Transaction{
+		UUID:           transaction.UUID,
+		OrderUUID:      transaction.OrderUUID,
+		UserUUID:       transaction.UserUUID,
+		PaymentMethod:  string(transaction.PaymentMethod),
+		Amount:         transaction.Amount,
+		RefundedAmount: transaction.RefundedAmount,
+		Currency:       string(transaction.Currency),
+		Status:         string(transaction.Status),
+		CreatedAt:      transaction.CreatedAt,
+		UpdatedAt:      transaction.UpdatedAt,
+
+		AuthorizationExpiresAt: transaction.AuthorizationExpiresAt,
+		Kind:                   string(transaction.Kind),
+		ParentTransactionUUID:  parentUUID,
+	}
+}
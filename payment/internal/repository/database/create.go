@@ -0,0 +1,61 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+)
+
+func (r *repository) CreateTransaction(ctx context.Context, info model.TransactionInfo) (model.Transaction, bool, error) {
+	transaction := model.Transaction{
+		UUID:          uuid.NewString(),
+		OrderUUID:     info.OrderUUID,
+		UserUUID:      info.UserUUID,
+		PaymentMethod: info.PaymentMethod,
+		Amount:        info.Amount,
+		Currency:      info.Currency,
+		Status:        model.TransactionStatusPending,
+		Kind:          model.TransactionKindPayment,
+		CreatedAt:     time.Now().UTC(),
+	}
+	if info.AuthorizationExpiresAt != nil {
+		expiresAt := info.AuthorizationExpiresAt.UTC()
+		transaction.AuthorizationExpiresAt = &expiresAt
+	}
+
+	var idempotencyKey *string
+	if info.IdempotencyKey != "" {
+		idempotencyKey = &info.IdempotencyKey
+	}
+
+	// Уникальный индекс по ключу делает вставку атомарной: из конкурентных оплат записывается одна
+	res, err := r.db.ExecContext(ctx,
+		`INSERT INTO transactions (uuid, order_uuid, user_uuid, payment_method, amount, currency, status, created_at, idempotency_key, authorization_expires_at, kind)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (idempotency_key) DO NOTHING`,
+		transaction.UUID, transaction.OrderUUID, transaction.UserUUID, string(transaction.PaymentMethod), transaction.Amount,
+		string(transaction.Currency), string(transaction.Status), transaction.CreatedAt, idempotencyKey,
+		transaction.AuthorizationExpiresAt, string(transaction.Kind),
+	)
+	if err != nil {
+		return model.Transaction{}, false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return model.Transaction{}, false, err
+	}
+	if affected > 0 {
+		return transaction, true, nil
+	}
+
+	existing, err := r.getTransactionByKey(ctx, info.IdempotencyKey)
+	if err != nil {
+		return model.Transaction{}, false, err
+	}
+
+	return existing, false, nil
+}
@@ -0,0 +1,88 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	"github.com/baryshnikkov/rocket-factory/payment/internal/repository/converter"
+	repoModel "github.com/baryshnikkov/rocket-factory/payment/internal/repository/model"
+)
+
+const transactionColumns = `uuid, order_uuid, user_uuid, payment_method, amount, refunded_amount, currency, status, created_at, updated_at, authorization_expires_at, kind, parent_transaction_uuid`
+
+func (r *repository) GetTransaction(ctx context.Context, transactionUUID string) (model.Transaction, error) {
+	transaction, err := scanTransaction(r.db.QueryRowContext(ctx,
+		`SELECT `+transactionColumns+` FROM transactions WHERE uuid = $1`,
+		transactionUUID,
+	))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.Transaction{}, model.ErrTransactionNotFound
+		}
+		return model.Transaction{}, err
+	}
+
+	return converter.TransactionToModel(transaction), nil
+}
+
+func (r *repository) getTransactionByKey(ctx context.Context, idempotencyKey string) (model.Transaction, error) {
+	transaction, err := scanTransaction(r.db.QueryRowContext(ctx,
+		`SELECT `+transactionColumns+` FROM transactions WHERE idempotency_key = $1`,
+		idempotencyKey,
+	))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.Transaction{}, model.ErrTransactionNotFound
+		}
+		return model.Transaction{}, err
+	}
+
+	return converter.TransactionToModel(transaction), nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanTransaction читает строку с колонками transactionColumns.
+func scanTransaction(row rowScanner) (repoModel.Transaction, error) {
+	var (
+		transaction repoModel.Transaction
+		updatedAt   sql.NullTime
+		expiresAt   sql.NullTime
+		parentUUID  sql.NullString
+	)
+
+	err := row.Scan(
+		&transaction.UUID,
+		&transaction.OrderUUID,
+		&transaction.UserUUID,
+		&transaction.PaymentMethod,
+		&transaction.Amount,
+		&transaction.RefundedAmount,
+		&transaction.Currency,
+		&transaction.Status,
+		&transaction.CreatedAt,
+		&updatedAt,
+		&expiresAt,
+		&transaction.Kind,
+		&parentUUID,
+	)
+	if err != nil {
+		return repoModel.Transaction{}, err
+	}
+
+	if updatedAt.Valid {
+		transaction.UpdatedAt = &updatedAt.Time
+	}
+	if expiresAt.Valid {
+		transaction.AuthorizationExpiresAt = &expiresAt.Time
+	}
+	if parentUUID.Valid {
+		transaction.ParentTransactionUUID = &parentUUID.String
+	}
+
+	return transaction, nil
+}
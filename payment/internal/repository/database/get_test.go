@@ -0,0 +1,16 @@
+package database
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+)
+
+func (s *RepositorySuite) TestGetTransactionNotFound() {
+	// Act
+	res, err := s.repo.GetTransaction(s.ctx, gofakeit.UUID())
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrTransactionNotFound)
+	s.Require().Equal(model.Transaction{}, res)
+}
@@ -0,0 +1,136 @@
+package database
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	"github.com/baryshnikkov/rocket-factory/payment/internal/repository/converter"
+)
+
+func (r *repository) ListTransactions(ctx context.Context, filter model.TransactionsFilter, page model.TransactionsPageRequest) (model.TransactionsPage, error) {
+	where, args := buildFilter(filter)
+
+	countQuery := `SELECT COUNT(*) FROM transactions`
+	if where != "" {
+		countQuery += ` WHERE ` + where
+	}
+
+	var total int32
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		return model.TransactionsPage{}, err
+	}
+	if total == 0 {
+		return model.TransactionsPage{}, nil
+	}
+
+	var conds []string
+	if where != "" {
+		conds = append(conds, where)
+	}
+	if page.After != nil {
+		conds = append(conds, afterCondition(&args, *page.After))
+	}
+
+	query := `SELECT ` + transactionColumns + ` FROM transactions`
+	if len(conds) > 0 {
+		query += ` WHERE ` + strings.Join(conds, " AND ")
+	}
+	query += ` ORDER BY created_at DESC, uuid DESC`
+
+	// Запрашиваем на одну транзакцию больше, чтобы понять, есть ли следующая страница
+	if page.PageSize > 0 {
+		args = append(args, page.PageSize+1)
+		query += ` LIMIT ` + placeholder(len(args))
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return model.TransactionsPage{}, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	result := model.TransactionsPage{TotalSize: total}
+	for rows.Next() {
+		transaction, scanErr := scanTransaction(rows)
+		if scanErr != nil {
+			return model.TransactionsPage{}, scanErr
+		}
+		result.Transactions = append(result.Transactions, converter.TransactionToModel(transaction))
+	}
+	if err = rows.Err(); err != nil {
+		return model.TransactionsPage{}, err
+	}
+
+	if page.PageSize > 0 && len(result.Transactions) > int(page.PageSize) {
+		result.Transactions = result.Transactions[:page.PageSize]
+		result.Next = model.NewTransactionsCursor(result.Transactions[len(result.Transactions)-1])
+	}
+
+	return result, nil
+}
+
+// afterCondition отбирает транзакции, идущие в порядке выдачи строго после курсора.
+func afterCondition(args *[]any, after model.TransactionsCursor) string {
+	*args = append(*args, after.CreatedAt.UTC())
+	cond := `(created_at < ` + placeholder(len(*args))
+	*args = append(*args, after.CreatedAt.UTC())
+	cond += ` OR (created_at = ` + placeholder(len(*args))
+	*args = append(*args, after.UUID)
+	cond += ` AND uuid < ` + placeholder(len(*args)) + `))`
+
+	return cond
+}
+
+// buildFilter собирает условие WHERE по фильтру; пустые поля фильтра не ограничивают выборку.
+func buildFilter(filter model.TransactionsFilter) (string, []any) {
+	var (
+		conds []string
+		args  []any
+	)
+
+	if filter.UserUUID != "" {
+		args = append(args, filter.UserUUID)
+		conds = append(conds, `user_uuid = `+placeholder(len(args)))
+	}
+
+	if filter.OrderUUID != "" {
+		args = append(args, filter.OrderUUID)
+		conds = append(conds, `order_uuid = `+placeholder(len(args)))
+	}
+
+	if len(filter.Statuses) > 0 {
+		items := make([]string, 0, len(filter.Statuses))
+		for _, status := range filter.Statuses {
+			args = append(args, string(status))
+			items = append(items, placeholder(len(args)))
+		}
+		conds = append(conds, `status IN (`+strings.Join(items, ", ")+`)`)
+	}
+
+	if len(filter.Kinds) > 0 {
+		items := make([]string, 0, len(filter.Kinds))
+		for _, kind := range filter.Kinds {
+			args = append(args, string(kind))
+			items = append(items, placeholder(len(args)))
+		}
+		conds = append(conds, `kind IN (`+strings.Join(items, ", ")+`)`)
+	}
+
+	if filter.CreatedFrom != nil {
+		args = append(args, filter.CreatedFrom.UTC())
+		conds = append(conds, `created_at >= `+placeholder(len(args)))
+	}
+
+	if filter.CreatedTo != nil {
+		args = append(args, filter.CreatedTo.UTC())
+		conds = append(conds, `created_at <= `+placeholder(len(args)))
+	}
+
+	return strings.Join(conds, " AND "), args
+}
+
+func placeholder(n int) string {
+	return "$" + strconv.Itoa(n)
+}
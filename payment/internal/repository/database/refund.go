@@ -0,0 +1,87 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+)
+
+func (r *repository) RefundTransaction(ctx context.Context, transactionUUID string, amount int64) (model.Transaction, model.Transaction, error) {
+	for {
+		transaction, err := r.GetTransaction(ctx, transactionUUID)
+		if err != nil {
+			return model.Transaction{}, model.Transaction{}, err
+		}
+
+		refunded, refund, err := transaction.ApplyRefund(amount, time.Now().UTC())
+		if err != nil {
+			return model.Transaction{}, model.Transaction{}, err
+		}
+		refund.UUID = uuid.NewString()
+
+		applied, err := r.refundTransaction(ctx, transaction, refunded, refund)
+		if err != nil {
+			return model.Transaction{}, model.Transaction{}, err
+		}
+		if applied {
+			return refunded, refund, nil
+		}
+	}
+}
+
+// refundTransaction обновляет оплату и записывает возврат в одной транзакции; false - оплату успел
+// изменить параллельный возврат, и её нужно перечитать
+func (r *repository) refundTransaction(ctx context.Context, transaction, refunded, refund model.Transaction) (applied bool, err error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err != nil || !applied {
+			_ = tx.Rollback()
+		}
+	}()
+
+	// Условие на уже возвращённую сумму делает обновление compare-and-set: если параллельный
+	// возврат успел изменить транзакцию, перечитываем её и проверяем остаток заново
+	res, err := tx.ExecContext(ctx,
+		`UPDATE transactions SET refunded_amount = $1, status = $2, updated_at = $3
+		WHERE uuid = $4 AND refunded_amount = $5 AND status = $6`,
+		refunded.RefundedAmount,
+		string(refunded.Status),
+		*refunded.UpdatedAt,
+		transaction.UUID,
+		transaction.RefundedAmount,
+		string(transaction.Status),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if affected == 0 {
+		return false, nil
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO transactions (uuid, order_uuid, user_uuid, payment_method, amount, currency, status, created_at, kind, parent_transaction_uuid)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		refund.UUID, refund.OrderUUID, refund.UserUUID, string(refund.PaymentMethod), refund.Amount,
+		string(refund.Currency), string(refund.Status), refund.CreatedAt, string(refund.Kind), refund.ParentTransactionUUID,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
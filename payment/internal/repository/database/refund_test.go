@@ -0,0 +1,173 @@
+package database
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+)
+
+func (s *RepositorySuite) newTransaction(amount int64) model.Transaction {
+	transaction, _, err := s.repo.CreateTransaction(s.ctx, model.TransactionInfo{
+		OrderUUID:     gofakeit.UUID(),
+		UserUUID:      gofakeit.UUID(),
+		PaymentMethod: model.Card,
+		Amount:        amount,
+	})
+	s.Require().NoError(err)
+
+	transaction, err = s.repo.TransitionTransaction(s.ctx, transaction.UUID, model.TransactionStatusSucceeded)
+	s.Require().NoError(err)
+
+	return transaction
+}
+
+func (s *RepositorySuite) TestRefundTransactionPartialThenRest() {
+	// Arrange
+	transaction := s.newTransaction(300)
+
+	// Act
+	partial, partialRefund, err := s.repo.RefundTransaction(s.ctx, transaction.UUID, 100)
+	s.Require().NoError(err)
+	rest, restRefund, err := s.repo.RefundTransaction(s.ctx, transaction.UUID, 0)
+	s.Require().NoError(err)
+
+	// Assert
+	s.Require().Equal(model.TransactionStatusPartiallyRefunded, partial.Status)
+	s.Require().Equal(int64(100), partial.RefundedAmount)
+	s.Require().Equal(model.TransactionStatusRefunded, rest.Status)
+	s.Require().Equal(int64(300), rest.RefundedAmount)
+
+	stored, err := s.repo.GetTransaction(s.ctx, transaction.UUID)
+	s.Require().NoError(err)
+	s.Require().Equal(model.TransactionStatusRefunded, stored.Status)
+	s.Require().Equal(int64(300), stored.RefundedAmount)
+	s.Require().NotNil(stored.UpdatedAt)
+
+	// Каждый возврат записан в журнал отдельной транзакцией и ждёт ответа провайдера
+	s.Require().Equal(int64(100), partialRefund.Amount)
+	s.Require().Equal(int64(200), restRefund.Amount)
+	for _, refund := range []model.Transaction{partialRefund, restRefund} {
+		storedRefund, err := s.repo.GetTransaction(s.ctx, refund.UUID)
+		s.Require().NoError(err)
+		s.Require().Equal(model.TransactionKindRefund, storedRefund.Kind)
+		s.Require().Equal(transaction.UUID, storedRefund.ParentTransactionUUID)
+		s.Require().Equal(transaction.OrderUUID, storedRefund.OrderUUID)
+		s.Require().Equal(refund.Amount, storedRefund.Amount)
+		s.Require().Equal(model.TransactionStatusPending, storedRefund.Status)
+	}
+	s.Require().Equal(model.TransactionKindPayment, stored.Kind)
+}
+
+func (s *RepositorySuite) TestRefundTransactionPartsAddUpToAmount() {
+	// Arrange
+	transaction := s.newTransaction(30)
+
+	// Act
+	_, _, err := s.repo.RefundTransaction(s.ctx, transaction.UUID, 20)
+	s.Require().NoError(err)
+	rest, _, err := s.repo.RefundTransaction(s.ctx, transaction.UUID, 10)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(model.TransactionStatusRefunded, rest.Status)
+	s.Require().Equal(int64(30), rest.RefundedAmount)
+}
+
+func (s *RepositorySuite) TestRefundTransactionOfRefund() {
+	// Arrange
+	transaction := s.newTransaction(300)
+	_, refund, err := s.repo.RefundTransaction(s.ctx, transaction.UUID, 100)
+	s.Require().NoError(err)
+
+	// Act
+	_, _, err = s.repo.RefundTransaction(s.ctx, refund.UUID, 0)
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrInvalidTransaction)
+}
+
+func (s *RepositorySuite) TestRefundTransactionExceedsAmount() {
+	// Arrange
+	transaction := s.newTransaction(300)
+
+	// Act
+	_, _, err := s.repo.RefundTransaction(s.ctx, transaction.UUID, 301)
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrRefundExceedsAmount)
+
+	stored, err := s.repo.GetTransaction(s.ctx, transaction.UUID)
+	s.Require().NoError(err)
+	s.Require().Equal(model.TransactionStatusSucceeded, stored.Status)
+}
+
+func (s *RepositorySuite) TestRefundTransactionAlreadyRefunded() {
+	// Arrange
+	transaction := s.newTransaction(300)
+	_, _, err := s.repo.RefundTransaction(s.ctx, transaction.UUID, 0)
+	s.Require().NoError(err)
+
+	// Act
+	_, _, err = s.repo.RefundTransaction(s.ctx, transaction.UUID, 0)
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrTransactionAlreadyRefunded)
+}
+
+func (s *RepositorySuite) TestRefundTransactionNotPaid() {
+	// Arrange
+	transaction, _, err := s.repo.CreateTransaction(s.ctx, model.TransactionInfo{
+		OrderUUID:     gofakeit.UUID(),
+		UserUUID:      gofakeit.UUID(),
+		PaymentMethod: model.Card,
+		Amount:        300,
+	})
+	s.Require().NoError(err)
+
+	// Act
+	_, _, err = s.repo.RefundTransaction(s.ctx, transaction.UUID, 0)
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrTransactionNotPaid)
+}
+
+func (s *RepositorySuite) TestRefundTransactionHoldNotPaid() {
+	for _, path := range [][]model.TransactionStatus{
+		{model.TransactionStatusAuthorized},
+		{model.TransactionStatusAuthorized, model.TransactionStatusCapturing},
+		{model.TransactionStatusAuthorized, model.TransactionStatusVoiding, model.TransactionStatusVoided},
+		{model.TransactionStatusAuthorized, model.TransactionStatusExpired},
+	} {
+		// Arrange
+		transaction, _, err := s.repo.CreateTransaction(s.ctx, model.TransactionInfo{
+			OrderUUID:     gofakeit.UUID(),
+			UserUUID:      gofakeit.UUID(),
+			PaymentMethod: model.Card,
+			Amount:        300,
+		})
+		s.Require().NoError(err)
+		for _, status := range path {
+			_, err = s.repo.TransitionTransaction(s.ctx, transaction.UUID, status)
+			s.Require().NoError(err)
+		}
+		status := path[len(path)-1]
+
+		// Act
+		_, _, err = s.repo.RefundTransaction(s.ctx, transaction.UUID, 0)
+
+		// Assert
+		s.Require().ErrorIs(err, model.ErrTransactionNotPaid, status)
+
+		stored, err := s.repo.GetTransaction(s.ctx, transaction.UUID)
+		s.Require().NoError(err)
+		s.Require().Equal(status, stored.Status)
+	}
+}
+
+func (s *RepositorySuite) TestRefundTransactionNotFound() {
+	// Act
+	_, _, err := s.repo.RefundTransaction(s.ctx, gofakeit.UUID(), 0)
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrTransactionNotFound)
+}
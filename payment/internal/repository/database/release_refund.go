@@ -0,0 +1,100 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+)
+
+func (r *repository) ReleaseRefund(ctx context.Context, refundUUID string) (model.Transaction, model.Transaction, error) {
+	for {
+		refund, err := r.GetTransaction(ctx, refundUUID)
+		if err != nil {
+			return model.Transaction{}, model.Transaction{}, err
+		}
+		if refund.Kind != model.TransactionKindRefund {
+			return model.Transaction{}, model.Transaction{}, model.ErrInvalidTransaction
+		}
+
+		transaction, err := r.GetTransaction(ctx, refund.ParentTransactionUUID)
+		if err != nil {
+			return model.Transaction{}, model.Transaction{}, err
+		}
+
+		released, failed, err := transaction.ReleaseRefund(refund, time.Now().UTC())
+		if err != nil {
+			return model.Transaction{}, model.Transaction{}, err
+		}
+
+		applied, err := r.releaseRefund(ctx, transaction, released, refund, failed)
+		if err != nil {
+			return model.Transaction{}, model.Transaction{}, err
+		}
+		if applied {
+			return released, failed, nil
+		}
+	}
+}
+
+// releaseRefund закрывает возврат и возвращает его сумму в остаток оплаты в одной транзакции;
+// false - оплату или возврат успели изменить, и их нужно перечитать
+func (r *repository) releaseRefund(ctx context.Context, transaction, released, refund, failed model.Transaction) (applied bool, err error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err != nil || !applied {
+			_ = tx.Rollback()
+		}
+	}()
+
+	res, err := tx.ExecContext(ctx,
+		`UPDATE transactions SET status = $1, updated_at = $2 WHERE uuid = $3 AND status = $4`,
+		string(failed.Status),
+		*failed.UpdatedAt,
+		refund.UUID,
+		string(refund.Status),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if affected == 0 {
+		return false, nil
+	}
+
+	// Как и в refundTransaction, условие на возвращённую сумму делает обновление compare-and-set
+	res, err = tx.ExecContext(ctx,
+		`UPDATE transactions SET refunded_amount = $1, status = $2, updated_at = $3
+		WHERE uuid = $4 AND refunded_amount = $5 AND status = $6`,
+		released.RefundedAmount,
+		string(released.Status),
+		*released.UpdatedAt,
+		transaction.UUID,
+		transaction.RefundedAmount,
+		string(transaction.Status),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err = res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if affected == 0 {
+		return false, nil
+	}
+
+	if err = tx.Commit(); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
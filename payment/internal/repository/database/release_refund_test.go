@@ -0,0 +1,67 @@
+package database
+
+import (
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+)
+
+func (s *RepositorySuite) TestReleaseRefundRestoresRemaining() {
+	// Arrange
+	transaction := s.newTransaction(300)
+	_, partial, err := s.repo.RefundTransaction(s.ctx, transaction.UUID, 100)
+	s.Require().NoError(err)
+	_, rest, err := s.repo.RefundTransaction(s.ctx, transaction.UUID, 0)
+	s.Require().NoError(err)
+
+	// Act
+	released, failed, err := s.repo.ReleaseRefund(s.ctx, rest.UUID)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(model.TransactionStatusPartiallyRefunded, released.Status)
+	s.Require().Equal(int64(100), released.RefundedAmount)
+	s.Require().Equal(model.TransactionStatusFailed, failed.Status)
+
+	storedRefund, err := s.repo.GetTransaction(s.ctx, rest.UUID)
+	s.Require().NoError(err)
+	s.Require().Equal(model.TransactionStatusFailed, storedRefund.Status)
+
+	released, _, err = s.repo.ReleaseRefund(s.ctx, partial.UUID)
+	s.Require().NoError(err)
+	s.Require().Equal(model.TransactionStatusSucceeded, released.Status)
+	s.Require().Zero(released.RefundedAmount)
+
+	stored, err := s.repo.GetTransaction(s.ctx, transaction.UUID)
+	s.Require().NoError(err)
+	s.Require().Equal(released.Status, stored.Status)
+	s.Require().Zero(stored.RefundedAmount)
+}
+
+func (s *RepositorySuite) TestReleaseRefundTwice() {
+	// Arrange
+	transaction := s.newTransaction(300)
+	_, refund, err := s.repo.RefundTransaction(s.ctx, transaction.UUID, 0)
+	s.Require().NoError(err)
+	_, _, err = s.repo.ReleaseRefund(s.ctx, refund.UUID)
+	s.Require().NoError(err)
+
+	// Act
+	_, _, err = s.repo.ReleaseRefund(s.ctx, refund.UUID)
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrInvalidTransactionTransition)
+
+	stored, err := s.repo.GetTransaction(s.ctx, transaction.UUID)
+	s.Require().NoError(err)
+	s.Require().Zero(stored.RefundedAmount)
+}
+
+func (s *RepositorySuite) TestReleaseRefundOfPayment() {
+	// Arrange
+	transaction := s.newTransaction(300)
+
+	// Act
+	_, _, err := s.repo.ReleaseRefund(s.ctx, transaction.UUID)
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrInvalidTransaction)
+}
@@ -0,0 +1,21 @@
+package database
+
+import (
+	"database/sql"
+
+	def "github.com/baryshnikkov/rocket-factory/payment/internal/repository"
+)
+
+var _ def.TransactionRepository = (*repository)(nil)
+
+// repository — реализация TransactionRepository поверх SQL-базы (PostgreSQL или SQLite).
+// Схема создаётся миграциями из пакета migrations, см. Open.
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) *repository {
+	return &repository{
+		db: db,
+	}
+}
@@ -0,0 +1,38 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/baryshnikkov/rocket-factory/payment/migrations"
+	sharedDatabase "github.com/baryshnikkov/rocket-factory/shared/pkg/database"
+)
+
+type RepositorySuite struct {
+	suite.Suite
+	ctx context.Context
+
+	db   *sql.DB
+	repo *repository
+}
+
+func (s *RepositorySuite) SetupTest() {
+	s.ctx = context.Background()
+
+	db, err := sharedDatabase.Open(s.ctx, sharedDatabase.DriverSQLite, ":memory:", migrations.FS)
+	s.Require().NoError(err)
+
+	s.db = db
+	s.repo = NewRepository(db)
+}
+
+func (s *RepositorySuite) TearDownTest() {
+	s.Require().NoError(s.db.Close())
+}
+
+func TestRepositoryTransactionDatabaseIntegration(t *testing.T) {
+	suite.Run(t, new(RepositorySuite))
+}
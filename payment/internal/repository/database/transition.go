@@ -0,0 +1,47 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+)
+
+func (r *repository) TransitionTransaction(ctx context.Context, transactionUUID string, status model.TransactionStatus) (model.Transaction, error) {
+	query := `UPDATE transactions SET status = $1, updated_at = $2 WHERE uuid = $3 AND status = $4`
+	if status == model.TransactionStatusFailed {
+		query = `UPDATE transactions SET status = $1, updated_at = $2, idempotency_key = NULL WHERE uuid = $3 AND status = $4`
+	}
+
+	for {
+		transaction, err := r.GetTransaction(ctx, transactionUUID)
+		if err != nil {
+			return model.Transaction{}, err
+		}
+
+		transitioned, err := transaction.Transition(status, time.Now().UTC())
+		if err != nil {
+			return model.Transaction{}, err
+		}
+
+		// Условие на прежний статус делает обновление compare-and-set: если статус успели
+		// сменить, перечитываем транзакцию и проверяем переход заново
+		res, err := r.db.ExecContext(ctx, query,
+			string(transitioned.Status),
+			*transitioned.UpdatedAt,
+			transactionUUID,
+			string(transaction.Status),
+		)
+		if err != nil {
+			return model.Transaction{}, err
+		}
+
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return model.Transaction{}, err
+		}
+		if affected > 0 {
+			return transitioned, nil
+		}
+	}
+}
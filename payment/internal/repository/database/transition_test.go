@@ -0,0 +1,125 @@
+package database
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+)
+
+func pendingTransactionInfo() model.TransactionInfo {
+	return model.TransactionInfo{
+		OrderUUID:      gofakeit.UUID(),
+		UserUUID:       gofakeit.UUID(),
+		PaymentMethod:  model.Card,
+		Amount:         100,
+		Currency:       model.RUB,
+		IdempotencyKey: gofakeit.UUID(),
+	}
+}
+
+func (s *RepositorySuite) TestTransitionTransactionSucceeded() {
+	// Arrange
+	info := pendingTransactionInfo()
+	transaction, _, err := s.repo.CreateTransaction(s.ctx, info)
+	s.Require().NoError(err)
+
+	// Act
+	finished, err := s.repo.TransitionTransaction(s.ctx, transaction.UUID, model.TransactionStatusSucceeded)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(model.TransactionStatusSucceeded, finished.Status)
+	s.Require().NotNil(finished.UpdatedAt)
+
+	stored, err := s.repo.GetTransaction(s.ctx, transaction.UUID)
+	s.Require().NoError(err)
+	s.Require().Equal(model.TransactionStatusSucceeded, stored.Status)
+
+	replay, created, err := s.repo.CreateTransaction(s.ctx, info)
+	s.Require().NoError(err)
+	s.Require().False(created)
+	s.Require().Equal(transaction.UUID, replay.UUID)
+}
+
+func (s *RepositorySuite) TestTransitionTransactionFailedReleasesKey() {
+	// Arrange
+	info := pendingTransactionInfo()
+	transaction, _, err := s.repo.CreateTransaction(s.ctx, info)
+	s.Require().NoError(err)
+
+	// Act
+	finished, err := s.repo.TransitionTransaction(s.ctx, transaction.UUID, model.TransactionStatusFailed)
+	s.Require().NoError(err)
+	retry, created, err := s.repo.CreateTransaction(s.ctx, info)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(model.TransactionStatusFailed, finished.Status)
+	s.Require().True(created)
+	s.Require().NotEqual(transaction.UUID, retry.UUID)
+
+	stored, err := s.repo.GetTransaction(s.ctx, transaction.UUID)
+	s.Require().NoError(err)
+	s.Require().Equal(model.TransactionStatusFailed, stored.Status)
+}
+
+func (s *RepositorySuite) TestTransitionTransactionNotAllowed() {
+	// Arrange
+	transaction, _, err := s.repo.CreateTransaction(s.ctx, pendingTransactionInfo())
+	s.Require().NoError(err)
+	_, err = s.repo.TransitionTransaction(s.ctx, transaction.UUID, model.TransactionStatusSucceeded)
+	s.Require().NoError(err)
+
+	// Act
+	_, err = s.repo.TransitionTransaction(s.ctx, transaction.UUID, model.TransactionStatusFailed)
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrInvalidTransactionTransition)
+
+	stored, err := s.repo.GetTransaction(s.ctx, transaction.UUID)
+	s.Require().NoError(err)
+	s.Require().Equal(model.TransactionStatusSucceeded, stored.Status)
+}
+
+func (s *RepositorySuite) TestTransitionTransactionAuthorization() {
+	// Arrange
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	info := pendingTransactionInfo()
+	info.AuthorizationExpiresAt = &expiresAt
+	transaction, _, err := s.repo.CreateTransaction(s.ctx, info)
+	s.Require().NoError(err)
+
+	// Act
+	authorized, err := s.repo.TransitionTransaction(s.ctx, transaction.UUID, model.TransactionStatusAuthorized)
+	s.Require().NoError(err)
+	_, directErr := s.repo.TransitionTransaction(s.ctx, transaction.UUID, model.TransactionStatusSucceeded)
+	capturing, err := s.repo.TransitionTransaction(s.ctx, transaction.UUID, model.TransactionStatusCapturing)
+	s.Require().NoError(err)
+	_, voidErr := s.repo.TransitionTransaction(s.ctx, transaction.UUID, model.TransactionStatusVoiding)
+	captured, err := s.repo.TransitionTransaction(s.ctx, transaction.UUID, model.TransactionStatusSucceeded)
+	s.Require().NoError(err)
+
+	// Assert
+	s.Require().Equal(model.TransactionStatusAuthorized, authorized.Status)
+	// Холд списывается только после того, как списание заняло транзакцию
+	s.Require().ErrorIs(directErr, model.ErrInvalidTransactionTransition)
+	s.Require().Equal(model.TransactionStatusCapturing, capturing.Status)
+	s.Require().ErrorIs(voidErr, model.ErrInvalidTransactionTransition)
+	s.Require().Equal(model.TransactionStatusSucceeded, captured.Status)
+
+	stored, err := s.repo.GetTransaction(s.ctx, transaction.UUID)
+	s.Require().NoError(err)
+	s.Require().Equal(model.TransactionStatusSucceeded, stored.Status)
+	s.Require().NotNil(stored.AuthorizationExpiresAt)
+	s.Require().True(expiresAt.Equal(*stored.AuthorizationExpiresAt))
+}
+
+func (s *RepositorySuite) TestTransitionTransactionNotFound() {
+	// Act
+	_, err := s.repo.TransitionTransaction(s.ctx, gofakeit.UUID(), model.TransactionStatusSucceeded)
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrTransactionNotFound)
+}
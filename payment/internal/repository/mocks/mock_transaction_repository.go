@@ -0,0 +1,405 @@
+// Code generated for baryshnikkov service
+// © baryshnikkov 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TransactionRepository is an autogenerated mock type for the TransactionRepository type
+type TransactionRepository struct {
+	mock.Mock
+}
+
+type TransactionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TransactionRepository) EXPECT() *TransactionRepository_Expecter {
+	return &TransactionRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateTransaction provides a mock function with given fields: ctx, info
+func (_m *TransactionRepository) CreateTransaction(ctx context.Context, info model.TransactionInfo) (model.Transaction, bool, error) {
+	ret := _m.Called(ctx, info)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTransaction")
+	}
+
+	var r0 model.Transaction
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.TransactionInfo) (model.Transaction, bool, error)); ok {
+		return rf(ctx, info)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.TransactionInfo) model.Transaction); ok {
+		r0 = rf(ctx, info)
+	} else {
+		r0 = ret.Get(0).(model.Transaction)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.TransactionInfo) bool); ok {
+		r1 = rf(ctx, info)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, model.TransactionInfo) error); ok {
+		r2 = rf(ctx, info)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// TransactionRepository_CreateTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTransaction'
+type TransactionRepository_CreateTransaction_Call struct {
+	*mock.Call
+}
+
+// CreateTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - info model.TransactionInfo
+func (_e *TransactionRepository_Expecter) CreateTransaction(ctx interface{}, info interface{}) *TransactionRepository_CreateTransaction_Call {
+	return &TransactionRepository_CreateTransaction_Call{Call: _e.mock.On("CreateTransaction", ctx, info)}
+}
+
+func (_c *TransactionRepository_CreateTransaction_Call) Run(run func(ctx context.Context, info model.TransactionInfo)) *TransactionRepository_CreateTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.TransactionInfo))
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_CreateTransaction_Call) Return(transaction model.Transaction, created bool, err error) *TransactionRepository_CreateTransaction_Call {
+	_c.Call.Return(transaction, created, err)
+	return _c
+}
+
+func (_c *TransactionRepository_CreateTransaction_Call) RunAndReturn(run func(context.Context, model.TransactionInfo) (model.Transaction, bool, error)) *TransactionRepository_CreateTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTransaction provides a mock function with given fields: ctx, transactionUUID
+func (_m *TransactionRepository) GetTransaction(ctx context.Context, transactionUUID string) (model.Transaction, error) {
+	ret := _m.Called(ctx, transactionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransaction")
+	}
+
+	var r0 model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.Transaction, error)); ok {
+		return rf(ctx, transactionUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.Transaction); ok {
+		r0 = rf(ctx, transactionUUID)
+	} else {
+		r0 = ret.Get(0).(model.Transaction)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, transactionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransactionRepository_GetTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransaction'
+type TransactionRepository_GetTransaction_Call struct {
+	*mock.Call
+}
+
+// GetTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID string
+func (_e *TransactionRepository_Expecter) GetTransaction(ctx interface{}, transactionUUID interface{}) *TransactionRepository_GetTransaction_Call {
+	return &TransactionRepository_GetTransaction_Call{Call: _e.mock.On("GetTransaction", ctx, transactionUUID)}
+}
+
+func (_c *TransactionRepository_GetTransaction_Call) Run(run func(ctx context.Context, transactionUUID string)) *TransactionRepository_GetTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_GetTransaction_Call) Return(_a0 model.Transaction, _a1 error) *TransactionRepository_GetTransaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransactionRepository_GetTransaction_Call) RunAndReturn(run func(context.Context, string) (model.Transaction, error)) *TransactionRepository_GetTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTransactions provides a mock function with given fields: ctx, filter, page
+func (_m *TransactionRepository) ListTransactions(ctx context.Context, filter model.TransactionsFilter, page model.TransactionsPageRequest) (model.TransactionsPage, error) {
+	ret := _m.Called(ctx, filter, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTransactions")
+	}
+
+	var r0 model.TransactionsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.TransactionsFilter, model.TransactionsPageRequest) (model.TransactionsPage, error)); ok {
+		return rf(ctx, filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.TransactionsFilter, model.TransactionsPageRequest) model.TransactionsPage); ok {
+		r0 = rf(ctx, filter, page)
+	} else {
+		r0 = ret.Get(0).(model.TransactionsPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.TransactionsFilter, model.TransactionsPageRequest) error); ok {
+		r1 = rf(ctx, filter, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransactionRepository_ListTransactions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTransactions'
+type TransactionRepository_ListTransactions_Call struct {
+	*mock.Call
+}
+
+// ListTransactions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.TransactionsFilter
+//   - page model.TransactionsPageRequest
+func (_e *TransactionRepository_Expecter) ListTransactions(ctx interface{}, filter interface{}, page interface{}) *TransactionRepository_ListTransactions_Call {
+	return &TransactionRepository_ListTransactions_Call{Call: _e.mock.On("ListTransactions", ctx, filter, page)}
+}
+
+func (_c *TransactionRepository_ListTransactions_Call) Run(run func(ctx context.Context, filter model.TransactionsFilter, page model.TransactionsPageRequest)) *TransactionRepository_ListTransactions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.TransactionsFilter), args[2].(model.TransactionsPageRequest))
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_ListTransactions_Call) Return(_a0 model.TransactionsPage, _a1 error) *TransactionRepository_ListTransactions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransactionRepository_ListTransactions_Call) RunAndReturn(run func(context.Context, model.TransactionsFilter, model.TransactionsPageRequest) (model.TransactionsPage, error)) *TransactionRepository_ListTransactions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefundTransaction provides a mock function with given fields: ctx, transactionUUID, amount
+func (_m *TransactionRepository) RefundTransaction(ctx context.Context, transactionUUID string, amount int64) (model.Transaction, model.Transaction, error) {
+	ret := _m.Called(ctx, transactionUUID, amount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefundTransaction")
+	}
+
+	var r0 model.Transaction
+	var r1 model.Transaction
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) (model.Transaction, model.Transaction, error)); ok {
+		return rf(ctx, transactionUUID, amount)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) model.Transaction); ok {
+		r0 = rf(ctx, transactionUUID, amount)
+	} else {
+		r0 = ret.Get(0).(model.Transaction)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) model.Transaction); ok {
+		r1 = rf(ctx, transactionUUID, amount)
+	} else {
+		r1 = ret.Get(1).(model.Transaction)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, int64) error); ok {
+		r2 = rf(ctx, transactionUUID, amount)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// TransactionRepository_RefundTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefundTransaction'
+type TransactionRepository_RefundTransaction_Call struct {
+	*mock.Call
+}
+
+// RefundTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID string
+//   - amount int64
+func (_e *TransactionRepository_Expecter) RefundTransaction(ctx interface{}, transactionUUID interface{}, amount interface{}) *TransactionRepository_RefundTransaction_Call {
+	return &TransactionRepository_RefundTransaction_Call{Call: _e.mock.On("RefundTransaction", ctx, transactionUUID, amount)}
+}
+
+func (_c *TransactionRepository_RefundTransaction_Call) Run(run func(ctx context.Context, transactionUUID string, amount int64)) *TransactionRepository_RefundTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_RefundTransaction_Call) Return(transaction model.Transaction, refund model.Transaction, err error) *TransactionRepository_RefundTransaction_Call {
+	_c.Call.Return(transaction, refund, err)
+	return _c
+}
+
+func (_c *TransactionRepository_RefundTransaction_Call) RunAndReturn(run func(context.Context, string, int64) (model.Transaction, model.Transaction, error)) *TransactionRepository_RefundTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseRefund provides a mock function with given fields: ctx, refundUUID
+func (_m *TransactionRepository) ReleaseRefund(ctx context.Context, refundUUID string) (model.Transaction, model.Transaction, error) {
+	ret := _m.Called(ctx, refundUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseRefund")
+	}
+
+	var r0 model.Transaction
+	var r1 model.Transaction
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.Transaction, model.Transaction, error)); ok {
+		return rf(ctx, refundUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.Transaction); ok {
+		r0 = rf(ctx, refundUUID)
+	} else {
+		r0 = ret.Get(0).(model.Transaction)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) model.Transaction); ok {
+		r1 = rf(ctx, refundUUID)
+	} else {
+		r1 = ret.Get(1).(model.Transaction)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(ctx, refundUUID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// TransactionRepository_ReleaseRefund_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseRefund'
+type TransactionRepository_ReleaseRefund_Call struct {
+	*mock.Call
+}
+
+// ReleaseRefund is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refundUUID string
+func (_e *TransactionRepository_Expecter) ReleaseRefund(ctx interface{}, refundUUID interface{}) *TransactionRepository_ReleaseRefund_Call {
+	return &TransactionRepository_ReleaseRefund_Call{Call: _e.mock.On("ReleaseRefund", ctx, refundUUID)}
+}
+
+func (_c *TransactionRepository_ReleaseRefund_Call) Run(run func(ctx context.Context, refundUUID string)) *TransactionRepository_ReleaseRefund_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_ReleaseRefund_Call) Return(transaction model.Transaction, refund model.Transaction, err error) *TransactionRepository_ReleaseRefund_Call {
+	_c.Call.Return(transaction, refund, err)
+	return _c
+}
+
+func (_c *TransactionRepository_ReleaseRefund_Call) RunAndReturn(run func(context.Context, string) (model.Transaction, model.Transaction, error)) *TransactionRepository_ReleaseRefund_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransitionTransaction provides a mock function with given fields: ctx, transactionUUID, status
+func (_m *TransactionRepository) TransitionTransaction(ctx context.Context, transactionUUID string, status model.TransactionStatus) (model.Transaction, error) {
+	ret := _m.Called(ctx, transactionUUID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransitionTransaction")
+	}
+
+	var r0 model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.TransactionStatus) (model.Transaction, error)); ok {
+		return rf(ctx, transactionUUID, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.TransactionStatus) model.Transaction); ok {
+		r0 = rf(ctx, transactionUUID, status)
+	} else {
+		r0 = ret.Get(0).(model.Transaction)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.TransactionStatus) error); ok {
+		r1 = rf(ctx, transactionUUID, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransactionRepository_TransitionTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransitionTransaction'
+type TransactionRepository_TransitionTransaction_Call struct {
+	*mock.Call
+}
+
+// TransitionTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID string
+//   - status model.TransactionStatus
+func (_e *TransactionRepository_Expecter) TransitionTransaction(ctx interface{}, transactionUUID interface{}, status interface{}) *TransactionRepository_TransitionTransaction_Call {
+	return &TransactionRepository_TransitionTransaction_Call{Call: _e.mock.On("TransitionTransaction", ctx, transactionUUID, status)}
+}
+
+func (_c *TransactionRepository_TransitionTransaction_Call) Run(run func(ctx context.Context, transactionUUID string, status model.TransactionStatus)) *TransactionRepository_TransitionTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(model.TransactionStatus))
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_TransitionTransaction_Call) Return(_a0 model.Transaction, _a1 error) *TransactionRepository_TransitionTransaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransactionRepository_TransitionTransaction_Call) RunAndReturn(run func(context.Context, string, model.TransactionStatus) (model.Transaction, error)) *TransactionRepository_TransitionTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTransactionRepository creates a new instance of TransactionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTransactionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TransactionRepository {
+	mock := &TransactionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+type Transaction struct {
+	UUID           string
+	OrderUUID      string
+	UserUUID       string
+	PaymentMethod  string
+	Amount         int64
+	RefundedAmount int64
+	Currency       string
+	IdempotencyKey *string
+	Status         string
+	CreatedAt      time.Time
+	UpdatedAt      *time.Time
+
+	AuthorizationExpiresAt *time.Time
+	Kind                   string
+	ParentTransactionUUID  *string
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+)
+
+// TransactionRepository - журнал оплат.
+type TransactionRepository interface {
+	// CreateTransaction атомарно записывает транзакцию в статусе PENDING, если транзакции с info.IdempotencyKey ещё нет.
+	// Иначе возвращает существующую транзакцию и created = false.
+	CreateTransaction(ctx context.Context, info model.TransactionInfo) (transaction model.Transaction, created bool, err error)
+	// TransitionTransaction атомарно переводит транзакцию в статус status, см. Transaction.Transition.
+	// У транзакции в FAILED освобождается ключ идемпотентности, чтобы оплату можно было повторить.
+	TransitionTransaction(ctx context.Context, transactionUUID string, status model.TransactionStatus) (model.Transaction, error)
+	GetTransaction(ctx context.Context, transactionUUID string) (model.Transaction, error)
+	// ListTransactions возвращает транзакции по фильтру от новых к старым.
+	ListTransactions(ctx context.Context, filter model.TransactionsFilter, page model.TransactionsPageRequest) (model.TransactionsPage, error)
+	// RefundTransaction атомарно применяет к транзакции возврат amount (0 — весь остаток)
+	// и записывает возврат в журнал отдельной транзакцией в PENDING, см. Transaction.ApplyRefund.
+	// Успешный возврат переводится в SUCCEEDED через TransitionTransaction.
+	RefundTransaction(ctx context.Context, transactionUUID string, amount int64) (transaction, refund model.Transaction, err error)
+	// ReleaseRefund атомарно переводит возврат в FAILED и возвращает его сумму в остаток оплаты,
+	// см. Transaction.ReleaseRefund.
+	ReleaseRefund(ctx context.Context, refundUUID string) (transaction, refund model.Transaction, err error)
+}
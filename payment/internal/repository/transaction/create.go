@@ -0,0 +1,43 @@
+package transaction
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	"github.com/baryshnikkov/rocket-factory/payment/internal/repository/converter"
+)
+
+func (r *repository) CreateTransaction(_ context.Context, info model.TransactionInfo) (model.Transaction, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if existingUUID, ok := r.keys[info.IdempotencyKey]; ok && info.IdempotencyKey != "" {
+		return converter.TransactionToModel(r.data[existingUUID]), false, nil
+	}
+
+	transaction := model.Transaction{
+		UUID:          uuid.NewString(),
+		OrderUUID:     info.OrderUUID,
+		UserUUID:      info.UserUUID,
+		PaymentMethod: info.PaymentMethod,
+		Amount:        info.Amount,
+		Currency:      info.Currency,
+		Status:        model.TransactionStatusPending,
+		Kind:          model.TransactionKindPayment,
+		CreatedAt:     time.Now(),
+
+		AuthorizationExpiresAt: info.AuthorizationExpiresAt,
+	}
+
+	stored := converter.TransactionToRepoModel(transaction)
+	if info.IdempotencyKey != "" {
+		stored.IdempotencyKey = &info.IdempotencyKey
+		r.keys[info.IdempotencyKey] = transaction.UUID
+	}
+	r.data[transaction.UUID] = stored
+
+	return transaction, true, nil
+}
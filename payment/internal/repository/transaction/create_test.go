@@ -0,0 +1,133 @@
+package transaction
+
+import (
+	"sync"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+)
+
+func (s *RepositorySuite) TestCreateTransactionPersists() {
+	// Arrange
+	info := model.TransactionInfo{
+		OrderUUID:     gofakeit.UUID(),
+		UserUUID:      gofakeit.UUID(),
+		PaymentMethod: model.SBP,
+		Amount:        125050,
+		Currency:      model.EUR,
+	}
+
+	// Act
+	created, isNew, err := s.repo.CreateTransaction(s.ctx, info)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().True(isNew)
+	s.Require().NotEmpty(created.UUID)
+	s.Require().Equal(model.TransactionStatusPending, created.Status)
+
+	res, err := s.repo.GetTransaction(s.ctx, created.UUID)
+	s.Require().NoError(err)
+	s.Require().Equal(info.OrderUUID, res.OrderUUID)
+	s.Require().Equal(info.UserUUID, res.UserUUID)
+	s.Require().Equal(info.PaymentMethod, res.PaymentMethod)
+	s.Require().Equal(info.Amount, res.Amount)
+	s.Require().Equal(info.Currency, res.Currency)
+	s.Require().Zero(res.RefundedAmount)
+	s.Require().Equal(model.TransactionStatusPending, res.Status)
+	s.Require().True(created.CreatedAt.Equal(res.CreatedAt))
+	s.Require().Nil(res.UpdatedAt)
+}
+
+func (s *RepositorySuite) TestCreateTransactionSameKeyReturnsExisting() {
+	// Arrange
+	info := model.TransactionInfo{
+		OrderUUID:      gofakeit.UUID(),
+		UserUUID:       gofakeit.UUID(),
+		PaymentMethod:  model.Card,
+		Amount:         100,
+		Currency:       model.RUB,
+		IdempotencyKey: gofakeit.UUID(),
+	}
+	first, _, err := s.repo.CreateTransaction(s.ctx, info)
+	s.Require().NoError(err)
+
+	// Act - повтор с тем же ключом, но другой суммой
+	info.Amount = 200
+	second, isNew, err := s.repo.CreateTransaction(s.ctx, info)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().False(isNew)
+	s.Require().Equal(first.UUID, second.UUID)
+	s.Require().Equal(int64(100), second.Amount)
+}
+
+func (s *RepositorySuite) TestCreateTransactionWithoutKeyIsNotDeduplicated() {
+	// Arrange
+	info := model.TransactionInfo{
+		OrderUUID:     gofakeit.UUID(),
+		UserUUID:      gofakeit.UUID(),
+		PaymentMethod: model.Card,
+		Amount:        100,
+		Currency:      model.RUB,
+	}
+
+	// Act
+	first, firstNew, err := s.repo.CreateTransaction(s.ctx, info)
+	s.Require().NoError(err)
+	second, secondNew, err := s.repo.CreateTransaction(s.ctx, info)
+	s.Require().NoError(err)
+
+	// Assert
+	s.Require().True(firstNew)
+	s.Require().True(secondNew)
+	s.Require().NotEqual(first.UUID, second.UUID)
+}
+
+func (s *RepositorySuite) TestCreateTransactionConcurrentSameKey() {
+	// Arrange
+	const workers = 20
+	info := model.TransactionInfo{
+		OrderUUID:      gofakeit.UUID(),
+		UserUUID:       gofakeit.UUID(),
+		PaymentMethod:  model.SBP,
+		Amount:         100,
+		Currency:       model.RUB,
+		IdempotencyKey: gofakeit.UUID(),
+	}
+
+	var (
+		wg      sync.WaitGroup
+		uuids   = make([]string, workers)
+		created = make([]bool, workers)
+		errs    = make([]error, workers)
+	)
+
+	// Act
+	for i := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			transaction, isNew, err := s.repo.CreateTransaction(s.ctx, info)
+			uuids[i], created[i], errs[i] = transaction.UUID, isNew, err
+		}()
+	}
+	wg.Wait()
+
+	// Assert
+	createdCount := 0
+	for i := range workers {
+		s.Require().NoError(errs[i])
+		s.Require().Equal(uuids[0], uuids[i])
+		if created[i] {
+			createdCount++
+		}
+	}
+	s.Require().Equal(1, createdCount)
+
+	page, err := s.repo.ListTransactions(s.ctx, model.TransactionsFilter{OrderUUID: info.OrderUUID}, model.TransactionsPageRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(int32(1), page.TotalSize)
+}
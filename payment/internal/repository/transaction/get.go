@@ -0,0 +1,20 @@
+package transaction
+
+import (
+	"context"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	"github.com/baryshnikkov/rocket-factory/payment/internal/repository/converter"
+)
+
+func (r *repository) GetTransaction(_ context.Context, transactionUUID string) (model.Transaction, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	transaction, ok := r.data[transactionUUID]
+	if !ok {
+		return model.Transaction{}, model.ErrTransactionNotFound
+	}
+
+	return converter.TransactionToModel(transaction), nil
+}
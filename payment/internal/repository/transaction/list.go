@@ -0,0 +1,59 @@
+package transaction
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	"github.com/baryshnikkov/rocket-factory/payment/internal/repository/converter"
+)
+
+func (r *repository) ListTransactions(_ context.Context, filter model.TransactionsFilter, page model.TransactionsPageRequest) (model.TransactionsPage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var matched []model.Transaction
+	for _, transaction := range r.data {
+		modelTransaction := converter.TransactionToModel(transaction)
+		if filter.Match(modelTransaction) {
+			matched = append(matched, modelTransaction)
+		}
+	}
+
+	// Порядок map случаен, поэтому сортируем всегда — иначе страницы не будут стабильными
+	slices.SortFunc(matched, func(a, b model.Transaction) int {
+		return compareTransactionKeys(*model.NewTransactionsCursor(a), *model.NewTransactionsCursor(b))
+	})
+
+	start := 0
+	if page.After != nil {
+		start, _ = slices.BinarySearchFunc(matched, *page.After, func(transaction model.Transaction, after model.TransactionsCursor) int {
+			if compareTransactionKeys(*model.NewTransactionsCursor(transaction), after) <= 0 {
+				return -1
+			}
+			return 1
+		})
+	}
+
+	result := model.TransactionsPage{
+		Transactions: matched[start:],
+		TotalSize:    int32(len(matched)),
+	}
+
+	if page.PageSize > 0 && len(result.Transactions) > int(page.PageSize) {
+		result.Transactions = result.Transactions[:page.PageSize]
+		result.Next = model.NewTransactionsCursor(result.Transactions[len(result.Transactions)-1])
+	}
+
+	return result, nil
+}
+
+// compareTransactionKeys упорядочивает транзакции от новых к старым, при равном времени — по убыванию UUID.
+func compareTransactionKeys(a, b model.TransactionsCursor) int {
+	c := b.CreatedAt.Compare(a.CreatedAt)
+	if c == 0 {
+		c = cmp.Compare(b.UUID, a.UUID)
+	}
+	return c
+}
@@ -0,0 +1,107 @@
+package transaction
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+)
+
+// createTransaction создаёт оплаченную транзакцию и переносит её создание на createdAt.
+func (s *RepositorySuite) createTransaction(userUUID, orderUUID string, createdAt time.Time) string {
+	transaction, _, err := s.repo.CreateTransaction(s.ctx, model.TransactionInfo{
+		OrderUUID:     orderUUID,
+		UserUUID:      userUUID,
+		PaymentMethod: model.Card,
+		Amount:        100,
+	})
+	s.Require().NoError(err)
+	_, err = s.repo.TransitionTransaction(s.ctx, transaction.UUID, model.TransactionStatusSucceeded)
+	s.Require().NoError(err)
+
+	stored := s.repo.data[transaction.UUID]
+	stored.CreatedAt = createdAt
+	s.repo.data[transaction.UUID] = stored
+
+	return transaction.UUID
+}
+
+func transactionUUIDs(transactions []model.Transaction) []string {
+	uuids := make([]string, 0, len(transactions))
+	for _, transaction := range transactions {
+		uuids = append(uuids, transaction.UUID)
+	}
+	return uuids
+}
+
+func (s *RepositorySuite) TestListTransactionsFilter() {
+	// Arrange
+	var (
+		userUUID  = gofakeit.UUID()
+		orderUUID = gofakeit.UUID()
+		now       = time.Now()
+		from      = now.Add(-time.Hour)
+		to        = now.Add(time.Hour)
+	)
+	match := s.createTransaction(userUUID, orderUUID, now)
+	s.createTransaction(gofakeit.UUID(), orderUUID, now)             // другой пользователь
+	s.createTransaction(userUUID, gofakeit.UUID(), now)              // другой заказ
+	s.createTransaction(userUUID, orderUUID, now.Add(-48*time.Hour)) // раньше диапазона
+	refunded := s.createTransaction(userUUID, orderUUID, now)
+	_, _, err := s.repo.RefundTransaction(s.ctx, refunded, 0)
+	s.Require().NoError(err)
+
+	filter := model.TransactionsFilter{
+		UserUUID:    userUUID,
+		OrderUUID:   orderUUID,
+		Statuses:    []model.TransactionStatus{model.TransactionStatusSucceeded, model.TransactionStatusPartiallyRefunded},
+		Kinds:       []model.TransactionKind{model.TransactionKindPayment},
+		CreatedFrom: &from,
+		CreatedTo:   &to,
+	}
+
+	// Act
+	res, err := s.repo.ListTransactions(s.ctx, filter, model.TransactionsPageRequest{})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal([]string{match}, transactionUUIDs(res.Transactions))
+	s.Require().Equal(int32(1), res.TotalSize)
+	s.Require().Nil(res.Next)
+}
+
+func (s *RepositorySuite) TestListTransactionsPagesNewestFirst() {
+	// Arrange
+	var (
+		userUUID = gofakeit.UUID()
+		now      = time.Now().Truncate(time.Second)
+	)
+	oldest := s.createTransaction(userUUID, gofakeit.UUID(), now.Add(-2*time.Minute))
+	middle := s.createTransaction(userUUID, gofakeit.UUID(), now.Add(-time.Minute))
+	newest := s.createTransaction(userUUID, gofakeit.UUID(), now)
+	filter := model.TransactionsFilter{UserUUID: userUUID}
+
+	// Act
+	first, err := s.repo.ListTransactions(s.ctx, filter, model.TransactionsPageRequest{PageSize: 2})
+	s.Require().NoError(err)
+	second, err := s.repo.ListTransactions(s.ctx, filter, model.TransactionsPageRequest{PageSize: 2, After: first.Next})
+	s.Require().NoError(err)
+
+	// Assert
+	s.Require().Equal([]string{newest, middle}, transactionUUIDs(first.Transactions))
+	s.Require().NotNil(first.Next)
+	s.Require().Equal([]string{oldest}, transactionUUIDs(second.Transactions))
+	s.Require().Nil(second.Next)
+	s.Require().Equal(int32(3), second.TotalSize)
+}
+
+func (s *RepositorySuite) TestListTransactionsEmpty() {
+	// Act
+	res, err := s.repo.ListTransactions(s.ctx, model.TransactionsFilter{UserUUID: gofakeit.UUID()}, model.TransactionsPageRequest{PageSize: 10})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Empty(res.Transactions)
+	s.Require().Zero(res.TotalSize)
+}
@@ -0,0 +1,34 @@
+package transaction
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	"github.com/baryshnikkov/rocket-factory/payment/internal/repository/converter"
+)
+
+func (r *repository) RefundTransaction(_ context.Context, transactionUUID string, amount int64) (model.Transaction, model.Transaction, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	transaction, ok := r.data[transactionUUID]
+	if !ok {
+		return model.Transaction{}, model.Transaction{}, model.ErrTransactionNotFound
+	}
+
+	refunded, refund, err := converter.TransactionToModel(transaction).ApplyRefund(amount, time.Now())
+	if err != nil {
+		return model.Transaction{}, model.Transaction{}, err
+	}
+	refund.UUID = uuid.NewString()
+
+	stored := converter.TransactionToRepoModel(refunded)
+	stored.IdempotencyKey = transaction.IdempotencyKey
+	r.data[transactionUUID] = stored
+	r.data[refund.UUID] = converter.TransactionToRepoModel(refund)
+
+	return refunded, refund, nil
+}
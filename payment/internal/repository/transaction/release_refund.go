@@ -0,0 +1,38 @@
+package transaction
+
+import (
+	"context"
+	"time"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	"github.com/baryshnikkov/rocket-factory/payment/internal/repository/converter"
+)
+
+func (r *repository) ReleaseRefund(_ context.Context, refundUUID string) (model.Transaction, model.Transaction, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	refund, ok := r.data[refundUUID]
+	if !ok {
+		return model.Transaction{}, model.Transaction{}, model.ErrTransactionNotFound
+	}
+	if refund.ParentTransactionUUID == nil {
+		return model.Transaction{}, model.Transaction{}, model.ErrInvalidTransaction
+	}
+	transaction, ok := r.data[*refund.ParentTransactionUUID]
+	if !ok {
+		return model.Transaction{}, model.Transaction{}, model.ErrTransactionNotFound
+	}
+
+	released, failed, err := converter.TransactionToModel(transaction).ReleaseRefund(converter.TransactionToModel(refund), time.Now())
+	if err != nil {
+		return model.Transaction{}, model.Transaction{}, err
+	}
+
+	stored := converter.TransactionToRepoModel(released)
+	stored.IdempotencyKey = transaction.IdempotencyKey
+	r.data[transaction.UUID] = stored
+	r.data[refundUUID] = converter.TransactionToRepoModel(failed)
+
+	return released, failed, nil
+}
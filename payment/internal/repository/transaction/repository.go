@@ -0,0 +1,25 @@
+package transaction
+
+import (
+	"sync"
+
+	def "github.com/baryshnikkov/rocket-factory/payment/internal/repository"
+	repoModel "github.com/baryshnikkov/rocket-factory/payment/internal/repository/model"
+)
+
+var _ def.TransactionRepository = (*repository)(nil)
+
+// repository хранит журнал оплат в памяти процесса
+type repository struct {
+	mu   sync.RWMutex
+	data map[string]repoModel.Transaction
+	// UUID транзакции по ключу идемпотентности
+	keys map[string]string
+}
+
+func NewRepository() *repository {
+	return &repository{
+		data: make(map[string]repoModel.Transaction),
+		keys: make(map[string]string),
+	}
+}
@@ -0,0 +1,24 @@
+package transaction
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type RepositorySuite struct {
+	suite.Suite
+	ctx context.Context
+
+	repo *repository
+}
+
+func (s *RepositorySuite) SetupTest() {
+	s.ctx = context.Background()
+	s.repo = NewRepository()
+}
+
+func TestRepositoryTransactionIntegration(t *testing.T) {
+	suite.Run(t, new(RepositorySuite))
+}
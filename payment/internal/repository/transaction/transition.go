@@ -0,0 +1,34 @@
+package transaction
+
+import (
+	"context"
+	"time"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	"github.com/baryshnikkov/rocket-factory/payment/internal/repository/converter"
+)
+
+func (r *repository) TransitionTransaction(_ context.Context, transactionUUID string, status model.TransactionStatus) (model.Transaction, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	transaction, ok := r.data[transactionUUID]
+	if !ok {
+		return model.Transaction{}, model.ErrTransactionNotFound
+	}
+
+	transitioned, err := converter.TransactionToModel(transaction).Transition(status, time.Now())
+	if err != nil {
+		return model.Transaction{}, err
+	}
+
+	stored := converter.TransactionToRepoModel(transitioned)
+	stored.IdempotencyKey = transaction.IdempotencyKey
+	if status == model.TransactionStatusFailed && transaction.IdempotencyKey != nil {
+		delete(r.keys, *transaction.IdempotencyKey)
+		stored.IdempotencyKey = nil
+	}
+	r.data[transactionUUID] = stored
+
+	return transitioned, nil
+}
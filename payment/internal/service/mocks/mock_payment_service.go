@@ -9,6 +9,8 @@ import (
 
 	model "github.com/baryshnikkov/rocket-factory/payment/internal/model"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // PaymentService is an autogenerated mock type for the PaymentService type
@@ -24,6 +26,292 @@ func (_m *PaymentService) EXPECT() *PaymentService_Expecter {
 	return &PaymentService_Expecter{mock: &_m.Mock}
 }
 
+// AuthorizePayment provides a mock function with given fields: ctx, req
+func (_m *PaymentService) AuthorizePayment(ctx context.Context, req model.AuthorizePaymentRequest) (model.AuthorizePaymentResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuthorizePayment")
+	}
+
+	var r0 model.AuthorizePaymentResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AuthorizePaymentRequest) (model.AuthorizePaymentResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AuthorizePaymentRequest) model.AuthorizePaymentResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(model.AuthorizePaymentResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AuthorizePaymentRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentService_AuthorizePayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuthorizePayment'
+type PaymentService_AuthorizePayment_Call struct {
+	*mock.Call
+}
+
+// AuthorizePayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req model.AuthorizePaymentRequest
+func (_e *PaymentService_Expecter) AuthorizePayment(ctx interface{}, req interface{}) *PaymentService_AuthorizePayment_Call {
+	return &PaymentService_AuthorizePayment_Call{Call: _e.mock.On("AuthorizePayment", ctx, req)}
+}
+
+func (_c *PaymentService_AuthorizePayment_Call) Run(run func(ctx context.Context, req model.AuthorizePaymentRequest)) *PaymentService_AuthorizePayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.AuthorizePaymentRequest))
+	})
+	return _c
+}
+
+func (_c *PaymentService_AuthorizePayment_Call) Return(_a0 model.AuthorizePaymentResponse, _a1 error) *PaymentService_AuthorizePayment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentService_AuthorizePayment_Call) RunAndReturn(run func(context.Context, model.AuthorizePaymentRequest) (model.AuthorizePaymentResponse, error)) *PaymentService_AuthorizePayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CapturePayment provides a mock function with given fields: ctx, req
+func (_m *PaymentService) CapturePayment(ctx context.Context, req model.CapturePaymentRequest) (model.CapturePaymentResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CapturePayment")
+	}
+
+	var r0 model.CapturePaymentResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.CapturePaymentRequest) (model.CapturePaymentResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.CapturePaymentRequest) model.CapturePaymentResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(model.CapturePaymentResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.CapturePaymentRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentService_CapturePayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CapturePayment'
+type PaymentService_CapturePayment_Call struct {
+	*mock.Call
+}
+
+// CapturePayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req model.CapturePaymentRequest
+func (_e *PaymentService_Expecter) CapturePayment(ctx interface{}, req interface{}) *PaymentService_CapturePayment_Call {
+	return &PaymentService_CapturePayment_Call{Call: _e.mock.On("CapturePayment", ctx, req)}
+}
+
+func (_c *PaymentService_CapturePayment_Call) Run(run func(ctx context.Context, req model.CapturePaymentRequest)) *PaymentService_CapturePayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.CapturePaymentRequest))
+	})
+	return _c
+}
+
+func (_c *PaymentService_CapturePayment_Call) Return(_a0 model.CapturePaymentResponse, _a1 error) *PaymentService_CapturePayment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentService_CapturePayment_Call) RunAndReturn(run func(context.Context, model.CapturePaymentRequest) (model.CapturePaymentResponse, error)) *PaymentService_CapturePayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpirePendingTransactions provides a mock function with given fields: ctx, createdBefore
+func (_m *PaymentService) ExpirePendingTransactions(ctx context.Context, createdBefore time.Time) (int, error) {
+	ret := _m.Called(ctx, createdBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpirePendingTransactions")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, createdBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, createdBefore)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, createdBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentService_ExpirePendingTransactions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpirePendingTransactions'
+type PaymentService_ExpirePendingTransactions_Call struct {
+	*mock.Call
+}
+
+// ExpirePendingTransactions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - createdBefore time.Time
+func (_e *PaymentService_Expecter) ExpirePendingTransactions(ctx interface{}, createdBefore interface{}) *PaymentService_ExpirePendingTransactions_Call {
+	return &PaymentService_ExpirePendingTransactions_Call{Call: _e.mock.On("ExpirePendingTransactions", ctx, createdBefore)}
+}
+
+func (_c *PaymentService_ExpirePendingTransactions_Call) Run(run func(ctx context.Context, createdBefore time.Time)) *PaymentService_ExpirePendingTransactions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *PaymentService_ExpirePendingTransactions_Call) Return(expired int, err error) *PaymentService_ExpirePendingTransactions_Call {
+	_c.Call.Return(expired, err)
+	return _c
+}
+
+func (_c *PaymentService_ExpirePendingTransactions_Call) RunAndReturn(run func(context.Context, time.Time) (int, error)) *PaymentService_ExpirePendingTransactions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTransaction provides a mock function with given fields: ctx, transactionUUID
+func (_m *PaymentService) GetTransaction(ctx context.Context, transactionUUID string) (model.Transaction, error) {
+	ret := _m.Called(ctx, transactionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransaction")
+	}
+
+	var r0 model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.Transaction, error)); ok {
+		return rf(ctx, transactionUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.Transaction); ok {
+		r0 = rf(ctx, transactionUUID)
+	} else {
+		r0 = ret.Get(0).(model.Transaction)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, transactionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentService_GetTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransaction'
+type PaymentService_GetTransaction_Call struct {
+	*mock.Call
+}
+
+// GetTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID string
+func (_e *PaymentService_Expecter) GetTransaction(ctx interface{}, transactionUUID interface{}) *PaymentService_GetTransaction_Call {
+	return &PaymentService_GetTransaction_Call{Call: _e.mock.On("GetTransaction", ctx, transactionUUID)}
+}
+
+func (_c *PaymentService_GetTransaction_Call) Run(run func(ctx context.Context, transactionUUID string)) *PaymentService_GetTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PaymentService_GetTransaction_Call) Return(_a0 model.Transaction, _a1 error) *PaymentService_GetTransaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentService_GetTransaction_Call) RunAndReturn(run func(context.Context, string) (model.Transaction, error)) *PaymentService_GetTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTransactions provides a mock function with given fields: ctx, filter, params
+func (_m *PaymentService) ListTransactions(ctx context.Context, filter model.TransactionsFilter, params model.ListTransactionsParams) (model.TransactionsList, error) {
+	ret := _m.Called(ctx, filter, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTransactions")
+	}
+
+	var r0 model.TransactionsList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.TransactionsFilter, model.ListTransactionsParams) (model.TransactionsList, error)); ok {
+		return rf(ctx, filter, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.TransactionsFilter, model.ListTransactionsParams) model.TransactionsList); ok {
+		r0 = rf(ctx, filter, params)
+	} else {
+		r0 = ret.Get(0).(model.TransactionsList)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.TransactionsFilter, model.ListTransactionsParams) error); ok {
+		r1 = rf(ctx, filter, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentService_ListTransactions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTransactions'
+type PaymentService_ListTransactions_Call struct {
+	*mock.Call
+}
+
+// ListTransactions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.TransactionsFilter
+//   - params model.ListTransactionsParams
+func (_e *PaymentService_Expecter) ListTransactions(ctx interface{}, filter interface{}, params interface{}) *PaymentService_ListTransactions_Call {
+	return &PaymentService_ListTransactions_Call{Call: _e.mock.On("ListTransactions", ctx, filter, params)}
+}
+
+func (_c *PaymentService_ListTransactions_Call) Run(run func(ctx context.Context, filter model.TransactionsFilter, params model.ListTransactionsParams)) *PaymentService_ListTransactions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.TransactionsFilter), args[2].(model.ListTransactionsParams))
+	})
+	return _c
+}
+
+func (_c *PaymentService_ListTransactions_Call) Return(_a0 model.TransactionsList, _a1 error) *PaymentService_ListTransactions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentService_ListTransactions_Call) RunAndReturn(run func(context.Context, model.TransactionsFilter, model.ListTransactionsParams) (model.TransactionsList, error)) *PaymentService_ListTransactions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PayOrder provides a mock function with given fields: ctx, req
 func (_m *PaymentService) PayOrder(ctx context.Context, req model.PayOrderRequest) (model.PayOrderResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -81,6 +369,120 @@ func (_c *PaymentService_PayOrder_Call) RunAndReturn(run func(context.Context, m
 	return _c
 }
 
+// RefundPayment provides a mock function with given fields: ctx, req
+func (_m *PaymentService) RefundPayment(ctx context.Context, req model.RefundPaymentRequest) (model.RefundPaymentResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefundPayment")
+	}
+
+	var r0 model.RefundPaymentResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.RefundPaymentRequest) (model.RefundPaymentResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.RefundPaymentRequest) model.RefundPaymentResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(model.RefundPaymentResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.RefundPaymentRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentService_RefundPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefundPayment'
+type PaymentService_RefundPayment_Call struct {
+	*mock.Call
+}
+
+// RefundPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req model.RefundPaymentRequest
+func (_e *PaymentService_Expecter) RefundPayment(ctx interface{}, req interface{}) *PaymentService_RefundPayment_Call {
+	return &PaymentService_RefundPayment_Call{Call: _e.mock.On("RefundPayment", ctx, req)}
+}
+
+func (_c *PaymentService_RefundPayment_Call) Run(run func(ctx context.Context, req model.RefundPaymentRequest)) *PaymentService_RefundPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.RefundPaymentRequest))
+	})
+	return _c
+}
+
+func (_c *PaymentService_RefundPayment_Call) Return(_a0 model.RefundPaymentResponse, _a1 error) *PaymentService_RefundPayment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentService_RefundPayment_Call) RunAndReturn(run func(context.Context, model.RefundPaymentRequest) (model.RefundPaymentResponse, error)) *PaymentService_RefundPayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VoidAuthorization provides a mock function with given fields: ctx, req
+func (_m *PaymentService) VoidAuthorization(ctx context.Context, req model.VoidAuthorizationRequest) (model.VoidAuthorizationResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VoidAuthorization")
+	}
+
+	var r0 model.VoidAuthorizationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.VoidAuthorizationRequest) (model.VoidAuthorizationResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.VoidAuthorizationRequest) model.VoidAuthorizationResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(model.VoidAuthorizationResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.VoidAuthorizationRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentService_VoidAuthorization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VoidAuthorization'
+type PaymentService_VoidAuthorization_Call struct {
+	*mock.Call
+}
+
+// VoidAuthorization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req model.VoidAuthorizationRequest
+func (_e *PaymentService_Expecter) VoidAuthorization(ctx interface{}, req interface{}) *PaymentService_VoidAuthorization_Call {
+	return &PaymentService_VoidAuthorization_Call{Call: _e.mock.On("VoidAuthorization", ctx, req)}
+}
+
+func (_c *PaymentService_VoidAuthorization_Call) Run(run func(ctx context.Context, req model.VoidAuthorizationRequest)) *PaymentService_VoidAuthorization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.VoidAuthorizationRequest))
+	})
+	return _c
+}
+
+func (_c *PaymentService_VoidAuthorization_Call) Return(_a0 model.VoidAuthorizationResponse, _a1 error) *PaymentService_VoidAuthorization_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentService_VoidAuthorization_Call) RunAndReturn(run func(context.Context, model.VoidAuthorizationRequest) (model.VoidAuthorizationResponse, error)) *PaymentService_VoidAuthorization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewPaymentService creates a new instance of PaymentService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPaymentService(t interface {
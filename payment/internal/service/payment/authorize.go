@@ -0,0 +1,49 @@
+package payment
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+)
+
+func (s *service) AuthorizePayment(ctx context.Context, req model.AuthorizePaymentRequest) (model.AuthorizePaymentResponse, error) {
+	if err := validatePayment(req); err != nil {
+		return model.AuthorizePaymentResponse{}, err
+	}
+
+	log.Printf(`
+🔒 [Payment Authorized]
+• 🆔 Order UUID: %s
+• 👤 User UUID: %s
+• 💰 Payment Method: %s
+• 💵 Amount: %d %s
+`, req.OrderUUID, req.UserUUID, req.PaymentMethod, req.Amount, req.Currency,
+	)
+
+	// Холд и оплата одного заказа — разные операции, поэтому ключи не пересекаются
+	expiresAt := time.Now().Add(s.authorizationTTL)
+	transaction, created, err := s.openTransaction(ctx, req, "authorize:"+req.DeduplicationKey(), &expiresAt)
+	if err != nil {
+		return model.AuthorizePaymentResponse{}, err
+	}
+
+	if created {
+		if err := s.process(ctx, transaction.UUID, req, s.provider.Authorize, model.TransactionStatusAuthorized); err != nil {
+			return model.AuthorizePaymentResponse{}, err
+		}
+		log.Printf("✅Сумма холдирована, transaction_uuid: %v\n", transaction.UUID)
+	}
+
+	if transaction.AuthorizationExpiresAt != nil {
+		expiresAt = *transaction.AuthorizationExpiresAt
+	}
+
+	return model.AuthorizePaymentResponse{
+		TransactionUUID: transaction.UUID,
+		Amount:          req.Amount,
+		Currency:        req.Currency,
+		ExpiresAt:       expiresAt,
+	}, nil
+}
@@ -0,0 +1,117 @@
+package payment
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	"github.com/baryshnikkov/rocket-factory/payment/internal/repository/transaction"
+)
+
+// expectAuthorization ожидает запись холда заказа req и возвращает UUID транзакции
+func (s *ServiceSuite) expectAuthorization(req model.AuthorizePaymentRequest, authorizeErr error) string {
+	transactionUUID := gofakeit.UUID()
+	before := time.Now()
+
+	s.transactionRepository.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(info model.TransactionInfo) bool {
+		return info.IdempotencyKey == "authorize:order:"+req.OrderUUID &&
+			info.OrderUUID == req.OrderUUID &&
+			info.AuthorizationExpiresAt != nil &&
+			!info.AuthorizationExpiresAt.Before(before.Add(authorizationTTL))
+	})).Return(model.Transaction{UUID: transactionUUID, Status: model.TransactionStatusPending}, true, nil).Once()
+
+	s.provider.On("Authorize", mock.Anything, mock.MatchedBy(func(charge model.Charge) bool {
+		return charge.TransactionUUID == transactionUUID && charge.Amount == req.Amount
+	})).Return(model.ChargeResult{ProviderTransactionID: gofakeit.UUID()}, authorizeErr).Once()
+
+	s.expectOutcome(transactionUUID, model.TransactionStatusAuthorized, authorizeErr)
+
+	return transactionUUID
+}
+
+func (s *ServiceSuite) TestAuthorizePaymentSuccess() {
+	req := payRequest(model.Card, 150_050, model.RUB)
+	transactionUUID := s.expectAuthorization(req, nil)
+	before := time.Now()
+
+	res, err := s.service.AuthorizePayment(s.ctx, req)
+
+	s.NoError(err)
+	s.Equal(transactionUUID, res.TransactionUUID)
+	s.Equal(int64(150_050), res.Amount)
+	s.Equal(model.RUB, res.Currency)
+	s.False(res.ExpiresAt.Before(before.Add(authorizationTTL)))
+}
+
+func (s *ServiceSuite) TestAuthorizePaymentDeclined() {
+	req := payRequest(model.SBP, 10_000, model.RUB)
+	s.expectAuthorization(req, model.ErrInsufficientFunds)
+
+	_, err := s.service.AuthorizePayment(s.ctx, req)
+
+	s.ErrorIs(err, model.ErrInsufficientFunds)
+}
+
+func (s *ServiceSuite) TestAuthorizePaymentAboveMethodLimit() {
+	req := payRequest(model.CreditCard, 50_000_001, model.RUB)
+
+	_, err := s.service.AuthorizePayment(s.ctx, req)
+
+	s.ErrorIs(err, model.ErrAmountOutOfLimits)
+}
+
+func (s *ServiceSuite) TestAuthorizePaymentReplayReturnsOriginalExpiry() {
+	req := payRequest(model.Card, 10_000, model.RUB)
+	expiresAt := time.Now().Add(time.Minute)
+	original := model.Transaction{
+		UUID:          gofakeit.UUID(),
+		OrderUUID:     req.OrderUUID,
+		UserUUID:      req.UserUUID,
+		PaymentMethod: req.PaymentMethod,
+		Amount:        req.Amount,
+		Currency:      req.Currency,
+		Status:        model.TransactionStatusAuthorized,
+
+		AuthorizationExpiresAt: &expiresAt,
+	}
+
+	s.transactionRepository.On("CreateTransaction", s.ctx, mock.Anything).Return(original, false, nil)
+
+	res, err := s.service.AuthorizePayment(s.ctx, req)
+
+	s.NoError(err)
+	s.Equal(original.UUID, res.TransactionUUID)
+	s.True(expiresAt.Equal(res.ExpiresAt))
+}
+
+func (s *ServiceSuite) TestAuthorizeThenCaptureThroughLedger() {
+	svc := NewService(transaction.NewRepository(), s.provider, authorizationTTL)
+	req := payRequest(model.Card, 10_000, model.RUB)
+
+	s.provider.On("Authorize", s.ctx, mock.Anything).Return(model.ChargeResult{}, nil).Once()
+	s.provider.On("Capture", s.ctx, mock.Anything).Return(nil).Once()
+
+	authorized, err := svc.AuthorizePayment(s.ctx, req)
+	s.Require().NoError(err)
+
+	// Оплата того же заказа — отдельная операция и не считается повтором холда
+	s.provider.On("Charge", s.ctx, mock.Anything).Return(model.ChargeResult{}, nil).Once()
+	paid, err := svc.PayOrder(s.ctx, req)
+	s.Require().NoError(err)
+	s.Require().NotEqual(authorized.TransactionUUID, paid.TransactionUUID)
+
+	captureReq := model.CapturePaymentRequest{TransactionUUID: authorized.TransactionUUID, OrderUUID: req.OrderUUID}
+	_, err = svc.CapturePayment(s.ctx, captureReq)
+	s.Require().NoError(err)
+	_, err = svc.CapturePayment(s.ctx, captureReq)
+	s.Require().NoError(err)
+
+	captured, err := svc.GetTransaction(s.ctx, authorized.TransactionUUID)
+	s.Require().NoError(err)
+	s.Require().Equal(model.TransactionStatusSucceeded, captured.Status)
+
+	_, err = svc.VoidAuthorization(s.ctx, model.VoidAuthorizationRequest{TransactionUUID: authorized.TransactionUUID})
+	s.Require().ErrorIs(err, model.ErrTransactionNotAuthorized)
+}
@@ -0,0 +1,121 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+)
+
+func (s *service) CapturePayment(ctx context.Context, req model.CapturePaymentRequest) (model.CapturePaymentResponse, error) {
+	transaction, err := s.authorization(ctx, req.TransactionUUID, req.OrderUUID)
+	if err != nil {
+		return model.CapturePaymentResponse{}, err
+	}
+
+	switch {
+	case transaction.Status == model.TransactionStatusSucceeded,
+		transaction.Status == model.TransactionStatusPartiallyRefunded,
+		transaction.Status == model.TransactionStatusRefunded:
+		// Повтор: холд уже списан
+		return model.CapturePaymentResponse{TransactionUUID: transaction.UUID}, nil
+	case transaction.AuthorizationExpired(time.Now()):
+		if _, err := s.settle(ctx, transaction.UUID, model.TransactionStatusExpired); err != nil {
+			log.Printf("failed to expire authorization %s: %v\n", transaction.UUID, err)
+		}
+		return model.CapturePaymentResponse{}, model.ErrAuthorizationExpired
+	case transaction.Status == model.TransactionStatusExpired:
+		return model.CapturePaymentResponse{}, model.ErrAuthorizationExpired
+	case transaction.Status != model.TransactionStatusAuthorized && transaction.Status != model.TransactionStatusCapturing:
+		return model.CapturePaymentResponse{}, model.ErrTransactionNotAuthorized
+	}
+
+	if err := s.claim(ctx, transaction, model.TransactionStatusCapturing); err != nil {
+		return model.CapturePaymentResponse{}, err
+	}
+
+	err = s.provider.Capture(ctx, model.ChargeOf(transaction))
+	if _, err = s.finish(ctx, transaction.UUID, err, model.TransactionStatusSucceeded); err != nil {
+		return model.CapturePaymentResponse{}, err
+	}
+
+	log.Printf("✅Холд списан, transaction_uuid: %v\n", transaction.UUID)
+
+	return model.CapturePaymentResponse{TransactionUUID: transaction.UUID}, nil
+}
+
+// authorization находит транзакцию холда; транзакция другого заказа — ошибка клиента
+func (s *service) authorization(ctx context.Context, transactionUUID, orderUUID string) (model.Transaction, error) {
+	if err := uuid.Validate(transactionUUID); err != nil {
+		return model.Transaction{}, model.ErrInvalidTransaction
+	}
+
+	transaction, err := s.transactionRepository.GetTransaction(ctx, transactionUUID)
+	if err != nil {
+		return model.Transaction{}, err
+	}
+
+	if orderUUID != "" && transaction.OrderUUID != orderUUID {
+		return model.Transaction{}, model.ErrInvalidTransaction
+	}
+	if !transaction.Authorization() {
+		return model.Transaction{}, model.ErrTransactionNotAuthorized
+	}
+
+	return transaction, nil
+}
+
+// claim занимает холд под операцию status (CAPTURING или VOIDING) до обращения к провайдеру:
+// из конкурентных списания и снятия холда к провайдеру попадает только одно. Повтор операции,
+// исход которой неизвестен, продолжает её
+func (s *service) claim(ctx context.Context, transaction model.Transaction, status model.TransactionStatus) error {
+	if transaction.Status == status {
+		return nil
+	}
+
+	_, err := s.settle(ctx, transaction.UUID, status)
+	return err
+}
+
+// finish записывает ответ провайдера на операцию над занятым холдом: status при успехе, AUTHORIZED
+// при отказе. Если исход неизвестен, холд остаётся занятым, и операцию можно повторить
+func (s *service) finish(ctx context.Context, transactionUUID string, callErr error, status model.TransactionStatus) (model.Transaction, error) {
+	if callErr == nil {
+		return s.settle(ctx, transactionUUID, status)
+	}
+
+	if declined(callErr) {
+		if _, err := s.settle(ctx, transactionUUID, model.TransactionStatusAuthorized); err != nil {
+			log.Printf("failed to return authorization %s: %v\n", transactionUUID, err)
+		}
+	} else {
+		log.Printf("⏳ Исход операции над холдом неизвестен, transaction_uuid: %v: %v\n", transactionUUID, callErr)
+	}
+
+	return model.Transaction{}, providerError(callErr)
+}
+
+// settle записывает итог операции над холдом. Если параллельный запрос уже перевёл холд
+// в тот же статус, операция считается выполненной.
+func (s *service) settle(ctx context.Context, transactionUUID string, status model.TransactionStatus) (model.Transaction, error) {
+	ctx = withoutCancel(ctx)
+
+	transaction, err := s.transactionRepository.TransitionTransaction(ctx, transactionUUID, status)
+	if errors.Is(err, model.ErrInvalidTransactionTransition) {
+		current, getErr := s.transactionRepository.GetTransaction(ctx, transactionUUID)
+		if getErr == nil && current.Status == status {
+			return current, nil
+		}
+		return model.Transaction{}, model.ErrTransactionNotAuthorized
+	}
+	if err != nil {
+		return model.Transaction{}, fmt.Errorf("%w: %w", model.ErrPaymentInternalError, err)
+	}
+
+	return transaction, nil
+}
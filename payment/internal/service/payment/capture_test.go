@@ -0,0 +1,210 @@
+package payment
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+)
+
+// authorizedTransaction возвращает холд со сроком expiresIn от текущего времени
+func authorizedTransaction(status model.TransactionStatus, expiresIn time.Duration) model.Transaction {
+	expiresAt := time.Now().Add(expiresIn)
+
+	return model.Transaction{
+		UUID:          gofakeit.UUID(),
+		OrderUUID:     gofakeit.UUID(),
+		UserUUID:      gofakeit.UUID(),
+		PaymentMethod: model.Card,
+		Amount:        150050,
+		Currency:      model.RUB,
+		Status:        status,
+
+		AuthorizationExpiresAt: &expiresAt,
+	}
+}
+
+func captureRequest(transaction model.Transaction) model.CapturePaymentRequest {
+	return model.CapturePaymentRequest{
+		TransactionUUID: transaction.UUID,
+		OrderUUID:       transaction.OrderUUID,
+	}
+}
+
+func (s *ServiceSuite) TestCapturePaymentSuccess() {
+	transaction := authorizedTransaction(model.TransactionStatusAuthorized, time.Hour)
+
+	s.transactionRepository.On("GetTransaction", s.ctx, transaction.UUID).Return(transaction, nil).Once()
+	s.transactionRepository.On("TransitionTransaction", mock.Anything, transaction.UUID, model.TransactionStatusCapturing).
+		Return(model.Transaction{UUID: transaction.UUID, Status: model.TransactionStatusCapturing}, nil).Once()
+	s.provider.On("Capture", s.ctx, model.Charge{
+		TransactionUUID: transaction.UUID,
+		OrderUUID:       transaction.OrderUUID,
+		UserUUID:        transaction.UserUUID,
+		PaymentMethod:   transaction.PaymentMethod,
+		Amount:          150_050,
+		Currency:        transaction.Currency,
+	}).Return(nil).Once()
+	s.transactionRepository.On("TransitionTransaction", mock.Anything, transaction.UUID, model.TransactionStatusSucceeded).
+		Return(model.Transaction{UUID: transaction.UUID, Status: model.TransactionStatusSucceeded}, nil).Once()
+
+	res, err := s.service.CapturePayment(s.ctx, captureRequest(transaction))
+
+	s.NoError(err)
+	s.Equal(transaction.UUID, res.TransactionUUID)
+}
+
+func (s *ServiceSuite) TestCapturePaymentAlreadyCaptured() {
+	transaction := authorizedTransaction(model.TransactionStatusSucceeded, -time.Hour)
+
+	s.transactionRepository.On("GetTransaction", s.ctx, transaction.UUID).Return(transaction, nil).Once()
+
+	res, err := s.service.CapturePayment(s.ctx, captureRequest(transaction))
+
+	s.NoError(err)
+	s.Equal(transaction.UUID, res.TransactionUUID)
+}
+
+func (s *ServiceSuite) TestCapturePaymentExpired() {
+	transaction := authorizedTransaction(model.TransactionStatusAuthorized, -time.Minute)
+
+	s.transactionRepository.On("GetTransaction", s.ctx, transaction.UUID).Return(transaction, nil).Once()
+	s.transactionRepository.On("TransitionTransaction", mock.Anything, transaction.UUID, model.TransactionStatusExpired).
+		Return(model.Transaction{UUID: transaction.UUID, Status: model.TransactionStatusExpired}, nil).Once()
+
+	_, err := s.service.CapturePayment(s.ctx, captureRequest(transaction))
+
+	s.ErrorIs(err, model.ErrAuthorizationExpired)
+}
+
+func (s *ServiceSuite) TestCapturePaymentVoided() {
+	transaction := authorizedTransaction(model.TransactionStatusVoided, time.Hour)
+
+	s.transactionRepository.On("GetTransaction", s.ctx, transaction.UUID).Return(transaction, nil).Once()
+
+	_, err := s.service.CapturePayment(s.ctx, captureRequest(transaction))
+
+	s.ErrorIs(err, model.ErrTransactionNotAuthorized)
+}
+
+func (s *ServiceSuite) TestCapturePaymentNotAuthorization() {
+	transaction := authorizedTransaction(model.TransactionStatusSucceeded, time.Hour)
+	transaction.AuthorizationExpiresAt = nil
+
+	s.transactionRepository.On("GetTransaction", s.ctx, transaction.UUID).Return(transaction, nil).Once()
+
+	_, err := s.service.CapturePayment(s.ctx, captureRequest(transaction))
+
+	s.ErrorIs(err, model.ErrTransactionNotAuthorized)
+}
+
+func (s *ServiceSuite) TestCapturePaymentOtherOrder() {
+	transaction := authorizedTransaction(model.TransactionStatusAuthorized, time.Hour)
+	req := captureRequest(transaction)
+	req.OrderUUID = gofakeit.UUID()
+
+	s.transactionRepository.On("GetTransaction", s.ctx, transaction.UUID).Return(transaction, nil).Once()
+
+	_, err := s.service.CapturePayment(s.ctx, req)
+
+	s.ErrorIs(err, model.ErrInvalidTransaction)
+}
+
+func (s *ServiceSuite) TestCapturePaymentInvalidUUID() {
+	_, err := s.service.CapturePayment(s.ctx, model.CapturePaymentRequest{TransactionUUID: "not-a-uuid"})
+
+	s.ErrorIs(err, model.ErrInvalidTransaction)
+}
+
+func (s *ServiceSuite) TestCapturePaymentProviderTimeout() {
+	transaction := authorizedTransaction(model.TransactionStatusAuthorized, time.Hour)
+
+	s.transactionRepository.On("GetTransaction", s.ctx, transaction.UUID).Return(transaction, nil).Once()
+	s.transactionRepository.On("TransitionTransaction", mock.Anything, transaction.UUID, model.TransactionStatusCapturing).
+		Return(model.Transaction{UUID: transaction.UUID, Status: model.TransactionStatusCapturing}, nil).Once()
+	s.provider.On("Capture", s.ctx, mock.Anything).Return(model.ErrProviderTimeout).Once()
+
+	_, err := s.service.CapturePayment(s.ctx, captureRequest(transaction))
+
+	// Исход неизвестен: холд остаётся в CAPTURING до повтора
+	s.ErrorIs(err, model.ErrProviderTimeout)
+	s.transactionRepository.AssertNotCalled(s.T(), "TransitionTransaction", mock.Anything, transaction.UUID, model.TransactionStatusAuthorized)
+}
+
+func (s *ServiceSuite) TestCapturePaymentDeclined() {
+	transaction := authorizedTransaction(model.TransactionStatusAuthorized, time.Hour)
+
+	s.transactionRepository.On("GetTransaction", s.ctx, transaction.UUID).Return(transaction, nil).Once()
+	s.transactionRepository.On("TransitionTransaction", mock.Anything, transaction.UUID, model.TransactionStatusCapturing).
+		Return(model.Transaction{UUID: transaction.UUID, Status: model.TransactionStatusCapturing}, nil).Once()
+	s.provider.On("Capture", s.ctx, mock.Anything).Return(model.ErrPaymentDeclined).Once()
+	s.transactionRepository.On("TransitionTransaction", mock.Anything, transaction.UUID, model.TransactionStatusAuthorized).
+		Return(transaction, nil).Once()
+
+	_, err := s.service.CapturePayment(s.ctx, captureRequest(transaction))
+
+	s.ErrorIs(err, model.ErrPaymentDeclined)
+}
+
+func (s *ServiceSuite) TestCapturePaymentResumesCapturing() {
+	transaction := authorizedTransaction(model.TransactionStatusCapturing, time.Hour)
+
+	s.transactionRepository.On("GetTransaction", s.ctx, transaction.UUID).Return(transaction, nil).Once()
+	s.provider.On("Capture", s.ctx, mock.Anything).Return(nil).Once()
+	s.transactionRepository.On("TransitionTransaction", mock.Anything, transaction.UUID, model.TransactionStatusSucceeded).
+		Return(model.Transaction{UUID: transaction.UUID, Status: model.TransactionStatusSucceeded}, nil).Once()
+
+	res, err := s.service.CapturePayment(s.ctx, captureRequest(transaction))
+
+	s.NoError(err)
+	s.Equal(transaction.UUID, res.TransactionUUID)
+}
+
+func (s *ServiceSuite) TestCapturePaymentVoiding() {
+	transaction := authorizedTransaction(model.TransactionStatusVoiding, time.Hour)
+
+	s.transactionRepository.On("GetTransaction", s.ctx, transaction.UUID).Return(transaction, nil).Once()
+
+	_, err := s.service.CapturePayment(s.ctx, captureRequest(transaction))
+
+	s.ErrorIs(err, model.ErrTransactionNotAuthorized)
+	s.provider.AssertNotCalled(s.T(), "Capture", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestCapturePaymentVoidedConcurrently() {
+	transaction := authorizedTransaction(model.TransactionStatusAuthorized, time.Hour)
+	voiding := transaction
+	voiding.Status = model.TransactionStatusVoiding
+
+	s.transactionRepository.On("GetTransaction", s.ctx, transaction.UUID).Return(transaction, nil).Once()
+	s.transactionRepository.On("TransitionTransaction", mock.Anything, transaction.UUID, model.TransactionStatusCapturing).
+		Return(model.Transaction{}, model.ErrInvalidTransactionTransition).Once()
+	s.transactionRepository.On("GetTransaction", mock.Anything, transaction.UUID).Return(voiding, nil).Once()
+
+	_, err := s.service.CapturePayment(s.ctx, captureRequest(transaction))
+
+	// Холд уже занят снятием: провайдер не получает списание
+	s.ErrorIs(err, model.ErrTransactionNotAuthorized)
+	s.provider.AssertNotCalled(s.T(), "Capture", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestCapturePaymentCapturedConcurrently() {
+	transaction := authorizedTransaction(model.TransactionStatusAuthorized, time.Hour)
+	captured := transaction
+	captured.Status = model.TransactionStatusSucceeded
+
+	s.transactionRepository.On("GetTransaction", s.ctx, transaction.UUID).Return(transaction, nil).Once()
+	s.transactionRepository.On("TransitionTransaction", mock.Anything, transaction.UUID, model.TransactionStatusCapturing).
+		Return(model.Transaction{UUID: transaction.UUID, Status: model.TransactionStatusCapturing}, nil).Once()
+	s.provider.On("Capture", s.ctx, mock.Anything).Return(nil).Once()
+	s.transactionRepository.On("TransitionTransaction", mock.Anything, transaction.UUID, model.TransactionStatusSucceeded).
+		Return(model.Transaction{}, model.ErrInvalidTransactionTransition).Once()
+	s.transactionRepository.On("GetTransaction", mock.Anything, transaction.UUID).Return(captured, nil).Once()
+
+	res, err := s.service.CapturePayment(s.ctx, captureRequest(transaction))
+
+	s.NoError(err)
+	s.Equal(transaction.UUID, res.TransactionUUID)
+}
@@ -0,0 +1,72 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+)
+
+// expireBatchSize - сколько зависших транзакций читается из хранилища за раз
+const expireBatchSize = 100
+
+func (s *service) ExpirePendingTransactions(ctx context.Context, createdBefore time.Time) (expired int, err error) {
+	filter := model.TransactionsFilter{
+		Statuses:  []model.TransactionStatus{model.TransactionStatusPending},
+		CreatedTo: &createdBefore,
+	}
+	page := model.TransactionsPageRequest{PageSize: expireBatchSize}
+
+	for {
+		result, err := s.transactionRepository.ListTransactions(ctx, filter, page)
+		if err != nil {
+			return expired, err
+		}
+
+		for _, transaction := range result.Transactions {
+			if ctx.Err() != nil {
+				return expired, ctx.Err()
+			}
+			if s.expirePending(ctx, transaction) {
+				expired++
+			}
+		}
+
+		if result.Next == nil {
+			return expired, nil
+		}
+		page.After = result.Next
+	}
+}
+
+// expirePending закрывает транзакцию, исход которой так и не стал известен. Возврат повторяется
+// у провайдера: он идемпотентен по UUID возврата, а закрыть его без ответа провайдера нельзя —
+// деньги могли уже вернуться. Оплата или холд отменяются у провайдера и переходят в FAILED.
+// false - исход по-прежнему неизвестен, транзакция будет проверена снова
+func (s *service) expirePending(ctx context.Context, transaction model.Transaction) bool {
+	if transaction.Kind == model.TransactionKindRefund {
+		err := s.processRefund(ctx, transaction)
+		return err == nil || declined(err)
+	}
+
+	if err := s.provider.Void(ctx, model.ChargeOf(transaction)); err != nil {
+		log.Printf("⏳ Не удалось отменить зависшую операцию, transaction_uuid: %v: %v\n", transaction.UUID, err)
+		return false
+	}
+
+	_, err := s.transactionRepository.TransitionTransaction(withoutCancel(ctx), transaction.UUID, model.TransactionStatusFailed)
+	if errors.Is(err, model.ErrInvalidTransactionTransition) {
+		// Исход успели записать параллельно
+		return false
+	}
+	if err != nil {
+		log.Printf("failed to move transaction %s to %s: %v\n", transaction.UUID, model.TransactionStatusFailed, err)
+		return false
+	}
+
+	log.Printf("⌛ Зависшая операция отменена, transaction_uuid: %v\n", transaction.UUID)
+
+	return true
+}
@@ -0,0 +1,90 @@
+package payment
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+)
+
+// expectPendingList ожидает выборку транзакций в PENDING, созданных не позже createdBefore
+func (s *ServiceSuite) expectPendingList(createdBefore time.Time, transactions ...model.Transaction) {
+	s.transactionRepository.On("ListTransactions", s.ctx, model.TransactionsFilter{
+		Statuses:  []model.TransactionStatus{model.TransactionStatusPending},
+		CreatedTo: &createdBefore,
+	}, model.TransactionsPageRequest{PageSize: expireBatchSize}).
+		Return(model.TransactionsPage{Transactions: transactions, TotalSize: int32(len(transactions))}, nil).Once()
+}
+
+func pendingTransaction(kind model.TransactionKind) model.Transaction {
+	return model.Transaction{
+		UUID:          gofakeit.UUID(),
+		OrderUUID:     gofakeit.UUID(),
+		UserUUID:      gofakeit.UUID(),
+		PaymentMethod: model.Card,
+		Amount:        10_000,
+		Currency:      model.RUB,
+		Status:        model.TransactionStatusPending,
+		Kind:          kind,
+	}
+}
+
+func (s *ServiceSuite) TestExpirePendingTransactionsVoidsAndFails() {
+	createdBefore := time.Now()
+	transaction := pendingTransaction(model.TransactionKindPayment)
+	s.expectPendingList(createdBefore, transaction)
+
+	s.provider.On("Void", s.ctx, model.ChargeOf(transaction)).Return(nil).Once()
+	s.transactionRepository.On("TransitionTransaction", mock.Anything, transaction.UUID, model.TransactionStatusFailed).
+		Return(model.Transaction{UUID: transaction.UUID, Status: model.TransactionStatusFailed}, nil).Once()
+
+	expired, err := s.service.ExpirePendingTransactions(s.ctx, createdBefore)
+
+	s.NoError(err)
+	s.Equal(1, expired)
+}
+
+func (s *ServiceSuite) TestExpirePendingTransactionsKeepsUnknownOutcome() {
+	createdBefore := time.Now()
+	transaction := pendingTransaction(model.TransactionKindPayment)
+	s.expectPendingList(createdBefore, transaction)
+
+	// Провайдер снова не ответил: транзакция остаётся в PENDING до следующей проверки
+	s.provider.On("Void", s.ctx, model.ChargeOf(transaction)).Return(model.ErrProviderTimeout).Once()
+
+	expired, err := s.service.ExpirePendingTransactions(s.ctx, createdBefore)
+
+	s.NoError(err)
+	s.Zero(expired)
+	s.transactionRepository.AssertNotCalled(s.T(), "TransitionTransaction", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestExpirePendingTransactionsRetriesRefund() {
+	createdBefore := time.Now()
+	refund := pendingTransaction(model.TransactionKindRefund)
+	refund.ParentTransactionUUID = gofakeit.UUID()
+	s.expectPendingList(createdBefore, refund)
+
+	// Возврат нельзя закрыть без ответа провайдера: он повторяется
+	s.expectRefund(refund, nil)
+
+	expired, err := s.service.ExpirePendingTransactions(s.ctx, createdBefore)
+
+	s.NoError(err)
+	s.Equal(1, expired)
+	s.provider.AssertNotCalled(s.T(), "Void", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestExpirePendingTransactionsListError() {
+	createdBefore := time.Now()
+	expectedErr := gofakeit.Error()
+
+	s.transactionRepository.On("ListTransactions", s.ctx, mock.Anything, mock.Anything).
+		Return(model.TransactionsPage{}, expectedErr).Once()
+
+	_, err := s.service.ExpirePendingTransactions(s.ctx, createdBefore)
+
+	s.ErrorIs(err, expectedErr)
+}
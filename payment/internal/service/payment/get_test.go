@@ -0,0 +1,38 @@
+package payment
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+)
+
+func (s *ServiceSuite) TestGetTransactionSuccess() {
+	transaction := model.Transaction{
+		UUID:      gofakeit.UUID(),
+		OrderUUID: gofakeit.UUID(),
+		Status:    model.TransactionStatusSucceeded,
+	}
+
+	s.transactionRepository.On("GetTransaction", s.ctx, transaction.UUID).Return(transaction, nil)
+
+	res, err := s.service.GetTransaction(s.ctx, transaction.UUID)
+
+	s.NoError(err)
+	s.Equal(transaction, res)
+}
+
+func (s *ServiceSuite) TestGetTransactionInvalidUUID() {
+	_, err := s.service.GetTransaction(s.ctx, "not-a-uuid")
+
+	s.ErrorIs(err, model.ErrInvalidTransaction)
+}
+
+func (s *ServiceSuite) TestGetTransactionNotFound() {
+	transactionUUID := gofakeit.UUID()
+
+	s.transactionRepository.On("GetTransaction", s.ctx, transactionUUID).Return(model.Transaction{}, model.ErrTransactionNotFound)
+
+	_, err := s.service.GetTransaction(s.ctx, transactionUUID)
+
+	s.ErrorIs(err, model.ErrTransactionNotFound)
+}
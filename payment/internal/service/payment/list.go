@@ -0,0 +1,40 @@
+package payment
+
+import (
+	"context"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	"github.com/baryshnikkov/rocket-factory/shared/pkg/pagetoken"
+)
+
+func (s *service) ListTransactions(ctx context.Context, filter model.TransactionsFilter, params model.ListTransactionsParams) (model.TransactionsList, error) {
+	page := model.TransactionsPageRequest{
+		PageSize: params.PageSize,
+	}
+
+	if params.PageToken != "" {
+		cursor, err := pagetoken.Decode[model.TransactionsCursor](params.PageToken, filter)
+		if err != nil {
+			return model.TransactionsList{}, model.ErrInvalidPageToken
+		}
+		page.After = &cursor
+	}
+
+	result, err := s.transactionRepository.ListTransactions(ctx, filter, page)
+	if err != nil {
+		return model.TransactionsList{}, err
+	}
+
+	list := model.TransactionsList{
+		Transactions: result.Transactions,
+		TotalSize:    result.TotalSize,
+	}
+	if result.Next != nil {
+		list.NextPageToken, err = pagetoken.Encode(*result.Next, filter)
+		if err != nil {
+			return model.TransactionsList{}, err
+		}
+	}
+
+	return list, nil
+}
@@ -0,0 +1,47 @@
+package payment
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	"github.com/baryshnikkov/rocket-factory/shared/pkg/pagetoken"
+)
+
+func (s *ServiceSuite) TestListTransactionsPageToken() {
+	filter := model.TransactionsFilter{UserUUID: gofakeit.UUID()}
+	last := model.Transaction{UUID: gofakeit.UUID(), CreatedAt: time.Now().UTC()}
+	cursor := model.NewTransactionsCursor(last)
+
+	s.transactionRepository.On("ListTransactions", s.ctx, filter, model.TransactionsPageRequest{PageSize: 1}).
+		Return(model.TransactionsPage{Transactions: []model.Transaction{last}, TotalSize: 2, Next: cursor}, nil)
+
+	first, err := s.service.ListTransactions(s.ctx, filter, model.ListTransactionsParams{PageSize: 1})
+	s.Require().NoError(err)
+	s.Require().NotEmpty(first.NextPageToken)
+	s.Equal(int32(2), first.TotalSize)
+
+	s.transactionRepository.On("ListTransactions", s.ctx, filter, model.TransactionsPageRequest{PageSize: 1, After: cursor}).
+		Return(model.TransactionsPage{TotalSize: 2}, nil)
+
+	second, err := s.service.ListTransactions(s.ctx, filter, model.ListTransactionsParams{PageSize: 1, PageToken: first.NextPageToken})
+	s.Require().NoError(err)
+	s.Empty(second.NextPageToken)
+}
+
+func (s *ServiceSuite) TestListTransactionsTokenForOtherFilter() {
+	filter := model.TransactionsFilter{UserUUID: gofakeit.UUID()}
+	token, err := pagetoken.Encode(model.TransactionsCursor{UUID: gofakeit.UUID()}, filter)
+	s.Require().NoError(err)
+
+	_, err = s.service.ListTransactions(s.ctx, model.TransactionsFilter{UserUUID: gofakeit.UUID()}, model.ListTransactionsParams{PageToken: token})
+
+	s.ErrorIs(err, model.ErrInvalidPageToken)
+}
+
+func (s *ServiceSuite) TestListTransactionsInvalidToken() {
+	_, err := s.service.ListTransactions(s.ctx, model.TransactionsFilter{}, model.ListTransactionsParams{PageToken: "!"})
+
+	s.ErrorIs(err, model.ErrInvalidPageToken)
+}
@@ -2,30 +2,167 @@ package payment
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
-
-	"github.com/google/uuid"
+	"time"
 
 	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
 )
 
 func (s *service) PayOrder(ctx context.Context, req model.PayOrderRequest) (model.PayOrderResponse, error) {
+	if err := validatePayment(req); err != nil {
+		return model.PayOrderResponse{}, err
+	}
+
 	log.Printf(`
 💳 [Order Paid]
 • 🆔 Order UUID: %s
 • 👤 User UUID: %s
 • 💰 Payment Method: %s
-`, req.OrderUUID, req.UserUUID, req.PaymentMethod,
+• 💵 Amount: %d %s
+`, req.OrderUUID, req.UserUUID, req.PaymentMethod, req.Amount, req.Currency,
 	)
 
-	//  if err != nil {
-	//	  return model.PayOrderResponse{}, model.ErrPaymentInternalError
-	//  }
+	transaction, created, err := s.openTransaction(ctx, req, req.DeduplicationKey(), nil)
+	if err != nil {
+		return model.PayOrderResponse{}, err
+	}
 
-	UUID := uuid.New().String()
-	log.Printf("✅Оплата прошла успешно, transaction_uuid: %v\n", UUID)
+	if created {
+		if err := s.process(ctx, transaction.UUID, req, s.provider.Charge, model.TransactionStatusSucceeded); err != nil {
+			return model.PayOrderResponse{}, err
+		}
+		log.Printf("✅Оплата прошла успешно, transaction_uuid: %v\n", transaction.UUID)
+	}
 
 	return model.PayOrderResponse{
-		TransactionUUID: UUID,
+		TransactionUUID: transaction.UUID,
+		Amount:          req.Amount,
+		Currency:        req.Currency,
 	}, nil
 }
+
+// openTransaction записывает в журнал транзакцию в PENDING с ключом key. Если транзакция с этим
+// ключом уже есть, возвращает её с created = false.
+func (s *service) openTransaction(ctx context.Context, req model.PayOrderRequest, key string, authorizationExpiresAt *time.Time) (model.Transaction, bool, error) {
+	info := model.TransactionInfo{
+		OrderUUID:      req.OrderUUID,
+		UserUUID:       req.UserUUID,
+		PaymentMethod:  req.PaymentMethod,
+		Amount:         req.Amount,
+		Currency:       req.Currency,
+		IdempotencyKey: key,
+
+		AuthorizationExpiresAt: authorizationExpiresAt,
+	}
+
+	transaction, created, err := s.transactionRepository.CreateTransaction(ctx, info)
+	if err != nil {
+		return model.Transaction{}, false, fmt.Errorf("%w: %w", model.ErrPaymentInternalError, err)
+	}
+
+	if !created {
+		// Повтор уже проведённой операции: второй раз к провайдеру не обращаемся
+		if !transaction.Matches(info) {
+			return model.Transaction{}, false, model.ErrIdempotencyConflict
+		}
+		if transaction.Status == model.TransactionStatusPending {
+			return model.Transaction{}, false, model.ErrPaymentInProgress
+		}
+		log.Printf("🔁 Повтор оплаты, возвращаем transaction_uuid: %v\n", transaction.UUID)
+	}
+
+	return transaction, created, nil
+}
+
+// process проводит операцию call у провайдера и записывает её исход в журнал: status при успехе,
+// FAILED при отказе провайдера. Если исход неизвестен, транзакция остаётся в PENDING
+// и держит ключ идемпотентности
+func (s *service) process(
+	ctx context.Context,
+	transactionUUID string,
+	req model.PayOrderRequest,
+	call func(context.Context, model.Charge) (model.ChargeResult, error),
+	status model.TransactionStatus,
+) error {
+	result, callErr := call(ctx, model.Charge{
+		TransactionUUID: transactionUUID,
+		OrderUUID:       req.OrderUUID,
+		UserUUID:        req.UserUUID,
+		PaymentMethod:   req.PaymentMethod,
+		Amount:          req.Amount,
+		Currency:        req.Currency,
+	})
+	if callErr != nil {
+		if !declined(callErr) {
+			// Провайдер мог провести операцию: освободить ключ — значит рискнуть вторым списанием
+			log.Printf("⏳ Исход операции неизвестен, транзакция остаётся в PENDING, transaction_uuid: %v: %v\n", transactionUUID, callErr)
+			return providerError(callErr)
+		}
+		status = model.TransactionStatusFailed
+	}
+
+	// Исход записываем и после отмены запроса, иначе транзакция останется в PENDING
+	if _, err := s.transactionRepository.TransitionTransaction(withoutCancel(ctx), transactionUUID, status); err != nil {
+		log.Printf("failed to move transaction %s to %s: %v\n", transactionUUID, status, err)
+		if callErr == nil {
+			return fmt.Errorf("%w: %w", model.ErrPaymentInternalError, err)
+		}
+	}
+
+	if callErr != nil {
+		log.Printf("❌ Провайдер не провёл оплату, transaction_uuid: %v: %v\n", transactionUUID, callErr)
+		return providerError(callErr)
+	}
+
+	log.Printf("🏦 Провайдер провёл оплату %s, transaction_uuid: %v\n", result.ProviderTransactionID, transactionUUID)
+
+	return nil
+}
+
+// declined сообщает, что провайдер точно не провёл операцию
+func declined(err error) bool {
+	return errors.Is(err, model.ErrPaymentDeclined) || errors.Is(err, model.ErrInsufficientFunds)
+}
+
+// providerError оставляет известные отказы провайдера как есть, остальные ошибки считает внутренними
+func providerError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrPaymentDeclined),
+		errors.Is(err, model.ErrInsufficientFunds),
+		errors.Is(err, model.ErrProviderTimeout),
+		errors.Is(err, model.ErrUnsupportedPaymentMethod),
+		errors.Is(err, context.DeadlineExceeded),
+		errors.Is(err, context.Canceled):
+		return err
+	default:
+		return fmt.Errorf("%w: %w", model.ErrPaymentInternalError, err)
+	}
+}
+
+func withoutCancel(ctx context.Context) context.Context {
+	if ctx == nil {
+		return context.Background()
+	}
+	return context.WithoutCancel(ctx)
+}
+
+// validatePayment проверяет валюту и сумму по лимитам способа оплаты
+func validatePayment(req model.PayOrderRequest) error {
+	if !req.Currency.Supported() {
+		return fmt.Errorf("%w: %q", model.ErrUnsupportedCurrency, req.Currency)
+	}
+
+	limit, ok := req.PaymentMethod.AmountLimit()
+	if !ok {
+		return fmt.Errorf("%w: %s", model.ErrUnsupportedPaymentMethod, req.PaymentMethod)
+	}
+
+	if amount := req.Currency.MajorUnits(req.Amount); !limit.Allows(amount) {
+		return fmt.Errorf("%w: %s accepts %.2f..%.2f %s, got %.2f", model.ErrAmountOutOfLimits,
+			req.PaymentMethod, limit.Min, limit.Max, req.Currency, amount)
+	}
+
+	return nil
+}
@@ -1,102 +1,453 @@
 package payment
 
 import (
+	"context"
+	"errors"
+	"sync"
+
 	"github.com/brianvoe/gofakeit/v7"
-	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+	"github.com/baryshnikkov/rocket-factory/payment/internal/repository/transaction"
 )
 
-func (s *ServiceSuite) TestPayOrderSuccess() {
-	req := model.PayOrderRequest{
+func payRequest(method model.PaymentMethod, amount int64, currency model.Currency) model.PayOrderRequest {
+	return model.PayOrderRequest{
 		OrderUUID:     gofakeit.UUID(),
 		UserUUID:      gofakeit.UUID(),
-		PaymentMethod: model.Card,
+		PaymentMethod: method,
+		Amount:        amount,
+		Currency:      currency,
 	}
+}
 
-	res, err := s.service.PayOrder(s.ctx, req)
+// expectPendingTransaction ожидает запись транзакции на сумму запроса и возвращает её UUID
+func (s *ServiceSuite) expectPendingTransaction(req model.PayOrderRequest) string {
+	transactionUUID := gofakeit.UUID()
 
-	s.NoError(err)
-	s.NotEmpty(res.TransactionUUID)
+	s.transactionRepository.On("CreateTransaction", mock.Anything, model.TransactionInfo{
+		OrderUUID:      req.OrderUUID,
+		UserUUID:       req.UserUUID,
+		PaymentMethod:  req.PaymentMethod,
+		Amount:         req.Amount,
+		Currency:       req.Currency,
+		IdempotencyKey: "order:" + req.OrderUUID,
+	}).Return(model.Transaction{UUID: transactionUUID, Status: model.TransactionStatusPending}, true, nil)
+
+	return transactionUUID
+}
+
+// expectCharge ожидает списание у провайдера с ответом chargeErr и запись итогового статуса;
+// при неизвестном исходе статус не меняется
+func (s *ServiceSuite) expectCharge(transactionUUID string, req model.PayOrderRequest, chargeErr error) {
+	s.provider.On("Charge", mock.Anything, model.Charge{
+		TransactionUUID: transactionUUID,
+		OrderUUID:       req.OrderUUID,
+		UserUUID:        req.UserUUID,
+		PaymentMethod:   req.PaymentMethod,
+		Amount:          req.Amount,
+		Currency:        req.Currency,
+	}).Return(model.ChargeResult{ProviderTransactionID: gofakeit.UUID()}, chargeErr).Once()
+
+	s.expectOutcome(transactionUUID, model.TransactionStatusSucceeded, chargeErr)
+}
+
+// expectOutcome ожидает запись исхода операции у провайдера: status при успехе, FAILED при отказе
+func (s *ServiceSuite) expectOutcome(transactionUUID string, status model.TransactionStatus, providerErr error) {
+	switch {
+	case providerErr == nil:
+	case declined(providerErr):
+		status = model.TransactionStatusFailed
+	default:
+		return
+	}
+	s.transactionRepository.On("TransitionTransaction", mock.Anything, transactionUUID, status).
+		Return(model.Transaction{UUID: transactionUUID, Status: status}, nil).Once()
+}
+
+// expectTransaction ожидает успешную оплату на сумму запроса и возвращает UUID транзакции
+func (s *ServiceSuite) expectTransaction(req model.PayOrderRequest) string {
+	transactionUUID := s.expectPendingTransaction(req)
+	s.expectCharge(transactionUUID, req, nil)
+
+	return transactionUUID
+}
+
+func (s *ServiceSuite) TestPayOrderSuccess() {
+	req := payRequest(model.Card, 150_050, model.RUB)
+	transactionUUID := s.expectTransaction(req)
+
+	res, err := s.service.PayOrder(s.ctx, req)
 
-	// Проверяем что UUID валидный
-	_, err = uuid.Parse(res.TransactionUUID)
 	s.NoError(err)
+	s.Equal(model.PayOrderResponse{
+		TransactionUUID: transactionUUID,
+		Amount:          150_050,
+		Currency:        model.RUB,
+	}, res)
 }
 
 func (s *ServiceSuite) TestPayOrderSBP() {
-	req := model.PayOrderRequest{
-		OrderUUID:     gofakeit.UUID(),
-		UserUUID:      gofakeit.UUID(),
-		PaymentMethod: model.SBP,
-	}
+	req := payRequest(model.SBP, 100, model.RUB)
+	transactionUUID := s.expectTransaction(req)
 
 	res, err := s.service.PayOrder(s.ctx, req)
 
 	s.NoError(err)
-	s.NotEmpty(res.TransactionUUID)
+	s.Equal(transactionUUID, res.TransactionUUID)
 }
 
 func (s *ServiceSuite) TestPayOrderCreditCard() {
-	req := model.PayOrderRequest{
-		OrderUUID:     gofakeit.UUID(),
-		UserUUID:      gofakeit.UUID(),
-		PaymentMethod: model.CreditCard,
-	}
+	req := payRequest(model.CreditCard, 50_000_000, model.USD)
+	transactionUUID := s.expectTransaction(req)
 
 	res, err := s.service.PayOrder(s.ctx, req)
 
 	s.NoError(err)
-	s.NotEmpty(res.TransactionUUID)
+	s.Equal(transactionUUID, res.TransactionUUID)
 }
 
-func (s *ServiceSuite) TestPayOrderInvestorMoney() {
-	req := model.PayOrderRequest{
-		OrderUUID:     gofakeit.UUID(),
-		UserUUID:      gofakeit.UUID(),
-		PaymentMethod: model.InvestorMoney,
-	}
+func (s *ServiceSuite) TestPayOrderInvestorMoneyHasNoUpperLimit() {
+	req := payRequest(model.InvestorMoney, 100_000_000_000, model.RUB)
+	transactionUUID := s.expectTransaction(req)
 
 	res, err := s.service.PayOrder(s.ctx, req)
 
 	s.NoError(err)
-	s.NotEmpty(res.TransactionUUID)
+	s.Equal(transactionUUID, res.TransactionUUID)
 }
 
-func (s *ServiceSuite) TestPayOrderUnspecifiedPaymentMethod() {
-	req := model.PayOrderRequest{
-		OrderUUID:     gofakeit.UUID(),
-		UserUUID:      gofakeit.UUID(),
-		PaymentMethod: model.Unspecified,
-	}
+func (s *ServiceSuite) TestPayOrderCurrencyWithoutMinorUnits() {
+	req := payRequest(model.Card, 1500, model.JPY)
+	s.expectTransaction(req)
 
 	res, err := s.service.PayOrder(s.ctx, req)
 
 	s.NoError(err)
-	s.NotEmpty(res.TransactionUUID)
+	s.Equal(int64(1500), res.Amount)
+}
+
+func (s *ServiceSuite) TestPayOrderUnspecifiedPaymentMethod() {
+	req := payRequest(model.Unspecified, 10_000, model.RUB)
+
+	_, err := s.service.PayOrder(s.ctx, req)
+
+	s.ErrorIs(err, model.ErrUnsupportedPaymentMethod)
+}
+
+func (s *ServiceSuite) TestPayOrderUnsupportedCurrency() {
+	req := payRequest(model.Card, 10_000, "XYZ")
+
+	_, err := s.service.PayOrder(s.ctx, req)
+
+	s.ErrorIs(err, model.ErrUnsupportedCurrency)
+}
+
+func (s *ServiceSuite) TestPayOrderAboveMethodLimit() {
+	req := payRequest(model.CreditCard, 50_000_001, model.RUB)
+
+	_, err := s.service.PayOrder(s.ctx, req)
+
+	s.ErrorIs(err, model.ErrAmountOutOfLimits)
+}
+
+func (s *ServiceSuite) TestPayOrderBelowMethodLimit() {
+	req := payRequest(model.SBP, 99, model.RUB)
+
+	_, err := s.service.PayOrder(s.ctx, req)
+
+	s.ErrorIs(err, model.ErrAmountOutOfLimits)
+}
+
+func (s *ServiceSuite) TestPayOrderNegativeAmount() {
+	req := payRequest(model.InvestorMoney, -100, model.RUB)
+
+	_, err := s.service.PayOrder(s.ctx, req)
+
+	s.ErrorIs(err, model.ErrAmountOutOfLimits)
 }
 
 func (s *ServiceSuite) TestPayOrderEmptyUUIDs() {
-	req := model.PayOrderRequest{
-		OrderUUID:     "",
-		UserUUID:      "",
-		PaymentMethod: model.Card,
-	}
+	req := payRequest(model.Card, 10_000, model.RUB)
+	req.OrderUUID, req.UserUUID = "", ""
+	transactionUUID := s.expectTransaction(req)
 
 	res, err := s.service.PayOrder(s.ctx, req)
 
 	s.NoError(err)
-	s.NotEmpty(res.TransactionUUID)
+	s.Equal(transactionUUID, res.TransactionUUID)
 }
 
 func (s *ServiceSuite) TestPayOrderNilContext() {
-	req := model.PayOrderRequest{
-		OrderUUID:     gofakeit.UUID(),
-		UserUUID:      gofakeit.UUID(),
-		PaymentMethod: model.Card,
-	}
+	req := payRequest(model.Card, 10_000, model.RUB)
+	transactionUUID := s.expectTransaction(req)
 
 	res, err := s.service.PayOrder(nil, req)
+
+	s.NoError(err)
+	s.Equal(transactionUUID, res.TransactionUUID)
+}
+
+func (s *ServiceSuite) TestPayOrderRepositoryError() {
+	req := payRequest(model.Card, 10_000, model.RUB)
+	expectedErr := gofakeit.Error()
+
+	s.transactionRepository.On("CreateTransaction", s.ctx, mock.Anything).Return(model.Transaction{}, false, expectedErr)
+
+	_, err := s.service.PayOrder(s.ctx, req)
+
+	s.ErrorIs(err, model.ErrPaymentInternalError)
+	s.ErrorIs(err, expectedErr)
+}
+
+func (s *ServiceSuite) TestPayOrderReplayReturnsOriginalTransaction() {
+	req := payRequest(model.Card, 10_000, model.RUB)
+	original := model.Transaction{
+		UUID:          gofakeit.UUID(),
+		OrderUUID:     req.OrderUUID,
+		UserUUID:      req.UserUUID,
+		PaymentMethod: req.PaymentMethod,
+		Amount:        req.Amount,
+		Currency:      req.Currency,
+	}
+
+	s.transactionRepository.On("CreateTransaction", s.ctx, mock.Anything).Return(original, false, nil)
+
+	res, err := s.service.PayOrder(s.ctx, req)
+
+	s.NoError(err)
+	s.Equal(original.UUID, res.TransactionUUID)
+	s.Equal(req.Amount, res.Amount)
+}
+
+func (s *ServiceSuite) TestPayOrderReplayWithOtherParameters() {
+	req := payRequest(model.Card, 10_000, model.RUB)
+	original := model.Transaction{
+		UUID:          gofakeit.UUID(),
+		OrderUUID:     req.OrderUUID,
+		UserUUID:      req.UserUUID,
+		PaymentMethod: model.SBP,
+		Amount:        req.Amount,
+		Currency:      req.Currency,
+	}
+
+	s.transactionRepository.On("CreateTransaction", s.ctx, mock.Anything).Return(original, false, nil)
+
+	_, err := s.service.PayOrder(s.ctx, req)
+
+	s.ErrorIs(err, model.ErrIdempotencyConflict)
+}
+
+func (s *ServiceSuite) TestPayOrderExplicitIdempotencyKey() {
+	req := payRequest(model.Card, 10_000, model.RUB)
+	req.IdempotencyKey = gofakeit.UUID()
+
+	transactionUUID := gofakeit.UUID()
+
+	s.transactionRepository.On("CreateTransaction", s.ctx, mock.MatchedBy(func(info model.TransactionInfo) bool {
+		return info.IdempotencyKey == "key:"+req.IdempotencyKey
+	})).Return(model.Transaction{UUID: transactionUUID}, true, nil)
+	s.expectCharge(transactionUUID, req, nil)
+
+	_, err := s.service.PayOrder(s.ctx, req)
+
 	s.NoError(err)
-	s.NotEmpty(res.TransactionUUID)
+}
+
+func (s *ServiceSuite) TestPayOrderReplayWhileInProgress() {
+	req := payRequest(model.Card, 10_000, model.RUB)
+	pending := model.Transaction{
+		UUID:          gofakeit.UUID(),
+		OrderUUID:     req.OrderUUID,
+		UserUUID:      req.UserUUID,
+		PaymentMethod: req.PaymentMethod,
+		Amount:        req.Amount,
+		Currency:      req.Currency,
+		Status:        model.TransactionStatusPending,
+	}
+
+	s.transactionRepository.On("CreateTransaction", s.ctx, mock.Anything).Return(pending, false, nil)
+
+	_, err := s.service.PayOrder(s.ctx, req)
+
+	s.ErrorIs(err, model.ErrPaymentInProgress)
+}
+
+func (s *ServiceSuite) TestPayOrderProviderDeclines() {
+	for _, chargeErr := range []error{model.ErrPaymentDeclined, model.ErrInsufficientFunds, model.ErrProviderTimeout} {
+		req := payRequest(model.Card, 10_000, model.RUB)
+		transactionUUID := s.expectPendingTransaction(req)
+		s.expectCharge(transactionUUID, req, chargeErr)
+
+		_, err := s.service.PayOrder(s.ctx, req)
+
+		s.ErrorIs(err, chargeErr)
+		s.NotErrorIs(err, model.ErrPaymentInternalError)
+	}
+}
+
+func (s *ServiceSuite) TestPayOrderProviderUnknownError() {
+	req := payRequest(model.SBP, 10_000, model.RUB)
+	expectedErr := gofakeit.Error()
+	transactionUUID := s.expectPendingTransaction(req)
+	s.expectCharge(transactionUUID, req, expectedErr)
+
+	_, err := s.service.PayOrder(s.ctx, req)
+
+	s.ErrorIs(err, model.ErrPaymentInternalError)
+	s.ErrorIs(err, expectedErr)
+}
+
+func (s *ServiceSuite) TestPayOrderRecordsOutcomeAfterCancellation() {
+	ctx, cancel := context.WithCancel(s.ctx)
+	req := payRequest(model.Card, 10_000, model.RUB)
+	transactionUUID := s.expectPendingTransaction(req)
+
+	// Запрос отменяется, пока шлюз отвечает; отказ всё равно записывается с живым контекстом
+	s.provider.On("Charge", mock.Anything, mock.Anything).
+		Run(func(mock.Arguments) { cancel() }).
+		Return(model.ChargeResult{}, model.ErrPaymentDeclined).Once()
+	s.transactionRepository.On("TransitionTransaction", mock.MatchedBy(func(ctx context.Context) bool {
+		return ctx.Err() == nil
+	}), transactionUUID, model.TransactionStatusFailed).Return(model.Transaction{}, nil).Once()
+
+	_, err := s.service.PayOrder(ctx, req)
+
+	s.ErrorIs(err, model.ErrPaymentDeclined)
+}
+
+func (s *ServiceSuite) TestPayOrderCancelledKeepsPending() {
+	ctx, cancel := context.WithCancel(s.ctx)
+	req := payRequest(model.Card, 10_000, model.RUB)
+	s.expectPendingTransaction(req)
+
+	// Шлюз мог списать деньги до отмены: транзакция не переходит в FAILED
+	s.provider.On("Charge", mock.Anything, mock.Anything).
+		Run(func(mock.Arguments) { cancel() }).
+		Return(model.ChargeResult{}, context.Canceled).Once()
+
+	_, err := s.service.PayOrder(ctx, req)
+
+	s.ErrorIs(err, context.Canceled)
+	s.transactionRepository.AssertNotCalled(s.T(), "TransitionTransaction")
+}
+
+func (s *ServiceSuite) TestPayOrderRetryAfterTimeout() {
+	svc := NewService(transaction.NewRepository(), s.provider, authorizationTTL)
+	req := payRequest(model.Card, 10_000, model.RUB)
+
+	// Once: повтор не должен дойти до провайдера, пока исход первого списания неизвестен
+	s.provider.On("Charge", mock.Anything, mock.Anything).Return(model.ChargeResult{}, model.ErrProviderTimeout).Once()
+
+	_, err := svc.PayOrder(s.ctx, req)
+	s.Require().ErrorIs(err, model.ErrProviderTimeout)
+
+	_, err = svc.PayOrder(s.ctx, req)
+	s.Require().ErrorIs(err, model.ErrPaymentInProgress)
+
+	res, err := svc.ListTransactions(s.ctx, model.TransactionsFilter{OrderUUID: req.OrderUUID}, model.ListTransactionsParams{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Transactions, 1)
+	s.Require().Equal(model.TransactionStatusPending, res.Transactions[0].Status)
+}
+
+func (s *ServiceSuite) TestPayOrderRecordOutcomeError() {
+	req := payRequest(model.Card, 10_000, model.RUB)
+	expectedErr := gofakeit.Error()
+	transactionUUID := s.expectPendingTransaction(req)
+
+	s.provider.On("Charge", mock.Anything, mock.Anything).Return(model.ChargeResult{}, nil).Once()
+	s.transactionRepository.On("TransitionTransaction", mock.Anything, transactionUUID, model.TransactionStatusSucceeded).
+		Return(model.Transaction{}, expectedErr).Once()
+
+	_, err := s.service.PayOrder(s.ctx, req)
+
+	s.ErrorIs(err, model.ErrPaymentInternalError)
+	s.ErrorIs(err, expectedErr)
+}
+
+// payConcurrently оплачивает запросы параллельно через журнал в памяти
+func (s *ServiceSuite) payConcurrently(reqs []model.PayOrderRequest) ([]model.PayOrderResponse, []error) {
+	svc := NewService(transaction.NewRepository(), s.provider, authorizationTTL)
+
+	var (
+		wg   sync.WaitGroup
+		res  = make([]model.PayOrderResponse, len(reqs))
+		errs = make([]error, len(reqs))
+	)
+	for i, req := range reqs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res[i], errs[i] = svc.PayOrder(s.ctx, req)
+		}()
+	}
+	wg.Wait()
+
+	return res, errs
+}
+
+func (s *ServiceSuite) TestPayOrderConcurrentReplaysChargeOnce() {
+	req := payRequest(model.Card, 10_000, model.RUB)
+	reqs := make([]model.PayOrderRequest, 20)
+	for i := range reqs {
+		reqs[i] = req
+	}
+
+	// Once: провайдер должен получить одно списание
+	s.provider.On("Charge", mock.Anything, mock.Anything).Return(model.ChargeResult{}, nil).Once()
+
+	res, errs := s.payConcurrently(reqs)
+
+	// Повторы, пришедшие во время списания, получают ErrPaymentInProgress, остальные — ту же транзакцию
+	var transactionUUID string
+	for i := range reqs {
+		if errs[i] != nil {
+			s.Require().ErrorIs(errs[i], model.ErrPaymentInProgress)
+			continue
+		}
+		if transactionUUID == "" {
+			transactionUUID = res[i].TransactionUUID
+		}
+		s.Require().Equal(transactionUUID, res[i].TransactionUUID)
+	}
+	s.Require().NotEmpty(transactionUUID)
+}
+
+func (s *ServiceSuite) TestPayOrderConcurrentConflictingPayments() {
+	// Половина запросов платит за тот же заказ другой суммой
+	req := payRequest(model.Card, 10_000, model.RUB)
+	reqs := make([]model.PayOrderRequest, 20)
+	for i := range reqs {
+		reqs[i] = req
+		if i%2 == 1 {
+			reqs[i].Amount = 20_000
+		}
+	}
+
+	s.provider.On("Charge", mock.Anything, mock.Anything).Return(model.ChargeResult{}, nil).Once()
+
+	res, errs := s.payConcurrently(reqs)
+
+	// Проходят только запросы с параметрами победителя, и все получают одну транзакцию
+	var (
+		transactionUUID string
+		winnerAmount    int64
+	)
+	for i := range reqs {
+		if errors.Is(errs[i], model.ErrPaymentInProgress) {
+			continue
+		}
+		if errs[i] != nil {
+			s.Require().ErrorIs(errs[i], model.ErrIdempotencyConflict)
+			continue
+		}
+		if transactionUUID == "" {
+			transactionUUID, winnerAmount = res[i].TransactionUUID, reqs[i].Amount
+		}
+		s.Require().Equal(transactionUUID, res[i].TransactionUUID)
+		s.Require().Equal(winnerAmount, reqs[i].Amount)
+	}
+	s.Require().NotEmpty(transactionUUID)
 }
@@ -0,0 +1,105 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+)
+
+func (s *service) RefundPayment(ctx context.Context, req model.RefundPaymentRequest) (model.RefundPaymentResponse, error) {
+	if err := uuid.Validate(req.TransactionUUID); err != nil {
+		return model.RefundPaymentResponse{}, model.ErrInvalidTransaction
+	}
+
+	if req.Amount < 0 {
+		return model.RefundPaymentResponse{}, model.ErrInvalidRefundAmount
+	}
+
+	transaction, err := s.transactionRepository.GetTransaction(ctx, req.TransactionUUID)
+	if err != nil {
+		return model.RefundPaymentResponse{}, err
+	}
+
+	// Возврат по чужому заказу — ошибка клиента, а не отсутствующая транзакция
+	if req.OrderUUID != "" && transaction.OrderUUID != req.OrderUUID {
+		return model.RefundPaymentResponse{}, model.ErrInvalidTransaction
+	}
+
+	if err = validateRefundCurrency(req, transaction); err != nil {
+		return model.RefundPaymentResponse{}, err
+	}
+
+	transaction, refund, err := s.transactionRepository.RefundTransaction(ctx, req.TransactionUUID, req.Amount)
+	if err != nil {
+		return model.RefundPaymentResponse{}, err
+	}
+
+	if err = s.processRefund(ctx, refund); err != nil {
+		return model.RefundPaymentResponse{}, err
+	}
+
+	log.Printf(`
+💸 [Payment Refunded]
+• 🆔 Order UUID: %s
+• 🧾 Transaction UUID: %s
+• 💰 Amount: %d %s (full: %t), refunded total: %d
+• 📝 Reason: %s
+`, req.OrderUUID, req.TransactionUUID, refund.Amount, transaction.Currency, req.Full(), transaction.RefundedAmount, req.Reason,
+	)
+
+	log.Printf("✅Возврат прошёл успешно, refund_transaction_uuid: %v\n", refund.UUID)
+
+	return model.RefundPaymentResponse{
+		RefundTransactionUUID: refund.UUID,
+	}, nil
+}
+
+// processRefund проводит возврат у провайдера и записывает исход в журнал: SUCCEEDED при успехе,
+// при отказе возврат закрывается в FAILED, а его сумма возвращается в остаток оплаты. Если исход
+// неизвестен, возврат остаётся в PENDING и продолжает занимать сумму, как и оплата в process
+func (s *service) processRefund(ctx context.Context, refund model.Transaction) error {
+	callErr := s.provider.Refund(ctx, model.ChargeOf(refund))
+	if callErr != nil && !declined(callErr) {
+		log.Printf("⏳ Исход возврата неизвестен, возврат остаётся в PENDING, refund_transaction_uuid: %v: %v\n", refund.UUID, callErr)
+		return providerError(callErr)
+	}
+
+	// Исход записываем и после отмены запроса, иначе возврат останется в PENDING
+	ctx = withoutCancel(ctx)
+	if callErr != nil {
+		if _, _, err := s.transactionRepository.ReleaseRefund(ctx, refund.UUID); err != nil {
+			log.Printf("failed to release refund %s: %v\n", refund.UUID, err)
+		}
+		log.Printf("❌ Провайдер не провёл возврат, refund_transaction_uuid: %v: %v\n", refund.UUID, callErr)
+		return providerError(callErr)
+	}
+
+	if _, err := s.transactionRepository.TransitionTransaction(ctx, refund.UUID, model.TransactionStatusSucceeded); err != nil {
+		log.Printf("failed to move refund %s to %s: %v\n", refund.UUID, model.TransactionStatusSucceeded, err)
+		return fmt.Errorf("%w: %w", model.ErrPaymentInternalError, err)
+	}
+
+	return nil
+}
+
+// validateRefundCurrency проверяет, что возврат запрошен в валюте оплаты. Полный возврат
+// может не указывать валюту: сумму берём из транзакции
+func validateRefundCurrency(req model.RefundPaymentRequest, transaction model.Transaction) error {
+	if req.Currency == "" && req.Full() {
+		return nil
+	}
+
+	if !req.Currency.Supported() {
+		return fmt.Errorf("%w: %q", model.ErrUnsupportedCurrency, req.Currency)
+	}
+
+	if req.Currency != transaction.Currency {
+		return fmt.Errorf("%w: %s, paid in %s", model.ErrRefundCurrencyMismatch, req.Currency, transaction.Currency)
+	}
+
+	return nil
+}
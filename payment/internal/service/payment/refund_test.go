@@ -0,0 +1,211 @@
+package payment
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+)
+
+// expectRefund ожидает возврат у провайдера с ответом refundErr и запись его исхода: SUCCEEDED
+// при успехе, освобождение суммы при отказе; при неизвестном исходе возврат не меняется
+func (s *ServiceSuite) expectRefund(refund model.Transaction, refundErr error) {
+	s.provider.On("Refund", mock.Anything, model.ChargeOf(refund)).Return(refundErr).Once()
+
+	switch {
+	case refundErr == nil:
+		s.transactionRepository.On("TransitionTransaction", mock.Anything, refund.UUID, model.TransactionStatusSucceeded).
+			Return(model.Transaction{UUID: refund.UUID, Status: model.TransactionStatusSucceeded}, nil).Once()
+	case declined(refundErr):
+		s.transactionRepository.On("ReleaseRefund", mock.Anything, refund.UUID).
+			Return(model.Transaction{}, model.Transaction{UUID: refund.UUID, Status: model.TransactionStatusFailed}, nil).Once()
+	}
+}
+
+func (s *ServiceSuite) TestRefundPaymentFull() {
+	req := model.RefundPaymentRequest{
+		TransactionUUID: gofakeit.UUID(),
+		OrderUUID:       gofakeit.UUID(),
+		Reason:          "cancelled by user",
+	}
+	transaction := model.Transaction{UUID: req.TransactionUUID, OrderUUID: req.OrderUUID, Amount: 30000}
+
+	s.transactionRepository.On("GetTransaction", s.ctx, req.TransactionUUID).Return(transaction, nil)
+	refund := model.Transaction{UUID: gofakeit.UUID(), Amount: 30000, Kind: model.TransactionKindRefund, ParentTransactionUUID: req.TransactionUUID}
+	s.transactionRepository.On("RefundTransaction", s.ctx, req.TransactionUUID, int64(0)).
+		Return(model.Transaction{RefundedAmount: 30000, Status: model.TransactionStatusRefunded}, refund, nil)
+	s.expectRefund(refund, nil)
+
+	res, err := s.service.RefundPayment(s.ctx, req)
+
+	s.NoError(err)
+	s.True(req.Full())
+	// Клиент получает UUID возврата, записанного в журнал
+	s.Equal(refund.UUID, res.RefundTransactionUUID)
+}
+
+func (s *ServiceSuite) TestRefundPaymentPartial() {
+	req := model.RefundPaymentRequest{
+		TransactionUUID: gofakeit.UUID(),
+		OrderUUID:       gofakeit.UUID(),
+		Amount:          15050,
+		Currency:        model.RUB,
+	}
+	transaction := model.Transaction{UUID: req.TransactionUUID, OrderUUID: req.OrderUUID, Amount: 30000, Currency: model.RUB}
+
+	s.transactionRepository.On("GetTransaction", s.ctx, req.TransactionUUID).Return(transaction, nil)
+	refund := model.Transaction{UUID: gofakeit.UUID(), Amount: 15050, Kind: model.TransactionKindRefund, ParentTransactionUUID: req.TransactionUUID}
+	s.transactionRepository.On("RefundTransaction", s.ctx, req.TransactionUUID, int64(15050)).
+		Return(model.Transaction{RefundedAmount: 15050, Status: model.TransactionStatusPartiallyRefunded}, refund, nil)
+	s.expectRefund(refund, nil)
+
+	res, err := s.service.RefundPayment(s.ctx, req)
+
+	s.NoError(err)
+	s.False(req.Full())
+	s.NotEmpty(res.RefundTransactionUUID)
+}
+
+func (s *ServiceSuite) TestRefundPaymentCurrencyMismatch() {
+	req := model.RefundPaymentRequest{
+		TransactionUUID: gofakeit.UUID(),
+		OrderUUID:       gofakeit.UUID(),
+		Amount:          1000,
+		Currency:        model.USD,
+	}
+
+	s.transactionRepository.On("GetTransaction", s.ctx, req.TransactionUUID).
+		Return(model.Transaction{UUID: req.TransactionUUID, OrderUUID: req.OrderUUID, Amount: 30000, Currency: model.RUB}, nil)
+
+	_, err := s.service.RefundPayment(s.ctx, req)
+
+	s.ErrorIs(err, model.ErrRefundCurrencyMismatch)
+	s.transactionRepository.AssertNotCalled(s.T(), "RefundTransaction")
+}
+
+func (s *ServiceSuite) TestRefundPaymentPartialWithoutCurrency() {
+	req := model.RefundPaymentRequest{
+		TransactionUUID: gofakeit.UUID(),
+		OrderUUID:       gofakeit.UUID(),
+		Amount:          1000,
+	}
+
+	s.transactionRepository.On("GetTransaction", s.ctx, req.TransactionUUID).
+		Return(model.Transaction{UUID: req.TransactionUUID, OrderUUID: req.OrderUUID, Amount: 30000, Currency: model.RUB}, nil)
+
+	_, err := s.service.RefundPayment(s.ctx, req)
+
+	s.ErrorIs(err, model.ErrUnsupportedCurrency)
+}
+
+func (s *ServiceSuite) TestRefundPaymentInvalidTransaction() {
+	req := model.RefundPaymentRequest{
+		TransactionUUID: "not-a-uuid",
+		OrderUUID:       gofakeit.UUID(),
+	}
+
+	_, err := s.service.RefundPayment(s.ctx, req)
+
+	s.ErrorIs(err, model.ErrInvalidTransaction)
+}
+
+func (s *ServiceSuite) TestRefundPaymentNegativeAmount() {
+	req := model.RefundPaymentRequest{
+		TransactionUUID: gofakeit.UUID(),
+		OrderUUID:       gofakeit.UUID(),
+		Amount:          -1,
+	}
+
+	_, err := s.service.RefundPayment(s.ctx, req)
+
+	s.ErrorIs(err, model.ErrInvalidRefundAmount)
+}
+
+func (s *ServiceSuite) TestRefundPaymentTransactionNotFound() {
+	req := model.RefundPaymentRequest{
+		TransactionUUID: gofakeit.UUID(),
+		OrderUUID:       gofakeit.UUID(),
+	}
+
+	s.transactionRepository.On("GetTransaction", s.ctx, req.TransactionUUID).Return(model.Transaction{}, model.ErrTransactionNotFound)
+
+	_, err := s.service.RefundPayment(s.ctx, req)
+
+	s.ErrorIs(err, model.ErrTransactionNotFound)
+}
+
+func (s *ServiceSuite) TestRefundPaymentOtherOrder() {
+	req := model.RefundPaymentRequest{
+		TransactionUUID: gofakeit.UUID(),
+		OrderUUID:       gofakeit.UUID(),
+	}
+
+	s.transactionRepository.On("GetTransaction", s.ctx, req.TransactionUUID).
+		Return(model.Transaction{UUID: req.TransactionUUID, OrderUUID: gofakeit.UUID()}, nil)
+
+	_, err := s.service.RefundPayment(s.ctx, req)
+
+	s.ErrorIs(err, model.ErrInvalidTransaction)
+}
+
+func (s *ServiceSuite) TestRefundPaymentAlreadyRefunded() {
+	req := model.RefundPaymentRequest{
+		TransactionUUID: gofakeit.UUID(),
+		OrderUUID:       gofakeit.UUID(),
+	}
+
+	s.transactionRepository.On("GetTransaction", s.ctx, req.TransactionUUID).
+		Return(model.Transaction{UUID: req.TransactionUUID, OrderUUID: req.OrderUUID}, nil)
+	s.transactionRepository.On("RefundTransaction", s.ctx, req.TransactionUUID, int64(0)).
+		Return(model.Transaction{}, model.Transaction{}, model.ErrTransactionAlreadyRefunded)
+
+	_, err := s.service.RefundPayment(s.ctx, req)
+
+	s.ErrorIs(err, model.ErrTransactionAlreadyRefunded)
+}
+
+// refundOutcomeRequest готовит полный возврат оплаты, записанный в журнал, и возвращает запись возврата
+func (s *ServiceSuite) refundOutcomeRequest() (model.RefundPaymentRequest, model.Transaction) {
+	req := model.RefundPaymentRequest{
+		TransactionUUID: gofakeit.UUID(),
+		OrderUUID:       gofakeit.UUID(),
+	}
+	refund := model.Transaction{
+		UUID:                  gofakeit.UUID(),
+		OrderUUID:             req.OrderUUID,
+		PaymentMethod:         model.Card,
+		Amount:                30000,
+		Currency:              model.RUB,
+		Status:                model.TransactionStatusPending,
+		Kind:                  model.TransactionKindRefund,
+		ParentTransactionUUID: req.TransactionUUID,
+	}
+
+	s.transactionRepository.On("GetTransaction", s.ctx, req.TransactionUUID).
+		Return(model.Transaction{UUID: req.TransactionUUID, OrderUUID: req.OrderUUID, Amount: 30000, Currency: model.RUB}, nil)
+	s.transactionRepository.On("RefundTransaction", s.ctx, req.TransactionUUID, int64(0)).
+		Return(model.Transaction{RefundedAmount: 30000, Status: model.TransactionStatusRefunded}, refund, nil)
+
+	return req, refund
+}
+
+func (s *ServiceSuite) TestRefundPaymentDeclinedReleasesAmount() {
+	req, refund := s.refundOutcomeRequest()
+	s.expectRefund(refund, model.ErrPaymentDeclined)
+
+	_, err := s.service.RefundPayment(s.ctx, req)
+
+	s.ErrorIs(err, model.ErrPaymentDeclined)
+}
+
+func (s *ServiceSuite) TestRefundPaymentUnknownOutcomeKeepsPending() {
+	req, refund := s.refundOutcomeRequest()
+	// Провайдер мог провести возврат: сумма остаётся занятой, а возврат — в PENDING
+	s.expectRefund(refund, model.ErrProviderTimeout)
+
+	_, err := s.service.RefundPayment(s.ctx, req)
+
+	s.ErrorIs(err, model.ErrProviderTimeout)
+	s.transactionRepository.AssertNotCalled(s.T(), "ReleaseRefund", mock.Anything, mock.Anything)
+	s.transactionRepository.AssertNotCalled(s.T(), "TransitionTransaction", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -1,13 +1,26 @@
 package payment
 
 import (
+	"time"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/provider"
+	"github.com/baryshnikkov/rocket-factory/payment/internal/repository"
 	def "github.com/baryshnikkov/rocket-factory/payment/internal/service"
 )
 
 var _ def.PaymentService = (*service)(nil)
 
-type service struct{}
+type service struct {
+	transactionRepository repository.TransactionRepository
+	provider              provider.Provider
+	// authorizationTTL - срок холда AuthorizePayment
+	authorizationTTL time.Duration
+}
 
-func NewService() *service {
-	return &service{}
+func NewService(transactionRepository repository.TransactionRepository, provider provider.Provider, authorizationTTL time.Duration) *service {
+	return &service{
+		transactionRepository: transactionRepository,
+		provider:              provider,
+		authorizationTTL:      authorizationTTL,
+	}
 }
@@ -3,21 +3,33 @@ package payment
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
+
+	providerMocks "github.com/baryshnikkov/rocket-factory/payment/internal/provider/mocks"
+	"github.com/baryshnikkov/rocket-factory/payment/internal/repository/mocks"
 )
 
+const authorizationTTL = time.Hour
+
 type ServiceSuite struct {
 	suite.Suite
 	ctx context.Context
 
+	transactionRepository *mocks.TransactionRepository
+	provider              *providerMocks.Provider
+
 	service *service
 }
 
 func (s *ServiceSuite) SetupTest() {
 	s.ctx = context.Background()
 
-	s.service = NewService()
+	s.transactionRepository = mocks.NewTransactionRepository(s.T())
+	s.provider = providerMocks.NewProvider(s.T())
+
+	s.service = NewService(s.transactionRepository, s.provider, authorizationTTL)
 }
 
 func (s *ServiceSuite) TearDownTest() {
@@ -0,0 +1,57 @@
+package payment
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+)
+
+func (s *service) VoidAuthorization(ctx context.Context, req model.VoidAuthorizationRequest) (model.VoidAuthorizationResponse, error) {
+	transaction, err := s.authorization(ctx, req.TransactionUUID, req.OrderUUID)
+	if err != nil {
+		return model.VoidAuthorizationResponse{}, err
+	}
+
+	switch {
+	case transaction.Status == model.TransactionStatusVoided, transaction.Status == model.TransactionStatusExpired:
+		// Повтор или холд уже истёк: деньги и так не списаны
+		return voidResponse(transaction), nil
+	case transaction.AuthorizationExpired(time.Now()):
+		expired, err := s.settle(ctx, transaction.UUID, model.TransactionStatusExpired)
+		if err != nil {
+			return model.VoidAuthorizationResponse{}, err
+		}
+		return voidResponse(expired), nil
+	case transaction.Status != model.TransactionStatusAuthorized && transaction.Status != model.TransactionStatusVoiding:
+		return model.VoidAuthorizationResponse{}, model.ErrTransactionNotAuthorized
+	}
+
+	if err = s.claim(ctx, transaction, model.TransactionStatusVoiding); err != nil {
+		return model.VoidAuthorizationResponse{}, err
+	}
+
+	err = s.provider.Void(ctx, model.ChargeOf(transaction))
+	voided, err := s.finish(ctx, transaction.UUID, err, model.TransactionStatusVoided)
+	if err != nil {
+		return model.VoidAuthorizationResponse{}, err
+	}
+
+	log.Printf(`
+🔓 [Authorization Voided]
+• 🆔 Order UUID: %s
+• 🧾 Transaction UUID: %s
+• 📝 Reason: %s
+`, transaction.OrderUUID, transaction.UUID, req.Reason,
+	)
+
+	return voidResponse(voided), nil
+}
+
+func voidResponse(transaction model.Transaction) model.VoidAuthorizationResponse {
+	return model.VoidAuthorizationResponse{
+		TransactionUUID: transaction.UUID,
+		Status:          transaction.Status,
+	}
+}
@@ -0,0 +1,135 @@
+package payment
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
+)
+
+func voidRequest(transaction model.Transaction) model.VoidAuthorizationRequest {
+	return model.VoidAuthorizationRequest{
+		TransactionUUID: transaction.UUID,
+		OrderUUID:       transaction.OrderUUID,
+		Reason:          "cancelled by user",
+	}
+}
+
+func (s *ServiceSuite) TestVoidAuthorizationSuccess() {
+	transaction := authorizedTransaction(model.TransactionStatusAuthorized, time.Hour)
+
+	s.transactionRepository.On("GetTransaction", s.ctx, transaction.UUID).Return(transaction, nil).Once()
+	s.transactionRepository.On("TransitionTransaction", mock.Anything, transaction.UUID, model.TransactionStatusVoiding).
+		Return(model.Transaction{UUID: transaction.UUID, Status: model.TransactionStatusVoiding}, nil).Once()
+	s.provider.On("Void", s.ctx, model.ChargeOf(transaction)).Return(nil).Once()
+	s.transactionRepository.On("TransitionTransaction", mock.Anything, transaction.UUID, model.TransactionStatusVoided).
+		Return(model.Transaction{UUID: transaction.UUID, Status: model.TransactionStatusVoided}, nil).Once()
+
+	res, err := s.service.VoidAuthorization(s.ctx, voidRequest(transaction))
+
+	s.NoError(err)
+	s.Equal(model.VoidAuthorizationResponse{
+		TransactionUUID: transaction.UUID,
+		Status:          model.TransactionStatusVoided,
+	}, res)
+}
+
+func (s *ServiceSuite) TestVoidAuthorizationExpired() {
+	transaction := authorizedTransaction(model.TransactionStatusAuthorized, -time.Minute)
+
+	s.transactionRepository.On("GetTransaction", s.ctx, transaction.UUID).Return(transaction, nil).Once()
+	s.transactionRepository.On("TransitionTransaction", mock.Anything, transaction.UUID, model.TransactionStatusExpired).
+		Return(model.Transaction{UUID: transaction.UUID, Status: model.TransactionStatusExpired}, nil).Once()
+
+	res, err := s.service.VoidAuthorization(s.ctx, voidRequest(transaction))
+
+	s.NoError(err)
+	s.Equal(model.TransactionStatusExpired, res.Status)
+}
+
+func (s *ServiceSuite) TestVoidAuthorizationAlreadyVoided() {
+	transaction := authorizedTransaction(model.TransactionStatusVoided, time.Hour)
+
+	s.transactionRepository.On("GetTransaction", s.ctx, transaction.UUID).Return(transaction, nil).Once()
+
+	res, err := s.service.VoidAuthorization(s.ctx, voidRequest(transaction))
+
+	s.NoError(err)
+	s.Equal(model.TransactionStatusVoided, res.Status)
+}
+
+func (s *ServiceSuite) TestVoidAuthorizationCaptured() {
+	transaction := authorizedTransaction(model.TransactionStatusSucceeded, time.Hour)
+
+	s.transactionRepository.On("GetTransaction", s.ctx, transaction.UUID).Return(transaction, nil).Once()
+
+	_, err := s.service.VoidAuthorization(s.ctx, voidRequest(transaction))
+
+	s.ErrorIs(err, model.ErrTransactionNotAuthorized)
+}
+
+func (s *ServiceSuite) TestVoidAuthorizationNotFound() {
+	transaction := authorizedTransaction(model.TransactionStatusAuthorized, time.Hour)
+
+	s.transactionRepository.On("GetTransaction", s.ctx, transaction.UUID).Return(model.Transaction{}, model.ErrTransactionNotFound).Once()
+
+	_, err := s.service.VoidAuthorization(s.ctx, voidRequest(transaction))
+
+	s.ErrorIs(err, model.ErrTransactionNotFound)
+}
+
+func (s *ServiceSuite) TestVoidAuthorizationProviderError() {
+	transaction := authorizedTransaction(model.TransactionStatusAuthorized, time.Hour)
+
+	s.transactionRepository.On("GetTransaction", s.ctx, transaction.UUID).Return(transaction, nil).Once()
+	s.transactionRepository.On("TransitionTransaction", mock.Anything, transaction.UUID, model.TransactionStatusVoiding).
+		Return(model.Transaction{UUID: transaction.UUID, Status: model.TransactionStatusVoiding}, nil).Once()
+	s.provider.On("Void", s.ctx, mock.Anything).Return(model.ErrProviderTimeout).Once()
+
+	_, err := s.service.VoidAuthorization(s.ctx, voidRequest(transaction))
+
+	// Исход неизвестен: холд остаётся в VOIDING до повтора
+	s.ErrorIs(err, model.ErrProviderTimeout)
+	s.transactionRepository.AssertNotCalled(s.T(), "TransitionTransaction", mock.Anything, transaction.UUID, model.TransactionStatusAuthorized)
+}
+
+func (s *ServiceSuite) TestVoidAuthorizationDeclined() {
+	transaction := authorizedTransaction(model.TransactionStatusAuthorized, time.Hour)
+
+	s.transactionRepository.On("GetTransaction", s.ctx, transaction.UUID).Return(transaction, nil).Once()
+	s.transactionRepository.On("TransitionTransaction", mock.Anything, transaction.UUID, model.TransactionStatusVoiding).
+		Return(model.Transaction{UUID: transaction.UUID, Status: model.TransactionStatusVoiding}, nil).Once()
+	s.provider.On("Void", s.ctx, mock.Anything).Return(model.ErrPaymentDeclined).Once()
+	s.transactionRepository.On("TransitionTransaction", mock.Anything, transaction.UUID, model.TransactionStatusAuthorized).
+		Return(transaction, nil).Once()
+
+	_, err := s.service.VoidAuthorization(s.ctx, voidRequest(transaction))
+
+	s.ErrorIs(err, model.ErrPaymentDeclined)
+}
+
+func (s *ServiceSuite) TestVoidAuthorizationResumesVoiding() {
+	transaction := authorizedTransaction(model.TransactionStatusVoiding, time.Hour)
+
+	s.transactionRepository.On("GetTransaction", s.ctx, transaction.UUID).Return(transaction, nil).Once()
+	s.provider.On("Void", s.ctx, mock.Anything).Return(nil).Once()
+	s.transactionRepository.On("TransitionTransaction", mock.Anything, transaction.UUID, model.TransactionStatusVoided).
+		Return(model.Transaction{UUID: transaction.UUID, Status: model.TransactionStatusVoided}, nil).Once()
+
+	res, err := s.service.VoidAuthorization(s.ctx, voidRequest(transaction))
+
+	s.NoError(err)
+	s.Equal(model.TransactionStatusVoided, res.Status)
+}
+
+func (s *ServiceSuite) TestVoidAuthorizationCapturing() {
+	transaction := authorizedTransaction(model.TransactionStatusCapturing, time.Hour)
+
+	s.transactionRepository.On("GetTransaction", s.ctx, transaction.UUID).Return(transaction, nil).Once()
+
+	_, err := s.service.VoidAuthorization(s.ctx, voidRequest(transaction))
+
+	s.ErrorIs(err, model.ErrTransactionNotAuthorized)
+	s.provider.AssertNotCalled(s.T(), "Void", mock.Anything, mock.Anything)
+}
@@ -2,10 +2,20 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/baryshnikkov/rocket-factory/payment/internal/model"
 )
 
 type PaymentService interface {
 	PayOrder(ctx context.Context, req model.PayOrderRequest) (model.PayOrderResponse, error)
+	RefundPayment(ctx context.Context, req model.RefundPaymentRequest) (model.RefundPaymentResponse, error)
+	GetTransaction(ctx context.Context, transactionUUID string) (model.Transaction, error)
+	ListTransactions(ctx context.Context, filter model.TransactionsFilter, params model.ListTransactionsParams) (model.TransactionsList, error)
+	AuthorizePayment(ctx context.Context, req model.AuthorizePaymentRequest) (model.AuthorizePaymentResponse, error)
+	CapturePayment(ctx context.Context, req model.CapturePaymentRequest) (model.CapturePaymentResponse, error)
+	VoidAuthorization(ctx context.Context, req model.VoidAuthorizationRequest) (model.VoidAuthorizationResponse, error)
+	// ExpirePendingTransactions закрывает транзакции, оставшиеся в PENDING с момента не позже createdBefore,
+	// и возвращает их количество
+	ExpirePendingTransactions(ctx context.Context, createdBefore time.Time) (expired int, err error)
 }
@@ -0,0 +1,38 @@
+package expiration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/baryshnikkov/rocket-factory/payment/internal/service/mocks"
+)
+
+// fakeClock - часы с заданным временем
+type fakeClock struct {
+	now time.Time
+}
+
+func (c fakeClock) Now() time.Time {
+	return c.now
+}
+
+type WorkerSuite struct {
+	suite.Suite
+	ctx context.Context
+
+	paymentService *mocks.PaymentService
+	clock          fakeClock
+}
+
+func (s *WorkerSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.paymentService = mocks.NewPaymentService(s.T())
+	s.clock = fakeClock{now: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)}
+}
+
+func TestExpirationWorker(t *testing.T) {
+	suite.Run(t, new(WorkerSuite))
+}
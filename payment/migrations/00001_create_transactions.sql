@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS transactions (
+    uuid            VARCHAR(36)      PRIMARY KEY,
+    order_uuid      VARCHAR(36)      NOT NULL,
+    user_uuid       VARCHAR(36)      NOT NULL,
+    payment_method  VARCHAR(32)      NOT NULL,
+    amount          DOUBLE PRECISION NOT NULL,
+    refunded_amount DOUBLE PRECISION NOT NULL DEFAULT 0,
+    status          VARCHAR(32)      NOT NULL,
+    created_at      TIMESTAMP        NOT NULL,
+    updated_at      TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS transactions_created_at_idx ON transactions (created_at, uuid);
+CREATE INDEX IF NOT EXISTS transactions_order_uuid_idx ON transactions (order_uuid);
+CREATE INDEX IF NOT EXISTS transactions_user_uuid_idx ON transactions (user_uuid);
+
+-- +goose Down
+DROP TABLE IF EXISTS transactions;
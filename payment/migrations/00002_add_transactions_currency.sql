@@ -0,0 +1,6 @@
+-- +goose Up
+-- Транзакции, записанные до появления валюты, проводились в рублях
+ALTER TABLE transactions ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT 'RUB';
+
+-- +goose Down
+ALTER TABLE transactions DROP COLUMN currency;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- Ключ идемпотентности оплаты: явный ключ клиента или UUID заказа. У транзакций, записанных
+-- до появления ключа, он пустой и повторы по ним не отслеживаются.
+ALTER TABLE transactions ADD COLUMN idempotency_key VARCHAR(255);
+
+CREATE UNIQUE INDEX IF NOT EXISTS transactions_idempotency_key_idx ON transactions (idempotency_key);
+
+-- +goose Down
+DROP INDEX IF EXISTS transactions_idempotency_key_idx;
+ALTER TABLE transactions DROP COLUMN idempotency_key;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Срок холда у транзакций AuthorizePayment; у обычных оплат пустой.
+ALTER TABLE transactions ADD COLUMN authorization_expires_at TIMESTAMP;
+
+-- +goose Down
+ALTER TABLE transactions DROP COLUMN authorization_expires_at;
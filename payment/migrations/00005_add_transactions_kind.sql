@@ -0,0 +1,12 @@
+-- +goose Up
+-- Возвраты записываются в журнал отдельными транзакциями со ссылкой на оплату. Возвраты,
+-- проведённые раньше, отражены только в refunded_amount оплаты.
+ALTER TABLE transactions ADD COLUMN kind VARCHAR(16) NOT NULL DEFAULT 'PAYMENT';
+ALTER TABLE transactions ADD COLUMN parent_transaction_uuid VARCHAR(36);
+
+CREATE INDEX IF NOT EXISTS transactions_parent_transaction_uuid_idx ON transactions (parent_transaction_uuid);
+
+-- +goose Down
+DROP INDEX IF EXISTS transactions_parent_transaction_uuid_idx;
+ALTER TABLE transactions DROP COLUMN parent_transaction_uuid;
+ALTER TABLE transactions DROP COLUMN kind;
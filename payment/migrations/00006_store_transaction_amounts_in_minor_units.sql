@@ -0,0 +1,27 @@
+-- +goose Up
+-- Суммы журнала переводятся из основных единиц валюты в минимальные: возвраты суммируются
+-- и сравниваются в целых числах. У JPY минимальная единица совпадает с основной.
+ALTER TABLE transactions ADD COLUMN amount_minor BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE transactions ADD COLUMN refunded_amount_minor BIGINT NOT NULL DEFAULT 0;
+
+UPDATE transactions SET
+    amount_minor = CAST(ROUND(amount * CASE currency WHEN 'JPY' THEN 1 ELSE 100 END) AS BIGINT),
+    refunded_amount_minor = CAST(ROUND(refunded_amount * CASE currency WHEN 'JPY' THEN 1 ELSE 100 END) AS BIGINT);
+
+ALTER TABLE transactions DROP COLUMN amount;
+ALTER TABLE transactions DROP COLUMN refunded_amount;
+ALTER TABLE transactions RENAME COLUMN amount_minor TO amount;
+ALTER TABLE transactions RENAME COLUMN refunded_amount_minor TO refunded_amount;
+
+-- +goose Down
+ALTER TABLE transactions ADD COLUMN amount_major DOUBLE PRECISION NOT NULL DEFAULT 0;
+ALTER TABLE transactions ADD COLUMN refunded_amount_major DOUBLE PRECISION NOT NULL DEFAULT 0;
+
+UPDATE transactions SET
+    amount_major = amount / CASE currency WHEN 'JPY' THEN 1.0 ELSE 100.0 END,
+    refunded_amount_major = refunded_amount / CASE currency WHEN 'JPY' THEN 1.0 ELSE 100.0 END;
+
+ALTER TABLE transactions DROP COLUMN amount;
+ALTER TABLE transactions DROP COLUMN refunded_amount;
+ALTER TABLE transactions RENAME COLUMN amount_major TO amount;
+ALTER TABLE transactions RENAME COLUMN refunded_amount_major TO refunded_amount;
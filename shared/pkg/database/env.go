@@ -0,0 +1,29 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"os"
+)
+
+// StorageMemory — хранилище в памяти процесса, значение переменной хранилища по умолчанию
+const StorageMemory = "memory"
+
+// OpenFromEnv выбирает хранилище по переменной окружения storageEnv: memory (по умолчанию),
+// postgres или sqlite. Для SQL-хранилищ строка подключения берётся из dsnEnv.
+// Для memory возвращает nil: данные сервиса хранятся в памяти.
+func OpenFromEnv(ctx context.Context, storageEnv, dsnEnv string, migrations fs.FS) (*sql.DB, error) {
+	storage := os.Getenv(storageEnv)
+	if storage == "" || storage == StorageMemory {
+		return nil, nil
+	}
+
+	dsn := os.Getenv(dsnEnv)
+	if dsn == "" {
+		return nil, fmt.Errorf("%s is required for %s storage", dsnEnv, storage)
+	}
+
+	return Open(ctx, Driver(storage), dsn, migrations)
+}
@@ -0,0 +1,75 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+
+	// Регистрирует драйвер "pgx" для database/sql
+	_ "github.com/jackc/pgx/v5/stdlib"
+	// Регистрирует драйвер "sqlite3" для database/sql
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/pressly/goose/v3"
+)
+
+// Driver — поддерживаемый SQL-движок хранилища сервиса.
+type Driver string
+
+const (
+	DriverPostgres Driver = "postgres"
+	DriverSQLite   Driver = "sqlite"
+)
+
+// Open открывает соединение с базой, проверяет его и накатывает миграции сервиса из migrations.
+func Open(ctx context.Context, driver Driver, dsn string, migrations fs.FS) (*sql.DB, error) {
+	var (
+		driverName string
+		dialect    goose.Dialect
+	)
+
+	switch driver {
+	case DriverPostgres:
+		driverName, dialect = "pgx", goose.DialectPostgres
+	case DriverSQLite:
+		driverName, dialect = "sqlite3", goose.DialectSQLite3
+	default:
+		return nil, fmt.Errorf("unsupported database driver %q", driver)
+	}
+
+	db, err := sql.Open(driverName, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("open database: %w", err)
+	}
+
+	if driver == DriverSQLite {
+		// SQLite не поддерживает конкурентную запись, а in-memory база
+		// существует только в рамках одного соединения
+		db.SetMaxOpenConns(1)
+	}
+
+	if err = db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("ping database: %w", err)
+	}
+
+	if err = migrate(ctx, db, dialect, migrations); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	return db, nil
+}
+
+func migrate(ctx context.Context, db *sql.DB, dialect goose.Dialect, migrations fs.FS) error {
+	provider, err := goose.NewProvider(dialect, db, migrations)
+	if err != nil {
+		return fmt.Errorf("create migration provider: %w", err)
+	}
+
+	if _, err = provider.Up(ctx); err != nil {
+		return fmt.Errorf("apply migrations: %w", err)
+	}
+
+	return nil
+}
@@ -9,10 +9,22 @@ type CreateOrderRes interface {
 	createOrderRes()
 }
 
+type GetOrderHistoryRes interface {
+	getOrderHistoryRes()
+}
+
 type GetOrderRes interface {
 	getOrderRes()
 }
 
+type ListOrdersRes interface {
+	listOrdersRes()
+}
+
 type PayOrderRes interface {
 	payOrderRes()
 }
+
+type UpdateOrderStatusRes interface {
+	updateOrderStatusRes()
+}
@@ -6,8 +6,11 @@ package order_v1
 type OperationName = string
 
 const (
-	CancelOrderOperation OperationName = "CancelOrder"
-	CreateOrderOperation OperationName = "CreateOrder"
-	GetOrderOperation    OperationName = "GetOrder"
-	PayOrderOperation    OperationName = "PayOrder"
+	CancelOrderOperation       OperationName = "CancelOrder"
+	CreateOrderOperation       OperationName = "CreateOrder"
+	GetOrderOperation          OperationName = "GetOrder"
+	GetOrderHistoryOperation   OperationName = "GetOrderHistory"
+	ListOrdersOperation        OperationName = "ListOrders"
+	PayOrderOperation          OperationName = "PayOrder"
+	UpdateOrderStatusOperation OperationName = "UpdateOrderStatus"
 )
@@ -59,10 +59,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 			if len(elem) == 0 {
 				switch r.Method {
+				case "GET":
+					s.handleListOrdersRequest([0]string{}, elemIsEscaped, w, r)
 				case "POST":
 					s.handleCreateOrderRequest([0]string{}, elemIsEscaped, w, r)
 				default:
-					s.notAllowed(w, r, "POST")
+					s.notAllowed(w, r, "GET,POST")
 				}
 
 				return
@@ -132,6 +134,28 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							return
 						}
 
+					case 'h': // Prefix: "history"
+
+						if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetOrderHistoryRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
 					case 'p': // Prefix: "pay"
 
 						if l := len("pay"); len(elem) >= l && elem[0:l] == "pay" {
@@ -154,6 +178,28 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							return
 						}
 
+					case 's': // Prefix: "status"
+
+						if l := len("status"); len(elem) >= l && elem[0:l] == "status" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleUpdateOrderStatusRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
 					}
 
 				}
@@ -250,6 +296,14 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 			if len(elem) == 0 {
 				switch method {
+				case "GET":
+					r.name = ListOrdersOperation
+					r.summary = "List orders"
+					r.operationID = "ListOrders"
+					r.pathPattern = "/api/v1/orders"
+					r.args = args
+					r.count = 0
+					return r, true
 				case "POST":
 					r.name = CreateOrderOperation
 					r.summary = "Create new order"
@@ -331,6 +385,30 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 						}
 
+					case 'h': // Prefix: "history"
+
+						if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetOrderHistoryOperation
+								r.summary = "Get order status history"
+								r.operationID = "GetOrderHistory"
+								r.pathPattern = "/api/v1/orders/{order_uuid}/history"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
 					case 'p': // Prefix: "pay"
 
 						if l := len("pay"); len(elem) >= l && elem[0:l] == "pay" {
@@ -355,6 +433,30 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 						}
 
+					case 's': // Prefix: "status"
+
+						if l := len("status"); len(elem) >= l && elem[0:l] == "status" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = UpdateOrderStatusOperation
+								r.summary = "Update order status"
+								r.operationID = "UpdateOrderStatus"
+								r.pathPattern = "/api/v1/orders/{order_uuid}/status"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
 					}
 
 				}
@@ -42,10 +42,11 @@ func (s *BadGatewayError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*BadGatewayError) cancelOrderRes() {}
-func (*BadGatewayError) createOrderRes() {}
-func (*BadGatewayError) getOrderRes()    {}
-func (*BadGatewayError) payOrderRes()    {}
+func (*BadGatewayError) cancelOrderRes()       {}
+func (*BadGatewayError) createOrderRes()       {}
+func (*BadGatewayError) getOrderRes()          {}
+func (*BadGatewayError) payOrderRes()          {}
+func (*BadGatewayError) updateOrderStatusRes() {}
 
 // Ref: #/components/schemas/bad_request_error
 type BadRequestError struct {
@@ -76,6 +77,7 @@ func (s *BadRequestError) SetMessage(val string) {
 }
 
 func (*BadRequestError) createOrderRes() {}
+func (*BadRequestError) listOrdersRes()  {}
 func (*BadRequestError) payOrderRes()    {}
 
 // CancelOrderNoContent is response for CancelOrder operation.
@@ -111,14 +113,48 @@ func (s *ConflictError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*ConflictError) cancelOrderRes() {}
-func (*ConflictError) payOrderRes()    {}
+func (*ConflictError) cancelOrderRes()       {}
+func (*ConflictError) createOrderRes()       {}
+func (*ConflictError) payOrderRes()          {}
+func (*ConflictError) updateOrderStatusRes() {}
+
+// Ref: #/components/schemas/create_order_item
+type CreateOrderItem struct {
+	// Уникальный идентификатор детали.
+	PartUUID uuid.UUID `json:"part_uuid"`
+	// Количество единиц детали.
+	Quantity int64 `json:"quantity"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *CreateOrderItem) GetPartUUID() uuid.UUID {
+	return s.PartUUID
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *CreateOrderItem) GetQuantity() int64 {
+	return s.Quantity
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *CreateOrderItem) SetPartUUID(val uuid.UUID) {
+	s.PartUUID = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *CreateOrderItem) SetQuantity(val int64) {
+	s.Quantity = val
+}
 
 // Ref: #/components/schemas/create_order_request
 type CreateOrderRequest struct {
 	// Уникальный идентификатор пользователя.
 	UserUUID uuid.UUID `json:"user_uuid"`
-	// Список UUID деталей.
+	// Позиции заказа; одна и та же деталь в нескольких
+	// позициях суммируется.
+	Items []CreateOrderItem `json:"items"`
+	// Список UUID деталей, каждый UUID — одна единица детали.
+	// Устарело, используйте items.
 	PartUuids []uuid.UUID `json:"part_uuids"`
 }
 
@@ -127,6 +163,11 @@ func (s *CreateOrderRequest) GetUserUUID() uuid.UUID {
 	return s.UserUUID
 }
 
+// GetItems returns the value of Items.
+func (s *CreateOrderRequest) GetItems() []CreateOrderItem {
+	return s.Items
+}
+
 // GetPartUuids returns the value of PartUuids.
 func (s *CreateOrderRequest) GetPartUuids() []uuid.UUID {
 	return s.PartUuids
@@ -137,6 +178,11 @@ func (s *CreateOrderRequest) SetUserUUID(val uuid.UUID) {
 	s.UserUUID = val
 }
 
+// SetItems sets the value of Items.
+func (s *CreateOrderRequest) SetItems(val []CreateOrderItem) {
+	s.Items = val
+}
+
 // SetPartUuids sets the value of PartUuids.
 func (s *CreateOrderRequest) SetPartUuids(val []uuid.UUID) {
 	s.PartUuids = val
@@ -226,6 +272,37 @@ func (s *GenericErrorStatusCode) SetResponse(val GenericError) {
 	s.Response = val
 }
 
+// Ref: #/components/schemas/get_order_history_response
+type GetOrderHistoryResponse struct {
+	// Уникальный идентификатор заказа.
+	OrderUUID uuid.UUID `json:"order_uuid"`
+	// Смены статуса от старых к новым; у первой записи
+	// (создание заказа) нет from_status.
+	Transitions []OrderStatusTransition `json:"transitions"`
+}
+
+// GetOrderUUID returns the value of OrderUUID.
+func (s *GetOrderHistoryResponse) GetOrderUUID() uuid.UUID {
+	return s.OrderUUID
+}
+
+// GetTransitions returns the value of Transitions.
+func (s *GetOrderHistoryResponse) GetTransitions() []OrderStatusTransition {
+	return s.Transitions
+}
+
+// SetOrderUUID sets the value of OrderUUID.
+func (s *GetOrderHistoryResponse) SetOrderUUID(val uuid.UUID) {
+	s.OrderUUID = val
+}
+
+// SetTransitions sets the value of Transitions.
+func (s *GetOrderHistoryResponse) SetTransitions(val []OrderStatusTransition) {
+	s.Transitions = val
+}
+
+func (*GetOrderHistoryResponse) getOrderHistoryRes() {}
+
 // Ref: #/components/schemas/get_order_response
 type GetOrderResponse struct {
 	Data OrderDto `json:"data"`
@@ -271,10 +348,56 @@ func (s *InternalServerError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*InternalServerError) cancelOrderRes() {}
-func (*InternalServerError) createOrderRes() {}
-func (*InternalServerError) getOrderRes()    {}
-func (*InternalServerError) payOrderRes()    {}
+func (*InternalServerError) cancelOrderRes()       {}
+func (*InternalServerError) createOrderRes()       {}
+func (*InternalServerError) getOrderHistoryRes()   {}
+func (*InternalServerError) getOrderRes()          {}
+func (*InternalServerError) listOrdersRes()        {}
+func (*InternalServerError) payOrderRes()          {}
+func (*InternalServerError) updateOrderStatusRes() {}
+
+// Ref: #/components/schemas/list_orders_response
+type ListOrdersResponse struct {
+	// Заказы текущей страницы.
+	Orders []OrderDto `json:"orders"`
+	// Токен следующей страницы (отсутствует на последней
+	// странице).
+	NextPageToken OptString `json:"next_page_token"`
+	// Общее количество заказов по фильтру.
+	TotalSize int32 `json:"total_size"`
+}
+
+// GetOrders returns the value of Orders.
+func (s *ListOrdersResponse) GetOrders() []OrderDto {
+	return s.Orders
+}
+
+// GetNextPageToken returns the value of NextPageToken.
+func (s *ListOrdersResponse) GetNextPageToken() OptString {
+	return s.NextPageToken
+}
+
+// GetTotalSize returns the value of TotalSize.
+func (s *ListOrdersResponse) GetTotalSize() int32 {
+	return s.TotalSize
+}
+
+// SetOrders sets the value of Orders.
+func (s *ListOrdersResponse) SetOrders(val []OrderDto) {
+	s.Orders = val
+}
+
+// SetNextPageToken sets the value of NextPageToken.
+func (s *ListOrdersResponse) SetNextPageToken(val OptString) {
+	s.NextPageToken = val
+}
+
+// SetTotalSize sets the value of TotalSize.
+func (s *ListOrdersResponse) SetTotalSize(val int32) {
+	s.TotalSize = val
+}
+
+func (*ListOrdersResponse) listOrdersRes() {}
 
 // Ref: #/components/schemas/not_found_error
 type NotFoundError struct {
@@ -282,6 +405,8 @@ type NotFoundError struct {
 	Code int `json:"code"`
 	// Заказ не найден.
 	Message string `json:"message"`
+	// UUID деталей, которых нет в каталоге.
+	PartUuids []uuid.UUID `json:"part_uuids"`
 }
 
 // GetCode returns the value of Code.
@@ -294,6 +419,11 @@ func (s *NotFoundError) GetMessage() string {
 	return s.Message
 }
 
+// GetPartUuids returns the value of PartUuids.
+func (s *NotFoundError) GetPartUuids() []uuid.UUID {
+	return s.PartUuids
+}
+
 // SetCode sets the value of Code.
 func (s *NotFoundError) SetCode(val int) {
 	s.Code = val
@@ -304,10 +434,17 @@ func (s *NotFoundError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*NotFoundError) cancelOrderRes() {}
-func (*NotFoundError) createOrderRes() {}
-func (*NotFoundError) getOrderRes()    {}
-func (*NotFoundError) payOrderRes()    {}
+// SetPartUuids sets the value of PartUuids.
+func (s *NotFoundError) SetPartUuids(val []uuid.UUID) {
+	s.PartUuids = val
+}
+
+func (*NotFoundError) cancelOrderRes()       {}
+func (*NotFoundError) createOrderRes()       {}
+func (*NotFoundError) getOrderHistoryRes()   {}
+func (*NotFoundError) getOrderRes()          {}
+func (*NotFoundError) payOrderRes()          {}
+func (*NotFoundError) updateOrderStatusRes() {}
 
 // NewOptDateTime returns new OptDateTime with value set to v.
 func NewOptDateTime(v time.Time) OptDateTime {
@@ -355,6 +492,98 @@ func (o OptDateTime) Or(d time.Time) time.Time {
 	return d
 }
 
+// NewOptInt32 returns new OptInt32 with value set to v.
+func NewOptInt32(v int32) OptInt32 {
+	return OptInt32{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt32 is optional int32.
+type OptInt32 struct {
+	Value int32
+	Set   bool
+}
+
+// IsSet returns true if OptInt32 was set.
+func (o OptInt32) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt32) Reset() {
+	var v int32
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt32) SetTo(v int32) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt32) Get() (v int32, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt32) Or(d int32) int32 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptOrderStatus returns new OptOrderStatus with value set to v.
+func NewOptOrderStatus(v OrderStatus) OptOrderStatus {
+	return OptOrderStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptOrderStatus is optional OrderStatus.
+type OptOrderStatus struct {
+	Value OrderStatus
+	Set   bool
+}
+
+// IsSet returns true if OptOrderStatus was set.
+func (o OptOrderStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptOrderStatus) Reset() {
+	var v OrderStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptOrderStatus) SetTo(v OrderStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptOrderStatus) Get() (v OrderStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptOrderStatus) Or(d OrderStatus) OrderStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptPaymentMethod returns new OptPaymentMethod with value set to v.
 func NewOptPaymentMethod(v PaymentMethod) OptPaymentMethod {
 	return OptPaymentMethod{
@@ -401,6 +630,52 @@ func (o OptPaymentMethod) Or(d PaymentMethod) PaymentMethod {
 	return d
 }
 
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptUUID returns new OptUUID with value set to v.
 func NewOptUUID(v uuid.UUID) OptUUID {
 	return OptUUID{
@@ -447,20 +722,74 @@ func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
 	return d
 }
 
+// Инициатор смены статуса:
+// - user: Действие пользователя
+// - system: Автоматическое действие сервиса.
+// Ref: #/components/schemas/order_actor
+type OrderActor string
+
+const (
+	OrderActorUser   OrderActor = "user"
+	OrderActorSystem OrderActor = "system"
+)
+
+// AllValues returns all OrderActor values.
+func (OrderActor) AllValues() []OrderActor {
+	return []OrderActor{
+		OrderActorUser,
+		OrderActorSystem,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s OrderActor) MarshalText() ([]byte, error) {
+	switch s {
+	case OrderActorUser:
+		return []byte(s), nil
+	case OrderActorSystem:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *OrderActor) UnmarshalText(data []byte) error {
+	switch OrderActor(data) {
+	case OrderActorUser:
+		*s = OrderActorUser
+		return nil
+	case OrderActorSystem:
+		*s = OrderActorSystem
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // Ref: #/components/schemas/order_dto
 type OrderDto struct {
 	// Уникальный идентификатор заказа.
 	OrderUUID uuid.UUID `json:"order_uuid"`
 	// Уникальный идентификатор пользователя.
 	UserUUID uuid.UUID `json:"user_uuid"`
-	// Список UUID деталей.
+	// Позиции заказа.
+	Items []OrderItem `json:"items"`
+	// Список UUID деталей, по одному на каждую единицу.
+	// Устарело, используйте items.
 	PartUuids []uuid.UUID `json:"part_uuids"`
-	// Итоговая стоимость заказа.
+	// Итоговая стоимость заказа (сумма стоимостей позиций).
 	TotalPrice float64 `json:"total_price"`
 	// Уникальный идентификатор транзакции.
-	TransactionUUID OptUUID          `json:"transaction_uuid"`
-	PaymentMethod   OptPaymentMethod `json:"payment_method"`
-	Status          OrderStatus      `json:"status"`
+	TransactionUUID OptUUID `json:"transaction_uuid"`
+	// Уникальный идентификатор транзакции возврата (есть у
+	// отменённого оплаченного заказа).
+	RefundTransactionUUID OptUUID `json:"refund_transaction_uuid"`
+	// Дата и время списания оплаты; до отгрузки оплата
+	// только авторизована (деньги заморожены).
+	PaymentCapturedAt OptDateTime      `json:"payment_captured_at"`
+	PaymentMethod     OptPaymentMethod `json:"payment_method"`
+	Status            OrderStatus      `json:"status"`
 	// Дата и время создания заказа.
 	CreatedAt OptDateTime `json:"created_at"`
 	// Дата и время последнего обновления заказа.
@@ -477,6 +806,11 @@ func (s *OrderDto) GetUserUUID() uuid.UUID {
 	return s.UserUUID
 }
 
+// GetItems returns the value of Items.
+func (s *OrderDto) GetItems() []OrderItem {
+	return s.Items
+}
+
 // GetPartUuids returns the value of PartUuids.
 func (s *OrderDto) GetPartUuids() []uuid.UUID {
 	return s.PartUuids
@@ -492,6 +826,16 @@ func (s *OrderDto) GetTransactionUUID() OptUUID {
 	return s.TransactionUUID
 }
 
+// GetRefundTransactionUUID returns the value of RefundTransactionUUID.
+func (s *OrderDto) GetRefundTransactionUUID() OptUUID {
+	return s.RefundTransactionUUID
+}
+
+// GetPaymentCapturedAt returns the value of PaymentCapturedAt.
+func (s *OrderDto) GetPaymentCapturedAt() OptDateTime {
+	return s.PaymentCapturedAt
+}
+
 // GetPaymentMethod returns the value of PaymentMethod.
 func (s *OrderDto) GetPaymentMethod() OptPaymentMethod {
 	return s.PaymentMethod
@@ -522,6 +866,11 @@ func (s *OrderDto) SetUserUUID(val uuid.UUID) {
 	s.UserUUID = val
 }
 
+// SetItems sets the value of Items.
+func (s *OrderDto) SetItems(val []OrderItem) {
+	s.Items = val
+}
+
 // SetPartUuids sets the value of PartUuids.
 func (s *OrderDto) SetPartUuids(val []uuid.UUID) {
 	s.PartUuids = val
@@ -537,6 +886,16 @@ func (s *OrderDto) SetTransactionUUID(val OptUUID) {
 	s.TransactionUUID = val
 }
 
+// SetRefundTransactionUUID sets the value of RefundTransactionUUID.
+func (s *OrderDto) SetRefundTransactionUUID(val OptUUID) {
+	s.RefundTransactionUUID = val
+}
+
+// SetPaymentCapturedAt sets the value of PaymentCapturedAt.
+func (s *OrderDto) SetPaymentCapturedAt(val OptDateTime) {
+	s.PaymentCapturedAt = val
+}
+
 // SetPaymentMethod sets the value of PaymentMethod.
 func (s *OrderDto) SetPaymentMethod(val OptPaymentMethod) {
 	s.PaymentMethod = val
@@ -557,25 +916,116 @@ func (s *OrderDto) SetUpdatedAt(val OptDateTime) {
 	s.UpdatedAt = val
 }
 
-// Статус платежа:
+// Ref: #/components/schemas/order_item
+type OrderItem struct {
+	// Уникальный идентификатор детали.
+	PartUUID uuid.UUID `json:"part_uuid"`
+	// Название детали на момент заказа.
+	Name string `json:"name"`
+	// Количество единиц детали.
+	Quantity int64 `json:"quantity"`
+	// Цена за единицу на момент заказа.
+	UnitPrice float64 `json:"unit_price"`
+	// Стоимость позиции (unit_price × quantity).
+	TotalPrice float64 `json:"total_price"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *OrderItem) GetPartUUID() uuid.UUID {
+	return s.PartUUID
+}
+
+// GetName returns the value of Name.
+func (s *OrderItem) GetName() string {
+	return s.Name
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *OrderItem) GetQuantity() int64 {
+	return s.Quantity
+}
+
+// GetUnitPrice returns the value of UnitPrice.
+func (s *OrderItem) GetUnitPrice() float64 {
+	return s.UnitPrice
+}
+
+// GetTotalPrice returns the value of TotalPrice.
+func (s *OrderItem) GetTotalPrice() float64 {
+	return s.TotalPrice
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *OrderItem) SetPartUUID(val uuid.UUID) {
+	s.PartUUID = val
+}
+
+// SetName sets the value of Name.
+func (s *OrderItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *OrderItem) SetQuantity(val int64) {
+	s.Quantity = val
+}
+
+// SetUnitPrice sets the value of UnitPrice.
+func (s *OrderItem) SetUnitPrice(val float64) {
+	s.UnitPrice = val
+}
+
+// SetTotalPrice sets the value of TotalPrice.
+func (s *OrderItem) SetTotalPrice(val float64) {
+	s.TotalPrice = val
+}
+
+// Статус заказа:
 // - PENDING_PAYMENT: Ожидает оплаты
+// - PAYMENT_IN_PROGRESS: Платёж обрабатывается
 // - PAID: Оплачен
-// - CANCELLED: Отменён.
+// - ASSEMBLING: Собирается
+// - READY: Собран и ждёт отгрузки
+// - CAPTURE_IN_PROGRESS: Оплата списывается перед отгрузкой
+// - SHIPPED: Отгружен
+// - COMPLETED: Выполнен
+// - CANCELLED: Отменён
+// - REFUND_IN_PROGRESS: Возврат оплаты обрабатывается
+// - VOID_IN_PROGRESS: Холд оплаты снимается
+// - REFUNDED: Оплата возвращена.
 // Ref: #/components/schemas/order_status
 type OrderStatus string
 
 const (
-	OrderStatusPENDINGPAYMENT OrderStatus = "PENDING_PAYMENT"
-	OrderStatusPAID           OrderStatus = "PAID"
-	OrderStatusCANCELLED      OrderStatus = "CANCELLED"
+	OrderStatusPENDINGPAYMENT    OrderStatus = "PENDING_PAYMENT"
+	OrderStatusPAYMENTINPROGRESS OrderStatus = "PAYMENT_IN_PROGRESS"
+	OrderStatusPAID              OrderStatus = "PAID"
+	OrderStatusASSEMBLING        OrderStatus = "ASSEMBLING"
+	OrderStatusREADY             OrderStatus = "READY"
+	OrderStatusCAPTUREINPROGRESS OrderStatus = "CAPTURE_IN_PROGRESS"
+	OrderStatusSHIPPED           OrderStatus = "SHIPPED"
+	OrderStatusCOMPLETED         OrderStatus = "COMPLETED"
+	OrderStatusCANCELLED         OrderStatus = "CANCELLED"
+	OrderStatusREFUNDINPROGRESS  OrderStatus = "REFUND_IN_PROGRESS"
+	OrderStatusVOIDINPROGRESS    OrderStatus = "VOID_IN_PROGRESS"
+	OrderStatusREFUNDED          OrderStatus = "REFUNDED"
 )
 
 // AllValues returns all OrderStatus values.
 func (OrderStatus) AllValues() []OrderStatus {
 	return []OrderStatus{
 		OrderStatusPENDINGPAYMENT,
+		OrderStatusPAYMENTINPROGRESS,
 		OrderStatusPAID,
+		OrderStatusASSEMBLING,
+		OrderStatusREADY,
+		OrderStatusCAPTUREINPROGRESS,
+		OrderStatusSHIPPED,
+		OrderStatusCOMPLETED,
 		OrderStatusCANCELLED,
+		OrderStatusREFUNDINPROGRESS,
+		OrderStatusVOIDINPROGRESS,
+		OrderStatusREFUNDED,
 	}
 }
 
@@ -584,10 +1034,28 @@ func (s OrderStatus) MarshalText() ([]byte, error) {
 	switch s {
 	case OrderStatusPENDINGPAYMENT:
 		return []byte(s), nil
+	case OrderStatusPAYMENTINPROGRESS:
+		return []byte(s), nil
 	case OrderStatusPAID:
 		return []byte(s), nil
+	case OrderStatusASSEMBLING:
+		return []byte(s), nil
+	case OrderStatusREADY:
+		return []byte(s), nil
+	case OrderStatusCAPTUREINPROGRESS:
+		return []byte(s), nil
+	case OrderStatusSHIPPED:
+		return []byte(s), nil
+	case OrderStatusCOMPLETED:
+		return []byte(s), nil
 	case OrderStatusCANCELLED:
 		return []byte(s), nil
+	case OrderStatusREFUNDINPROGRESS:
+		return []byte(s), nil
+	case OrderStatusVOIDINPROGRESS:
+		return []byte(s), nil
+	case OrderStatusREFUNDED:
+		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
 	}
@@ -599,17 +1067,105 @@ func (s *OrderStatus) UnmarshalText(data []byte) error {
 	case OrderStatusPENDINGPAYMENT:
 		*s = OrderStatusPENDINGPAYMENT
 		return nil
+	case OrderStatusPAYMENTINPROGRESS:
+		*s = OrderStatusPAYMENTINPROGRESS
+		return nil
 	case OrderStatusPAID:
 		*s = OrderStatusPAID
 		return nil
+	case OrderStatusASSEMBLING:
+		*s = OrderStatusASSEMBLING
+		return nil
+	case OrderStatusREADY:
+		*s = OrderStatusREADY
+		return nil
+	case OrderStatusCAPTUREINPROGRESS:
+		*s = OrderStatusCAPTUREINPROGRESS
+		return nil
+	case OrderStatusSHIPPED:
+		*s = OrderStatusSHIPPED
+		return nil
+	case OrderStatusCOMPLETED:
+		*s = OrderStatusCOMPLETED
+		return nil
 	case OrderStatusCANCELLED:
 		*s = OrderStatusCANCELLED
 		return nil
+	case OrderStatusREFUNDINPROGRESS:
+		*s = OrderStatusREFUNDINPROGRESS
+		return nil
+	case OrderStatusVOIDINPROGRESS:
+		*s = OrderStatusVOIDINPROGRESS
+		return nil
+	case OrderStatusREFUNDED:
+		*s = OrderStatusREFUNDED
+		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
+// Ref: #/components/schemas/order_status_transition
+type OrderStatusTransition struct {
+	FromStatus OptOrderStatus `json:"from_status"`
+	ToStatus   OrderStatus    `json:"to_status"`
+	Actor      OrderActor     `json:"actor"`
+	// Причина смены статуса.
+	Reason string `json:"reason"`
+	// Дата и время смены статуса.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// GetFromStatus returns the value of FromStatus.
+func (s *OrderStatusTransition) GetFromStatus() OptOrderStatus {
+	return s.FromStatus
+}
+
+// GetToStatus returns the value of ToStatus.
+func (s *OrderStatusTransition) GetToStatus() OrderStatus {
+	return s.ToStatus
+}
+
+// GetActor returns the value of Actor.
+func (s *OrderStatusTransition) GetActor() OrderActor {
+	return s.Actor
+}
+
+// GetReason returns the value of Reason.
+func (s *OrderStatusTransition) GetReason() string {
+	return s.Reason
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *OrderStatusTransition) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetFromStatus sets the value of FromStatus.
+func (s *OrderStatusTransition) SetFromStatus(val OptOrderStatus) {
+	s.FromStatus = val
+}
+
+// SetToStatus sets the value of ToStatus.
+func (s *OrderStatusTransition) SetToStatus(val OrderStatus) {
+	s.ToStatus = val
+}
+
+// SetActor sets the value of Actor.
+func (s *OrderStatusTransition) SetActor(val OrderActor) {
+	s.Actor = val
+}
+
+// SetReason sets the value of Reason.
+func (s *OrderStatusTransition) SetReason(val string) {
+	s.Reason = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *OrderStatusTransition) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
 // Ref: #/components/schemas/pay_order_request
 type PayOrderRequest struct {
 	PaymentMethod PaymentMethod `json:"payment_method"`
@@ -740,7 +1296,74 @@ func (s *ServiceUnavailableError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*ServiceUnavailableError) cancelOrderRes() {}
-func (*ServiceUnavailableError) createOrderRes() {}
-func (*ServiceUnavailableError) getOrderRes()    {}
-func (*ServiceUnavailableError) payOrderRes()    {}
+func (*ServiceUnavailableError) cancelOrderRes()       {}
+func (*ServiceUnavailableError) createOrderRes()       {}
+func (*ServiceUnavailableError) getOrderRes()          {}
+func (*ServiceUnavailableError) payOrderRes()          {}
+func (*ServiceUnavailableError) updateOrderStatusRes() {}
+
+// UpdateOrderStatusNoContent is response for UpdateOrderStatus operation.
+type UpdateOrderStatusNoContent struct{}
+
+func (*UpdateOrderStatusNoContent) updateOrderStatusRes() {}
+
+// Ref: #/components/schemas/update_order_status_request
+type UpdateOrderStatusRequest struct {
+	Status OrderStatus `json:"status"`
+}
+
+// GetStatus returns the value of Status.
+func (s *UpdateOrderStatusRequest) GetStatus() OrderStatus {
+	return s.Status
+}
+
+// SetStatus sets the value of Status.
+func (s *UpdateOrderStatusRequest) SetStatus(val OrderStatus) {
+	s.Status = val
+}
+
+// Ref: #/components/schemas/validation_error
+type ValidationError struct {
+	// HTTP-код ошибки.
+	Code int `json:"code"`
+	// Сервер не может обработать запрос из-за
+	// семантической ошибки.
+	Message string `json:"message"`
+	// UUID деталей, которые нельзя заказать.
+	PartUuids []uuid.UUID `json:"part_uuids"`
+}
+
+// GetCode returns the value of Code.
+func (s *ValidationError) GetCode() int {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *ValidationError) GetMessage() string {
+	return s.Message
+}
+
+// GetPartUuids returns the value of PartUuids.
+func (s *ValidationError) GetPartUuids() []uuid.UUID {
+	return s.PartUuids
+}
+
+// SetCode sets the value of Code.
+func (s *ValidationError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ValidationError) SetMessage(val string) {
+	s.Message = val
+}
+
+// SetPartUuids sets the value of PartUuids.
+func (s *ValidationError) SetPartUuids(val []uuid.UUID) {
+	s.PartUuids = val
+}
+
+func (*ValidationError) cancelOrderRes()       {}
+func (*ValidationError) createOrderRes()       {}
+func (*ValidationError) payOrderRes()          {}
+func (*ValidationError) updateOrderStatusRes() {}
@@ -10,7 +10,9 @@ import (
 type Handler interface {
 	// CancelOrder implements CancelOrder operation.
 	//
-	// Cancel order.
+	// Отменяет неоплаченный заказ; с оплаченного, но не
+	// отгруженного заказа снимается холд оплаты, а уже
+	// списанная оплата возвращается полностью.
 	//
 	// POST /api/v1/orders/{order_uuid}/cancel
 	CancelOrder(ctx context.Context, params CancelOrderParams) (CancelOrderRes, error)
@@ -19,19 +21,39 @@ type Handler interface {
 	// Create new order.
 	//
 	// POST /api/v1/orders
-	CreateOrder(ctx context.Context, req *CreateOrderRequest) (CreateOrderRes, error)
+	CreateOrder(ctx context.Context, req *CreateOrderRequest, params CreateOrderParams) (CreateOrderRes, error)
 	// GetOrder implements GetOrder operation.
 	//
 	// Get order by uuid.
 	//
 	// GET /api/v1/orders/{order_uuid}
 	GetOrder(ctx context.Context, params GetOrderParams) (GetOrderRes, error)
+	// GetOrderHistory implements GetOrderHistory operation.
+	//
+	// Get order status history.
+	//
+	// GET /api/v1/orders/{order_uuid}/history
+	GetOrderHistory(ctx context.Context, params GetOrderHistoryParams) (GetOrderHistoryRes, error)
+	// ListOrders implements ListOrders operation.
+	//
+	// List orders.
+	//
+	// GET /api/v1/orders
+	ListOrders(ctx context.Context, params ListOrdersParams) (ListOrdersRes, error)
 	// PayOrder implements PayOrder operation.
 	//
 	// Order payment.
 	//
 	// POST /api/v1/orders/{order_uuid}/pay
 	PayOrder(ctx context.Context, req *PayOrderRequest, params PayOrderParams) (PayOrderRes, error)
+	// UpdateOrderStatus implements UpdateOrderStatus operation.
+	//
+	// Переводит оплаченный заказ по этапам сборки и
+	// доставки (ASSEMBLING, READY, SHIPPED, COMPLETED); при отгрузке
+	// списывается авторизованная оплата.
+	//
+	// POST /api/v1/orders/{order_uuid}/status
+	UpdateOrderStatus(ctx context.Context, req *UpdateOrderStatusRequest, params UpdateOrderStatusParams) (UpdateOrderStatusRes, error)
 	// NewError creates *GenericErrorStatusCode from error returned by handler.
 	//
 	// Used for common default response.
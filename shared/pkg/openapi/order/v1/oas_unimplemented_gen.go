@@ -15,7 +15,9 @@ var _ Handler = UnimplementedHandler{}
 
 // CancelOrder implements CancelOrder operation.
 //
-// Cancel order.
+// Отменяет неоплаченный заказ; с оплаченного, но не
+// отгруженного заказа снимается холд оплаты, а уже
+// списанная оплата возвращается полностью.
 //
 // POST /api/v1/orders/{order_uuid}/cancel
 func (UnimplementedHandler) CancelOrder(ctx context.Context, params CancelOrderParams) (r CancelOrderRes, _ error) {
@@ -27,7 +29,7 @@ func (UnimplementedHandler) CancelOrder(ctx context.Context, params CancelOrderP
 // Create new order.
 //
 // POST /api/v1/orders
-func (UnimplementedHandler) CreateOrder(ctx context.Context, req *CreateOrderRequest) (r CreateOrderRes, _ error) {
+func (UnimplementedHandler) CreateOrder(ctx context.Context, req *CreateOrderRequest, params CreateOrderParams) (r CreateOrderRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -40,6 +42,24 @@ func (UnimplementedHandler) GetOrder(ctx context.Context, params GetOrderParams)
 	return r, ht.ErrNotImplemented
 }
 
+// GetOrderHistory implements GetOrderHistory operation.
+//
+// Get order status history.
+//
+// GET /api/v1/orders/{order_uuid}/history
+func (UnimplementedHandler) GetOrderHistory(ctx context.Context, params GetOrderHistoryParams) (r GetOrderHistoryRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListOrders implements ListOrders operation.
+//
+// List orders.
+//
+// GET /api/v1/orders
+func (UnimplementedHandler) ListOrders(ctx context.Context, params ListOrdersParams) (r ListOrdersRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // PayOrder implements PayOrder operation.
 //
 // Order payment.
@@ -49,6 +69,17 @@ func (UnimplementedHandler) PayOrder(ctx context.Context, req *PayOrderRequest,
 	return r, ht.ErrNotImplemented
 }
 
+// UpdateOrderStatus implements UpdateOrderStatus operation.
+//
+// Переводит оплаченный заказ по этапам сборки и
+// доставки (ASSEMBLING, READY, SHIPPED, COMPLETED); при отгрузке
+// списывается авторизованная оплата.
+//
+// POST /api/v1/orders/{order_uuid}/status
+func (UnimplementedHandler) UpdateOrderStatus(ctx context.Context, req *UpdateOrderStatusRequest, params UpdateOrderStatusParams) (r UpdateOrderStatusRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // NewError creates *GenericErrorStatusCode from error returned by handler.
 //
 // Used for common default response.
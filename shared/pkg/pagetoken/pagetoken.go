@@ -0,0 +1,55 @@
+package pagetoken
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"hash/fnv"
+)
+
+// ErrInvalid — токен повреждён или выдан для других параметров запроса
+var ErrInvalid = errors.New("invalid page token")
+
+// token - содержимое next_page_token. Токен привязан к параметрам запроса (фильтру, сортировке),
+// с которыми получена страница: с другими параметрами он недействителен.
+type token[C any] struct {
+	Query uint64 `json:"q"`
+	After C      `json:"a"`
+}
+
+// Encode упаковывает курсор страницы вместе с отпечатком параметров запроса query.
+func Encode[C any](after C, query ...any) (string, error) {
+	data, err := json.Marshal(token[C]{
+		Query: fingerprint(query),
+		After: after,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// Decode возвращает курсор из токена, выданного Encode с теми же параметрами запроса, иначе ErrInvalid.
+func Decode[C any](encoded string, query ...any) (C, error) {
+	var decoded token[C]
+
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return decoded.After, ErrInvalid
+	}
+	if err = json.Unmarshal(data, &decoded); err != nil || decoded.Query != fingerprint(query) {
+		var zero C
+		return zero, ErrInvalid
+	}
+
+	return decoded.After, nil
+}
+
+// fingerprint хеширует JSON параметров: в отличие от %v он раскрывает значения указателей.
+func fingerprint(query []any) uint64 {
+	data, _ := json.Marshal(query) // параметры запроса состоят только из сериализуемых полей
+	h := fnv.New64a()
+	_, _ = h.Write(data)
+	return h.Sum64()
+}
@@ -10,6 +10,8 @@ import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -79,6 +81,112 @@ func (Category) EnumDescriptor() ([]byte, []int) {
 	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{0}
 }
 
+// PartEventType - тип изменения детали
+type PartEventType int32
+
+const (
+	PartEventType_PART_EVENT_TYPE_UNSPECIFIED PartEventType = 0
+	PartEventType_PART_EVENT_TYPE_CREATED     PartEventType = 1
+	PartEventType_PART_EVENT_TYPE_UPDATED     PartEventType = 2
+	PartEventType_PART_EVENT_TYPE_DELETED     PartEventType = 3
+	PartEventType_PART_EVENT_TYPE_REMOVED     PartEventType = 4 // Деталь изменилась и больше не подходит под фильтр подписки
+)
+
+// Enum value maps for PartEventType.
+var (
+	PartEventType_name = map[int32]string{
+		0: "PART_EVENT_TYPE_UNSPECIFIED",
+		1: "PART_EVENT_TYPE_CREATED",
+		2: "PART_EVENT_TYPE_UPDATED",
+		3: "PART_EVENT_TYPE_DELETED",
+		4: "PART_EVENT_TYPE_REMOVED",
+	}
+	PartEventType_value = map[string]int32{
+		"PART_EVENT_TYPE_UNSPECIFIED": 0,
+		"PART_EVENT_TYPE_CREATED":     1,
+		"PART_EVENT_TYPE_UPDATED":     2,
+		"PART_EVENT_TYPE_DELETED":     3,
+		"PART_EVENT_TYPE_REMOVED":     4,
+	}
+)
+
+func (x PartEventType) Enum() *PartEventType {
+	p := new(PartEventType)
+	*p = x
+	return p
+}
+
+func (x PartEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PartEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[1].Descriptor()
+}
+
+func (PartEventType) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[1]
+}
+
+func (x PartEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PartEventType.Descriptor instead.
+func (PartEventType) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+// CatalogFormat - формат файла каталога
+type CatalogFormat int32
+
+const (
+	CatalogFormat_CATALOG_FORMAT_UNSPECIFIED CatalogFormat = 0 // Формат не указан
+	CatalogFormat_CATALOG_FORMAT_JSONL       CatalogFormat = 1 // JSON Lines: одна деталь на строку
+	CatalogFormat_CATALOG_FORMAT_CSV         CatalogFormat = 2 // CSV с заголовком; теги и метаданные - JSON в ячейках
+)
+
+// Enum value maps for CatalogFormat.
+var (
+	CatalogFormat_name = map[int32]string{
+		0: "CATALOG_FORMAT_UNSPECIFIED",
+		1: "CATALOG_FORMAT_JSONL",
+		2: "CATALOG_FORMAT_CSV",
+	}
+	CatalogFormat_value = map[string]int32{
+		"CATALOG_FORMAT_UNSPECIFIED": 0,
+		"CATALOG_FORMAT_JSONL":       1,
+		"CATALOG_FORMAT_CSV":         2,
+	}
+)
+
+func (x CatalogFormat) Enum() *CatalogFormat {
+	p := new(CatalogFormat)
+	*p = x
+	return p
+}
+
+func (x CatalogFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CatalogFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[2].Descriptor()
+}
+
+func (CatalogFormat) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[2]
+}
+
+func (x CatalogFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CatalogFormat.Descriptor instead.
+func (CatalogFormat) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{2}
+}
+
 // GetPartRequest представляет запрос на получение детали по UUID.
 type GetPartRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -184,6 +292,7 @@ type Part struct {
 	Metadata      map[string]*Value      `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Гибкие метаданные
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                                        //	timestamp	Дата создания
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                                                        //	timestamp	Дата обновления
+	Archived      bool                   `protobuf:"varint,13,opt,name=archived,proto3" json:"archived,omitempty"`                                                                          // Деталь снята с продажи
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -302,6 +411,13 @@ func (x *Part) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Part) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
 // Dimensions - размеры деталей
 type Dimensions struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -550,7 +666,10 @@ func (*Value_BoolValue) isValue_Kind() {}
 // ListPartsRequest представляет запрос на получение списка деталей по фильтрам.
 type ListPartsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filter        *PartsFilter           `protobuf:"bytes,1,opt,name=filter,proto3,oneof" json:"filter,omitempty"` // Фильтр по деталям (все поля опциональны)
+	Filter        *PartsFilter           `protobuf:"bytes,1,opt,name=filter,proto3,oneof" json:"filter,omitempty"`                  // Фильтр по деталям (все поля опциональны)
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Размер страницы (0 — все детали)
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Токен страницы из next_page_token предыдущего ответа
+	OrderBy       string                 `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`       // Поле сортировки и направление, например "price desc"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -592,10 +711,33 @@ func (x *ListPartsRequest) GetFilter() *PartsFilter {
 	return nil
 }
 
+func (x *ListPartsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPartsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPartsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 // ListPartsResponse представляет ответ на получение списка деталей по фильтрам.
 type ListPartsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Parts         []*Part                `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"` // Список найденных деталей
+	Parts         []*Part                `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`                                        // Список найденных деталей
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Токен следующей страницы (пустой на последней странице)
+	TotalSize     int32                  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`              // Общее количество деталей по фильтру
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -637,32 +779,42 @@ func (x *ListPartsResponse) GetParts() []*Part {
 	return nil
 }
 
-// PartsFilter - возможные фильтры для получения списка деталей
-type PartsFilter struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Uuids                 []string               `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`
-	Names                 []string               `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
-	Categories            []Category             `protobuf:"varint,3,rep,packed,name=categories,proto3,enum=inventory.v1.Category" json:"categories,omitempty"`
-	ManufacturerCountries []string               `protobuf:"bytes,4,rep,name=manufacturer_countries,json=manufacturerCountries,proto3" json:"manufacturer_countries,omitempty"`
-	Tags                  []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+func (x *ListPartsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
 }
 
-func (x *PartsFilter) Reset() {
-	*x = PartsFilter{}
+func (x *ListPartsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+// BatchGetPartsRequest представляет запрос на получение деталей по списку UUID.
+type BatchGetPartsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuids         []string               `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"` // UUID деталей (повторы допускаются)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetPartsRequest) Reset() {
+	*x = BatchGetPartsRequest{}
 	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PartsFilter) String() string {
+func (x *BatchGetPartsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PartsFilter) ProtoMessage() {}
+func (*BatchGetPartsRequest) ProtoMessage() {}
 
-func (x *PartsFilter) ProtoReflect() protoreflect.Message {
+func (x *BatchGetPartsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -674,187 +826,2743 @@ func (x *PartsFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PartsFilter.ProtoReflect.Descriptor instead.
-func (*PartsFilter) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchGetPartsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetPartsRequest) Descriptor() ([]byte, []int) {
 	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *PartsFilter) GetUuids() []string {
+func (x *BatchGetPartsRequest) GetUuids() []string {
 	if x != nil {
 		return x.Uuids
 	}
 	return nil
 }
 
-func (x *PartsFilter) GetNames() []string {
+// BatchGetPartsResponse представляет ответ на получение деталей по списку UUID.
+type BatchGetPartsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Parts         map[string]*Part       `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Найденные детали по UUID
+	MissingUuids  []string               `protobuf:"bytes,2,rep,name=missing_uuids,json=missingUuids,proto3" json:"missing_uuids,omitempty"`                                         // UUID, которых нет в каталоге, в порядке запроса без повторов
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetPartsResponse) Reset() {
+	*x = BatchGetPartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetPartsResponse) ProtoMessage() {}
+
+func (x *BatchGetPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[9]
 	if x != nil {
-		return x.Names
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *PartsFilter) GetCategories() []Category {
+// Deprecated: Use BatchGetPartsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetPartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BatchGetPartsResponse) GetParts() map[string]*Part {
 	if x != nil {
-		return x.Categories
+		return x.Parts
 	}
 	return nil
 }
 
-func (x *PartsFilter) GetManufacturerCountries() []string {
+func (x *BatchGetPartsResponse) GetMissingUuids() []string {
 	if x != nil {
-		return x.ManufacturerCountries
+		return x.MissingUuids
 	}
 	return nil
 }
 
-func (x *PartsFilter) GetTags() []string {
+// GetPartFacetsRequest представляет запрос на подсчёт фасетов каталога.
+type GetPartFacetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *PartsFilter           `protobuf:"bytes,1,opt,name=filter,proto3,oneof" json:"filter,omitempty"` // Текущий фильтр каталога
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPartFacetsRequest) Reset() {
+	*x = GetPartFacetsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPartFacetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartFacetsRequest) ProtoMessage() {}
+
+func (x *GetPartFacetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[10]
 	if x != nil {
-		return x.Tags
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartFacetsRequest.ProtoReflect.Descriptor instead.
+func (*GetPartFacetsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetPartFacetsRequest) GetFilter() *PartsFilter {
+	if x != nil {
+		return x.Filter
 	}
 	return nil
 }
 
-var File_inventory_v1_inventory_proto protoreflect.FileDescriptor
+// GetPartFacetsResponse представляет ответ с фасетами каталога. Счётчики каждого фасета
+// посчитаны без условия фильтра на этот же фасет.
+type GetPartFacetsResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Categories            []*CategoryFacet       `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	ManufacturerCountries []*FacetCount          `protobuf:"bytes,2,rep,name=manufacturer_countries,json=manufacturerCountries,proto3" json:"manufacturer_countries,omitempty"`
+	Tags                  []*FacetCount          `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
 
-const file_inventory_v1_inventory_proto_rawDesc = "" +
-	"\n" +
-	"\x1cinventory/v1/inventory.proto\x12\finventory.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"$\n" +
-	"\x0eGetPartRequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"9\n" +
-	"\x0fGetPartResponse\x12&\n" +
-	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"\xd5\x04\n" +
-	"\x04Part\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\x12%\n" +
-	"\x0estock_quantity\x18\x05 \x01(\x03R\rstockQuantity\x122\n" +
-	"\bcategory\x18\x06 \x01(\x0e2\x16.inventory.v1.CategoryR\bcategory\x128\n" +
-	"\n" +
-	"dimensions\x18\a \x01(\v2\x18.inventory.v1.DimensionsR\n" +
-	"dimensions\x12>\n" +
-	"\fmanufacturer\x18\b \x01(\v2\x1a.inventory.v1.ManufacturerR\fmanufacturer\x12\x12\n" +
-	"\x04tags\x18\t \x03(\tR\x04tags\x12<\n" +
-	"\bmetadata\x18\n" +
-	" \x03(\v2 .inventory.v1.Part.MetadataEntryR\bmetadata\x129\n" +
-	"\n" +
-	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1aP\n" +
-	"\rMetadataEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
-	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01\"j\n" +
-	"\n" +
-	"Dimensions\x12\x16\n" +
-	"\x06length\x18\x01 \x01(\x01R\x06length\x12\x14\n" +
-	"\x05width\x18\x02 \x01(\x01R\x05width\x12\x16\n" +
-	"\x06height\x18\x03 \x01(\x01R\x06height\x12\x16\n" +
-	"\x06weight\x18\x04 \x01(\x01R\x06weight\"V\n" +
-	"\fManufacturer\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
-	"\acountry\x18\x02 \x01(\tR\acountry\x12\x18\n" +
-	"\awebsite\x18\x03 \x01(\tR\awebsite\"\x9d\x01\n" +
-	"\x05Value\x12#\n" +
-	"\fstring_value\x18\x01 \x01(\tH\x00R\vstringValue\x12!\n" +
-	"\vint64_value\x18\x02 \x01(\x03H\x00R\n" +
-	"int64Value\x12#\n" +
-	"\fdouble_value\x18\x03 \x01(\x01H\x00R\vdoubleValue\x12\x1f\n" +
-	"\n" +
-	"bool_value\x18\x04 \x01(\bH\x00R\tboolValueB\x06\n" +
-	"\x04kind\"U\n" +
-	"\x10ListPartsRequest\x126\n" +
-	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterH\x00R\x06filter\x88\x01\x01B\t\n" +
-	"\a_filter\"=\n" +
-	"\x11ListPartsResponse\x12(\n" +
-	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\"\xe4\x01\n" +
-	"\vPartsFilter\x12\x1e\n" +
-	"\x05uuids\x18\x01 \x03(\tB\b\xfaB\x05\x92\x01\x02\x18\x01R\x05uuids\x12\x1e\n" +
-	"\x05names\x18\x02 \x03(\tB\b\xfaB\x05\x92\x01\x02\x18\x01R\x05names\x126\n" +
-	"\n" +
-	"categories\x18\x03 \x03(\x0e2\x16.inventory.v1.CategoryR\n" +
-	"categories\x12?\n" +
-	"\x16manufacturer_countries\x18\x04 \x03(\tB\b\xfaB\x05\x92\x01\x02\x18\x01R\x15manufacturerCountries\x12\x1c\n" +
-	"\x04tags\x18\x05 \x03(\tB\b\xfaB\x05\x92\x01\x02\x18\x01R\x04tags*v\n" +
-	"\bCategory\x12\x18\n" +
-	"\x14CATEGORY_UNSPECIFIED\x10\x00\x12\x13\n" +
-	"\x0fCATEGORY_ENGINE\x10\x01\x12\x11\n" +
-	"\rCATEGORY_FUEL\x10\x02\x12\x15\n" +
-	"\x11CATEGORY_PORTHOLE\x10\x03\x12\x11\n" +
-	"\rCATEGORY_WING\x10\x042\xa8\x01\n" +
-	"\x10InventoryService\x12F\n" +
-	"\aGetPart\x12\x1c.inventory.v1.GetPartRequest\x1a\x1d.inventory.v1.GetPartResponse\x12L\n" +
-	"\tListParts\x12\x1e.inventory.v1.ListPartsRequest\x1a\x1f.inventory.v1.ListPartsResponseBSZQgithub.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1;inventory_v1b\x06proto3"
+func (x *GetPartFacetsResponse) Reset() {
+	*x = GetPartFacetsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-var (
-	file_inventory_v1_inventory_proto_rawDescOnce sync.Once
-	file_inventory_v1_inventory_proto_rawDescData []byte
-)
+func (x *GetPartFacetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_inventory_v1_inventory_proto_rawDescGZIP() []byte {
-	file_inventory_v1_inventory_proto_rawDescOnce.Do(func() {
-		file_inventory_v1_inventory_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_inventory_v1_inventory_proto_rawDesc), len(file_inventory_v1_inventory_proto_rawDesc)))
-	})
-	return file_inventory_v1_inventory_proto_rawDescData
+func (*GetPartFacetsResponse) ProtoMessage() {}
+
+func (x *GetPartFacetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_inventory_v1_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_inventory_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
-var file_inventory_v1_inventory_proto_goTypes = []any{
-	(Category)(0),                 // 0: inventory.v1.Category
-	(*GetPartRequest)(nil),        // 1: inventory.v1.GetPartRequest
-	(*GetPartResponse)(nil),       // 2: inventory.v1.GetPartResponse
-	(*Part)(nil),                  // 3: inventory.v1.Part
-	(*Dimensions)(nil),            // 4: inventory.v1.Dimensions
-	(*Manufacturer)(nil),          // 5: inventory.v1.Manufacturer
-	(*Value)(nil),                 // 6: inventory.v1.Value
-	(*ListPartsRequest)(nil),      // 7: inventory.v1.ListPartsRequest
-	(*ListPartsResponse)(nil),     // 8: inventory.v1.ListPartsResponse
-	(*PartsFilter)(nil),           // 9: inventory.v1.PartsFilter
-	nil,                           // 10: inventory.v1.Part.MetadataEntry
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+// Deprecated: Use GetPartFacetsResponse.ProtoReflect.Descriptor instead.
+func (*GetPartFacetsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{11}
 }
-var file_inventory_v1_inventory_proto_depIdxs = []int32{
-	3,  // 0: inventory.v1.GetPartResponse.part:type_name -> inventory.v1.Part
-	0,  // 1: inventory.v1.Part.category:type_name -> inventory.v1.Category
-	4,  // 2: inventory.v1.Part.dimensions:type_name -> inventory.v1.Dimensions
-	5,  // 3: inventory.v1.Part.manufacturer:type_name -> inventory.v1.Manufacturer
-	10, // 4: inventory.v1.Part.metadata:type_name -> inventory.v1.Part.MetadataEntry
-	11, // 5: inventory.v1.Part.created_at:type_name -> google.protobuf.Timestamp
-	11, // 6: inventory.v1.Part.updated_at:type_name -> google.protobuf.Timestamp
-	9,  // 7: inventory.v1.ListPartsRequest.filter:type_name -> inventory.v1.PartsFilter
-	3,  // 8: inventory.v1.ListPartsResponse.parts:type_name -> inventory.v1.Part
-	0,  // 9: inventory.v1.PartsFilter.categories:type_name -> inventory.v1.Category
-	6,  // 10: inventory.v1.Part.MetadataEntry.value:type_name -> inventory.v1.Value
-	1,  // 11: inventory.v1.InventoryService.GetPart:input_type -> inventory.v1.GetPartRequest
-	7,  // 12: inventory.v1.InventoryService.ListParts:input_type -> inventory.v1.ListPartsRequest
-	2,  // 13: inventory.v1.InventoryService.GetPart:output_type -> inventory.v1.GetPartResponse
-	8,  // 14: inventory.v1.InventoryService.ListParts:output_type -> inventory.v1.ListPartsResponse
-	13, // [13:15] is the sub-list for method output_type
-	11, // [11:13] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+
+func (x *GetPartFacetsResponse) GetCategories() []*CategoryFacet {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
 }
 
-func init() { file_inventory_v1_inventory_proto_init() }
-func file_inventory_v1_inventory_proto_init() {
-	if File_inventory_v1_inventory_proto != nil {
-		return
+func (x *GetPartFacetsResponse) GetManufacturerCountries() []*FacetCount {
+	if x != nil {
+		return x.ManufacturerCountries
 	}
-	file_inventory_v1_inventory_proto_msgTypes[5].OneofWrappers = []any{
-		(*Value_StringValue)(nil),
-		(*Value_Int64Value)(nil),
+	return nil
+}
+
+func (x *GetPartFacetsResponse) GetTags() []*FacetCount {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// CategoryFacet - количество деталей в категории
+type CategoryFacet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      Category               `protobuf:"varint,1,opt,name=category,proto3,enum=inventory.v1.Category" json:"category,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryFacet) Reset() {
+	*x = CategoryFacet{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryFacet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryFacet) ProtoMessage() {}
+
+func (x *CategoryFacet) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryFacet.ProtoReflect.Descriptor instead.
+func (*CategoryFacet) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CategoryFacet) GetCategory() Category {
+	if x != nil {
+		return x.Category
+	}
+	return Category_CATEGORY_UNSPECIFIED
+}
+
+func (x *CategoryFacet) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// FacetCount - количество деталей со значением фасета
+type FacetCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetCount) Reset() {
+	*x = FacetCount{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetCount) ProtoMessage() {}
+
+func (x *FacetCount) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetCount.ProtoReflect.Descriptor instead.
+func (*FacetCount) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FacetCount) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FacetCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// SearchPartsRequest представляет запрос полнотекстового поиска деталей.
+type SearchPartsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`  // Поисковый запрос, регистр не важен, допускаются опечатки
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Максимум результатов (0 — значение по умолчанию)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPartsRequest) Reset() {
+	*x = SearchPartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPartsRequest) ProtoMessage() {}
+
+func (x *SearchPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPartsRequest.ProtoReflect.Descriptor instead.
+func (*SearchPartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SearchPartsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchPartsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// SearchPartsResponse представляет ответ полнотекстового поиска деталей.
+type SearchPartsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          []*SearchHit           `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"` // Результаты по убыванию релевантности
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPartsResponse) Reset() {
+	*x = SearchPartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPartsResponse) ProtoMessage() {}
+
+func (x *SearchPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPartsResponse.ProtoReflect.Descriptor instead.
+func (*SearchPartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SearchPartsResponse) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+// SearchHit - найденная деталь
+type SearchHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Part          *Part                  `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`         // Оценка релевантности
+	Highlights    []*Highlight           `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty"` // Фрагменты с подсвеченными совпадениями
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SearchHit) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+func (x *SearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchHit) GetHighlights() []*Highlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+// Highlight - фрагмент поля, совпавшие слова обёрнуты в <em></em>
+type Highlight struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"` // name, description, tags или manufacturer_name
+	Snippet       string                 `protobuf:"bytes,2,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Highlight) Reset() {
+	*x = Highlight{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Highlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Highlight) ProtoMessage() {}
+
+func (x *Highlight) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Highlight.ProtoReflect.Descriptor instead.
+func (*Highlight) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Highlight) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Highlight) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+// PartsFilter - возможные фильтры для получения списка деталей
+type PartsFilter struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Uuids                 []string               `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`
+	Names                 []string               `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+	Categories            []Category             `protobuf:"varint,3,rep,packed,name=categories,proto3,enum=inventory.v1.Category" json:"categories,omitempty"`
+	ManufacturerCountries []string               `protobuf:"bytes,4,rep,name=manufacturer_countries,json=manufacturerCountries,proto3" json:"manufacturer_countries,omitempty"`
+	Tags                  []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	IncludeArchived       bool                   `protobuf:"varint,6,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"` // Включать в выдачу архивные детали
+	Price                 *DoubleRange           `protobuf:"bytes,7,opt,name=price,proto3" json:"price,omitempty"`                                             // Диапазон цены
+	StockQuantity         *Int64Range            `protobuf:"bytes,8,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`        // Диапазон количества на складе
+	Dimensions            *DimensionsRange       `protobuf:"bytes,9,opt,name=dimensions,proto3" json:"dimensions,omitempty"`                                   // Диапазоны размеров
+	CreatedAt             *TimestampRange        `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                   // Диапазон даты создания
+	UpdatedAt             *TimestampRange        `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                   // Диапазон даты обновления (детали без обновлений не попадают)
+	Metadata              []*MetadataCondition   `protobuf:"bytes,12,rep,name=metadata,proto3" json:"metadata,omitempty"`                                      // Условия на метаданные (должны выполняться все)
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *PartsFilter) Reset() {
+	*x = PartsFilter{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartsFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartsFilter) ProtoMessage() {}
+
+func (x *PartsFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartsFilter.ProtoReflect.Descriptor instead.
+func (*PartsFilter) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PartsFilter) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetCategories() []Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetManufacturerCountries() []string {
+	if x != nil {
+		return x.ManufacturerCountries
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+func (x *PartsFilter) GetPrice() *DoubleRange {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetStockQuantity() *Int64Range {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetDimensions() *DimensionsRange {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetCreatedAt() *TimestampRange {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetUpdatedAt() *TimestampRange {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetMetadata() []*MetadataCondition {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// MetadataCondition - условие на метаданные детали
+type MetadataCondition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`     // Ключ, который должен присутствовать
+	Value         *Value                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // Если задано — значение по ключу должно совпадать (с учётом типа)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MetadataCondition) Reset() {
+	*x = MetadataCondition{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetadataCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetadataCondition) ProtoMessage() {}
+
+func (x *MetadataCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetadataCondition.ProtoReflect.Descriptor instead.
+func (*MetadataCondition) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MetadataCondition) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *MetadataCondition) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// DoubleRange - диапазон значений, границы включаются; отсутствующая граница не ограничивает
+type DoubleRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           *float64               `protobuf:"fixed64,1,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max           *float64               `protobuf:"fixed64,2,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DoubleRange) Reset() {
+	*x = DoubleRange{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DoubleRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoubleRange) ProtoMessage() {}
+
+func (x *DoubleRange) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoubleRange.ProtoReflect.Descriptor instead.
+func (*DoubleRange) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DoubleRange) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *DoubleRange) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+// Int64Range - диапазон целых значений, границы включаются
+type Int64Range struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           *int64                 `protobuf:"varint,1,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max           *int64                 `protobuf:"varint,2,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Int64Range) Reset() {
+	*x = Int64Range{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Int64Range) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Int64Range) ProtoMessage() {}
+
+func (x *Int64Range) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Int64Range.ProtoReflect.Descriptor instead.
+func (*Int64Range) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Int64Range) GetMin() int64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *Int64Range) GetMax() int64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+// TimestampRange - диапазон времени, границы включаются
+type TimestampRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimestampRange) Reset() {
+	*x = TimestampRange{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimestampRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimestampRange) ProtoMessage() {}
+
+func (x *TimestampRange) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimestampRange.ProtoReflect.Descriptor instead.
+func (*TimestampRange) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TimestampRange) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *TimestampRange) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+// DimensionsRange - диапазоны размеров детали
+type DimensionsRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Length        *DoubleRange           `protobuf:"bytes,1,opt,name=length,proto3" json:"length,omitempty"` // Длина в см
+	Width         *DoubleRange           `protobuf:"bytes,2,opt,name=width,proto3" json:"width,omitempty"`   // Ширина в см
+	Height        *DoubleRange           `protobuf:"bytes,3,opt,name=height,proto3" json:"height,omitempty"` // Высота в см
+	Weight        *DoubleRange           `protobuf:"bytes,4,opt,name=weight,proto3" json:"weight,omitempty"` // Вес в кг
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DimensionsRange) Reset() {
+	*x = DimensionsRange{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DimensionsRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DimensionsRange) ProtoMessage() {}
+
+func (x *DimensionsRange) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DimensionsRange.ProtoReflect.Descriptor instead.
+func (*DimensionsRange) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DimensionsRange) GetLength() *DoubleRange {
+	if x != nil {
+		return x.Length
+	}
+	return nil
+}
+
+func (x *DimensionsRange) GetWidth() *DoubleRange {
+	if x != nil {
+		return x.Width
+	}
+	return nil
+}
+
+func (x *DimensionsRange) GetHeight() *DoubleRange {
+	if x != nil {
+		return x.Height
+	}
+	return nil
+}
+
+func (x *DimensionsRange) GetWeight() *DoubleRange {
+	if x != nil {
+		return x.Weight
+	}
+	return nil
+}
+
+// WatchPartsRequest представляет подписку на изменения каталога.
+type WatchPartsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *PartsFilter           `protobuf:"bytes,1,opt,name=filter,proto3,oneof" json:"filter,omitempty"`                                     // Фильтр деталей; архивация передаётся независимо от include_archived
+	AfterRevision *int64                 `protobuf:"varint,2,opt,name=after_revision,json=afterRevision,proto3,oneof" json:"after_revision,omitempty"` // Ревизия последнего полученного события (не задана — только новые события)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPartsRequest) Reset() {
+	*x = WatchPartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPartsRequest) ProtoMessage() {}
+
+func (x *WatchPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPartsRequest.ProtoReflect.Descriptor instead.
+func (*WatchPartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *WatchPartsRequest) GetFilter() *PartsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *WatchPartsRequest) GetAfterRevision() int64 {
+	if x != nil && x.AfterRevision != nil {
+		return *x.AfterRevision
+	}
+	return 0
+}
+
+// WatchPartsResponse - событие изменения каталога.
+type WatchPartsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int64                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"` // Ревизия события, строго возрастает
+	Type          PartEventType          `protobuf:"varint,2,opt,name=type,proto3,enum=inventory.v1.PartEventType" json:"type,omitempty"`
+	Part          *Part                  `protobuf:"bytes,3,opt,name=part,proto3" json:"part,omitempty"` // Деталь после изменения (для удаления — последнее состояние)
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPartsResponse) Reset() {
+	*x = WatchPartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPartsResponse) ProtoMessage() {}
+
+func (x *WatchPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPartsResponse.ProtoReflect.Descriptor instead.
+func (*WatchPartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *WatchPartsResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *WatchPartsResponse) GetType() PartEventType {
+	if x != nil {
+		return x.Type
+	}
+	return PartEventType_PART_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *WatchPartsResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+func (x *WatchPartsResponse) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// ExportPartsRequest представляет запрос на выгрузку каталога.
+type ExportPartsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        CatalogFormat          `protobuf:"varint,1,opt,name=format,proto3,enum=inventory.v1.CatalogFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportPartsRequest) Reset() {
+	*x = ExportPartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPartsRequest) ProtoMessage() {}
+
+func (x *ExportPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPartsRequest.ProtoReflect.Descriptor instead.
+func (*ExportPartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ExportPartsRequest) GetFormat() CatalogFormat {
+	if x != nil {
+		return x.Format
+	}
+	return CatalogFormat_CATALOG_FORMAT_UNSPECIFIED
+}
+
+// ExportPartsResponse представляет часть выгруженного каталога.
+type ExportPartsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         []byte                 `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"` // Очередная часть файла
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportPartsResponse) Reset() {
+	*x = ExportPartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPartsResponse) ProtoMessage() {}
+
+func (x *ExportPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPartsResponse.ProtoReflect.Descriptor instead.
+func (*ExportPartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ExportPartsResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// ImportPartsRequest представляет сообщение потока загрузки каталога.
+type ImportPartsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ImportPartsRequest_Options
+	//	*ImportPartsRequest_Chunk
+	Payload       isImportPartsRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportPartsRequest) Reset() {
+	*x = ImportPartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportPartsRequest) ProtoMessage() {}
+
+func (x *ImportPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportPartsRequest.ProtoReflect.Descriptor instead.
+func (*ImportPartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ImportPartsRequest) GetPayload() isImportPartsRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImportPartsRequest) GetOptions() *ImportPartsOptions {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportPartsRequest_Options); ok {
+			return x.Options
+		}
+	}
+	return nil
+}
+
+func (x *ImportPartsRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportPartsRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isImportPartsRequest_Payload interface {
+	isImportPartsRequest_Payload()
+}
+
+type ImportPartsRequest_Options struct {
+	Options *ImportPartsOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"` // Параметры импорта (только в первом сообщении)
+}
+
+type ImportPartsRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"` // Очередная часть файла
+}
+
+func (*ImportPartsRequest_Options) isImportPartsRequest_Payload() {}
+
+func (*ImportPartsRequest_Chunk) isImportPartsRequest_Payload() {}
+
+// ImportPartsOptions представляет параметры загрузки каталога.
+type ImportPartsOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        CatalogFormat          `protobuf:"varint,1,opt,name=format,proto3,enum=inventory.v1.CatalogFormat" json:"format,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // Только посчитать изменения, ничего не записывая
+	Prune         bool                   `protobuf:"varint,3,opt,name=prune,proto3" json:"prune,omitempty"`                 // Удалить детали, которых нет в файле; зарезервированные детали архивируются
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportPartsOptions) Reset() {
+	*x = ImportPartsOptions{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportPartsOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportPartsOptions) ProtoMessage() {}
+
+func (x *ImportPartsOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportPartsOptions.ProtoReflect.Descriptor instead.
+func (*ImportPartsOptions) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ImportPartsOptions) GetFormat() CatalogFormat {
+	if x != nil {
+		return x.Format
+	}
+	return CatalogFormat_CATALOG_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportPartsOptions) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportPartsOptions) GetPrune() bool {
+	if x != nil {
+		return x.Prune
+	}
+	return false
+}
+
+// ImportPartsResponse представляет отчёт об импорте (или о том, что импорт сделал бы при dry_run).
+type ImportPartsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Added         []*ImportChange        `protobuf:"bytes,1,rep,name=added,proto3" json:"added,omitempty"`          // Новые детали
+	Changed       []*ImportChange        `protobuf:"bytes,2,rep,name=changed,proto3" json:"changed,omitempty"`      // Изменённые детали
+	Removed       []*ImportChange        `protobuf:"bytes,3,rep,name=removed,proto3" json:"removed,omitempty"`      // Удалённые детали (только при prune)
+	Unchanged     int32                  `protobuf:"varint,4,opt,name=unchanged,proto3" json:"unchanged,omitempty"` // Количество деталей без изменений
+	Errors        []*ImportError         `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`        // Ошибки строк
+	Archived      []*ImportChange        `protobuf:"bytes,6,rep,name=archived,proto3" json:"archived,omitempty"`    // Детали, которые нельзя удалить из-за активных резервов (только при prune)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportPartsResponse) Reset() {
+	*x = ImportPartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportPartsResponse) ProtoMessage() {}
+
+func (x *ImportPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportPartsResponse.ProtoReflect.Descriptor instead.
+func (*ImportPartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ImportPartsResponse) GetAdded() []*ImportChange {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *ImportPartsResponse) GetChanged() []*ImportChange {
+	if x != nil {
+		return x.Changed
+	}
+	return nil
+}
+
+func (x *ImportPartsResponse) GetRemoved() []*ImportChange {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *ImportPartsResponse) GetUnchanged() int32 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+func (x *ImportPartsResponse) GetErrors() []*ImportError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportPartsResponse) GetArchived() []*ImportChange {
+	if x != nil {
+		return x.Archived
+	}
+	return nil
+}
+
+// ImportChange - деталь, затронутая импортом
+type ImportChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`    // Номер строки файла (0 для удалённых деталей)
+	Uuid          string                 `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`     // UUID детали (пустой у новой детали без UUID при dry_run)
+	Fields        []string               `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"` // Изменённые поля
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportChange) Reset() {
+	*x = ImportChange{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportChange) ProtoMessage() {}
+
+func (x *ImportChange) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportChange.ProtoReflect.Descriptor instead.
+func (*ImportChange) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ImportChange) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportChange) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ImportChange) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// ImportError - ошибка строки файла
+type ImportError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"` // Номер строки файла (0, если ошибка возникла при удалении)
+	Uuid          string                 `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportError) Reset() {
+	*x = ImportError{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportError) ProtoMessage() {}
+
+func (x *ImportError) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportError.ProtoReflect.Descriptor instead.
+func (*ImportError) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ImportError) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportError) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ImportError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ReservationItem - резервируемое количество детали
+type ReservationItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid      string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"` // UUID детали
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`                // Количество
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReservationItem) Reset() {
+	*x = ReservationItem{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationItem) ProtoMessage() {}
+
+func (x *ReservationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationItem.ProtoReflect.Descriptor instead.
+func (*ReservationItem) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ReservationItem) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *ReservationItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// ReservePartsRequest представляет запрос на резервирование деталей.
+type ReservePartsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ReservationItem     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // Резервируемые детали
+	Ttl           *durationpb.Duration   `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`     // Срок жизни резерва (по умолчанию задаёт сервер)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReservePartsRequest) Reset() {
+	*x = ReservePartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservePartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservePartsRequest) ProtoMessage() {}
+
+func (x *ReservePartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservePartsRequest.ProtoReflect.Descriptor instead.
+func (*ReservePartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ReservePartsRequest) GetItems() []*ReservationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ReservePartsRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+// ReservePartsResponse представляет ответ на резервирование деталей.
+type ReservePartsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ReservationUuid string                 `protobuf:"bytes,1,opt,name=reservation_uuid,json=reservationUuid,proto3" json:"reservation_uuid,omitempty"` // Идентификатор резерва
+	ExpiresAt       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                   // Время истечения резерва
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReservePartsResponse) Reset() {
+	*x = ReservePartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservePartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservePartsResponse) ProtoMessage() {}
+
+func (x *ReservePartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservePartsResponse.ProtoReflect.Descriptor instead.
+func (*ReservePartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ReservePartsResponse) GetReservationUuid() string {
+	if x != nil {
+		return x.ReservationUuid
+	}
+	return ""
+}
+
+func (x *ReservePartsResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// ReleaseReservationRequest представляет запрос на снятие резерва.
+type ReleaseReservationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ReservationUuid string                 `protobuf:"bytes,1,opt,name=reservation_uuid,json=reservationUuid,proto3" json:"reservation_uuid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReleaseReservationRequest) Reset() {
+	*x = ReleaseReservationRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseReservationRequest) ProtoMessage() {}
+
+func (x *ReleaseReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseReservationRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseReservationRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReleaseReservationRequest) GetReservationUuid() string {
+	if x != nil {
+		return x.ReservationUuid
+	}
+	return ""
+}
+
+// ReleaseReservationResponse представляет ответ на снятие резерва.
+type ReleaseReservationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseReservationResponse) Reset() {
+	*x = ReleaseReservationResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseReservationResponse) ProtoMessage() {}
+
+func (x *ReleaseReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseReservationResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseReservationResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{37}
+}
+
+// CommitReservationRequest представляет запрос на подтверждение резерва.
+type CommitReservationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ReservationUuid string                 `protobuf:"bytes,1,opt,name=reservation_uuid,json=reservationUuid,proto3" json:"reservation_uuid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CommitReservationRequest) Reset() {
+	*x = CommitReservationRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitReservationRequest) ProtoMessage() {}
+
+func (x *CommitReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitReservationRequest.ProtoReflect.Descriptor instead.
+func (*CommitReservationRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CommitReservationRequest) GetReservationUuid() string {
+	if x != nil {
+		return x.ReservationUuid
+	}
+	return ""
+}
+
+// CommitReservationResponse представляет ответ на подтверждение резерва.
+type CommitReservationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitReservationResponse) Reset() {
+	*x = CommitReservationResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitReservationResponse) ProtoMessage() {}
+
+func (x *CommitReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitReservationResponse.ProtoReflect.Descriptor instead.
+func (*CommitReservationResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{39}
+}
+
+// PartInput - изменяемые поля детали
+type PartInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                                                   // Название детали
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                                                                     // Описание детали
+	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`                                                                               // Цена за единицу
+	StockQuantity int64                  `protobuf:"varint,4,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`                                           // Количество на складе
+	Category      Category               `protobuf:"varint,5,opt,name=category,proto3,enum=inventory.v1.Category" json:"category,omitempty"`                                               // Категория
+	Dimensions    *Dimensions            `protobuf:"bytes,6,opt,name=dimensions,proto3" json:"dimensions,omitempty"`                                                                       // Размеры детали
+	Manufacturer  *Manufacturer          `protobuf:"bytes,7,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`                                                                   // Информация о производителе
+	Tags          []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`                                                                                   // Теги для быстрого поиска
+	Metadata      map[string]*Value      `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Гибкие метаданные
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartInput) Reset() {
+	*x = PartInput{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartInput) ProtoMessage() {}
+
+func (x *PartInput) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartInput.ProtoReflect.Descriptor instead.
+func (*PartInput) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PartInput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PartInput) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PartInput) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PartInput) GetStockQuantity() int64 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+func (x *PartInput) GetCategory() Category {
+	if x != nil {
+		return x.Category
+	}
+	return Category_CATEGORY_UNSPECIFIED
+}
+
+func (x *PartInput) GetDimensions() *Dimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+func (x *PartInput) GetManufacturer() *Manufacturer {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return nil
+}
+
+func (x *PartInput) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PartInput) GetMetadata() map[string]*Value {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// CreatePartRequest представляет запрос на создание детали.
+type CreatePartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Part          *PartInput             `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePartRequest) Reset() {
+	*x = CreatePartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePartRequest) ProtoMessage() {}
+
+func (x *CreatePartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePartRequest.ProtoReflect.Descriptor instead.
+func (*CreatePartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreatePartRequest) GetPart() *PartInput {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// CreatePartResponse представляет ответ на создание детали.
+type CreatePartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Part          *Part                  `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePartResponse) Reset() {
+	*x = CreatePartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePartResponse) ProtoMessage() {}
+
+func (x *CreatePartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePartResponse.ProtoReflect.Descriptor instead.
+func (*CreatePartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreatePartResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// UpdatePartRequest представляет запрос на изменение детали.
+type UpdatePartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Part          *PartInput             `protobuf:"bytes,2,opt,name=part,proto3" json:"part,omitempty"`                               // Новые значения полей
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"` // Изменяемые поля верхнего уровня PartInput
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePartRequest) Reset() {
+	*x = UpdatePartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePartRequest) ProtoMessage() {}
+
+func (x *UpdatePartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePartRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UpdatePartRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *UpdatePartRequest) GetPart() *PartInput {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+func (x *UpdatePartRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// UpdatePartResponse представляет ответ на изменение детали.
+type UpdatePartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Part          *Part                  `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePartResponse) Reset() {
+	*x = UpdatePartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePartResponse) ProtoMessage() {}
+
+func (x *UpdatePartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePartResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UpdatePartResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// ArchivePartRequest представляет запрос на архивацию детали.
+type ArchivePartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchivePartRequest) Reset() {
+	*x = ArchivePartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivePartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivePartRequest) ProtoMessage() {}
+
+func (x *ArchivePartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivePartRequest.ProtoReflect.Descriptor instead.
+func (*ArchivePartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ArchivePartRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// ArchivePartResponse представляет ответ на архивацию детали.
+type ArchivePartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Part          *Part                  `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchivePartResponse) Reset() {
+	*x = ArchivePartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivePartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivePartResponse) ProtoMessage() {}
+
+func (x *ArchivePartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivePartResponse.ProtoReflect.Descriptor instead.
+func (*ArchivePartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ArchivePartResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// DeletePartRequest представляет запрос на удаление детали.
+type DeletePartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePartRequest) Reset() {
+	*x = DeletePartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePartRequest) ProtoMessage() {}
+
+func (x *DeletePartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePartRequest.ProtoReflect.Descriptor instead.
+func (*DeletePartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DeletePartRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// DeletePartResponse представляет ответ на удаление детали.
+type DeletePartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePartResponse) Reset() {
+	*x = DeletePartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePartResponse) ProtoMessage() {}
+
+func (x *DeletePartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePartResponse.ProtoReflect.Descriptor instead.
+func (*DeletePartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{48}
+}
+
+var File_inventory_v1_inventory_proto protoreflect.FileDescriptor
+
+const file_inventory_v1_inventory_proto_rawDesc = "" +
+	"\n" +
+	"\x1cinventory/v1/inventory.proto\x12\finventory.v1\x1a\x1egoogle/protobuf/duration.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"$\n" +
+	"\x0eGetPartRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"9\n" +
+	"\x0fGetPartResponse\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"\xf1\x04\n" +
+	"\x04Part\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x01R\x05price\x12%\n" +
+	"\x0estock_quantity\x18\x05 \x01(\x03R\rstockQuantity\x122\n" +
+	"\bcategory\x18\x06 \x01(\x0e2\x16.inventory.v1.CategoryR\bcategory\x128\n" +
+	"\n" +
+	"dimensions\x18\a \x01(\v2\x18.inventory.v1.DimensionsR\n" +
+	"dimensions\x12>\n" +
+	"\fmanufacturer\x18\b \x01(\v2\x1a.inventory.v1.ManufacturerR\fmanufacturer\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x12<\n" +
+	"\bmetadata\x18\n" +
+	" \x03(\v2 .inventory.v1.Part.MetadataEntryR\bmetadata\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
+	"\barchived\x18\r \x01(\bR\barchived\x1aP\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01\"\xaa\x01\n" +
+	"\n" +
+	"Dimensions\x12&\n" +
+	"\x06length\x18\x01 \x01(\x01B\x0e\xfaB\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x06length\x12$\n" +
+	"\x05width\x18\x02 \x01(\x01B\x0e\xfaB\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x05width\x12&\n" +
+	"\x06height\x18\x03 \x01(\x01B\x0e\xfaB\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x06height\x12&\n" +
+	"\x06weight\x18\x04 \x01(\x01B\x0e\xfaB\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x06weight\"V\n" +
+	"\fManufacturer\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\acountry\x18\x02 \x01(\tR\acountry\x12\x18\n" +
+	"\awebsite\x18\x03 \x01(\tR\awebsite\"\xa2\x01\n" +
+	"\x05Value\x12#\n" +
+	"\fstring_value\x18\x01 \x01(\tH\x00R\vstringValue\x12!\n" +
+	"\vint64_value\x18\x02 \x01(\x03H\x00R\n" +
+	"int64Value\x12#\n" +
+	"\fdouble_value\x18\x03 \x01(\x01H\x00R\vdoubleValue\x12\x1f\n" +
+	"\n" +
+	"bool_value\x18\x04 \x01(\bH\x00R\tboolValueB\v\n" +
+	"\x04kind\x12\x03\xf8B\x01\"\xfa\x01\n" +
+	"\x10ListPartsRequest\x126\n" +
+	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterH\x00R\x06filter\x88\x01\x01\x12'\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12[\n" +
+	"\border_by\x18\x04 \x01(\tB@\xfaB=r;29^((price|name|created_at|stock_quantity)( (asc|desc))?)?$R\aorderByB\t\n" +
+	"\a_filter\"\x84\x01\n" +
+	"\x11ListPartsResponse\x12(\n" +
+	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"@\n" +
+	"\x14BatchGetPartsRequest\x12(\n" +
+	"\x05uuids\x18\x01 \x03(\tB\x12\xfaB\x0f\x92\x01\f\b\x01\x10\xe8\a\"\x05r\x03\xb0\x01\x01R\x05uuids\"\xd0\x01\n" +
+	"\x15BatchGetPartsResponse\x12D\n" +
+	"\x05parts\x18\x01 \x03(\v2..inventory.v1.BatchGetPartsResponse.PartsEntryR\x05parts\x12#\n" +
+	"\rmissing_uuids\x18\x02 \x03(\tR\fmissingUuids\x1aL\n" +
+	"\n" +
+	"PartsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
+	"\x05value\x18\x02 \x01(\v2\x12.inventory.v1.PartR\x05value:\x028\x01\"Y\n" +
+	"\x14GetPartFacetsRequest\x126\n" +
+	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterH\x00R\x06filter\x88\x01\x01B\t\n" +
+	"\a_filter\"\xd3\x01\n" +
+	"\x15GetPartFacetsResponse\x12;\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x1b.inventory.v1.CategoryFacetR\n" +
+	"categories\x12O\n" +
+	"\x16manufacturer_countries\x18\x02 \x03(\v2\x18.inventory.v1.FacetCountR\x15manufacturerCountries\x12,\n" +
+	"\x04tags\x18\x03 \x03(\v2\x18.inventory.v1.FacetCountR\x04tags\"Y\n" +
+	"\rCategoryFacet\x122\n" +
+	"\bcategory\x18\x01 \x01(\x0e2\x16.inventory.v1.CategoryR\bcategory\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"8\n" +
+	"\n" +
+	"FacetCount\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"W\n" +
+	"\x12SearchPartsRequest\x12 \n" +
+	"\x05query\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x02R\x05query\x12\x1f\n" +
+	"\x05limit\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\x05limit\"B\n" +
+	"\x13SearchPartsResponse\x12+\n" +
+	"\x04hits\x18\x01 \x03(\v2\x17.inventory.v1.SearchHitR\x04hits\"\x82\x01\n" +
+	"\tSearchHit\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x127\n" +
+	"\n" +
+	"highlights\x18\x03 \x03(\v2\x17.inventory.v1.HighlightR\n" +
+	"highlights\";\n" +
+	"\tHighlight\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x18\n" +
+	"\asnippet\x18\x02 \x01(\tR\asnippet\"\xf7\x04\n" +
+	"\vPartsFilter\x12\x1e\n" +
+	"\x05uuids\x18\x01 \x03(\tB\b\xfaB\x05\x92\x01\x02\x18\x01R\x05uuids\x12\x1e\n" +
+	"\x05names\x18\x02 \x03(\tB\b\xfaB\x05\x92\x01\x02\x18\x01R\x05names\x126\n" +
+	"\n" +
+	"categories\x18\x03 \x03(\x0e2\x16.inventory.v1.CategoryR\n" +
+	"categories\x12?\n" +
+	"\x16manufacturer_countries\x18\x04 \x03(\tB\b\xfaB\x05\x92\x01\x02\x18\x01R\x15manufacturerCountries\x12\x1c\n" +
+	"\x04tags\x18\x05 \x03(\tB\b\xfaB\x05\x92\x01\x02\x18\x01R\x04tags\x12)\n" +
+	"\x10include_archived\x18\x06 \x01(\bR\x0fincludeArchived\x12/\n" +
+	"\x05price\x18\a \x01(\v2\x19.inventory.v1.DoubleRangeR\x05price\x12?\n" +
+	"\x0estock_quantity\x18\b \x01(\v2\x18.inventory.v1.Int64RangeR\rstockQuantity\x12=\n" +
+	"\n" +
+	"dimensions\x18\t \x01(\v2\x1d.inventory.v1.DimensionsRangeR\n" +
+	"dimensions\x12;\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1c.inventory.v1.TimestampRangeR\tcreatedAt\x12;\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1c.inventory.v1.TimestampRangeR\tupdatedAt\x12;\n" +
+	"\bmetadata\x18\f \x03(\v2\x1f.inventory.v1.MetadataConditionR\bmetadata\"Y\n" +
+	"\x11MetadataCondition\x12\x19\n" +
+	"\x03key\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x03key\x12)\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value\"K\n" +
+	"\vDoubleRange\x12\x15\n" +
+	"\x03min\x18\x01 \x01(\x01H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x02 \x01(\x01H\x01R\x03max\x88\x01\x01B\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"J\n" +
+	"\n" +
+	"Int64Range\x12\x15\n" +
+	"\x03min\x18\x01 \x01(\x03H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x02 \x01(\x03H\x01R\x03max\x88\x01\x01B\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"l\n" +
+	"\x0eTimestampRange\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\xdb\x01\n" +
+	"\x0fDimensionsRange\x121\n" +
+	"\x06length\x18\x01 \x01(\v2\x19.inventory.v1.DoubleRangeR\x06length\x12/\n" +
+	"\x05width\x18\x02 \x01(\v2\x19.inventory.v1.DoubleRangeR\x05width\x121\n" +
+	"\x06height\x18\x03 \x01(\v2\x19.inventory.v1.DoubleRangeR\x06height\x121\n" +
+	"\x06weight\x18\x04 \x01(\v2\x19.inventory.v1.DoubleRangeR\x06weight\"\x9e\x01\n" +
+	"\x11WatchPartsRequest\x126\n" +
+	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterH\x00R\x06filter\x88\x01\x01\x123\n" +
+	"\x0eafter_revision\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02(\x00H\x01R\rafterRevision\x88\x01\x01B\t\n" +
+	"\a_filterB\x11\n" +
+	"\x0f_after_revision\"\xc6\x01\n" +
+	"\x12WatchPartsResponse\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x03R\brevision\x12/\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1b.inventory.v1.PartEventTypeR\x04type\x12&\n" +
+	"\x04part\x18\x03 \x01(\v2\x12.inventory.v1.PartR\x04part\x12;\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"U\n" +
+	"\x12ExportPartsRequest\x12?\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x1b.inventory.v1.CatalogFormatB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x06format\"+\n" +
+	"\x13ExportPartsResponse\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\"z\n" +
+	"\x12ImportPartsRequest\x12<\n" +
+	"\aoptions\x18\x01 \x01(\v2 .inventory.v1.ImportPartsOptionsH\x00R\aoptions\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x0e\n" +
+	"\apayload\x12\x03\xf8B\x01\"\x84\x01\n" +
+	"\x12ImportPartsOptions\x12?\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x1b.inventory.v1.CatalogFormatB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x06format\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05prune\x18\x03 \x01(\bR\x05prune\"\xbc\x02\n" +
+	"\x13ImportPartsResponse\x120\n" +
+	"\x05added\x18\x01 \x03(\v2\x1a.inventory.v1.ImportChangeR\x05added\x124\n" +
+	"\achanged\x18\x02 \x03(\v2\x1a.inventory.v1.ImportChangeR\achanged\x124\n" +
+	"\aremoved\x18\x03 \x03(\v2\x1a.inventory.v1.ImportChangeR\aremoved\x12\x1c\n" +
+	"\tunchanged\x18\x04 \x01(\x05R\tunchanged\x121\n" +
+	"\x06errors\x18\x05 \x03(\v2\x19.inventory.v1.ImportErrorR\x06errors\x126\n" +
+	"\barchived\x18\x06 \x03(\v2\x1a.inventory.v1.ImportChangeR\barchived\"N\n" +
+	"\fImportChange\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x12\n" +
+	"\x04uuid\x18\x02 \x01(\tR\x04uuid\x12\x16\n" +
+	"\x06fields\x18\x03 \x03(\tR\x06fields\"O\n" +
+	"\vImportError\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x12\n" +
+	"\x04uuid\x18\x02 \x01(\tR\x04uuid\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"]\n" +
+	"\x0fReservationItem\x12%\n" +
+	"\tpart_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\bpartUuid\x12#\n" +
+	"\bquantity\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\bquantity\"\x8b\x01\n" +
+	"\x13ReservePartsRequest\x12=\n" +
+	"\x05items\x18\x01 \x03(\v2\x1d.inventory.v1.ReservationItemB\b\xfaB\x05\x92\x01\x02\b\x01R\x05items\x125\n" +
+	"\x03ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationB\b\xfaB\x05\xaa\x01\x02*\x00R\x03ttl\"|\n" +
+	"\x14ReservePartsResponse\x12)\n" +
+	"\x10reservation_uuid\x18\x01 \x01(\tR\x0freservationUuid\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"P\n" +
+	"\x19ReleaseReservationRequest\x123\n" +
+	"\x10reservation_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x0freservationUuid\"\x1c\n" +
+	"\x1aReleaseReservationResponse\"O\n" +
+	"\x18CommitReservationRequest\x123\n" +
+	"\x10reservation_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x0freservationUuid\"\x1b\n" +
+	"\x19CommitReservationResponse\"\xb1\x04\n" +
+	"\tPartInput\x12\x1c\n" +
+	"\x04name\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x04name\x12*\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\x80 R\vdescription\x12$\n" +
+	"\x05price\x18\x03 \x01(\x01B\x0e\xfaB\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x05price\x12.\n" +
+	"\x0estock_quantity\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\rstockQuantity\x12<\n" +
+	"\bcategory\x18\x05 \x01(\x0e2\x16.inventory.v1.CategoryB\b\xfaB\x05\x82\x01\x02\x10\x01R\bcategory\x128\n" +
+	"\n" +
+	"dimensions\x18\x06 \x01(\v2\x18.inventory.v1.DimensionsR\n" +
+	"dimensions\x12>\n" +
+	"\fmanufacturer\x18\a \x01(\v2\x1a.inventory.v1.ManufacturerR\fmanufacturer\x12\"\n" +
+	"\x04tags\x18\b \x03(\tB\x0e\xfaB\v\x92\x01\b\x18\x01\"\x04r\x02\x10\x01R\x04tags\x12V\n" +
+	"\bmetadata\x18\t \x03(\v2%.inventory.v1.PartInput.MetadataEntryB\x13\xfaB\x10\x9a\x01\r\"\x04r\x02\x10\x01*\x05\x8a\x01\x02\x10\x01R\bmetadata\x1aP\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01\"J\n" +
+	"\x11CreatePartRequest\x125\n" +
+	"\x04part\x18\x01 \x01(\v2\x17.inventory.v1.PartInputB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x04part\"<\n" +
+	"\x12CreatePartResponse\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"\xaf\x01\n" +
+	"\x11UpdatePartRequest\x12\x1c\n" +
+	"\x04uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x04uuid\x125\n" +
+	"\x04part\x18\x02 \x01(\v2\x17.inventory.v1.PartInputB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x04part\x12E\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskB\b\xfaB\x05\x8a\x01\x02\x10\x01R\n" +
+	"updateMask\"<\n" +
+	"\x12UpdatePartResponse\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"2\n" +
+	"\x12ArchivePartRequest\x12\x1c\n" +
+	"\x04uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x04uuid\"=\n" +
+	"\x13ArchivePartResponse\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"1\n" +
+	"\x11DeletePartRequest\x12\x1c\n" +
+	"\x04uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x04uuid\"\x14\n" +
+	"\x12DeletePartResponse*v\n" +
+	"\bCategory\x12\x18\n" +
+	"\x14CATEGORY_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fCATEGORY_ENGINE\x10\x01\x12\x11\n" +
+	"\rCATEGORY_FUEL\x10\x02\x12\x15\n" +
+	"\x11CATEGORY_PORTHOLE\x10\x03\x12\x11\n" +
+	"\rCATEGORY_WING\x10\x04*\xa4\x01\n" +
+	"\rPartEventType\x12\x1f\n" +
+	"\x1bPART_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17PART_EVENT_TYPE_CREATED\x10\x01\x12\x1b\n" +
+	"\x17PART_EVENT_TYPE_UPDATED\x10\x02\x12\x1b\n" +
+	"\x17PART_EVENT_TYPE_DELETED\x10\x03\x12\x1b\n" +
+	"\x17PART_EVENT_TYPE_REMOVED\x10\x04*a\n" +
+	"\rCatalogFormat\x12\x1e\n" +
+	"\x1aCATALOG_FORMAT_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14CATALOG_FORMAT_JSONL\x10\x01\x12\x16\n" +
+	"\x12CATALOG_FORMAT_CSV\x10\x022\x9c\n" +
+	"\n" +
+	"\x10InventoryService\x12F\n" +
+	"\aGetPart\x12\x1c.inventory.v1.GetPartRequest\x1a\x1d.inventory.v1.GetPartResponse\x12L\n" +
+	"\tListParts\x12\x1e.inventory.v1.ListPartsRequest\x1a\x1f.inventory.v1.ListPartsResponse\x12X\n" +
+	"\rBatchGetParts\x12\".inventory.v1.BatchGetPartsRequest\x1a#.inventory.v1.BatchGetPartsResponse\x12X\n" +
+	"\rGetPartFacets\x12\".inventory.v1.GetPartFacetsRequest\x1a#.inventory.v1.GetPartFacetsResponse\x12R\n" +
+	"\vSearchParts\x12 .inventory.v1.SearchPartsRequest\x1a!.inventory.v1.SearchPartsResponse\x12O\n" +
+	"\n" +
+	"CreatePart\x12\x1f.inventory.v1.CreatePartRequest\x1a .inventory.v1.CreatePartResponse\x12O\n" +
+	"\n" +
+	"UpdatePart\x12\x1f.inventory.v1.UpdatePartRequest\x1a .inventory.v1.UpdatePartResponse\x12R\n" +
+	"\vArchivePart\x12 .inventory.v1.ArchivePartRequest\x1a!.inventory.v1.ArchivePartResponse\x12O\n" +
+	"\n" +
+	"DeletePart\x12\x1f.inventory.v1.DeletePartRequest\x1a .inventory.v1.DeletePartResponse\x12Q\n" +
+	"\n" +
+	"WatchParts\x12\x1f.inventory.v1.WatchPartsRequest\x1a .inventory.v1.WatchPartsResponse0\x01\x12T\n" +
+	"\vExportParts\x12 .inventory.v1.ExportPartsRequest\x1a!.inventory.v1.ExportPartsResponse0\x01\x12T\n" +
+	"\vImportParts\x12 .inventory.v1.ImportPartsRequest\x1a!.inventory.v1.ImportPartsResponse(\x01\x12U\n" +
+	"\fReserveParts\x12!.inventory.v1.ReservePartsRequest\x1a\".inventory.v1.ReservePartsResponse\x12g\n" +
+	"\x12ReleaseReservation\x12'.inventory.v1.ReleaseReservationRequest\x1a(.inventory.v1.ReleaseReservationResponse\x12d\n" +
+	"\x11CommitReservation\x12&.inventory.v1.CommitReservationRequest\x1a'.inventory.v1.CommitReservationResponseBSZQgithub.com/baryshnikkov/rocket-factory/shared/pkg/proto/inventory/v1;inventory_v1b\x06proto3"
+
+var (
+	file_inventory_v1_inventory_proto_rawDescOnce sync.Once
+	file_inventory_v1_inventory_proto_rawDescData []byte
+)
+
+func file_inventory_v1_inventory_proto_rawDescGZIP() []byte {
+	file_inventory_v1_inventory_proto_rawDescOnce.Do(func() {
+		file_inventory_v1_inventory_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_inventory_v1_inventory_proto_rawDesc), len(file_inventory_v1_inventory_proto_rawDesc)))
+	})
+	return file_inventory_v1_inventory_proto_rawDescData
+}
+
+var file_inventory_v1_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_inventory_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
+var file_inventory_v1_inventory_proto_goTypes = []any{
+	(Category)(0),                      // 0: inventory.v1.Category
+	(PartEventType)(0),                 // 1: inventory.v1.PartEventType
+	(CatalogFormat)(0),                 // 2: inventory.v1.CatalogFormat
+	(*GetPartRequest)(nil),             // 3: inventory.v1.GetPartRequest
+	(*GetPartResponse)(nil),            // 4: inventory.v1.GetPartResponse
+	(*Part)(nil),                       // 5: inventory.v1.Part
+	(*Dimensions)(nil),                 // 6: inventory.v1.Dimensions
+	(*Manufacturer)(nil),               // 7: inventory.v1.Manufacturer
+	(*Value)(nil),                      // 8: inventory.v1.Value
+	(*ListPartsRequest)(nil),           // 9: inventory.v1.ListPartsRequest
+	(*ListPartsResponse)(nil),          // 10: inventory.v1.ListPartsResponse
+	(*BatchGetPartsRequest)(nil),       // 11: inventory.v1.BatchGetPartsRequest
+	(*BatchGetPartsResponse)(nil),      // 12: inventory.v1.BatchGetPartsResponse
+	(*GetPartFacetsRequest)(nil),       // 13: inventory.v1.GetPartFacetsRequest
+	(*GetPartFacetsResponse)(nil),      // 14: inventory.v1.GetPartFacetsResponse
+	(*CategoryFacet)(nil),              // 15: inventory.v1.CategoryFacet
+	(*FacetCount)(nil),                 // 16: inventory.v1.FacetCount
+	(*SearchPartsRequest)(nil),         // 17: inventory.v1.SearchPartsRequest
+	(*SearchPartsResponse)(nil),        // 18: inventory.v1.SearchPartsResponse
+	(*SearchHit)(nil),                  // 19: inventory.v1.SearchHit
+	(*Highlight)(nil),                  // 20: inventory.v1.Highlight
+	(*PartsFilter)(nil),                // 21: inventory.v1.PartsFilter
+	(*MetadataCondition)(nil),          // 22: inventory.v1.MetadataCondition
+	(*DoubleRange)(nil),                // 23: inventory.v1.DoubleRange
+	(*Int64Range)(nil),                 // 24: inventory.v1.Int64Range
+	(*TimestampRange)(nil),             // 25: inventory.v1.TimestampRange
+	(*DimensionsRange)(nil),            // 26: inventory.v1.DimensionsRange
+	(*WatchPartsRequest)(nil),          // 27: inventory.v1.WatchPartsRequest
+	(*WatchPartsResponse)(nil),         // 28: inventory.v1.WatchPartsResponse
+	(*ExportPartsRequest)(nil),         // 29: inventory.v1.ExportPartsRequest
+	(*ExportPartsResponse)(nil),        // 30: inventory.v1.ExportPartsResponse
+	(*ImportPartsRequest)(nil),         // 31: inventory.v1.ImportPartsRequest
+	(*ImportPartsOptions)(nil),         // 32: inventory.v1.ImportPartsOptions
+	(*ImportPartsResponse)(nil),        // 33: inventory.v1.ImportPartsResponse
+	(*ImportChange)(nil),               // 34: inventory.v1.ImportChange
+	(*ImportError)(nil),                // 35: inventory.v1.ImportError
+	(*ReservationItem)(nil),            // 36: inventory.v1.ReservationItem
+	(*ReservePartsRequest)(nil),        // 37: inventory.v1.ReservePartsRequest
+	(*ReservePartsResponse)(nil),       // 38: inventory.v1.ReservePartsResponse
+	(*ReleaseReservationRequest)(nil),  // 39: inventory.v1.ReleaseReservationRequest
+	(*ReleaseReservationResponse)(nil), // 40: inventory.v1.ReleaseReservationResponse
+	(*CommitReservationRequest)(nil),   // 41: inventory.v1.CommitReservationRequest
+	(*CommitReservationResponse)(nil),  // 42: inventory.v1.CommitReservationResponse
+	(*PartInput)(nil),                  // 43: inventory.v1.PartInput
+	(*CreatePartRequest)(nil),          // 44: inventory.v1.CreatePartRequest
+	(*CreatePartResponse)(nil),         // 45: inventory.v1.CreatePartResponse
+	(*UpdatePartRequest)(nil),          // 46: inventory.v1.UpdatePartRequest
+	(*UpdatePartResponse)(nil),         // 47: inventory.v1.UpdatePartResponse
+	(*ArchivePartRequest)(nil),         // 48: inventory.v1.ArchivePartRequest
+	(*ArchivePartResponse)(nil),        // 49: inventory.v1.ArchivePartResponse
+	(*DeletePartRequest)(nil),          // 50: inventory.v1.DeletePartRequest
+	(*DeletePartResponse)(nil),         // 51: inventory.v1.DeletePartResponse
+	nil,                                // 52: inventory.v1.Part.MetadataEntry
+	nil,                                // 53: inventory.v1.BatchGetPartsResponse.PartsEntry
+	nil,                                // 54: inventory.v1.PartInput.MetadataEntry
+	(*timestamppb.Timestamp)(nil),      // 55: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),        // 56: google.protobuf.Duration
+	(*fieldmaskpb.FieldMask)(nil),      // 57: google.protobuf.FieldMask
+}
+var file_inventory_v1_inventory_proto_depIdxs = []int32{
+	5,  // 0: inventory.v1.GetPartResponse.part:type_name -> inventory.v1.Part
+	0,  // 1: inventory.v1.Part.category:type_name -> inventory.v1.Category
+	6,  // 2: inventory.v1.Part.dimensions:type_name -> inventory.v1.Dimensions
+	7,  // 3: inventory.v1.Part.manufacturer:type_name -> inventory.v1.Manufacturer
+	52, // 4: inventory.v1.Part.metadata:type_name -> inventory.v1.Part.MetadataEntry
+	55, // 5: inventory.v1.Part.created_at:type_name -> google.protobuf.Timestamp
+	55, // 6: inventory.v1.Part.updated_at:type_name -> google.protobuf.Timestamp
+	21, // 7: inventory.v1.ListPartsRequest.filter:type_name -> inventory.v1.PartsFilter
+	5,  // 8: inventory.v1.ListPartsResponse.parts:type_name -> inventory.v1.Part
+	53, // 9: inventory.v1.BatchGetPartsResponse.parts:type_name -> inventory.v1.BatchGetPartsResponse.PartsEntry
+	21, // 10: inventory.v1.GetPartFacetsRequest.filter:type_name -> inventory.v1.PartsFilter
+	15, // 11: inventory.v1.GetPartFacetsResponse.categories:type_name -> inventory.v1.CategoryFacet
+	16, // 12: inventory.v1.GetPartFacetsResponse.manufacturer_countries:type_name -> inventory.v1.FacetCount
+	16, // 13: inventory.v1.GetPartFacetsResponse.tags:type_name -> inventory.v1.FacetCount
+	0,  // 14: inventory.v1.CategoryFacet.category:type_name -> inventory.v1.Category
+	19, // 15: inventory.v1.SearchPartsResponse.hits:type_name -> inventory.v1.SearchHit
+	5,  // 16: inventory.v1.SearchHit.part:type_name -> inventory.v1.Part
+	20, // 17: inventory.v1.SearchHit.highlights:type_name -> inventory.v1.Highlight
+	0,  // 18: inventory.v1.PartsFilter.categories:type_name -> inventory.v1.Category
+	23, // 19: inventory.v1.PartsFilter.price:type_name -> inventory.v1.DoubleRange
+	24, // 20: inventory.v1.PartsFilter.stock_quantity:type_name -> inventory.v1.Int64Range
+	26, // 21: inventory.v1.PartsFilter.dimensions:type_name -> inventory.v1.DimensionsRange
+	25, // 22: inventory.v1.PartsFilter.created_at:type_name -> inventory.v1.TimestampRange
+	25, // 23: inventory.v1.PartsFilter.updated_at:type_name -> inventory.v1.TimestampRange
+	22, // 24: inventory.v1.PartsFilter.metadata:type_name -> inventory.v1.MetadataCondition
+	8,  // 25: inventory.v1.MetadataCondition.value:type_name -> inventory.v1.Value
+	55, // 26: inventory.v1.TimestampRange.from:type_name -> google.protobuf.Timestamp
+	55, // 27: inventory.v1.TimestampRange.to:type_name -> google.protobuf.Timestamp
+	23, // 28: inventory.v1.DimensionsRange.length:type_name -> inventory.v1.DoubleRange
+	23, // 29: inventory.v1.DimensionsRange.width:type_name -> inventory.v1.DoubleRange
+	23, // 30: inventory.v1.DimensionsRange.height:type_name -> inventory.v1.DoubleRange
+	23, // 31: inventory.v1.DimensionsRange.weight:type_name -> inventory.v1.DoubleRange
+	21, // 32: inventory.v1.WatchPartsRequest.filter:type_name -> inventory.v1.PartsFilter
+	1,  // 33: inventory.v1.WatchPartsResponse.type:type_name -> inventory.v1.PartEventType
+	5,  // 34: inventory.v1.WatchPartsResponse.part:type_name -> inventory.v1.Part
+	55, // 35: inventory.v1.WatchPartsResponse.occurred_at:type_name -> google.protobuf.Timestamp
+	2,  // 36: inventory.v1.ExportPartsRequest.format:type_name -> inventory.v1.CatalogFormat
+	32, // 37: inventory.v1.ImportPartsRequest.options:type_name -> inventory.v1.ImportPartsOptions
+	2,  // 38: inventory.v1.ImportPartsOptions.format:type_name -> inventory.v1.CatalogFormat
+	34, // 39: inventory.v1.ImportPartsResponse.added:type_name -> inventory.v1.ImportChange
+	34, // 40: inventory.v1.ImportPartsResponse.changed:type_name -> inventory.v1.ImportChange
+	34, // 41: inventory.v1.ImportPartsResponse.removed:type_name -> inventory.v1.ImportChange
+	35, // 42: inventory.v1.ImportPartsResponse.errors:type_name -> inventory.v1.ImportError
+	34, // 43: inventory.v1.ImportPartsResponse.archived:type_name -> inventory.v1.ImportChange
+	36, // 44: inventory.v1.ReservePartsRequest.items:type_name -> inventory.v1.ReservationItem
+	56, // 45: inventory.v1.ReservePartsRequest.ttl:type_name -> google.protobuf.Duration
+	55, // 46: inventory.v1.ReservePartsResponse.expires_at:type_name -> google.protobuf.Timestamp
+	0,  // 47: inventory.v1.PartInput.category:type_name -> inventory.v1.Category
+	6,  // 48: inventory.v1.PartInput.dimensions:type_name -> inventory.v1.Dimensions
+	7,  // 49: inventory.v1.PartInput.manufacturer:type_name -> inventory.v1.Manufacturer
+	54, // 50: inventory.v1.PartInput.metadata:type_name -> inventory.v1.PartInput.MetadataEntry
+	43, // 51: inventory.v1.CreatePartRequest.part:type_name -> inventory.v1.PartInput
+	5,  // 52: inventory.v1.CreatePartResponse.part:type_name -> inventory.v1.Part
+	43, // 53: inventory.v1.UpdatePartRequest.part:type_name -> inventory.v1.PartInput
+	57, // 54: inventory.v1.UpdatePartRequest.update_mask:type_name -> google.protobuf.FieldMask
+	5,  // 55: inventory.v1.UpdatePartResponse.part:type_name -> inventory.v1.Part
+	5,  // 56: inventory.v1.ArchivePartResponse.part:type_name -> inventory.v1.Part
+	8,  // 57: inventory.v1.Part.MetadataEntry.value:type_name -> inventory.v1.Value
+	5,  // 58: inventory.v1.BatchGetPartsResponse.PartsEntry.value:type_name -> inventory.v1.Part
+	8,  // 59: inventory.v1.PartInput.MetadataEntry.value:type_name -> inventory.v1.Value
+	3,  // 60: inventory.v1.InventoryService.GetPart:input_type -> inventory.v1.GetPartRequest
+	9,  // 61: inventory.v1.InventoryService.ListParts:input_type -> inventory.v1.ListPartsRequest
+	11, // 62: inventory.v1.InventoryService.BatchGetParts:input_type -> inventory.v1.BatchGetPartsRequest
+	13, // 63: inventory.v1.InventoryService.GetPartFacets:input_type -> inventory.v1.GetPartFacetsRequest
+	17, // 64: inventory.v1.InventoryService.SearchParts:input_type -> inventory.v1.SearchPartsRequest
+	44, // 65: inventory.v1.InventoryService.CreatePart:input_type -> inventory.v1.CreatePartRequest
+	46, // 66: inventory.v1.InventoryService.UpdatePart:input_type -> inventory.v1.UpdatePartRequest
+	48, // 67: inventory.v1.InventoryService.ArchivePart:input_type -> inventory.v1.ArchivePartRequest
+	50, // 68: inventory.v1.InventoryService.DeletePart:input_type -> inventory.v1.DeletePartRequest
+	27, // 69: inventory.v1.InventoryService.WatchParts:input_type -> inventory.v1.WatchPartsRequest
+	29, // 70: inventory.v1.InventoryService.ExportParts:input_type -> inventory.v1.ExportPartsRequest
+	31, // 71: inventory.v1.InventoryService.ImportParts:input_type -> inventory.v1.ImportPartsRequest
+	37, // 72: inventory.v1.InventoryService.ReserveParts:input_type -> inventory.v1.ReservePartsRequest
+	39, // 73: inventory.v1.InventoryService.ReleaseReservation:input_type -> inventory.v1.ReleaseReservationRequest
+	41, // 74: inventory.v1.InventoryService.CommitReservation:input_type -> inventory.v1.CommitReservationRequest
+	4,  // 75: inventory.v1.InventoryService.GetPart:output_type -> inventory.v1.GetPartResponse
+	10, // 76: inventory.v1.InventoryService.ListParts:output_type -> inventory.v1.ListPartsResponse
+	12, // 77: inventory.v1.InventoryService.BatchGetParts:output_type -> inventory.v1.BatchGetPartsResponse
+	14, // 78: inventory.v1.InventoryService.GetPartFacets:output_type -> inventory.v1.GetPartFacetsResponse
+	18, // 79: inventory.v1.InventoryService.SearchParts:output_type -> inventory.v1.SearchPartsResponse
+	45, // 80: inventory.v1.InventoryService.CreatePart:output_type -> inventory.v1.CreatePartResponse
+	47, // 81: inventory.v1.InventoryService.UpdatePart:output_type -> inventory.v1.UpdatePartResponse
+	49, // 82: inventory.v1.InventoryService.ArchivePart:output_type -> inventory.v1.ArchivePartResponse
+	51, // 83: inventory.v1.InventoryService.DeletePart:output_type -> inventory.v1.DeletePartResponse
+	28, // 84: inventory.v1.InventoryService.WatchParts:output_type -> inventory.v1.WatchPartsResponse
+	30, // 85: inventory.v1.InventoryService.ExportParts:output_type -> inventory.v1.ExportPartsResponse
+	33, // 86: inventory.v1.InventoryService.ImportParts:output_type -> inventory.v1.ImportPartsResponse
+	38, // 87: inventory.v1.InventoryService.ReserveParts:output_type -> inventory.v1.ReservePartsResponse
+	40, // 88: inventory.v1.InventoryService.ReleaseReservation:output_type -> inventory.v1.ReleaseReservationResponse
+	42, // 89: inventory.v1.InventoryService.CommitReservation:output_type -> inventory.v1.CommitReservationResponse
+	75, // [75:90] is the sub-list for method output_type
+	60, // [60:75] is the sub-list for method input_type
+	60, // [60:60] is the sub-list for extension type_name
+	60, // [60:60] is the sub-list for extension extendee
+	0,  // [0:60] is the sub-list for field type_name
+}
+
+func init() { file_inventory_v1_inventory_proto_init() }
+func file_inventory_v1_inventory_proto_init() {
+	if File_inventory_v1_inventory_proto != nil {
+		return
+	}
+	file_inventory_v1_inventory_proto_msgTypes[5].OneofWrappers = []any{
+		(*Value_StringValue)(nil),
+		(*Value_Int64Value)(nil),
 		(*Value_DoubleValue)(nil),
 		(*Value_BoolValue)(nil),
 	}
 	file_inventory_v1_inventory_proto_msgTypes[6].OneofWrappers = []any{}
+	file_inventory_v1_inventory_proto_msgTypes[10].OneofWrappers = []any{}
+	file_inventory_v1_inventory_proto_msgTypes[20].OneofWrappers = []any{}
+	file_inventory_v1_inventory_proto_msgTypes[21].OneofWrappers = []any{}
+	file_inventory_v1_inventory_proto_msgTypes[24].OneofWrappers = []any{}
+	file_inventory_v1_inventory_proto_msgTypes[28].OneofWrappers = []any{
+		(*ImportPartsRequest_Options)(nil),
+		(*ImportPartsRequest_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_inventory_v1_inventory_proto_rawDesc), len(file_inventory_v1_inventory_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      3,
+			NumMessages:   52,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -35,6 +35,9 @@ var (
 	_ = sort.Sort
 )
 
+// define the regex for a UUID once up-front
+var _inventory_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
 // Validate checks the field values on GetPartRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -461,6 +464,8 @@ func (m *Part) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Archived
+
 	if len(errors) > 0 {
 		return PartMultiError(errors)
 	}
@@ -560,13 +565,49 @@ func (m *Dimensions) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for Length
+	if m.GetLength() < 0 {
+		err := DimensionsValidationError{
+			field:  "Length",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Width
+	if m.GetWidth() < 0 {
+		err := DimensionsValidationError{
+			field:  "Width",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Height
+	if m.GetHeight() < 0 {
+		err := DimensionsValidationError{
+			field:  "Height",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Weight
+	if m.GetWeight() < 0 {
+		err := DimensionsValidationError{
+			field:  "Weight",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
 		return DimensionsMultiError(errors)
@@ -771,6 +812,7 @@ func (m *Value) validate(all bool) error {
 
 	var errors []error
 
+	oneofKindPresent := false
 	switch v := m.Kind.(type) {
 	case *Value_StringValue:
 		if v == nil {
@@ -783,6 +825,7 @@ func (m *Value) validate(all bool) error {
 			}
 			errors = append(errors, err)
 		}
+		oneofKindPresent = true
 		// no validation rules for StringValue
 	case *Value_Int64Value:
 		if v == nil {
@@ -795,6 +838,7 @@ func (m *Value) validate(all bool) error {
 			}
 			errors = append(errors, err)
 		}
+		oneofKindPresent = true
 		// no validation rules for Int64Value
 	case *Value_DoubleValue:
 		if v == nil {
@@ -807,6 +851,7 @@ func (m *Value) validate(all bool) error {
 			}
 			errors = append(errors, err)
 		}
+		oneofKindPresent = true
 		// no validation rules for DoubleValue
 	case *Value_BoolValue:
 		if v == nil {
@@ -819,10 +864,21 @@ func (m *Value) validate(all bool) error {
 			}
 			errors = append(errors, err)
 		}
+		oneofKindPresent = true
 		// no validation rules for BoolValue
 	default:
 		_ = v // ensures v is used
 	}
+	if !oneofKindPresent {
+		err := ValueValidationError{
+			field:  "Kind",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
 		return ValueMultiError(errors)
@@ -923,6 +979,30 @@ func (m *ListPartsRequest) validate(all bool) error {
 
 	var errors []error
 
+	if val := m.GetPageSize(); val < 0 || val > 1000 {
+		err := ListPartsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if !_ListPartsRequest_OrderBy_Pattern.MatchString(m.GetOrderBy()) {
+		err := ListPartsRequestValidationError{
+			field:  "OrderBy",
+			reason: "value does not match regex pattern \"^((price|name|created_at|stock_quantity)( (asc|desc))?)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if m.Filter != nil {
 
 		if all {
@@ -1034,6 +1114,8 @@ var _ interface {
 	ErrorName() string
 } = ListPartsRequestValidationError{}
 
+var _ListPartsRequest_OrderBy_Pattern = regexp.MustCompile("^((price|name|created_at|stock_quantity)( (asc|desc))?)?$")
+
 // Validate checks the field values on ListPartsResponse with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -1090,6 +1172,10 @@ func (m *ListPartsResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
+	// no validation rules for TotalSize
+
 	if len(errors) > 0 {
 		return ListPartsResponseMultiError(errors)
 	}
@@ -1170,125 +1256,226 @@ var _ interface {
 	ErrorName() string
 } = ListPartsResponseValidationError{}
 
-// Validate checks the field values on PartsFilter with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *PartsFilter) Validate() error {
+// Validate checks the field values on BatchGetPartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetPartsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PartsFilter with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in PartsFilterMultiError, or
-// nil if none found.
-func (m *PartsFilter) ValidateAll() error {
+// ValidateAll checks the field values on BatchGetPartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetPartsRequestMultiError, or nil if none found.
+func (m *BatchGetPartsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PartsFilter) validate(all bool) error {
+func (m *BatchGetPartsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	_PartsFilter_Uuids_Unique := make(map[string]struct{}, len(m.GetUuids()))
+	if l := len(m.GetUuids()); l < 1 || l > 1000 {
+		err := BatchGetPartsRequestValidationError{
+			field:  "Uuids",
+			reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	for idx, item := range m.GetUuids() {
 		_, _ = idx, item
 
-		if _, exists := _PartsFilter_Uuids_Unique[item]; exists {
-			err := PartsFilterValidationError{
+		if err := m._validateUuid(item); err != nil {
+			err = BatchGetPartsRequestValidationError{
 				field:  fmt.Sprintf("Uuids[%v]", idx),
-				reason: "repeated value must contain unique items",
+				reason: "value must be a valid UUID",
+				cause:  err,
 			}
 			if !all {
 				return err
 			}
 			errors = append(errors, err)
-		} else {
-			_PartsFilter_Uuids_Unique[item] = struct{}{}
 		}
 
-		// no validation rules for Uuids[idx]
 	}
 
-	_PartsFilter_Names_Unique := make(map[string]struct{}, len(m.GetNames()))
+	if len(errors) > 0 {
+		return BatchGetPartsRequestMultiError(errors)
+	}
 
-	for idx, item := range m.GetNames() {
-		_, _ = idx, item
+	return nil
+}
 
-		if _, exists := _PartsFilter_Names_Unique[item]; exists {
-			err := PartsFilterValidationError{
-				field:  fmt.Sprintf("Names[%v]", idx),
-				reason: "repeated value must contain unique items",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else {
-			_PartsFilter_Names_Unique[item] = struct{}{}
-		}
+func (m *BatchGetPartsRequest) _validateUuid(uuid string) error {
+	if matched := _inventory_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
 
-		// no validation rules for Names[idx]
+	return nil
+}
+
+// BatchGetPartsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchGetPartsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetPartsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetPartsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	_PartsFilter_ManufacturerCountries_Unique := make(map[string]struct{}, len(m.GetManufacturerCountries()))
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetPartsRequestMultiError) AllErrors() []error { return m }
 
-	for idx, item := range m.GetManufacturerCountries() {
-		_, _ = idx, item
+// BatchGetPartsRequestValidationError is the validation error returned by
+// BatchGetPartsRequest.Validate if the designated constraints aren't met.
+type BatchGetPartsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-		if _, exists := _PartsFilter_ManufacturerCountries_Unique[item]; exists {
-			err := PartsFilterValidationError{
-				field:  fmt.Sprintf("ManufacturerCountries[%v]", idx),
-				reason: "repeated value must contain unique items",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else {
-			_PartsFilter_ManufacturerCountries_Unique[item] = struct{}{}
-		}
+// Field function returns field value.
+func (e BatchGetPartsRequestValidationError) Field() string { return e.field }
 
-		// no validation rules for ManufacturerCountries[idx]
+// Reason function returns reason value.
+func (e BatchGetPartsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetPartsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetPartsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetPartsRequestValidationError) ErrorName() string {
+	return "BatchGetPartsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetPartsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	_PartsFilter_Tags_Unique := make(map[string]struct{}, len(m.GetTags()))
+	key := ""
+	if e.key {
+		key = "key for "
+	}
 
-	for idx, item := range m.GetTags() {
-		_, _ = idx, item
+	return fmt.Sprintf(
+		"invalid %sBatchGetPartsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
 
-		if _, exists := _PartsFilter_Tags_Unique[item]; exists {
-			err := PartsFilterValidationError{
-				field:  fmt.Sprintf("Tags[%v]", idx),
-				reason: "repeated value must contain unique items",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else {
-			_PartsFilter_Tags_Unique[item] = struct{}{}
+var _ error = BatchGetPartsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetPartsRequestValidationError{}
+
+// Validate checks the field values on BatchGetPartsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetPartsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetPartsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetPartsResponseMultiError, or nil if none found.
+func (m *BatchGetPartsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetPartsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	{
+		sorted_keys := make([]string, len(m.GetParts()))
+		i := 0
+		for key := range m.GetParts() {
+			sorted_keys[i] = key
+			i++
 		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetParts()[key]
+			_ = val
 
-		// no validation rules for Tags[idx]
+			// no validation rules for Parts[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, BatchGetPartsResponseValidationError{
+							field:  fmt.Sprintf("Parts[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, BatchGetPartsResponseValidationError{
+							field:  fmt.Sprintf("Parts[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return BatchGetPartsResponseValidationError{
+						field:  fmt.Sprintf("Parts[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
 	}
 
 	if len(errors) > 0 {
-		return PartsFilterMultiError(errors)
+		return BatchGetPartsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PartsFilterMultiError is an error wrapping multiple validation errors
-// returned by PartsFilter.ValidateAll() if the designated constraints aren't met.
-type PartsFilterMultiError []error
+// BatchGetPartsResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchGetPartsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetPartsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PartsFilterMultiError) Error() string {
+func (m BatchGetPartsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1297,11 +1484,11 @@ func (m PartsFilterMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PartsFilterMultiError) AllErrors() []error { return m }
+func (m BatchGetPartsResponseMultiError) AllErrors() []error { return m }
 
-// PartsFilterValidationError is the validation error returned by
-// PartsFilter.Validate if the designated constraints aren't met.
-type PartsFilterValidationError struct {
+// BatchGetPartsResponseValidationError is the validation error returned by
+// BatchGetPartsResponse.Validate if the designated constraints aren't met.
+type BatchGetPartsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1309,22 +1496,24 @@ type PartsFilterValidationError struct {
 }
 
 // Field function returns field value.
-func (e PartsFilterValidationError) Field() string { return e.field }
+func (e BatchGetPartsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PartsFilterValidationError) Reason() string { return e.reason }
+func (e BatchGetPartsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PartsFilterValidationError) Cause() error { return e.cause }
+func (e BatchGetPartsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PartsFilterValidationError) Key() bool { return e.key }
+func (e BatchGetPartsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PartsFilterValidationError) ErrorName() string { return "PartsFilterValidationError" }
+func (e BatchGetPartsResponseValidationError) ErrorName() string {
+	return "BatchGetPartsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e PartsFilterValidationError) Error() string {
+func (e BatchGetPartsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1336,14 +1525,14 @@ func (e PartsFilterValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPartsFilter.%s: %s%s",
+		"invalid %sBatchGetPartsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PartsFilterValidationError{}
+var _ error = BatchGetPartsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1351,4 +1540,5785 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PartsFilterValidationError{}
+} = BatchGetPartsResponseValidationError{}
+
+// Validate checks the field values on GetPartFacetsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPartFacetsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPartFacetsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPartFacetsRequestMultiError, or nil if none found.
+func (m *GetPartFacetsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPartFacetsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Filter != nil {
+
+		if all {
+			switch v := interface{}(m.GetFilter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetPartFacetsRequestValidationError{
+						field:  "Filter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetPartFacetsRequestValidationError{
+						field:  "Filter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPartFacetsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetPartFacetsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPartFacetsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPartFacetsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPartFacetsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPartFacetsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPartFacetsRequestMultiError) AllErrors() []error { return m }
+
+// GetPartFacetsRequestValidationError is the validation error returned by
+// GetPartFacetsRequest.Validate if the designated constraints aren't met.
+type GetPartFacetsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPartFacetsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPartFacetsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPartFacetsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPartFacetsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPartFacetsRequestValidationError) ErrorName() string {
+	return "GetPartFacetsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPartFacetsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPartFacetsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPartFacetsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPartFacetsRequestValidationError{}
+
+// Validate checks the field values on GetPartFacetsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPartFacetsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPartFacetsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPartFacetsResponseMultiError, or nil if none found.
+func (m *GetPartFacetsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPartFacetsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCategories() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetPartFacetsResponseValidationError{
+						field:  fmt.Sprintf("Categories[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetPartFacetsResponseValidationError{
+						field:  fmt.Sprintf("Categories[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPartFacetsResponseValidationError{
+					field:  fmt.Sprintf("Categories[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetManufacturerCountries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetPartFacetsResponseValidationError{
+						field:  fmt.Sprintf("ManufacturerCountries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetPartFacetsResponseValidationError{
+						field:  fmt.Sprintf("ManufacturerCountries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPartFacetsResponseValidationError{
+					field:  fmt.Sprintf("ManufacturerCountries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetPartFacetsResponseValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetPartFacetsResponseValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPartFacetsResponseValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetPartFacetsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPartFacetsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetPartFacetsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetPartFacetsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPartFacetsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPartFacetsResponseMultiError) AllErrors() []error { return m }
+
+// GetPartFacetsResponseValidationError is the validation error returned by
+// GetPartFacetsResponse.Validate if the designated constraints aren't met.
+type GetPartFacetsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPartFacetsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPartFacetsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPartFacetsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPartFacetsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPartFacetsResponseValidationError) ErrorName() string {
+	return "GetPartFacetsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPartFacetsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPartFacetsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPartFacetsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPartFacetsResponseValidationError{}
+
+// Validate checks the field values on CategoryFacet with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CategoryFacet) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CategoryFacet with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CategoryFacetMultiError, or
+// nil if none found.
+func (m *CategoryFacet) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CategoryFacet) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Category
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return CategoryFacetMultiError(errors)
+	}
+
+	return nil
+}
+
+// CategoryFacetMultiError is an error wrapping multiple validation errors
+// returned by CategoryFacet.ValidateAll() if the designated constraints
+// aren't met.
+type CategoryFacetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CategoryFacetMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CategoryFacetMultiError) AllErrors() []error { return m }
+
+// CategoryFacetValidationError is the validation error returned by
+// CategoryFacet.Validate if the designated constraints aren't met.
+type CategoryFacetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CategoryFacetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CategoryFacetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CategoryFacetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CategoryFacetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CategoryFacetValidationError) ErrorName() string { return "CategoryFacetValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CategoryFacetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCategoryFacet.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CategoryFacetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CategoryFacetValidationError{}
+
+// Validate checks the field values on FacetCount with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FacetCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FacetCount with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FacetCountMultiError, or
+// nil if none found.
+func (m *FacetCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FacetCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Value
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return FacetCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// FacetCountMultiError is an error wrapping multiple validation errors
+// returned by FacetCount.ValidateAll() if the designated constraints aren't met.
+type FacetCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FacetCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FacetCountMultiError) AllErrors() []error { return m }
+
+// FacetCountValidationError is the validation error returned by
+// FacetCount.Validate if the designated constraints aren't met.
+type FacetCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FacetCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FacetCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FacetCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FacetCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FacetCountValidationError) ErrorName() string { return "FacetCountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FacetCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFacetCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FacetCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FacetCountValidationError{}
+
+// Validate checks the field values on SearchPartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchPartsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchPartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchPartsRequestMultiError, or nil if none found.
+func (m *SearchPartsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchPartsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetQuery()); l < 1 || l > 256 {
+		err := SearchPartsRequestValidationError{
+			field:  "Query",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := SearchPartsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SearchPartsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchPartsRequestMultiError is an error wrapping multiple validation errors
+// returned by SearchPartsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SearchPartsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchPartsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchPartsRequestMultiError) AllErrors() []error { return m }
+
+// SearchPartsRequestValidationError is the validation error returned by
+// SearchPartsRequest.Validate if the designated constraints aren't met.
+type SearchPartsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchPartsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchPartsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchPartsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchPartsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchPartsRequestValidationError) ErrorName() string {
+	return "SearchPartsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchPartsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchPartsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchPartsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchPartsRequestValidationError{}
+
+// Validate checks the field values on SearchPartsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchPartsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchPartsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchPartsResponseMultiError, or nil if none found.
+func (m *SearchPartsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchPartsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchPartsResponseValidationError{
+						field:  fmt.Sprintf("Hits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchPartsResponseValidationError{
+						field:  fmt.Sprintf("Hits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchPartsResponseValidationError{
+					field:  fmt.Sprintf("Hits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchPartsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchPartsResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchPartsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchPartsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchPartsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchPartsResponseMultiError) AllErrors() []error { return m }
+
+// SearchPartsResponseValidationError is the validation error returned by
+// SearchPartsResponse.Validate if the designated constraints aren't met.
+type SearchPartsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchPartsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchPartsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchPartsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchPartsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchPartsResponseValidationError) ErrorName() string {
+	return "SearchPartsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchPartsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchPartsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchPartsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchPartsResponseValidationError{}
+
+// Validate checks the field values on SearchHit with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchHit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchHit with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchHitMultiError, or nil
+// if none found.
+func (m *SearchHit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchHit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchHitValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchHitValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchHitValidationError{
+				field:  "Part",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Score
+
+	for idx, item := range m.GetHighlights() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchHitValidationError{
+						field:  fmt.Sprintf("Highlights[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchHitValidationError{
+						field:  fmt.Sprintf("Highlights[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchHitValidationError{
+					field:  fmt.Sprintf("Highlights[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchHitMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchHitMultiError is an error wrapping multiple validation errors returned
+// by SearchHit.ValidateAll() if the designated constraints aren't met.
+type SearchHitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchHitMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchHitMultiError) AllErrors() []error { return m }
+
+// SearchHitValidationError is the validation error returned by
+// SearchHit.Validate if the designated constraints aren't met.
+type SearchHitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchHitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchHitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchHitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchHitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchHitValidationError) ErrorName() string { return "SearchHitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchHitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchHit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchHitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchHitValidationError{}
+
+// Validate checks the field values on Highlight with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Highlight) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Highlight with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HighlightMultiError, or nil
+// if none found.
+func (m *Highlight) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Highlight) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for Snippet
+
+	if len(errors) > 0 {
+		return HighlightMultiError(errors)
+	}
+
+	return nil
+}
+
+// HighlightMultiError is an error wrapping multiple validation errors returned
+// by Highlight.ValidateAll() if the designated constraints aren't met.
+type HighlightMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HighlightMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HighlightMultiError) AllErrors() []error { return m }
+
+// HighlightValidationError is the validation error returned by
+// Highlight.Validate if the designated constraints aren't met.
+type HighlightValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HighlightValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HighlightValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HighlightValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HighlightValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HighlightValidationError) ErrorName() string { return "HighlightValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HighlightValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHighlight.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HighlightValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HighlightValidationError{}
+
+// Validate checks the field values on PartsFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PartsFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PartsFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PartsFilterMultiError, or
+// nil if none found.
+func (m *PartsFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PartsFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	_PartsFilter_Uuids_Unique := make(map[string]struct{}, len(m.GetUuids()))
+
+	for idx, item := range m.GetUuids() {
+		_, _ = idx, item
+
+		if _, exists := _PartsFilter_Uuids_Unique[item]; exists {
+			err := PartsFilterValidationError{
+				field:  fmt.Sprintf("Uuids[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_PartsFilter_Uuids_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for Uuids[idx]
+	}
+
+	_PartsFilter_Names_Unique := make(map[string]struct{}, len(m.GetNames()))
+
+	for idx, item := range m.GetNames() {
+		_, _ = idx, item
+
+		if _, exists := _PartsFilter_Names_Unique[item]; exists {
+			err := PartsFilterValidationError{
+				field:  fmt.Sprintf("Names[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_PartsFilter_Names_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for Names[idx]
+	}
+
+	_PartsFilter_ManufacturerCountries_Unique := make(map[string]struct{}, len(m.GetManufacturerCountries()))
+
+	for idx, item := range m.GetManufacturerCountries() {
+		_, _ = idx, item
+
+		if _, exists := _PartsFilter_ManufacturerCountries_Unique[item]; exists {
+			err := PartsFilterValidationError{
+				field:  fmt.Sprintf("ManufacturerCountries[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_PartsFilter_ManufacturerCountries_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for ManufacturerCountries[idx]
+	}
+
+	_PartsFilter_Tags_Unique := make(map[string]struct{}, len(m.GetTags()))
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if _, exists := _PartsFilter_Tags_Unique[item]; exists {
+			err := PartsFilterValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_PartsFilter_Tags_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for Tags[idx]
+	}
+
+	// no validation rules for IncludeArchived
+
+	if all {
+		switch v := interface{}(m.GetPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PartsFilterValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PartsFilterValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PartsFilterValidationError{
+				field:  "Price",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStockQuantity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PartsFilterValidationError{
+					field:  "StockQuantity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PartsFilterValidationError{
+					field:  "StockQuantity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStockQuantity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PartsFilterValidationError{
+				field:  "StockQuantity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDimensions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PartsFilterValidationError{
+					field:  "Dimensions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PartsFilterValidationError{
+					field:  "Dimensions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDimensions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PartsFilterValidationError{
+				field:  "Dimensions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PartsFilterValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PartsFilterValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PartsFilterValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PartsFilterValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PartsFilterValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PartsFilterValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetMetadata() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PartsFilterValidationError{
+						field:  fmt.Sprintf("Metadata[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PartsFilterValidationError{
+						field:  fmt.Sprintf("Metadata[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PartsFilterValidationError{
+					field:  fmt.Sprintf("Metadata[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PartsFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// PartsFilterMultiError is an error wrapping multiple validation errors
+// returned by PartsFilter.ValidateAll() if the designated constraints aren't met.
+type PartsFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PartsFilterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PartsFilterMultiError) AllErrors() []error { return m }
+
+// PartsFilterValidationError is the validation error returned by
+// PartsFilter.Validate if the designated constraints aren't met.
+type PartsFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PartsFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PartsFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PartsFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PartsFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PartsFilterValidationError) ErrorName() string { return "PartsFilterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PartsFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPartsFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PartsFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PartsFilterValidationError{}
+
+// Validate checks the field values on MetadataCondition with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MetadataCondition) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetadataCondition with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetadataConditionMultiError, or nil if none found.
+func (m *MetadataCondition) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetadataCondition) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetKey()) < 1 {
+		err := MetadataConditionValidationError{
+			field:  "Key",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetadataConditionValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetadataConditionValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetadataConditionValidationError{
+				field:  "Value",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MetadataConditionMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetadataConditionMultiError is an error wrapping multiple validation errors
+// returned by MetadataCondition.ValidateAll() if the designated constraints
+// aren't met.
+type MetadataConditionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetadataConditionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetadataConditionMultiError) AllErrors() []error { return m }
+
+// MetadataConditionValidationError is the validation error returned by
+// MetadataCondition.Validate if the designated constraints aren't met.
+type MetadataConditionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetadataConditionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetadataConditionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetadataConditionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetadataConditionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetadataConditionValidationError) ErrorName() string {
+	return "MetadataConditionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetadataConditionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetadataCondition.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetadataConditionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetadataConditionValidationError{}
+
+// Validate checks the field values on DoubleRange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DoubleRange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DoubleRange with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DoubleRangeMultiError, or
+// nil if none found.
+func (m *DoubleRange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DoubleRange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Min != nil {
+		// no validation rules for Min
+	}
+
+	if m.Max != nil {
+		// no validation rules for Max
+	}
+
+	if len(errors) > 0 {
+		return DoubleRangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// DoubleRangeMultiError is an error wrapping multiple validation errors
+// returned by DoubleRange.ValidateAll() if the designated constraints aren't met.
+type DoubleRangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DoubleRangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DoubleRangeMultiError) AllErrors() []error { return m }
+
+// DoubleRangeValidationError is the validation error returned by
+// DoubleRange.Validate if the designated constraints aren't met.
+type DoubleRangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DoubleRangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DoubleRangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DoubleRangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DoubleRangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DoubleRangeValidationError) ErrorName() string { return "DoubleRangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DoubleRangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDoubleRange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DoubleRangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DoubleRangeValidationError{}
+
+// Validate checks the field values on Int64Range with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Int64Range) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Int64Range with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Int64RangeMultiError, or
+// nil if none found.
+func (m *Int64Range) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Int64Range) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Min != nil {
+		// no validation rules for Min
+	}
+
+	if m.Max != nil {
+		// no validation rules for Max
+	}
+
+	if len(errors) > 0 {
+		return Int64RangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// Int64RangeMultiError is an error wrapping multiple validation errors
+// returned by Int64Range.ValidateAll() if the designated constraints aren't met.
+type Int64RangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Int64RangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Int64RangeMultiError) AllErrors() []error { return m }
+
+// Int64RangeValidationError is the validation error returned by
+// Int64Range.Validate if the designated constraints aren't met.
+type Int64RangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Int64RangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Int64RangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Int64RangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Int64RangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Int64RangeValidationError) ErrorName() string { return "Int64RangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Int64RangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInt64Range.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Int64RangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Int64RangeValidationError{}
+
+// Validate checks the field values on TimestampRange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TimestampRange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimestampRange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TimestampRangeMultiError,
+// or nil if none found.
+func (m *TimestampRange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimestampRange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimestampRangeValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimestampRangeValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimestampRangeValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimestampRangeValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimestampRangeValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimestampRangeValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TimestampRangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// TimestampRangeMultiError is an error wrapping multiple validation errors
+// returned by TimestampRange.ValidateAll() if the designated constraints
+// aren't met.
+type TimestampRangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TimestampRangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TimestampRangeMultiError) AllErrors() []error { return m }
+
+// TimestampRangeValidationError is the validation error returned by
+// TimestampRange.Validate if the designated constraints aren't met.
+type TimestampRangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimestampRangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimestampRangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimestampRangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimestampRangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimestampRangeValidationError) ErrorName() string { return "TimestampRangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TimestampRangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimestampRange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimestampRangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimestampRangeValidationError{}
+
+// Validate checks the field values on DimensionsRange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DimensionsRange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DimensionsRange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DimensionsRangeMultiError, or nil if none found.
+func (m *DimensionsRange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DimensionsRange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLength()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DimensionsRangeValidationError{
+					field:  "Length",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DimensionsRangeValidationError{
+					field:  "Length",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLength()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DimensionsRangeValidationError{
+				field:  "Length",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWidth()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DimensionsRangeValidationError{
+					field:  "Width",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DimensionsRangeValidationError{
+					field:  "Width",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWidth()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DimensionsRangeValidationError{
+				field:  "Width",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetHeight()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DimensionsRangeValidationError{
+					field:  "Height",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DimensionsRangeValidationError{
+					field:  "Height",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHeight()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DimensionsRangeValidationError{
+				field:  "Height",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWeight()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DimensionsRangeValidationError{
+					field:  "Weight",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DimensionsRangeValidationError{
+					field:  "Weight",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWeight()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DimensionsRangeValidationError{
+				field:  "Weight",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DimensionsRangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// DimensionsRangeMultiError is an error wrapping multiple validation errors
+// returned by DimensionsRange.ValidateAll() if the designated constraints
+// aren't met.
+type DimensionsRangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DimensionsRangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DimensionsRangeMultiError) AllErrors() []error { return m }
+
+// DimensionsRangeValidationError is the validation error returned by
+// DimensionsRange.Validate if the designated constraints aren't met.
+type DimensionsRangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DimensionsRangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DimensionsRangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DimensionsRangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DimensionsRangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DimensionsRangeValidationError) ErrorName() string { return "DimensionsRangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DimensionsRangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDimensionsRange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DimensionsRangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DimensionsRangeValidationError{}
+
+// Validate checks the field values on WatchPartsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WatchPartsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchPartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchPartsRequestMultiError, or nil if none found.
+func (m *WatchPartsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchPartsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Filter != nil {
+
+		if all {
+			switch v := interface{}(m.GetFilter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WatchPartsRequestValidationError{
+						field:  "Filter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WatchPartsRequestValidationError{
+						field:  "Filter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WatchPartsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.AfterRevision != nil {
+
+		if m.GetAfterRevision() < 0 {
+			err := WatchPartsRequestValidationError{
+				field:  "AfterRevision",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WatchPartsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchPartsRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchPartsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WatchPartsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchPartsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchPartsRequestMultiError) AllErrors() []error { return m }
+
+// WatchPartsRequestValidationError is the validation error returned by
+// WatchPartsRequest.Validate if the designated constraints aren't met.
+type WatchPartsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchPartsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchPartsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchPartsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchPartsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchPartsRequestValidationError) ErrorName() string {
+	return "WatchPartsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchPartsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchPartsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchPartsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchPartsRequestValidationError{}
+
+// Validate checks the field values on WatchPartsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchPartsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchPartsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchPartsResponseMultiError, or nil if none found.
+func (m *WatchPartsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchPartsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Revision
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetPart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchPartsResponseValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchPartsResponseValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchPartsResponseValidationError{
+				field:  "Part",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetOccurredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchPartsResponseValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchPartsResponseValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchPartsResponseValidationError{
+				field:  "OccurredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WatchPartsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchPartsResponseMultiError is an error wrapping multiple validation errors
+// returned by WatchPartsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type WatchPartsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchPartsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchPartsResponseMultiError) AllErrors() []error { return m }
+
+// WatchPartsResponseValidationError is the validation error returned by
+// WatchPartsResponse.Validate if the designated constraints aren't met.
+type WatchPartsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchPartsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchPartsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchPartsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchPartsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchPartsResponseValidationError) ErrorName() string {
+	return "WatchPartsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchPartsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchPartsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchPartsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchPartsResponseValidationError{}
+
+// Validate checks the field values on ExportPartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportPartsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportPartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportPartsRequestMultiError, or nil if none found.
+func (m *ExportPartsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportPartsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ExportPartsRequest_Format_NotInLookup[m.GetFormat()]; ok {
+		err := ExportPartsRequestValidationError{
+			field:  "Format",
+			reason: "value must not be in list [CATALOG_FORMAT_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := CatalogFormat_name[int32(m.GetFormat())]; !ok {
+		err := ExportPartsRequestValidationError{
+			field:  "Format",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExportPartsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportPartsRequestMultiError is an error wrapping multiple validation errors
+// returned by ExportPartsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ExportPartsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportPartsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportPartsRequestMultiError) AllErrors() []error { return m }
+
+// ExportPartsRequestValidationError is the validation error returned by
+// ExportPartsRequest.Validate if the designated constraints aren't met.
+type ExportPartsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportPartsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportPartsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportPartsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportPartsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportPartsRequestValidationError) ErrorName() string {
+	return "ExportPartsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportPartsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportPartsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportPartsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportPartsRequestValidationError{}
+
+var _ExportPartsRequest_Format_NotInLookup = map[CatalogFormat]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ExportPartsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportPartsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportPartsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportPartsResponseMultiError, or nil if none found.
+func (m *ExportPartsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportPartsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Chunk
+
+	if len(errors) > 0 {
+		return ExportPartsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportPartsResponseMultiError is an error wrapping multiple validation
+// errors returned by ExportPartsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExportPartsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportPartsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportPartsResponseMultiError) AllErrors() []error { return m }
+
+// ExportPartsResponseValidationError is the validation error returned by
+// ExportPartsResponse.Validate if the designated constraints aren't met.
+type ExportPartsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportPartsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportPartsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportPartsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportPartsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportPartsResponseValidationError) ErrorName() string {
+	return "ExportPartsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportPartsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportPartsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportPartsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportPartsResponseValidationError{}
+
+// Validate checks the field values on ImportPartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportPartsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportPartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportPartsRequestMultiError, or nil if none found.
+func (m *ImportPartsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportPartsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofPayloadPresent := false
+	switch v := m.Payload.(type) {
+	case *ImportPartsRequest_Options:
+		if v == nil {
+			err := ImportPartsRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofPayloadPresent = true
+
+		if all {
+			switch v := interface{}(m.GetOptions()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportPartsRequestValidationError{
+						field:  "Options",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportPartsRequestValidationError{
+						field:  "Options",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportPartsRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ImportPartsRequest_Chunk:
+		if v == nil {
+			err := ImportPartsRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofPayloadPresent = true
+		// no validation rules for Chunk
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofPayloadPresent {
+		err := ImportPartsRequestValidationError{
+			field:  "Payload",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ImportPartsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportPartsRequestMultiError is an error wrapping multiple validation errors
+// returned by ImportPartsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ImportPartsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportPartsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportPartsRequestMultiError) AllErrors() []error { return m }
+
+// ImportPartsRequestValidationError is the validation error returned by
+// ImportPartsRequest.Validate if the designated constraints aren't met.
+type ImportPartsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportPartsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportPartsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportPartsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportPartsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportPartsRequestValidationError) ErrorName() string {
+	return "ImportPartsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportPartsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportPartsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportPartsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportPartsRequestValidationError{}
+
+// Validate checks the field values on ImportPartsOptions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportPartsOptions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportPartsOptions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportPartsOptionsMultiError, or nil if none found.
+func (m *ImportPartsOptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportPartsOptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ImportPartsOptions_Format_NotInLookup[m.GetFormat()]; ok {
+		err := ImportPartsOptionsValidationError{
+			field:  "Format",
+			reason: "value must not be in list [CATALOG_FORMAT_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := CatalogFormat_name[int32(m.GetFormat())]; !ok {
+		err := ImportPartsOptionsValidationError{
+			field:  "Format",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DryRun
+
+	// no validation rules for Prune
+
+	if len(errors) > 0 {
+		return ImportPartsOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportPartsOptionsMultiError is an error wrapping multiple validation errors
+// returned by ImportPartsOptions.ValidateAll() if the designated constraints
+// aren't met.
+type ImportPartsOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportPartsOptionsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportPartsOptionsMultiError) AllErrors() []error { return m }
+
+// ImportPartsOptionsValidationError is the validation error returned by
+// ImportPartsOptions.Validate if the designated constraints aren't met.
+type ImportPartsOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportPartsOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportPartsOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportPartsOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportPartsOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportPartsOptionsValidationError) ErrorName() string {
+	return "ImportPartsOptionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportPartsOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportPartsOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportPartsOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportPartsOptionsValidationError{}
+
+var _ImportPartsOptions_Format_NotInLookup = map[CatalogFormat]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ImportPartsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportPartsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportPartsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportPartsResponseMultiError, or nil if none found.
+func (m *ImportPartsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportPartsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAdded() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportPartsResponseValidationError{
+						field:  fmt.Sprintf("Added[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportPartsResponseValidationError{
+						field:  fmt.Sprintf("Added[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportPartsResponseValidationError{
+					field:  fmt.Sprintf("Added[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetChanged() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportPartsResponseValidationError{
+						field:  fmt.Sprintf("Changed[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportPartsResponseValidationError{
+						field:  fmt.Sprintf("Changed[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportPartsResponseValidationError{
+					field:  fmt.Sprintf("Changed[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetRemoved() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportPartsResponseValidationError{
+						field:  fmt.Sprintf("Removed[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportPartsResponseValidationError{
+						field:  fmt.Sprintf("Removed[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportPartsResponseValidationError{
+					field:  fmt.Sprintf("Removed[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Unchanged
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportPartsResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportPartsResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportPartsResponseValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetArchived() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportPartsResponseValidationError{
+						field:  fmt.Sprintf("Archived[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportPartsResponseValidationError{
+						field:  fmt.Sprintf("Archived[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportPartsResponseValidationError{
+					field:  fmt.Sprintf("Archived[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ImportPartsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportPartsResponseMultiError is an error wrapping multiple validation
+// errors returned by ImportPartsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ImportPartsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportPartsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportPartsResponseMultiError) AllErrors() []error { return m }
+
+// ImportPartsResponseValidationError is the validation error returned by
+// ImportPartsResponse.Validate if the designated constraints aren't met.
+type ImportPartsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportPartsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportPartsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportPartsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportPartsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportPartsResponseValidationError) ErrorName() string {
+	return "ImportPartsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportPartsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportPartsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportPartsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportPartsResponseValidationError{}
+
+// Validate checks the field values on ImportChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImportChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImportChangeMultiError, or
+// nil if none found.
+func (m *ImportChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Line
+
+	// no validation rules for Uuid
+
+	if len(errors) > 0 {
+		return ImportChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportChangeMultiError is an error wrapping multiple validation errors
+// returned by ImportChange.ValidateAll() if the designated constraints aren't met.
+type ImportChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportChangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportChangeMultiError) AllErrors() []error { return m }
+
+// ImportChangeValidationError is the validation error returned by
+// ImportChange.Validate if the designated constraints aren't met.
+type ImportChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportChangeValidationError) ErrorName() string { return "ImportChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportChangeValidationError{}
+
+// Validate checks the field values on ImportError with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImportError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportError with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImportErrorMultiError, or
+// nil if none found.
+func (m *ImportError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Line
+
+	// no validation rules for Uuid
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return ImportErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportErrorMultiError is an error wrapping multiple validation errors
+// returned by ImportError.ValidateAll() if the designated constraints aren't met.
+type ImportErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportErrorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportErrorMultiError) AllErrors() []error { return m }
+
+// ImportErrorValidationError is the validation error returned by
+// ImportError.Validate if the designated constraints aren't met.
+type ImportErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportErrorValidationError) ErrorName() string { return "ImportErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportErrorValidationError{}
+
+// Validate checks the field values on ReservationItem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReservationItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReservationItem with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReservationItemMultiError, or nil if none found.
+func (m *ReservationItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReservationItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPartUuid()); err != nil {
+		err = ReservationItemValidationError{
+			field:  "PartUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetQuantity() <= 0 {
+		err := ReservationItemValidationError{
+			field:  "Quantity",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReservationItemMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ReservationItem) _validateUuid(uuid string) error {
+	if matched := _inventory_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ReservationItemMultiError is an error wrapping multiple validation errors
+// returned by ReservationItem.ValidateAll() if the designated constraints
+// aren't met.
+type ReservationItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReservationItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReservationItemMultiError) AllErrors() []error { return m }
+
+// ReservationItemValidationError is the validation error returned by
+// ReservationItem.Validate if the designated constraints aren't met.
+type ReservationItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReservationItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReservationItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReservationItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReservationItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReservationItemValidationError) ErrorName() string { return "ReservationItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReservationItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReservationItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReservationItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReservationItemValidationError{}
+
+// Validate checks the field values on ReservePartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReservePartsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReservePartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReservePartsRequestMultiError, or nil if none found.
+func (m *ReservePartsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReservePartsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetItems()) < 1 {
+		err := ReservePartsRequestValidationError{
+			field:  "Items",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReservePartsRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReservePartsRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReservePartsRequestValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if d := m.GetTtl(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = ReservePartsRequestValidationError{
+				field:  "Ttl",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := ReservePartsRequestValidationError{
+					field:  "Ttl",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReservePartsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReservePartsRequestMultiError is an error wrapping multiple validation
+// errors returned by ReservePartsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReservePartsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReservePartsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReservePartsRequestMultiError) AllErrors() []error { return m }
+
+// ReservePartsRequestValidationError is the validation error returned by
+// ReservePartsRequest.Validate if the designated constraints aren't met.
+type ReservePartsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReservePartsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReservePartsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReservePartsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReservePartsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReservePartsRequestValidationError) ErrorName() string {
+	return "ReservePartsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReservePartsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReservePartsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReservePartsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReservePartsRequestValidationError{}
+
+// Validate checks the field values on ReservePartsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReservePartsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReservePartsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReservePartsResponseMultiError, or nil if none found.
+func (m *ReservePartsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReservePartsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReservationUuid
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReservePartsResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReservePartsResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReservePartsResponseValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReservePartsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReservePartsResponseMultiError is an error wrapping multiple validation
+// errors returned by ReservePartsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReservePartsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReservePartsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReservePartsResponseMultiError) AllErrors() []error { return m }
+
+// ReservePartsResponseValidationError is the validation error returned by
+// ReservePartsResponse.Validate if the designated constraints aren't met.
+type ReservePartsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReservePartsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReservePartsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReservePartsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReservePartsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReservePartsResponseValidationError) ErrorName() string {
+	return "ReservePartsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReservePartsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReservePartsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReservePartsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReservePartsResponseValidationError{}
+
+// Validate checks the field values on ReleaseReservationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseReservationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseReservationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReleaseReservationRequestMultiError, or nil if none found.
+func (m *ReleaseReservationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseReservationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetReservationUuid()); err != nil {
+		err = ReleaseReservationRequestValidationError{
+			field:  "ReservationUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReleaseReservationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ReleaseReservationRequest) _validateUuid(uuid string) error {
+	if matched := _inventory_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ReleaseReservationRequestMultiError is an error wrapping multiple validation
+// errors returned by ReleaseReservationRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ReleaseReservationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseReservationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseReservationRequestMultiError) AllErrors() []error { return m }
+
+// ReleaseReservationRequestValidationError is the validation error returned by
+// ReleaseReservationRequest.Validate if the designated constraints aren't met.
+type ReleaseReservationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseReservationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseReservationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseReservationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseReservationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseReservationRequestValidationError) ErrorName() string {
+	return "ReleaseReservationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseReservationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseReservationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseReservationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseReservationRequestValidationError{}
+
+// Validate checks the field values on ReleaseReservationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseReservationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseReservationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReleaseReservationResponseMultiError, or nil if none found.
+func (m *ReleaseReservationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseReservationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ReleaseReservationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReleaseReservationResponseMultiError is an error wrapping multiple
+// validation errors returned by ReleaseReservationResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ReleaseReservationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseReservationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseReservationResponseMultiError) AllErrors() []error { return m }
+
+// ReleaseReservationResponseValidationError is the validation error returned
+// by ReleaseReservationResponse.Validate if the designated constraints aren't met.
+type ReleaseReservationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseReservationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseReservationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseReservationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseReservationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseReservationResponseValidationError) ErrorName() string {
+	return "ReleaseReservationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseReservationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseReservationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseReservationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseReservationResponseValidationError{}
+
+// Validate checks the field values on CommitReservationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CommitReservationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommitReservationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommitReservationRequestMultiError, or nil if none found.
+func (m *CommitReservationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommitReservationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetReservationUuid()); err != nil {
+		err = CommitReservationRequestValidationError{
+			field:  "ReservationUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CommitReservationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CommitReservationRequest) _validateUuid(uuid string) error {
+	if matched := _inventory_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CommitReservationRequestMultiError is an error wrapping multiple validation
+// errors returned by CommitReservationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CommitReservationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommitReservationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommitReservationRequestMultiError) AllErrors() []error { return m }
+
+// CommitReservationRequestValidationError is the validation error returned by
+// CommitReservationRequest.Validate if the designated constraints aren't met.
+type CommitReservationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommitReservationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommitReservationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommitReservationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommitReservationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommitReservationRequestValidationError) ErrorName() string {
+	return "CommitReservationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CommitReservationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommitReservationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommitReservationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommitReservationRequestValidationError{}
+
+// Validate checks the field values on CommitReservationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CommitReservationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommitReservationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommitReservationResponseMultiError, or nil if none found.
+func (m *CommitReservationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommitReservationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CommitReservationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommitReservationResponseMultiError is an error wrapping multiple validation
+// errors returned by CommitReservationResponse.ValidateAll() if the
+// designated constraints aren't met.
+type CommitReservationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommitReservationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommitReservationResponseMultiError) AllErrors() []error { return m }
+
+// CommitReservationResponseValidationError is the validation error returned by
+// CommitReservationResponse.Validate if the designated constraints aren't met.
+type CommitReservationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommitReservationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommitReservationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommitReservationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommitReservationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommitReservationResponseValidationError) ErrorName() string {
+	return "CommitReservationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CommitReservationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommitReservationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommitReservationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommitReservationResponseValidationError{}
+
+// Validate checks the field values on PartInput with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PartInput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PartInput with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PartInputMultiError, or nil
+// if none found.
+func (m *PartInput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PartInput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 255 {
+		err := PartInputValidationError{
+			field:  "Name",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 4096 {
+		err := PartInputValidationError{
+			field:  "Description",
+			reason: "value length must be at most 4096 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPrice() < 0 {
+		err := PartInputValidationError{
+			field:  "Price",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStockQuantity() < 0 {
+		err := PartInputValidationError{
+			field:  "StockQuantity",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Category_name[int32(m.GetCategory())]; !ok {
+		err := PartInputValidationError{
+			field:  "Category",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDimensions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PartInputValidationError{
+					field:  "Dimensions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PartInputValidationError{
+					field:  "Dimensions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDimensions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PartInputValidationError{
+				field:  "Dimensions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetManufacturer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PartInputValidationError{
+					field:  "Manufacturer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PartInputValidationError{
+					field:  "Manufacturer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetManufacturer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PartInputValidationError{
+				field:  "Manufacturer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	_PartInput_Tags_Unique := make(map[string]struct{}, len(m.GetTags()))
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if _, exists := _PartInput_Tags_Unique[item]; exists {
+			err := PartInputValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_PartInput_Tags_Unique[item] = struct{}{}
+		}
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := PartInputValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetMetadata()))
+		i := 0
+		for key := range m.GetMetadata() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetMetadata()[key]
+			_ = val
+
+			if utf8.RuneCountInString(key) < 1 {
+				err := PartInputValidationError{
+					field:  fmt.Sprintf("Metadata[%v]", key),
+					reason: "value length must be at least 1 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if val == nil {
+				err := PartInputValidationError{
+					field:  fmt.Sprintf("Metadata[%v]", key),
+					reason: "value is required",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, PartInputValidationError{
+							field:  fmt.Sprintf("Metadata[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, PartInputValidationError{
+							field:  fmt.Sprintf("Metadata[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return PartInputValidationError{
+						field:  fmt.Sprintf("Metadata[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return PartInputMultiError(errors)
+	}
+
+	return nil
+}
+
+// PartInputMultiError is an error wrapping multiple validation errors returned
+// by PartInput.ValidateAll() if the designated constraints aren't met.
+type PartInputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PartInputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PartInputMultiError) AllErrors() []error { return m }
+
+// PartInputValidationError is the validation error returned by
+// PartInput.Validate if the designated constraints aren't met.
+type PartInputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PartInputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PartInputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PartInputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PartInputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PartInputValidationError) ErrorName() string { return "PartInputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PartInputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPartInput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PartInputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PartInputValidationError{}
+
+// Validate checks the field values on CreatePartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreatePartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePartRequestMultiError, or nil if none found.
+func (m *CreatePartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPart() == nil {
+		err := CreatePartRequestValidationError{
+			field:  "Part",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePartRequestValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePartRequestValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreatePartRequestValidationError{
+				field:  "Part",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreatePartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePartRequestMultiError is an error wrapping multiple validation errors
+// returned by CreatePartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreatePartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePartRequestMultiError) AllErrors() []error { return m }
+
+// CreatePartRequestValidationError is the validation error returned by
+// CreatePartRequest.Validate if the designated constraints aren't met.
+type CreatePartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePartRequestValidationError) ErrorName() string {
+	return "CreatePartRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePartRequestValidationError{}
+
+// Validate checks the field values on CreatePartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePartResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePartResponseMultiError, or nil if none found.
+func (m *CreatePartResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePartResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePartResponseValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePartResponseValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreatePartResponseValidationError{
+				field:  "Part",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreatePartResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePartResponseMultiError is an error wrapping multiple validation errors
+// returned by CreatePartResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreatePartResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePartResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePartResponseMultiError) AllErrors() []error { return m }
+
+// CreatePartResponseValidationError is the validation error returned by
+// CreatePartResponse.Validate if the designated constraints aren't met.
+type CreatePartResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePartResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePartResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePartResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePartResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePartResponseValidationError) ErrorName() string {
+	return "CreatePartResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePartResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePartResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePartResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePartResponseValidationError{}
+
+// Validate checks the field values on UpdatePartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePartRequestMultiError, or nil if none found.
+func (m *UpdatePartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = UpdatePartRequestValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPart() == nil {
+		err := UpdatePartRequestValidationError{
+			field:  "Part",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePartRequestValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePartRequestValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdatePartRequestValidationError{
+				field:  "Part",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetUpdateMask() == nil {
+		err := UpdatePartRequestValidationError{
+			field:  "UpdateMask",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePartRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePartRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdatePartRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdatePartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdatePartRequest) _validateUuid(uuid string) error {
+	if matched := _inventory_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdatePartRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdatePartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdatePartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePartRequestMultiError) AllErrors() []error { return m }
+
+// UpdatePartRequestValidationError is the validation error returned by
+// UpdatePartRequest.Validate if the designated constraints aren't met.
+type UpdatePartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePartRequestValidationError) ErrorName() string {
+	return "UpdatePartRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePartRequestValidationError{}
+
+// Validate checks the field values on UpdatePartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePartResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePartResponseMultiError, or nil if none found.
+func (m *UpdatePartResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePartResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePartResponseValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePartResponseValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdatePartResponseValidationError{
+				field:  "Part",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdatePartResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePartResponseMultiError is an error wrapping multiple validation errors
+// returned by UpdatePartResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UpdatePartResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePartResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePartResponseMultiError) AllErrors() []error { return m }
+
+// UpdatePartResponseValidationError is the validation error returned by
+// UpdatePartResponse.Validate if the designated constraints aren't met.
+type UpdatePartResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePartResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePartResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePartResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePartResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePartResponseValidationError) ErrorName() string {
+	return "UpdatePartResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePartResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePartResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePartResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePartResponseValidationError{}
+
+// Validate checks the field values on ArchivePartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ArchivePartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ArchivePartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ArchivePartRequestMultiError, or nil if none found.
+func (m *ArchivePartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ArchivePartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = ArchivePartRequestValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ArchivePartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ArchivePartRequest) _validateUuid(uuid string) error {
+	if matched := _inventory_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ArchivePartRequestMultiError is an error wrapping multiple validation errors
+// returned by ArchivePartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ArchivePartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ArchivePartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ArchivePartRequestMultiError) AllErrors() []error { return m }
+
+// ArchivePartRequestValidationError is the validation error returned by
+// ArchivePartRequest.Validate if the designated constraints aren't met.
+type ArchivePartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ArchivePartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ArchivePartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ArchivePartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ArchivePartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ArchivePartRequestValidationError) ErrorName() string {
+	return "ArchivePartRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ArchivePartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sArchivePartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ArchivePartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ArchivePartRequestValidationError{}
+
+// Validate checks the field values on ArchivePartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ArchivePartResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ArchivePartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ArchivePartResponseMultiError, or nil if none found.
+func (m *ArchivePartResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ArchivePartResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ArchivePartResponseValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ArchivePartResponseValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ArchivePartResponseValidationError{
+				field:  "Part",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ArchivePartResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ArchivePartResponseMultiError is an error wrapping multiple validation
+// errors returned by ArchivePartResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ArchivePartResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ArchivePartResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ArchivePartResponseMultiError) AllErrors() []error { return m }
+
+// ArchivePartResponseValidationError is the validation error returned by
+// ArchivePartResponse.Validate if the designated constraints aren't met.
+type ArchivePartResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ArchivePartResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ArchivePartResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ArchivePartResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ArchivePartResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ArchivePartResponseValidationError) ErrorName() string {
+	return "ArchivePartResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ArchivePartResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sArchivePartResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ArchivePartResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ArchivePartResponseValidationError{}
+
+// Validate checks the field values on DeletePartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeletePartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePartRequestMultiError, or nil if none found.
+func (m *DeletePartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = DeletePartRequestValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeletePartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeletePartRequest) _validateUuid(uuid string) error {
+	if matched := _inventory_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeletePartRequestMultiError is an error wrapping multiple validation errors
+// returned by DeletePartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeletePartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePartRequestMultiError) AllErrors() []error { return m }
+
+// DeletePartRequestValidationError is the validation error returned by
+// DeletePartRequest.Validate if the designated constraints aren't met.
+type DeletePartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePartRequestValidationError) ErrorName() string {
+	return "DeletePartRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePartRequestValidationError{}
+
+// Validate checks the field values on DeletePartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePartResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePartResponseMultiError, or nil if none found.
+func (m *DeletePartResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePartResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeletePartResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletePartResponseMultiError is an error wrapping multiple validation errors
+// returned by DeletePartResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeletePartResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePartResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePartResponseMultiError) AllErrors() []error { return m }
+
+// DeletePartResponseValidationError is the validation error returned by
+// DeletePartResponse.Validate if the designated constraints aren't met.
+type DeletePartResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePartResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePartResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePartResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePartResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePartResponseValidationError) ErrorName() string {
+	return "DeletePartResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePartResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePartResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePartResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePartResponseValidationError{}
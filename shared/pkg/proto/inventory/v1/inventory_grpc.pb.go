@@ -19,8 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	InventoryService_GetPart_FullMethodName   = "/inventory.v1.InventoryService/GetPart"
-	InventoryService_ListParts_FullMethodName = "/inventory.v1.InventoryService/ListParts"
+	InventoryService_GetPart_FullMethodName            = "/inventory.v1.InventoryService/GetPart"
+	InventoryService_ListParts_FullMethodName          = "/inventory.v1.InventoryService/ListParts"
+	InventoryService_BatchGetParts_FullMethodName      = "/inventory.v1.InventoryService/BatchGetParts"
+	InventoryService_GetPartFacets_FullMethodName      = "/inventory.v1.InventoryService/GetPartFacets"
+	InventoryService_SearchParts_FullMethodName        = "/inventory.v1.InventoryService/SearchParts"
+	InventoryService_CreatePart_FullMethodName         = "/inventory.v1.InventoryService/CreatePart"
+	InventoryService_UpdatePart_FullMethodName         = "/inventory.v1.InventoryService/UpdatePart"
+	InventoryService_ArchivePart_FullMethodName        = "/inventory.v1.InventoryService/ArchivePart"
+	InventoryService_DeletePart_FullMethodName         = "/inventory.v1.InventoryService/DeletePart"
+	InventoryService_WatchParts_FullMethodName         = "/inventory.v1.InventoryService/WatchParts"
+	InventoryService_ExportParts_FullMethodName        = "/inventory.v1.InventoryService/ExportParts"
+	InventoryService_ImportParts_FullMethodName        = "/inventory.v1.InventoryService/ImportParts"
+	InventoryService_ReserveParts_FullMethodName       = "/inventory.v1.InventoryService/ReserveParts"
+	InventoryService_ReleaseReservation_FullMethodName = "/inventory.v1.InventoryService/ReleaseReservation"
+	InventoryService_CommitReservation_FullMethodName  = "/inventory.v1.InventoryService/CommitReservation"
 )
 
 // InventoryServiceClient is the client API for InventoryService service.
@@ -29,6 +42,34 @@ const (
 type InventoryServiceClient interface {
 	GetPart(ctx context.Context, in *GetPartRequest, opts ...grpc.CallOption) (*GetPartResponse, error)
 	ListParts(ctx context.Context, in *ListPartsRequest, opts ...grpc.CallOption) (*ListPartsResponse, error)
+	// BatchGetParts возвращает детали по списку UUID (включая архивные) и UUID, которых нет в каталоге.
+	BatchGetParts(ctx context.Context, in *BatchGetPartsRequest, opts ...grpc.CallOption) (*BatchGetPartsResponse, error)
+	// GetPartFacets считает, сколько деталей вернёт каждая категория, страна производителя и тег.
+	GetPartFacets(ctx context.Context, in *GetPartFacetsRequest, opts ...grpc.CallOption) (*GetPartFacetsResponse, error)
+	// SearchParts ищет детали по словам в названии, описании, тегах и имени производителя.
+	SearchParts(ctx context.Context, in *SearchPartsRequest, opts ...grpc.CallOption) (*SearchPartsResponse, error)
+	// CreatePart добавляет деталь в каталог.
+	CreatePart(ctx context.Context, in *CreatePartRequest, opts ...grpc.CallOption) (*CreatePartResponse, error)
+	// UpdatePart изменяет поля детали, перечисленные в update_mask.
+	UpdatePart(ctx context.Context, in *UpdatePartRequest, opts ...grpc.CallOption) (*UpdatePartResponse, error)
+	// ArchivePart снимает деталь с продажи, не удаляя её из каталога.
+	ArchivePart(ctx context.Context, in *ArchivePartRequest, opts ...grpc.CallOption) (*ArchivePartResponse, error)
+	// DeletePart удаляет деталь из каталога.
+	DeletePart(ctx context.Context, in *DeletePartRequest, opts ...grpc.CallOption) (*DeletePartResponse, error)
+	// WatchParts передаёт изменения каталога. Клиент, переподключившийся с after_revision
+	// последнего полученного события, продолжает без пропусков.
+	WatchParts(ctx context.Context, in *WatchPartsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchPartsResponse], error)
+	// ExportParts выгружает весь каталог, включая архивные детали, частями файла.
+	ExportParts(ctx context.Context, in *ExportPartsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportPartsResponse], error)
+	// ImportParts загружает каталог из файла: первое сообщение несёт параметры импорта, следующие - части файла.
+	// Детали сопоставляются по UUID, ошибки отдельных строк возвращаются в отчёте и не прерывают импорт.
+	ImportParts(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportPartsRequest, ImportPartsResponse], error)
+	// ReserveParts резервирует детали на складе до истечения срока резерва.
+	ReserveParts(ctx context.Context, in *ReservePartsRequest, opts ...grpc.CallOption) (*ReservePartsResponse, error)
+	// ReleaseReservation снимает резерв и возвращает детали на склад.
+	ReleaseReservation(ctx context.Context, in *ReleaseReservationRequest, opts ...grpc.CallOption) (*ReleaseReservationResponse, error)
+	// CommitReservation подтверждает резерв: детали окончательно списываются со склада.
+	CommitReservation(ctx context.Context, in *CommitReservationRequest, opts ...grpc.CallOption) (*CommitReservationResponse, error)
 }
 
 type inventoryServiceClient struct {
@@ -59,12 +100,191 @@ func (c *inventoryServiceClient) ListParts(ctx context.Context, in *ListPartsReq
 	return out, nil
 }
 
+func (c *inventoryServiceClient) BatchGetParts(ctx context.Context, in *BatchGetPartsRequest, opts ...grpc.CallOption) (*BatchGetPartsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetPartsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_BatchGetParts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetPartFacets(ctx context.Context, in *GetPartFacetsRequest, opts ...grpc.CallOption) (*GetPartFacetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPartFacetsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetPartFacets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SearchParts(ctx context.Context, in *SearchPartsRequest, opts ...grpc.CallOption) (*SearchPartsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchPartsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SearchParts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CreatePart(ctx context.Context, in *CreatePartRequest, opts ...grpc.CallOption) (*CreatePartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePartResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CreatePart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) UpdatePart(ctx context.Context, in *UpdatePartRequest, opts ...grpc.CallOption) (*UpdatePartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePartResponse)
+	err := c.cc.Invoke(ctx, InventoryService_UpdatePart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ArchivePart(ctx context.Context, in *ArchivePartRequest, opts ...grpc.CallOption) (*ArchivePartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchivePartResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ArchivePart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) DeletePart(ctx context.Context, in *DeletePartRequest, opts ...grpc.CallOption) (*DeletePartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePartResponse)
+	err := c.cc.Invoke(ctx, InventoryService_DeletePart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) WatchParts(ctx context.Context, in *WatchPartsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchPartsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InventoryService_ServiceDesc.Streams[0], InventoryService_WatchParts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchPartsRequest, WatchPartsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_WatchPartsClient = grpc.ServerStreamingClient[WatchPartsResponse]
+
+func (c *inventoryServiceClient) ExportParts(ctx context.Context, in *ExportPartsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportPartsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InventoryService_ServiceDesc.Streams[1], InventoryService_ExportParts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportPartsRequest, ExportPartsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_ExportPartsClient = grpc.ServerStreamingClient[ExportPartsResponse]
+
+func (c *inventoryServiceClient) ImportParts(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportPartsRequest, ImportPartsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InventoryService_ServiceDesc.Streams[2], InventoryService_ImportParts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportPartsRequest, ImportPartsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_ImportPartsClient = grpc.ClientStreamingClient[ImportPartsRequest, ImportPartsResponse]
+
+func (c *inventoryServiceClient) ReserveParts(ctx context.Context, in *ReservePartsRequest, opts ...grpc.CallOption) (*ReservePartsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReservePartsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ReserveParts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ReleaseReservation(ctx context.Context, in *ReleaseReservationRequest, opts ...grpc.CallOption) (*ReleaseReservationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseReservationResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ReleaseReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CommitReservation(ctx context.Context, in *CommitReservationRequest, opts ...grpc.CallOption) (*CommitReservationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommitReservationResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CommitReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InventoryServiceServer is the server API for InventoryService service.
 // All implementations must embed UnimplementedInventoryServiceServer
 // for forward compatibility.
 type InventoryServiceServer interface {
 	GetPart(context.Context, *GetPartRequest) (*GetPartResponse, error)
 	ListParts(context.Context, *ListPartsRequest) (*ListPartsResponse, error)
+	// BatchGetParts возвращает детали по списку UUID (включая архивные) и UUID, которых нет в каталоге.
+	BatchGetParts(context.Context, *BatchGetPartsRequest) (*BatchGetPartsResponse, error)
+	// GetPartFacets считает, сколько деталей вернёт каждая категория, страна производителя и тег.
+	GetPartFacets(context.Context, *GetPartFacetsRequest) (*GetPartFacetsResponse, error)
+	// SearchParts ищет детали по словам в названии, описании, тегах и имени производителя.
+	SearchParts(context.Context, *SearchPartsRequest) (*SearchPartsResponse, error)
+	// CreatePart добавляет деталь в каталог.
+	CreatePart(context.Context, *CreatePartRequest) (*CreatePartResponse, error)
+	// UpdatePart изменяет поля детали, перечисленные в update_mask.
+	UpdatePart(context.Context, *UpdatePartRequest) (*UpdatePartResponse, error)
+	// ArchivePart снимает деталь с продажи, не удаляя её из каталога.
+	ArchivePart(context.Context, *ArchivePartRequest) (*ArchivePartResponse, error)
+	// DeletePart удаляет деталь из каталога.
+	DeletePart(context.Context, *DeletePartRequest) (*DeletePartResponse, error)
+	// WatchParts передаёт изменения каталога. Клиент, переподключившийся с after_revision
+	// последнего полученного события, продолжает без пропусков.
+	WatchParts(*WatchPartsRequest, grpc.ServerStreamingServer[WatchPartsResponse]) error
+	// ExportParts выгружает весь каталог, включая архивные детали, частями файла.
+	ExportParts(*ExportPartsRequest, grpc.ServerStreamingServer[ExportPartsResponse]) error
+	// ImportParts загружает каталог из файла: первое сообщение несёт параметры импорта, следующие - части файла.
+	// Детали сопоставляются по UUID, ошибки отдельных строк возвращаются в отчёте и не прерывают импорт.
+	ImportParts(grpc.ClientStreamingServer[ImportPartsRequest, ImportPartsResponse]) error
+	// ReserveParts резервирует детали на складе до истечения срока резерва.
+	ReserveParts(context.Context, *ReservePartsRequest) (*ReservePartsResponse, error)
+	// ReleaseReservation снимает резерв и возвращает детали на склад.
+	ReleaseReservation(context.Context, *ReleaseReservationRequest) (*ReleaseReservationResponse, error)
+	// CommitReservation подтверждает резерв: детали окончательно списываются со склада.
+	CommitReservation(context.Context, *CommitReservationRequest) (*CommitReservationResponse, error)
 	mustEmbedUnimplementedInventoryServiceServer()
 }
 
@@ -81,6 +301,45 @@ func (UnimplementedInventoryServiceServer) GetPart(context.Context, *GetPartRequ
 func (UnimplementedInventoryServiceServer) ListParts(context.Context, *ListPartsRequest) (*ListPartsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListParts not implemented")
 }
+func (UnimplementedInventoryServiceServer) BatchGetParts(context.Context, *BatchGetPartsRequest) (*BatchGetPartsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetParts not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetPartFacets(context.Context, *GetPartFacetsRequest) (*GetPartFacetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPartFacets not implemented")
+}
+func (UnimplementedInventoryServiceServer) SearchParts(context.Context, *SearchPartsRequest) (*SearchPartsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchParts not implemented")
+}
+func (UnimplementedInventoryServiceServer) CreatePart(context.Context, *CreatePartRequest) (*CreatePartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePart not implemented")
+}
+func (UnimplementedInventoryServiceServer) UpdatePart(context.Context, *UpdatePartRequest) (*UpdatePartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePart not implemented")
+}
+func (UnimplementedInventoryServiceServer) ArchivePart(context.Context, *ArchivePartRequest) (*ArchivePartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchivePart not implemented")
+}
+func (UnimplementedInventoryServiceServer) DeletePart(context.Context, *DeletePartRequest) (*DeletePartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePart not implemented")
+}
+func (UnimplementedInventoryServiceServer) WatchParts(*WatchPartsRequest, grpc.ServerStreamingServer[WatchPartsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchParts not implemented")
+}
+func (UnimplementedInventoryServiceServer) ExportParts(*ExportPartsRequest, grpc.ServerStreamingServer[ExportPartsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportParts not implemented")
+}
+func (UnimplementedInventoryServiceServer) ImportParts(grpc.ClientStreamingServer[ImportPartsRequest, ImportPartsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportParts not implemented")
+}
+func (UnimplementedInventoryServiceServer) ReserveParts(context.Context, *ReservePartsRequest) (*ReservePartsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveParts not implemented")
+}
+func (UnimplementedInventoryServiceServer) ReleaseReservation(context.Context, *ReleaseReservationRequest) (*ReleaseReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseReservation not implemented")
+}
+func (UnimplementedInventoryServiceServer) CommitReservation(context.Context, *CommitReservationRequest) (*CommitReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitReservation not implemented")
+}
 func (UnimplementedInventoryServiceServer) mustEmbedUnimplementedInventoryServiceServer() {}
 func (UnimplementedInventoryServiceServer) testEmbeddedByValue()                          {}
 
@@ -138,6 +397,215 @@ func _InventoryService_ListParts_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_BatchGetParts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetPartsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).BatchGetParts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_BatchGetParts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).BatchGetParts(ctx, req.(*BatchGetPartsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetPartFacets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPartFacetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetPartFacets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetPartFacets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetPartFacets(ctx, req.(*GetPartFacetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SearchParts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPartsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SearchParts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SearchParts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SearchParts(ctx, req.(*SearchPartsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CreatePart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CreatePart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CreatePart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CreatePart(ctx, req.(*CreatePartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_UpdatePart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).UpdatePart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_UpdatePart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).UpdatePart(ctx, req.(*UpdatePartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ArchivePart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchivePartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ArchivePart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ArchivePart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ArchivePart(ctx, req.(*ArchivePartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_DeletePart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).DeletePart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_DeletePart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).DeletePart(ctx, req.(*DeletePartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_WatchParts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPartsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InventoryServiceServer).WatchParts(m, &grpc.GenericServerStream[WatchPartsRequest, WatchPartsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_WatchPartsServer = grpc.ServerStreamingServer[WatchPartsResponse]
+
+func _InventoryService_ExportParts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportPartsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InventoryServiceServer).ExportParts(m, &grpc.GenericServerStream[ExportPartsRequest, ExportPartsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_ExportPartsServer = grpc.ServerStreamingServer[ExportPartsResponse]
+
+func _InventoryService_ImportParts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(InventoryServiceServer).ImportParts(&grpc.GenericServerStream[ImportPartsRequest, ImportPartsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_ImportPartsServer = grpc.ClientStreamingServer[ImportPartsRequest, ImportPartsResponse]
+
+func _InventoryService_ReserveParts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReservePartsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReserveParts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReserveParts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReserveParts(ctx, req.(*ReservePartsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReleaseReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReleaseReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReleaseReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReleaseReservation(ctx, req.(*ReleaseReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CommitReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CommitReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CommitReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CommitReservation(ctx, req.(*CommitReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InventoryService_ServiceDesc is the grpc.ServiceDesc for InventoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,7 +621,63 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListParts",
 			Handler:    _InventoryService_ListParts_Handler,
 		},
+		{
+			MethodName: "BatchGetParts",
+			Handler:    _InventoryService_BatchGetParts_Handler,
+		},
+		{
+			MethodName: "GetPartFacets",
+			Handler:    _InventoryService_GetPartFacets_Handler,
+		},
+		{
+			MethodName: "SearchParts",
+			Handler:    _InventoryService_SearchParts_Handler,
+		},
+		{
+			MethodName: "CreatePart",
+			Handler:    _InventoryService_CreatePart_Handler,
+		},
+		{
+			MethodName: "UpdatePart",
+			Handler:    _InventoryService_UpdatePart_Handler,
+		},
+		{
+			MethodName: "ArchivePart",
+			Handler:    _InventoryService_ArchivePart_Handler,
+		},
+		{
+			MethodName: "DeletePart",
+			Handler:    _InventoryService_DeletePart_Handler,
+		},
+		{
+			MethodName: "ReserveParts",
+			Handler:    _InventoryService_ReserveParts_Handler,
+		},
+		{
+			MethodName: "ReleaseReservation",
+			Handler:    _InventoryService_ReleaseReservation_Handler,
+		},
+		{
+			MethodName: "CommitReservation",
+			Handler:    _InventoryService_CommitReservation_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchParts",
+			Handler:       _InventoryService_WatchParts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportParts",
+			Handler:       _InventoryService_ExportParts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportParts",
+			Handler:       _InventoryService_ImportParts_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "inventory/v1/inventory.proto",
 }
@@ -9,6 +9,7 @@ package payment_v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,6 +22,130 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// TransactionKind виды транзакций журнала
+type TransactionKind int32
+
+const (
+	TransactionKind_TRANSACTION_KIND_UNSPECIFIED TransactionKind = 0 // Неизвестный вид
+	TransactionKind_TRANSACTION_KIND_PAYMENT     TransactionKind = 1 // Оплата или холд
+	TransactionKind_TRANSACTION_KIND_REFUND      TransactionKind = 2 // Возврат по оплате
+)
+
+// Enum value maps for TransactionKind.
+var (
+	TransactionKind_name = map[int32]string{
+		0: "TRANSACTION_KIND_UNSPECIFIED",
+		1: "TRANSACTION_KIND_PAYMENT",
+		2: "TRANSACTION_KIND_REFUND",
+	}
+	TransactionKind_value = map[string]int32{
+		"TRANSACTION_KIND_UNSPECIFIED": 0,
+		"TRANSACTION_KIND_PAYMENT":     1,
+		"TRANSACTION_KIND_REFUND":      2,
+	}
+)
+
+func (x TransactionKind) Enum() *TransactionKind {
+	p := new(TransactionKind)
+	*p = x
+	return p
+}
+
+func (x TransactionKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[0].Descriptor()
+}
+
+func (TransactionKind) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[0]
+}
+
+func (x TransactionKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionKind.Descriptor instead.
+func (TransactionKind) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{0}
+}
+
+// TransactionStatus статусы транзакции оплаты
+type TransactionStatus int32
+
+const (
+	TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED        TransactionStatus = 0  // Неизвестный статус
+	TransactionStatus_TRANSACTION_STATUS_SUCCEEDED          TransactionStatus = 1  // Оплата прошла
+	TransactionStatus_TRANSACTION_STATUS_PARTIALLY_REFUNDED TransactionStatus = 2  // Часть суммы возвращена
+	TransactionStatus_TRANSACTION_STATUS_REFUNDED           TransactionStatus = 3  // Сумма возвращена полностью
+	TransactionStatus_TRANSACTION_STATUS_PENDING            TransactionStatus = 4  // Списание отправлено провайдеру
+	TransactionStatus_TRANSACTION_STATUS_FAILED             TransactionStatus = 5  // Провайдер не провёл списание
+	TransactionStatus_TRANSACTION_STATUS_AUTHORIZED         TransactionStatus = 6  // Сумма холдирована и ждёт списания
+	TransactionStatus_TRANSACTION_STATUS_VOIDED             TransactionStatus = 7  // Холд снят без списания
+	TransactionStatus_TRANSACTION_STATUS_EXPIRED            TransactionStatus = 8  // Холд истёк без списания
+	TransactionStatus_TRANSACTION_STATUS_CAPTURING          TransactionStatus = 9  // Списание холда отправлено провайдеру
+	TransactionStatus_TRANSACTION_STATUS_VOIDING            TransactionStatus = 10 // Снятие холда отправлено провайдеру
+)
+
+// Enum value maps for TransactionStatus.
+var (
+	TransactionStatus_name = map[int32]string{
+		0:  "TRANSACTION_STATUS_UNSPECIFIED",
+		1:  "TRANSACTION_STATUS_SUCCEEDED",
+		2:  "TRANSACTION_STATUS_PARTIALLY_REFUNDED",
+		3:  "TRANSACTION_STATUS_REFUNDED",
+		4:  "TRANSACTION_STATUS_PENDING",
+		5:  "TRANSACTION_STATUS_FAILED",
+		6:  "TRANSACTION_STATUS_AUTHORIZED",
+		7:  "TRANSACTION_STATUS_VOIDED",
+		8:  "TRANSACTION_STATUS_EXPIRED",
+		9:  "TRANSACTION_STATUS_CAPTURING",
+		10: "TRANSACTION_STATUS_VOIDING",
+	}
+	TransactionStatus_value = map[string]int32{
+		"TRANSACTION_STATUS_UNSPECIFIED":        0,
+		"TRANSACTION_STATUS_SUCCEEDED":          1,
+		"TRANSACTION_STATUS_PARTIALLY_REFUNDED": 2,
+		"TRANSACTION_STATUS_REFUNDED":           3,
+		"TRANSACTION_STATUS_PENDING":            4,
+		"TRANSACTION_STATUS_FAILED":             5,
+		"TRANSACTION_STATUS_AUTHORIZED":         6,
+		"TRANSACTION_STATUS_VOIDED":             7,
+		"TRANSACTION_STATUS_EXPIRED":            8,
+		"TRANSACTION_STATUS_CAPTURING":          9,
+		"TRANSACTION_STATUS_VOIDING":            10,
+	}
+)
+
+func (x TransactionStatus) Enum() *TransactionStatus {
+	p := new(TransactionStatus)
+	*p = x
+	return p
+}
+
+func (x TransactionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[1].Descriptor()
+}
+
+func (TransactionStatus) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[1]
+}
+
+func (x TransactionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionStatus.Descriptor instead.
+func (TransactionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{1}
+}
+
 // PaymentMethod способы оплаты
 type PaymentMethod int32
 
@@ -61,11 +186,11 @@ func (x PaymentMethod) String() string {
 }
 
 func (PaymentMethod) Descriptor() protoreflect.EnumDescriptor {
-	return file_payment_v1_payment_proto_enumTypes[0].Descriptor()
+	return file_payment_v1_payment_proto_enumTypes[2].Descriptor()
 }
 
 func (PaymentMethod) Type() protoreflect.EnumType {
-	return &file_payment_v1_payment_proto_enumTypes[0]
+	return &file_payment_v1_payment_proto_enumTypes[2]
 }
 
 func (x PaymentMethod) Number() protoreflect.EnumNumber {
@@ -74,17 +199,20 @@ func (x PaymentMethod) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PaymentMethod.Descriptor instead.
 func (PaymentMethod) EnumDescriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{0}
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{2}
 }
 
 // PayOrderRequest представляет запрос на оплату
 type PayOrderRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderUuid     string                 `protobuf:"bytes,1,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`                                            // UUID заказа
-	UserUuid      string                 `protobuf:"bytes,2,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`                                               //	UUID пользователя, который инициирует оплату
-	PaymentMethod PaymentMethod          `protobuf:"varint,3,opt,name=payment_method,json=paymentMethod,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"` // Выбранный способ оплаты
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderUuid      string                 `protobuf:"bytes,1,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`                                            // UUID заказа
+	UserUuid       string                 `protobuf:"bytes,2,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`                                               //	UUID пользователя, который инициирует оплату
+	PaymentMethod  PaymentMethod          `protobuf:"varint,3,opt,name=payment_method,json=paymentMethod,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"` // Выбранный способ оплаты
+	Amount         int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`                                                                  // Сумма в минимальных единицах валюты (копейки, центы)
+	Currency       string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`                                                               // Код валюты ISO 4217, например RUB
+	IdempotencyKey string                 `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`                             // Ключ идемпотентности; пустой — повторы определяются по order_uuid
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *PayOrderRequest) Reset() {
@@ -138,10 +266,33 @@ func (x *PayOrderRequest) GetPaymentMethod() PaymentMethod {
 	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
 }
 
-// PayOrderResponse представляет отавет на запрос на оплату
+func (x *PayOrderRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PayOrderRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PayOrderRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+// PayOrderResponse представляет ответ на запрос на оплату
 type PayOrderResponse struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"` // UUID транзакции оплаты
+	Amount          int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`                                         // Списанная сумма в минимальных единицах валюты
+	Currency        string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`                                      // Код валюты ISO 4217
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -183,27 +334,1073 @@ func (x *PayOrderResponse) GetTransactionUuid() string {
 	return ""
 }
 
-var File_payment_v1_payment_proto protoreflect.FileDescriptor
+func (x *PayOrderResponse) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
 
-const file_payment_v1_payment_proto_rawDesc = "" +
-	"\n" +
-	"\x18payment/v1/payment.proto\x12\n" +
-	"payment.v1\"\x8f\x01\n" +
-	"\x0fPayOrderRequest\x12\x1d\n" +
-	"\n" +
-	"order_uuid\x18\x01 \x01(\tR\torderUuid\x12\x1b\n" +
-	"\tuser_uuid\x18\x02 \x01(\tR\buserUuid\x12@\n" +
-	"\x0epayment_method\x18\x03 \x01(\x0e2\x19.payment.v1.PaymentMethodR\rpaymentMethod\"=\n" +
-	"\x10PayOrderResponse\x12)\n" +
-	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid*\xa3\x01\n" +
+func (x *PayOrderResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// RefundPaymentRequest представляет запрос на возврат оплаты
+type RefundPaymentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"` // UUID транзакции оплаты, которую нужно вернуть
+	OrderUuid       string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`                   // UUID заказа
+	Amount          int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`                                         // Сумма возврата в минимальных единицах валюты; 0 — полный возврат
+	Reason          string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                                          // Причина возврата
+	Currency        string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`                                      // Код валюты ISO 4217, должен совпадать с валютой оплаты; при полном возврате можно не указывать
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RefundPaymentRequest) Reset() {
+	*x = RefundPaymentRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundPaymentRequest) ProtoMessage() {}
+
+func (x *RefundPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundPaymentRequest.ProtoReflect.Descriptor instead.
+func (*RefundPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RefundPaymentRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *RefundPaymentRequest) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *RefundPaymentRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RefundPaymentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RefundPaymentRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// RefundPaymentResponse представляет ответ на запрос на возврат оплаты
+type RefundPaymentResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	RefundTransactionUuid string                 `protobuf:"bytes,1,opt,name=refund_transaction_uuid,json=refundTransactionUuid,proto3" json:"refund_transaction_uuid,omitempty"` // UUID транзакции возврата в журнале
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *RefundPaymentResponse) Reset() {
+	*x = RefundPaymentResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundPaymentResponse) ProtoMessage() {}
+
+func (x *RefundPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundPaymentResponse.ProtoReflect.Descriptor instead.
+func (*RefundPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RefundPaymentResponse) GetRefundTransactionUuid() string {
+	if x != nil {
+		return x.RefundTransactionUuid
+	}
+	return ""
+}
+
+// AuthorizePaymentRequest представляет запрос на холдирование суммы
+type AuthorizePaymentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderUuid      string                 `protobuf:"bytes,1,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`                                            // UUID заказа
+	UserUuid       string                 `protobuf:"bytes,2,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`                                               // UUID пользователя, который инициирует оплату
+	PaymentMethod  PaymentMethod          `protobuf:"varint,3,opt,name=payment_method,json=paymentMethod,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"` // Выбранный способ оплаты
+	Amount         int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`                                                                  // Сумма в минимальных единицах валюты (копейки, центы)
+	Currency       string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`                                                               // Код валюты ISO 4217, например RUB
+	IdempotencyKey string                 `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`                             // Ключ идемпотентности; пустой — повторы определяются по order_uuid
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AuthorizePaymentRequest) Reset() {
+	*x = AuthorizePaymentRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizePaymentRequest) ProtoMessage() {}
+
+func (x *AuthorizePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizePaymentRequest.ProtoReflect.Descriptor instead.
+func (*AuthorizePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AuthorizePaymentRequest) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *AuthorizePaymentRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *AuthorizePaymentRequest) GetPaymentMethod() PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+}
+
+func (x *AuthorizePaymentRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AuthorizePaymentRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *AuthorizePaymentRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+// AuthorizePaymentResponse представляет ответ на запрос на холдирование суммы
+type AuthorizePaymentResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"` // UUID транзакции с холдом
+	Amount          int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`                                         // Холдированная сумма в минимальных единицах валюты
+	Currency        string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`                                      // Код валюты ISO 4217
+	ExpiresAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                   // Холд нужно списать до этого времени
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AuthorizePaymentResponse) Reset() {
+	*x = AuthorizePaymentResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizePaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizePaymentResponse) ProtoMessage() {}
+
+func (x *AuthorizePaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizePaymentResponse.ProtoReflect.Descriptor instead.
+func (*AuthorizePaymentResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AuthorizePaymentResponse) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *AuthorizePaymentResponse) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AuthorizePaymentResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *AuthorizePaymentResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// CapturePaymentRequest представляет запрос на списание холда
+type CapturePaymentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"` // UUID транзакции с холдом
+	OrderUuid       string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`                   // UUID заказа
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CapturePaymentRequest) Reset() {
+	*x = CapturePaymentRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CapturePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapturePaymentRequest) ProtoMessage() {}
+
+func (x *CapturePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapturePaymentRequest.ProtoReflect.Descriptor instead.
+func (*CapturePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CapturePaymentRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *CapturePaymentRequest) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+// CapturePaymentResponse представляет ответ на запрос на списание холда
+type CapturePaymentResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"` // UUID транзакции, теперь в статусе SUCCEEDED
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CapturePaymentResponse) Reset() {
+	*x = CapturePaymentResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CapturePaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapturePaymentResponse) ProtoMessage() {}
+
+func (x *CapturePaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapturePaymentResponse.ProtoReflect.Descriptor instead.
+func (*CapturePaymentResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CapturePaymentResponse) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+// VoidAuthorizationRequest представляет запрос на снятие холда
+type VoidAuthorizationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"` // UUID транзакции с холдом
+	OrderUuid       string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`                   // UUID заказа
+	Reason          string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                                          // Причина снятия
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *VoidAuthorizationRequest) Reset() {
+	*x = VoidAuthorizationRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidAuthorizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidAuthorizationRequest) ProtoMessage() {}
+
+func (x *VoidAuthorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidAuthorizationRequest.ProtoReflect.Descriptor instead.
+func (*VoidAuthorizationRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *VoidAuthorizationRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *VoidAuthorizationRequest) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *VoidAuthorizationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// VoidAuthorizationResponse представляет ответ на запрос на снятие холда
+type VoidAuthorizationResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"` // UUID транзакции
+	Status          TransactionStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=payment.v1.TransactionStatus" json:"status,omitempty"`       // VOIDED или EXPIRED, если холд уже истёк
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *VoidAuthorizationResponse) Reset() {
+	*x = VoidAuthorizationResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidAuthorizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidAuthorizationResponse) ProtoMessage() {}
+
+func (x *VoidAuthorizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidAuthorizationResponse.ProtoReflect.Descriptor instead.
+func (*VoidAuthorizationResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *VoidAuthorizationResponse) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *VoidAuthorizationResponse) GetStatus() TransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED
+}
+
+// GetTransactionRequest представляет запрос на получение транзакции
+type GetTransactionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"` // UUID транзакции оплаты
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetTransactionRequest) Reset() {
+	*x = GetTransactionRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionRequest) ProtoMessage() {}
+
+func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetTransactionRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+// GetTransactionResponse представляет ответ на получение транзакции
+type GetTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionResponse) Reset() {
+	*x = GetTransactionResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionResponse) ProtoMessage() {}
+
+func (x *GetTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetTransactionResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+// ListTransactionsRequest представляет запрос на получение списка транзакций
+type ListTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *TransactionsFilter    `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`                        // Фильтр (все поля опциональны)
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Размер страницы (0 — все транзакции)
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Токен страницы из next_page_token предыдущего ответа
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionsRequest) Reset() {
+	*x = ListTransactionsRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsRequest) ProtoMessage() {}
+
+func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListTransactionsRequest) GetFilter() *TransactionsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListTransactionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTransactionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListTransactionsResponse представляет ответ на получение списка транзакций
+type ListTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`                          // Транзакции, от новых к старым
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Токен следующей страницы (пустой на последней странице)
+	TotalSize     int32                  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`              // Общее количество транзакций по фильтру
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionsResponse) Reset() {
+	*x = ListTransactionsResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsResponse) ProtoMessage() {}
+
+func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListTransactionsResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *ListTransactionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListTransactionsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+// TransactionsFilter - фильтр транзакций; пустые поля не ограничивают выборку
+type TransactionsFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUuid      string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`                           // UUID пользователя
+	OrderUuid     string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`                        // UUID заказа
+	Statuses      []TransactionStatus    `protobuf:"varint,3,rep,packed,name=statuses,proto3,enum=payment.v1.TransactionStatus" json:"statuses,omitempty"` // Подходит любой из статусов
+	CreatedFrom   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`                  // Создана не раньше (включительно)
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`                        // Создана не позже (включительно)
+	Kinds         []TransactionKind      `protobuf:"varint,6,rep,packed,name=kinds,proto3,enum=payment.v1.TransactionKind" json:"kinds,omitempty"`         // Подходит любой из видов
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionsFilter) Reset() {
+	*x = TransactionsFilter{}
+	mi := &file_payment_v1_payment_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionsFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionsFilter) ProtoMessage() {}
+
+func (x *TransactionsFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionsFilter.ProtoReflect.Descriptor instead.
+func (*TransactionsFilter) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TransactionsFilter) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *TransactionsFilter) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *TransactionsFilter) GetStatuses() []TransactionStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *TransactionsFilter) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *TransactionsFilter) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *TransactionsFilter) GetKinds() []TransactionKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+// Transaction - запись журнала: оплата заказа или возврат по ней
+type Transaction struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid        string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`                          // UUID транзакции
+	OrderUuid              string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`                                            // UUID заказа
+	UserUuid               string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`                                               // UUID пользователя
+	PaymentMethod          PaymentMethod          `protobuf:"varint,4,opt,name=payment_method,json=paymentMethod,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"` // Способ оплаты
+	Amount                 int64                  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`                                                                  // Сумма оплаты в минимальных единицах валюты
+	RefundedAmount         int64                  `protobuf:"varint,6,opt,name=refunded_amount,json=refundedAmount,proto3" json:"refunded_amount,omitempty"`                            // Сколько из суммы уже возвращено, в минимальных единицах валюты
+	Status                 TransactionStatus      `protobuf:"varint,7,opt,name=status,proto3,enum=payment.v1.TransactionStatus" json:"status,omitempty"`                                // Статус транзакции
+	CreatedAt              *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                            // Дата создания
+	UpdatedAt              *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                                            // Дата последнего изменения (нет, если не менялась)
+	Currency               string                 `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency,omitempty"`                                                              // Код валюты ISO 4217
+	AuthorizationExpiresAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=authorization_expires_at,json=authorizationExpiresAt,proto3" json:"authorization_expires_at,omitempty"`  // Срок холда (только у транзакций AuthorizePayment)
+	Kind                   TransactionKind        `protobuf:"varint,12,opt,name=kind,proto3,enum=payment.v1.TransactionKind" json:"kind,omitempty"`                                     // Вид транзакции
+	ParentTransactionUuid  string                 `protobuf:"bytes,13,opt,name=parent_transaction_uuid,json=parentTransactionUuid,proto3" json:"parent_transaction_uuid,omitempty"`     // У возврата - UUID оплаты, по которой он проведён
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Transaction) Reset() {
+	*x = Transaction{}
+	mi := &file_payment_v1_payment_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction) ProtoMessage() {}
+
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Transaction) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *Transaction) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *Transaction) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *Transaction) GetPaymentMethod() PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+}
+
+func (x *Transaction) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Transaction) GetRefundedAmount() int64 {
+	if x != nil {
+		return x.RefundedAmount
+	}
+	return 0
+}
+
+func (x *Transaction) GetStatus() TransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED
+}
+
+func (x *Transaction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Transaction) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Transaction) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Transaction) GetAuthorizationExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AuthorizationExpiresAt
+	}
+	return nil
+}
+
+func (x *Transaction) GetKind() TransactionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return TransactionKind_TRANSACTION_KIND_UNSPECIFIED
+}
+
+func (x *Transaction) GetParentTransactionUuid() string {
+	if x != nil {
+		return x.ParentTransactionUuid
+	}
+	return ""
+}
+
+var File_payment_v1_payment_proto protoreflect.FileDescriptor
+
+const file_payment_v1_payment_proto_rawDesc = "" +
+	"\n" +
+	"\x18payment/v1/payment.proto\x12\n" +
+	"payment.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xec\x01\n" +
+	"\x0fPayOrderRequest\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x01 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x02 \x01(\tR\buserUuid\x12@\n" +
+	"\x0epayment_method\x18\x03 \x01(\x0e2\x19.payment.v1.PaymentMethodR\rpaymentMethod\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12'\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tR\x0eidempotencyKey\"q\n" +
+	"\x10PayOrderResponse\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\"\xac\x01\n" +
+	"\x14RefundPaymentRequest\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\"O\n" +
+	"\x15RefundPaymentResponse\x126\n" +
+	"\x17refund_transaction_uuid\x18\x01 \x01(\tR\x15refundTransactionUuid\"\xf4\x01\n" +
+	"\x17AuthorizePaymentRequest\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x01 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x02 \x01(\tR\buserUuid\x12@\n" +
+	"\x0epayment_method\x18\x03 \x01(\x0e2\x19.payment.v1.PaymentMethodR\rpaymentMethod\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12'\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tR\x0eidempotencyKey\"\xb4\x01\n" +
+	"\x18AuthorizePaymentResponse\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"a\n" +
+	"\x15CapturePaymentRequest\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tR\torderUuid\"C\n" +
+	"\x16CapturePaymentResponse\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\"|\n" +
+	"\x18VoidAuthorizationRequest\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"}\n" +
+	"\x19VoidAuthorizationResponse\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\x125\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1d.payment.v1.TransactionStatusR\x06status\"B\n" +
+	"\x15GetTransactionRequest\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\"S\n" +
+	"\x16GetTransactionResponse\x129\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x17.payment.v1.TransactionR\vtransaction\"\x8d\x01\n" +
+	"\x17ListTransactionsRequest\x126\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1e.payment.v1.TransactionsFilterR\x06filter\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x9e\x01\n" +
+	"\x18ListTransactionsResponse\x12;\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x17.payment.v1.TransactionR\ftransactions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"\xb8\x02\n" +
+	"\x12TransactionsFilter\x12\x1b\n" +
+	"\tuser_uuid\x18\x01 \x01(\tR\buserUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tR\torderUuid\x129\n" +
+	"\bstatuses\x18\x03 \x03(\x0e2\x1d.payment.v1.TransactionStatusR\bstatuses\x12=\n" +
+	"\fcreated_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x121\n" +
+	"\x05kinds\x18\x06 \x03(\x0e2\x1b.payment.v1.TransactionKindR\x05kinds\"\xff\x04\n" +
+	"\vTransaction\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x12@\n" +
+	"\x0epayment_method\x18\x04 \x01(\x0e2\x19.payment.v1.PaymentMethodR\rpaymentMethod\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x03R\x06amount\x12'\n" +
+	"\x0frefunded_amount\x18\x06 \x01(\x03R\x0erefundedAmount\x125\n" +
+	"\x06status\x18\a \x01(\x0e2\x1d.payment.v1.TransactionStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
+	"\bcurrency\x18\n" +
+	" \x01(\tR\bcurrency\x12T\n" +
+	"\x18authorization_expires_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x16authorizationExpiresAt\x12/\n" +
+	"\x04kind\x18\f \x01(\x0e2\x1b.payment.v1.TransactionKindR\x04kind\x126\n" +
+	"\x17parent_transaction_uuid\x18\r \x01(\tR\x15parentTransactionUuid*n\n" +
+	"\x0fTransactionKind\x12 \n" +
+	"\x1cTRANSACTION_KIND_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18TRANSACTION_KIND_PAYMENT\x10\x01\x12\x1b\n" +
+	"\x17TRANSACTION_KIND_REFUND\x10\x02*\x88\x03\n" +
+	"\x11TransactionStatus\x12\"\n" +
+	"\x1eTRANSACTION_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cTRANSACTION_STATUS_SUCCEEDED\x10\x01\x12)\n" +
+	"%TRANSACTION_STATUS_PARTIALLY_REFUNDED\x10\x02\x12\x1f\n" +
+	"\x1bTRANSACTION_STATUS_REFUNDED\x10\x03\x12\x1e\n" +
+	"\x1aTRANSACTION_STATUS_PENDING\x10\x04\x12\x1d\n" +
+	"\x19TRANSACTION_STATUS_FAILED\x10\x05\x12!\n" +
+	"\x1dTRANSACTION_STATUS_AUTHORIZED\x10\x06\x12\x1d\n" +
+	"\x19TRANSACTION_STATUS_VOIDED\x10\a\x12\x1e\n" +
+	"\x1aTRANSACTION_STATUS_EXPIRED\x10\b\x12 \n" +
+	"\x1cTRANSACTION_STATUS_CAPTURING\x10\t\x12\x1e\n" +
+	"\x1aTRANSACTION_STATUS_VOIDING\x10\n" +
+	"*\xa3\x01\n" +
 	"\rPaymentMethod\x12\x1e\n" +
 	"\x1aPAYMENT_METHOD_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13PAYMENT_METHOD_CARD\x10\x01\x12\x16\n" +
 	"\x12PAYMENT_METHOD_SBP\x10\x02\x12\x1e\n" +
 	"\x1aPAYMENT_METHOD_CREDIT_CARD\x10\x03\x12!\n" +
-	"\x1dPAYMENT_METHOD_INVESTOR_MONEY\x10\x042W\n" +
+	"\x1dPAYMENT_METHOD_INVESTOR_MONEY\x10\x042\xff\x04\n" +
 	"\x0ePaymentService\x12E\n" +
-	"\bPayOrder\x12\x1b.payment.v1.PayOrderRequest\x1a\x1c.payment.v1.PayOrderResponseBOZMgithub.com/baryshnikkov/rocket-factory/shared/pkg/proto/payment/v1;payment_v1b\x06proto3"
+	"\bPayOrder\x12\x1b.payment.v1.PayOrderRequest\x1a\x1c.payment.v1.PayOrderResponse\x12T\n" +
+	"\rRefundPayment\x12 .payment.v1.RefundPaymentRequest\x1a!.payment.v1.RefundPaymentResponse\x12W\n" +
+	"\x0eGetTransaction\x12!.payment.v1.GetTransactionRequest\x1a\".payment.v1.GetTransactionResponse\x12]\n" +
+	"\x10ListTransactions\x12#.payment.v1.ListTransactionsRequest\x1a$.payment.v1.ListTransactionsResponse\x12]\n" +
+	"\x10AuthorizePayment\x12#.payment.v1.AuthorizePaymentRequest\x1a$.payment.v1.AuthorizePaymentResponse\x12W\n" +
+	"\x0eCapturePayment\x12!.payment.v1.CapturePaymentRequest\x1a\".payment.v1.CapturePaymentResponse\x12`\n" +
+	"\x11VoidAuthorization\x12$.payment.v1.VoidAuthorizationRequest\x1a%.payment.v1.VoidAuthorizationResponseBOZMgithub.com/baryshnikkov/rocket-factory/shared/pkg/proto/payment/v1;payment_v1b\x06proto3"
 
 var (
 	file_payment_v1_payment_proto_rawDescOnce sync.Once
@@ -217,22 +1414,67 @@ func file_payment_v1_payment_proto_rawDescGZIP() []byte {
 	return file_payment_v1_payment_proto_rawDescData
 }
 
-var file_payment_v1_payment_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_payment_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_payment_v1_payment_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_payment_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_payment_v1_payment_proto_goTypes = []any{
-	(PaymentMethod)(0),       // 0: payment.v1.PaymentMethod
-	(*PayOrderRequest)(nil),  // 1: payment.v1.PayOrderRequest
-	(*PayOrderResponse)(nil), // 2: payment.v1.PayOrderResponse
+	(TransactionKind)(0),              // 0: payment.v1.TransactionKind
+	(TransactionStatus)(0),            // 1: payment.v1.TransactionStatus
+	(PaymentMethod)(0),                // 2: payment.v1.PaymentMethod
+	(*PayOrderRequest)(nil),           // 3: payment.v1.PayOrderRequest
+	(*PayOrderResponse)(nil),          // 4: payment.v1.PayOrderResponse
+	(*RefundPaymentRequest)(nil),      // 5: payment.v1.RefundPaymentRequest
+	(*RefundPaymentResponse)(nil),     // 6: payment.v1.RefundPaymentResponse
+	(*AuthorizePaymentRequest)(nil),   // 7: payment.v1.AuthorizePaymentRequest
+	(*AuthorizePaymentResponse)(nil),  // 8: payment.v1.AuthorizePaymentResponse
+	(*CapturePaymentRequest)(nil),     // 9: payment.v1.CapturePaymentRequest
+	(*CapturePaymentResponse)(nil),    // 10: payment.v1.CapturePaymentResponse
+	(*VoidAuthorizationRequest)(nil),  // 11: payment.v1.VoidAuthorizationRequest
+	(*VoidAuthorizationResponse)(nil), // 12: payment.v1.VoidAuthorizationResponse
+	(*GetTransactionRequest)(nil),     // 13: payment.v1.GetTransactionRequest
+	(*GetTransactionResponse)(nil),    // 14: payment.v1.GetTransactionResponse
+	(*ListTransactionsRequest)(nil),   // 15: payment.v1.ListTransactionsRequest
+	(*ListTransactionsResponse)(nil),  // 16: payment.v1.ListTransactionsResponse
+	(*TransactionsFilter)(nil),        // 17: payment.v1.TransactionsFilter
+	(*Transaction)(nil),               // 18: payment.v1.Transaction
+	(*timestamppb.Timestamp)(nil),     // 19: google.protobuf.Timestamp
 }
 var file_payment_v1_payment_proto_depIdxs = []int32{
-	0, // 0: payment.v1.PayOrderRequest.payment_method:type_name -> payment.v1.PaymentMethod
-	1, // 1: payment.v1.PaymentService.PayOrder:input_type -> payment.v1.PayOrderRequest
-	2, // 2: payment.v1.PaymentService.PayOrder:output_type -> payment.v1.PayOrderResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2,  // 0: payment.v1.PayOrderRequest.payment_method:type_name -> payment.v1.PaymentMethod
+	2,  // 1: payment.v1.AuthorizePaymentRequest.payment_method:type_name -> payment.v1.PaymentMethod
+	19, // 2: payment.v1.AuthorizePaymentResponse.expires_at:type_name -> google.protobuf.Timestamp
+	1,  // 3: payment.v1.VoidAuthorizationResponse.status:type_name -> payment.v1.TransactionStatus
+	18, // 4: payment.v1.GetTransactionResponse.transaction:type_name -> payment.v1.Transaction
+	17, // 5: payment.v1.ListTransactionsRequest.filter:type_name -> payment.v1.TransactionsFilter
+	18, // 6: payment.v1.ListTransactionsResponse.transactions:type_name -> payment.v1.Transaction
+	1,  // 7: payment.v1.TransactionsFilter.statuses:type_name -> payment.v1.TransactionStatus
+	19, // 8: payment.v1.TransactionsFilter.created_from:type_name -> google.protobuf.Timestamp
+	19, // 9: payment.v1.TransactionsFilter.created_to:type_name -> google.protobuf.Timestamp
+	0,  // 10: payment.v1.TransactionsFilter.kinds:type_name -> payment.v1.TransactionKind
+	2,  // 11: payment.v1.Transaction.payment_method:type_name -> payment.v1.PaymentMethod
+	1,  // 12: payment.v1.Transaction.status:type_name -> payment.v1.TransactionStatus
+	19, // 13: payment.v1.Transaction.created_at:type_name -> google.protobuf.Timestamp
+	19, // 14: payment.v1.Transaction.updated_at:type_name -> google.protobuf.Timestamp
+	19, // 15: payment.v1.Transaction.authorization_expires_at:type_name -> google.protobuf.Timestamp
+	0,  // 16: payment.v1.Transaction.kind:type_name -> payment.v1.TransactionKind
+	3,  // 17: payment.v1.PaymentService.PayOrder:input_type -> payment.v1.PayOrderRequest
+	5,  // 18: payment.v1.PaymentService.RefundPayment:input_type -> payment.v1.RefundPaymentRequest
+	13, // 19: payment.v1.PaymentService.GetTransaction:input_type -> payment.v1.GetTransactionRequest
+	15, // 20: payment.v1.PaymentService.ListTransactions:input_type -> payment.v1.ListTransactionsRequest
+	7,  // 21: payment.v1.PaymentService.AuthorizePayment:input_type -> payment.v1.AuthorizePaymentRequest
+	9,  // 22: payment.v1.PaymentService.CapturePayment:input_type -> payment.v1.CapturePaymentRequest
+	11, // 23: payment.v1.PaymentService.VoidAuthorization:input_type -> payment.v1.VoidAuthorizationRequest
+	4,  // 24: payment.v1.PaymentService.PayOrder:output_type -> payment.v1.PayOrderResponse
+	6,  // 25: payment.v1.PaymentService.RefundPayment:output_type -> payment.v1.RefundPaymentResponse
+	14, // 26: payment.v1.PaymentService.GetTransaction:output_type -> payment.v1.GetTransactionResponse
+	16, // 27: payment.v1.PaymentService.ListTransactions:output_type -> payment.v1.ListTransactionsResponse
+	8,  // 28: payment.v1.PaymentService.AuthorizePayment:output_type -> payment.v1.AuthorizePaymentResponse
+	10, // 29: payment.v1.PaymentService.CapturePayment:output_type -> payment.v1.CapturePaymentResponse
+	12, // 30: payment.v1.PaymentService.VoidAuthorization:output_type -> payment.v1.VoidAuthorizationResponse
+	24, // [24:31] is the sub-list for method output_type
+	17, // [17:24] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_payment_v1_payment_proto_init() }
@@ -245,8 +1487,8 @@ func file_payment_v1_payment_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_payment_v1_payment_proto_rawDesc), len(file_payment_v1_payment_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   2,
+			NumEnums:      3,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -63,6 +63,12 @@ func (m *PayOrderRequest) validate(all bool) error {
 
 	// no validation rules for PaymentMethod
 
+	// no validation rules for Amount
+
+	// no validation rules for Currency
+
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return PayOrderRequestMultiError(errors)
 	}
@@ -165,6 +171,10 @@ func (m *PayOrderResponse) validate(all bool) error {
 
 	// no validation rules for TransactionUuid
 
+	// no validation rules for Amount
+
+	// no validation rules for Currency
+
 	if len(errors) > 0 {
 		return PayOrderResponseMultiError(errors)
 	}
@@ -242,3 +252,1774 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = PayOrderResponseValidationError{}
+
+// Validate checks the field values on RefundPaymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefundPaymentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefundPaymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefundPaymentRequestMultiError, or nil if none found.
+func (m *RefundPaymentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefundPaymentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TransactionUuid
+
+	// no validation rules for OrderUuid
+
+	// no validation rules for Amount
+
+	// no validation rules for Reason
+
+	// no validation rules for Currency
+
+	if len(errors) > 0 {
+		return RefundPaymentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefundPaymentRequestMultiError is an error wrapping multiple validation
+// errors returned by RefundPaymentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RefundPaymentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefundPaymentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefundPaymentRequestMultiError) AllErrors() []error { return m }
+
+// RefundPaymentRequestValidationError is the validation error returned by
+// RefundPaymentRequest.Validate if the designated constraints aren't met.
+type RefundPaymentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefundPaymentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefundPaymentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefundPaymentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefundPaymentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefundPaymentRequestValidationError) ErrorName() string {
+	return "RefundPaymentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefundPaymentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefundPaymentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefundPaymentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefundPaymentRequestValidationError{}
+
+// Validate checks the field values on RefundPaymentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefundPaymentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefundPaymentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefundPaymentResponseMultiError, or nil if none found.
+func (m *RefundPaymentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefundPaymentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RefundTransactionUuid
+
+	if len(errors) > 0 {
+		return RefundPaymentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefundPaymentResponseMultiError is an error wrapping multiple validation
+// errors returned by RefundPaymentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RefundPaymentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefundPaymentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefundPaymentResponseMultiError) AllErrors() []error { return m }
+
+// RefundPaymentResponseValidationError is the validation error returned by
+// RefundPaymentResponse.Validate if the designated constraints aren't met.
+type RefundPaymentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefundPaymentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefundPaymentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefundPaymentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefundPaymentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefundPaymentResponseValidationError) ErrorName() string {
+	return "RefundPaymentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefundPaymentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefundPaymentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefundPaymentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefundPaymentResponseValidationError{}
+
+// Validate checks the field values on AuthorizePaymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthorizePaymentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorizePaymentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthorizePaymentRequestMultiError, or nil if none found.
+func (m *AuthorizePaymentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorizePaymentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderUuid
+
+	// no validation rules for UserUuid
+
+	// no validation rules for PaymentMethod
+
+	// no validation rules for Amount
+
+	// no validation rules for Currency
+
+	// no validation rules for IdempotencyKey
+
+	if len(errors) > 0 {
+		return AuthorizePaymentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorizePaymentRequestMultiError is an error wrapping multiple validation
+// errors returned by AuthorizePaymentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AuthorizePaymentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorizePaymentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorizePaymentRequestMultiError) AllErrors() []error { return m }
+
+// AuthorizePaymentRequestValidationError is the validation error returned by
+// AuthorizePaymentRequest.Validate if the designated constraints aren't met.
+type AuthorizePaymentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorizePaymentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorizePaymentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorizePaymentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorizePaymentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorizePaymentRequestValidationError) ErrorName() string {
+	return "AuthorizePaymentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthorizePaymentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorizePaymentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorizePaymentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorizePaymentRequestValidationError{}
+
+// Validate checks the field values on AuthorizePaymentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthorizePaymentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorizePaymentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthorizePaymentResponseMultiError, or nil if none found.
+func (m *AuthorizePaymentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorizePaymentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TransactionUuid
+
+	// no validation rules for Amount
+
+	// no validation rules for Currency
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthorizePaymentResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthorizePaymentResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthorizePaymentResponseValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AuthorizePaymentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorizePaymentResponseMultiError is an error wrapping multiple validation
+// errors returned by AuthorizePaymentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AuthorizePaymentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorizePaymentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorizePaymentResponseMultiError) AllErrors() []error { return m }
+
+// AuthorizePaymentResponseValidationError is the validation error returned by
+// AuthorizePaymentResponse.Validate if the designated constraints aren't met.
+type AuthorizePaymentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorizePaymentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorizePaymentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorizePaymentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorizePaymentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorizePaymentResponseValidationError) ErrorName() string {
+	return "AuthorizePaymentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthorizePaymentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorizePaymentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorizePaymentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorizePaymentResponseValidationError{}
+
+// Validate checks the field values on CapturePaymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CapturePaymentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CapturePaymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CapturePaymentRequestMultiError, or nil if none found.
+func (m *CapturePaymentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CapturePaymentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TransactionUuid
+
+	// no validation rules for OrderUuid
+
+	if len(errors) > 0 {
+		return CapturePaymentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CapturePaymentRequestMultiError is an error wrapping multiple validation
+// errors returned by CapturePaymentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CapturePaymentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CapturePaymentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CapturePaymentRequestMultiError) AllErrors() []error { return m }
+
+// CapturePaymentRequestValidationError is the validation error returned by
+// CapturePaymentRequest.Validate if the designated constraints aren't met.
+type CapturePaymentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CapturePaymentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CapturePaymentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CapturePaymentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CapturePaymentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CapturePaymentRequestValidationError) ErrorName() string {
+	return "CapturePaymentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CapturePaymentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCapturePaymentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CapturePaymentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CapturePaymentRequestValidationError{}
+
+// Validate checks the field values on CapturePaymentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CapturePaymentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CapturePaymentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CapturePaymentResponseMultiError, or nil if none found.
+func (m *CapturePaymentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CapturePaymentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TransactionUuid
+
+	if len(errors) > 0 {
+		return CapturePaymentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CapturePaymentResponseMultiError is an error wrapping multiple validation
+// errors returned by CapturePaymentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CapturePaymentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CapturePaymentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CapturePaymentResponseMultiError) AllErrors() []error { return m }
+
+// CapturePaymentResponseValidationError is the validation error returned by
+// CapturePaymentResponse.Validate if the designated constraints aren't met.
+type CapturePaymentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CapturePaymentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CapturePaymentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CapturePaymentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CapturePaymentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CapturePaymentResponseValidationError) ErrorName() string {
+	return "CapturePaymentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CapturePaymentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCapturePaymentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CapturePaymentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CapturePaymentResponseValidationError{}
+
+// Validate checks the field values on VoidAuthorizationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VoidAuthorizationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VoidAuthorizationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VoidAuthorizationRequestMultiError, or nil if none found.
+func (m *VoidAuthorizationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VoidAuthorizationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TransactionUuid
+
+	// no validation rules for OrderUuid
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return VoidAuthorizationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VoidAuthorizationRequestMultiError is an error wrapping multiple validation
+// errors returned by VoidAuthorizationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type VoidAuthorizationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VoidAuthorizationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VoidAuthorizationRequestMultiError) AllErrors() []error { return m }
+
+// VoidAuthorizationRequestValidationError is the validation error returned by
+// VoidAuthorizationRequest.Validate if the designated constraints aren't met.
+type VoidAuthorizationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VoidAuthorizationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VoidAuthorizationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VoidAuthorizationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VoidAuthorizationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VoidAuthorizationRequestValidationError) ErrorName() string {
+	return "VoidAuthorizationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VoidAuthorizationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVoidAuthorizationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VoidAuthorizationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VoidAuthorizationRequestValidationError{}
+
+// Validate checks the field values on VoidAuthorizationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VoidAuthorizationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VoidAuthorizationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VoidAuthorizationResponseMultiError, or nil if none found.
+func (m *VoidAuthorizationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VoidAuthorizationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TransactionUuid
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return VoidAuthorizationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VoidAuthorizationResponseMultiError is an error wrapping multiple validation
+// errors returned by VoidAuthorizationResponse.ValidateAll() if the
+// designated constraints aren't met.
+type VoidAuthorizationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VoidAuthorizationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VoidAuthorizationResponseMultiError) AllErrors() []error { return m }
+
+// VoidAuthorizationResponseValidationError is the validation error returned by
+// VoidAuthorizationResponse.Validate if the designated constraints aren't met.
+type VoidAuthorizationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VoidAuthorizationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VoidAuthorizationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VoidAuthorizationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VoidAuthorizationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VoidAuthorizationResponseValidationError) ErrorName() string {
+	return "VoidAuthorizationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VoidAuthorizationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVoidAuthorizationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VoidAuthorizationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VoidAuthorizationResponseValidationError{}
+
+// Validate checks the field values on GetTransactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTransactionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTransactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTransactionRequestMultiError, or nil if none found.
+func (m *GetTransactionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTransactionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TransactionUuid
+
+	if len(errors) > 0 {
+		return GetTransactionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTransactionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetTransactionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetTransactionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTransactionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTransactionRequestMultiError) AllErrors() []error { return m }
+
+// GetTransactionRequestValidationError is the validation error returned by
+// GetTransactionRequest.Validate if the designated constraints aren't met.
+type GetTransactionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTransactionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTransactionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTransactionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTransactionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTransactionRequestValidationError) ErrorName() string {
+	return "GetTransactionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTransactionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTransactionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTransactionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTransactionRequestValidationError{}
+
+// Validate checks the field values on GetTransactionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTransactionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTransactionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTransactionResponseMultiError, or nil if none found.
+func (m *GetTransactionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTransactionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTransaction()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTransactionResponseValidationError{
+					field:  "Transaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTransactionResponseValidationError{
+					field:  "Transaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransaction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTransactionResponseValidationError{
+				field:  "Transaction",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetTransactionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTransactionResponseMultiError is an error wrapping multiple validation
+// errors returned by GetTransactionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetTransactionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTransactionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTransactionResponseMultiError) AllErrors() []error { return m }
+
+// GetTransactionResponseValidationError is the validation error returned by
+// GetTransactionResponse.Validate if the designated constraints aren't met.
+type GetTransactionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTransactionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTransactionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTransactionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTransactionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTransactionResponseValidationError) ErrorName() string {
+	return "GetTransactionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTransactionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTransactionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTransactionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTransactionResponseValidationError{}
+
+// Validate checks the field values on ListTransactionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTransactionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTransactionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTransactionsRequestMultiError, or nil if none found.
+func (m *ListTransactionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTransactionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListTransactionsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListTransactionsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListTransactionsRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListTransactionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTransactionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTransactionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTransactionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTransactionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTransactionsRequestMultiError) AllErrors() []error { return m }
+
+// ListTransactionsRequestValidationError is the validation error returned by
+// ListTransactionsRequest.Validate if the designated constraints aren't met.
+type ListTransactionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTransactionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTransactionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTransactionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTransactionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTransactionsRequestValidationError) ErrorName() string {
+	return "ListTransactionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTransactionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTransactionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTransactionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTransactionsRequestValidationError{}
+
+// Validate checks the field values on ListTransactionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTransactionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTransactionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTransactionsResponseMultiError, or nil if none found.
+func (m *ListTransactionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTransactionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTransactions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTransactionsResponseValidationError{
+						field:  fmt.Sprintf("Transactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTransactionsResponseValidationError{
+						field:  fmt.Sprintf("Transactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTransactionsResponseValidationError{
+					field:  fmt.Sprintf("Transactions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for TotalSize
+
+	if len(errors) > 0 {
+		return ListTransactionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTransactionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListTransactionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListTransactionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTransactionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTransactionsResponseMultiError) AllErrors() []error { return m }
+
+// ListTransactionsResponseValidationError is the validation error returned by
+// ListTransactionsResponse.Validate if the designated constraints aren't met.
+type ListTransactionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTransactionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTransactionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTransactionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTransactionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTransactionsResponseValidationError) ErrorName() string {
+	return "ListTransactionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTransactionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTransactionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTransactionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTransactionsResponseValidationError{}
+
+// Validate checks the field values on TransactionsFilter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TransactionsFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransactionsFilter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransactionsFilterMultiError, or nil if none found.
+func (m *TransactionsFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransactionsFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserUuid
+
+	// no validation rules for OrderUuid
+
+	if all {
+		switch v := interface{}(m.GetCreatedFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransactionsFilterValidationError{
+					field:  "CreatedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransactionsFilterValidationError{
+					field:  "CreatedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransactionsFilterValidationError{
+				field:  "CreatedFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransactionsFilterValidationError{
+					field:  "CreatedTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransactionsFilterValidationError{
+					field:  "CreatedTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransactionsFilterValidationError{
+				field:  "CreatedTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TransactionsFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransactionsFilterMultiError is an error wrapping multiple validation errors
+// returned by TransactionsFilter.ValidateAll() if the designated constraints
+// aren't met.
+type TransactionsFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransactionsFilterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransactionsFilterMultiError) AllErrors() []error { return m }
+
+// TransactionsFilterValidationError is the validation error returned by
+// TransactionsFilter.Validate if the designated constraints aren't met.
+type TransactionsFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransactionsFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransactionsFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransactionsFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransactionsFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransactionsFilterValidationError) ErrorName() string {
+	return "TransactionsFilterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TransactionsFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransactionsFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransactionsFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransactionsFilterValidationError{}
+
+// Validate checks the field values on Transaction with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Transaction) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Transaction with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TransactionMultiError, or
+// nil if none found.
+func (m *Transaction) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Transaction) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TransactionUuid
+
+	// no validation rules for OrderUuid
+
+	// no validation rules for UserUuid
+
+	// no validation rules for PaymentMethod
+
+	// no validation rules for Amount
+
+	// no validation rules for RefundedAmount
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransactionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransactionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransactionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransactionValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransactionValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransactionValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Currency
+
+	if all {
+		switch v := interface{}(m.GetAuthorizationExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransactionValidationError{
+					field:  "AuthorizationExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransactionValidationError{
+					field:  "AuthorizationExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuthorizationExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransactionValidationError{
+				field:  "AuthorizationExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Kind
+
+	// no validation rules for ParentTransactionUuid
+
+	if len(errors) > 0 {
+		return TransactionMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransactionMultiError is an error wrapping multiple validation errors
+// returned by Transaction.ValidateAll() if the designated constraints aren't met.
+type TransactionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransactionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransactionMultiError) AllErrors() []error { return m }
+
+// TransactionValidationError is the validation error returned by
+// Transaction.Validate if the designated constraints aren't met.
+type TransactionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransactionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransactionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransactionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransactionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransactionValidationError) ErrorName() string { return "TransactionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TransactionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransaction.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransactionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransactionValidationError{}